@@ -0,0 +1,133 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/radius-project/radius/pkg/cli"
+	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/cli/cmd/commonflags"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/spf13/cobra"
+)
+
+var resourceExecCmd = &cobra.Command{
+	Use:   "exec [type] [resource] [-- command]",
+	Short: "Run a command in a running containers resource",
+	Long: `Runs a command in a running resource. Currently only supports the resource type 'Applications.Core/containers' running on Kubernetes.
+This command allows you to open an interactive shell in a deployed container, or to run a single command and output its result to the local console.
+
+'rad resource exec' runs the command in a running replica of the resource, which is found from the Kubernetes deployment of the resource. Use the '--replica \<name\>' option to choose the replica, and the '--container \<name\>' option to choose a sidecar container.
+
+The command defaults to 'sh'. Specify the command after '--'. Use the '--tty' option for an interactive session, and exit the session to terminate the command.`,
+	Example: `# open an interactive shell in the 'orders' resource of the 'icecream-store' application
+rad resource exec containers orders --application icecream-store -it
+
+# list the files of the working directory of the 'orders' resource
+rad resource exec containers orders --application icecream-store -- ls -la
+
+# open an interactive shell in the 'daprd' sidecar container of the 'orders' resource
+rad resource exec containers orders --application icecream-store --container daprd -it`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		workspace, err := cli.RequireWorkspace(cmd, ConfigFromContext(cmd.Context()), DirectoryConfigFromContext(cmd.Context()))
+		if err != nil {
+			return err
+		}
+
+		scope, err := cli.RequireScope(cmd, *workspace)
+		if err != nil {
+			return err
+		}
+		workspace.Scope = scope
+
+		application, err := cli.RequireApplication(cmd, *workspace)
+		if err != nil {
+			return err
+		}
+
+		// The arguments after '--' are the command.
+		var command []string
+		if dash := cmd.ArgsLenAtDash(); dash >= 0 {
+			command = args[dash:]
+			args = args[:dash]
+		}
+
+		resourceType, resourceName, err := cli.RequireResource(cmd, args)
+		if err != nil {
+			return err
+		}
+		if !strings.EqualFold(resourceType, ContainerType) {
+			return fmt.Errorf("only %s is supported", ContainerType)
+		}
+
+		container, err := cmd.Flags().GetString("container")
+		if err != nil {
+			return err
+		}
+
+		replica, err := cmd.Flags().GetString("replica")
+		if err != nil {
+			return err
+		}
+
+		stdin, err := cmd.Flags().GetBool("stdin")
+		if err != nil {
+			return err
+		}
+
+		tty, err := cmd.Flags().GetBool("tty")
+		if err != nil {
+			return err
+		}
+
+		var client clients.DiagnosticsClient
+		client, err = connections.DefaultFactory.CreateDiagnosticsClient(cmd.Context(), *workspace)
+		if err != nil {
+			return err
+		}
+
+		options := clients.ExecOptions{
+			Application: application,
+			Resource:    resourceName,
+			Container:   container,
+			Replica:     replica,
+			Command:     command,
+			TTY:         tty,
+			Stdout:      os.Stdout,
+			Stderr:      os.Stderr,
+		}
+
+		// An interactive session always reads stdin.
+		if stdin || tty {
+			options.Stdin = os.Stdin
+		}
+
+		return client.Exec(cmd.Context(), options)
+	},
+}
+
+func init() {
+	resourceExecCmd.Flags().String("container", "", "specify the container in which the command runs")
+	resourceExecCmd.Flags().String("replica", "", "specify the replica in which the command runs")
+	resourceExecCmd.Flags().BoolP("stdin", "i", false, "specify that stdin is passed to the command")
+	resourceExecCmd.Flags().BoolP("tty", "t", false, "specify that the command runs in an interactive terminal")
+	commonflags.AddResourceGroupFlag(resourceExecCmd)
+	resourceCmd.AddCommand(resourceExecCmd)
+}
@@ -27,6 +27,7 @@ import (
 	"github.com/radius-project/radius/pkg/cli/clierrors"
 	"github.com/radius-project/radius/pkg/cli/cmd/commonflags"
 	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/deploy"
 	"github.com/spf13/cobra"
 )
 
@@ -42,10 +43,15 @@ var resourceExposeCmd = &cobra.Command{
 	Long: `Exposes a port inside a resource for network traffic using a local port.
 This command is useful for testing resources that accept network traffic but are not exposed to the public internet. Exposing a port for testing allows you to send TCP traffic from your local machine to the resource.
 
-Press CTRL+C to exit the command and terminate the tunnel.`,
+Press CTRL+C to exit the command and terminate the tunnel.
+
+Specify the '--public' option to expose the resource through a public endpoint instead. This deploys a gateway named '<resource>-public' which routes traffic to the port of the resource, and prints the URL of the gateway. Delete the gateway with 'rad resource delete gateways <resource>-public' when it is no longer needed.`,
 	Example: `# expose port 80 on the 'orders' resource of the 'icecream-store' application
 # on local port 5000
-rad resource expose --application icecream-store containers orders --port 5000 --remote-port 80`,
+rad resource expose --application icecream-store containers orders --port 5000 --remote-port 80
+
+# expose port 80 on the 'orders' resource of the 'icecream-store' application through a public endpoint
+rad resource expose --application icecream-store containers orders --port 80 --public`,
 	RunE: func(cmd *cobra.Command, args []string) error {
 		workspace, err := cli.RequireWorkspace(cmd, ConfigFromContext(cmd.Context()), DirectoryConfigFromContext(cmd.Context()))
 		if err != nil {
@@ -123,6 +129,24 @@ rad resource expose --application icecream-store containers orders --port 5000 -
 			remotePort = localPort
 		}
 
+		public, err := cmd.Flags().GetBool("public")
+		if err != nil {
+			return err
+		}
+
+		if public {
+			applicationID := workspace.Scope + "/providers/Applications.Core/applications/" + application
+			_, err = deploy.DeployWithProgress(cmd.Context(), deploy.Options{
+				ConnectionFactory: connections.DefaultFactory,
+				Workspace:         *workspace,
+				Template:          publicGatewayTemplate(applicationID, resourceName+"-public", resourceName, remotePort),
+				Parameters:        clients.DeploymentParameters{},
+				ProgressText:      fmt.Sprintf("Exposing resource '%v' of application '%v' through a public endpoint...", resourceName, application),
+				CompletionText:    "Expose Complete",
+			})
+			return err
+		}
+
 		var client clients.DiagnosticsClient
 		client, err = connections.DefaultFactory.CreateDiagnosticsClient(cmd.Context(), *workspace)
 
@@ -160,12 +184,47 @@ rad resource expose --application icecream-store containers orders --port 5000 -
 	},
 }
 
+// publicGatewayTemplate returns an ARM-JSON template of a gateway which routes all traffic to the port of the container.
+func publicGatewayTemplate(applicationID string, gatewayName string, containerName string, port int) map[string]any {
+	return map[string]any{
+		"$schema":         "https://schema.management.azure.com/schemas/2019-04-01/deploymentTemplate.json#",
+		"languageVersion": "1.9-experimental",
+		"contentVersion":  "1.0.0.0",
+		"imports": map[string]any{
+			"radius": map[string]any{
+				"provider": "Radius",
+				"version":  "1.0",
+			},
+		},
+		"resources": map[string]any{
+			"gateway": map[string]any{
+				"import": "radius",
+				"type":   "Applications.Core/gateways@2023-10-01-preview",
+				"properties": map[string]any{
+					"name":     gatewayName,
+					"location": "global",
+					"properties": map[string]any{
+						"application": applicationID,
+						"routes": []any{
+							map[string]any{
+								"path":        "/",
+								"destination": fmt.Sprintf("http://%s:%d", containerName, port),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func init() {
 	resourceExposeCmd.PersistentFlags().StringP("type", "t", "", "The resource type")
 	resourceExposeCmd.PersistentFlags().StringP("resource", "r", "", "The resource name")
 	resourceExposeCmd.Flags().IntP("remote-port", "", -1, "specify the remote port")
 	resourceExposeCmd.Flags().String("replica", "", "specify the replica to expose")
 	resourceExposeCmd.Flags().IntP("port", "p", -1, "specify the local port")
+	resourceExposeCmd.Flags().Bool("public", false, "specify that the resource is exposed through a public gateway instead of a local port")
 	commonflags.AddResourceGroupFlag(resourceExposeCmd)
 	err := resourceExposeCmd.MarkFlagRequired("port")
 	if err != nil {
@@ -0,0 +1,140 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"os/signal"
+	"strconv"
+	"strings"
+
+	"github.com/radius-project/radius/pkg/cli"
+	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/cli/cmd/commonflags"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/spf13/cobra"
+)
+
+var resourcePortForwardCmd = &cobra.Command{
+	Use:   "port-forward [type] [resource]",
+	Short: "Forward local ports to a running containers resource",
+	Long: `Forwards local ports to the ports of a running resource. Currently only supports the resource type 'Applications.Core/containers' running on Kubernetes.
+This command is useful for testing resources that accept network traffic but are not exposed to the public internet.
+
+'rad resource port-forward' forwards the ports declared by the resource to the same local ports. Use the '--port' option to specify the ports as '<port>' or '<local port>:<remote port>'.
+
+Traffic is forwarded to a healthy replica of the resource. Use the '--replica \<name\>' option to choose the replica.
+
+Press CTRL+C to exit the command and terminate the tunnel.`,
+	Example: `# forward the ports declared by the 'orders' resource of the 'icecream-store' application
+rad resource port-forward containers orders --application icecream-store
+
+# forward local port 5000 to port 80 of the 'orders' resource
+rad resource port-forward containers orders --application icecream-store --port 5000:80`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		workspace, err := cli.RequireWorkspace(cmd, ConfigFromContext(cmd.Context()), DirectoryConfigFromContext(cmd.Context()))
+		if err != nil {
+			return err
+		}
+
+		scope, err := cli.RequireScope(cmd, *workspace)
+		if err != nil {
+			return err
+		}
+		workspace.Scope = scope
+
+		application, err := cli.RequireApplication(cmd, *workspace)
+		if err != nil {
+			return err
+		}
+
+		resourceType, resourceName, err := cli.RequireResource(cmd, args)
+		if err != nil {
+			return err
+		}
+		if !strings.EqualFold(resourceType, ContainerType) {
+			return fmt.Errorf("only %s is supported", ContainerType)
+		}
+
+		values, err := cmd.Flags().GetStringSlice("port")
+		if err != nil {
+			return err
+		}
+
+		ports := []clients.PortMapping{}
+		for _, value := range values {
+			mapping, err := parsePortMapping(value)
+			if err != nil {
+				return err
+			}
+			ports = append(ports, mapping)
+		}
+
+		replica, err := cmd.Flags().GetString("replica")
+		if err != nil {
+			return err
+		}
+
+		var client clients.DiagnosticsClient
+		client, err = connections.DefaultFactory.CreateDiagnosticsClient(cmd.Context(), *workspace)
+		if err != nil {
+			return err
+		}
+
+		ctx, stop := signal.NotifyContext(cmd.Context(), os.Interrupt)
+		defer stop()
+
+		err = client.PortForward(ctx, clients.PortForwardOptions{
+			Application: application,
+			Resource:    resourceName,
+			Replica:     replica,
+			Ports:       ports})
+		if err != nil {
+			return fmt.Errorf("failed to port-forward: %w", err)
+		}
+
+		return nil
+	},
+}
+
+// parsePortMapping parses a port mapping of the form '<port>' or '<local port>:<remote port>'.
+func parsePortMapping(value string) (clients.PortMapping, error) {
+	local, remote, found := strings.Cut(value, ":")
+	if !found {
+		remote = local
+	}
+
+	localPort, err := strconv.Atoi(local)
+	if err != nil {
+		return clients.PortMapping{}, fmt.Errorf("invalid port %q: %w", value, err)
+	}
+
+	remotePort, err := strconv.Atoi(remote)
+	if err != nil {
+		return clients.PortMapping{}, fmt.Errorf("invalid port %q: %w", value, err)
+	}
+
+	return clients.PortMapping{LocalPort: localPort, RemotePort: remotePort}, nil
+}
+
+func init() {
+	resourcePortForwardCmd.Flags().StringSliceP("port", "p", nil, "specify the ports to forward as '<port>' or '<local port>:<remote port>'")
+	resourcePortForwardCmd.Flags().String("replica", "", "specify the replica to forward to")
+	commonflags.AddResourceGroupFlag(resourcePortForwardCmd)
+	resourceCmd.AddCommand(resourcePortForwardCmd)
+}
@@ -36,6 +36,8 @@ import (
 	app_show "github.com/radius-project/radius/pkg/cli/cmd/app/show"
 	app_status "github.com/radius-project/radius/pkg/cli/cmd/app/status"
 	bicep_publish "github.com/radius-project/radius/pkg/cli/cmd/bicep/publish"
+	"github.com/radius-project/radius/pkg/cli/cmd/bundle"
+	"github.com/radius-project/radius/pkg/cli/cmd/container"
 	credential "github.com/radius-project/radius/pkg/cli/cmd/credential"
 	cmd_deploy "github.com/radius-project/radius/pkg/cli/cmd/deploy"
 	env_create "github.com/radius-project/radius/pkg/cli/cmd/env/create"
@@ -48,6 +50,7 @@ import (
 	group "github.com/radius-project/radius/pkg/cli/cmd/group"
 	"github.com/radius-project/radius/pkg/cli/cmd/install"
 	install_kubernetes "github.com/radius-project/radius/pkg/cli/cmd/install/kubernetes"
+	"github.com/radius-project/radius/pkg/cli/cmd/plane"
 	"github.com/radius-project/radius/pkg/cli/cmd/radinit"
 	recipe_list "github.com/radius-project/radius/pkg/cli/cmd/recipe/list"
 	recipe_register "github.com/radius-project/radius/pkg/cli/cmd/recipe/register"
@@ -64,11 +67,13 @@ import (
 	workspace_list "github.com/radius-project/radius/pkg/cli/cmd/workspace/list"
 	workspace_show "github.com/radius-project/radius/pkg/cli/cmd/workspace/show"
 	workspace_switch "github.com/radius-project/radius/pkg/cli/cmd/workspace/switch"
+	"github.com/radius-project/radius/pkg/cli/completion"
 	"github.com/radius-project/radius/pkg/cli/config"
 	"github.com/radius-project/radius/pkg/cli/connections"
 	"github.com/radius-project/radius/pkg/cli/deploy"
 	"github.com/radius-project/radius/pkg/cli/framework"
 	"github.com/radius-project/radius/pkg/cli/helm"
+	"github.com/radius-project/radius/pkg/cli/httptrace"
 	"github.com/radius-project/radius/pkg/cli/kubernetes"
 	"github.com/radius-project/radius/pkg/cli/kubernetes/logstream"
 	"github.com/radius-project/radius/pkg/cli/kubernetes/portforward"
@@ -107,6 +112,12 @@ var workspaceCmd = NewWorkspaceCommand()
 var ConfigHolderKey = framework.NewContextKey("config")
 var ConfigHolder = &framework.ConfigHolder{}
 
+// httpTraceOptions holds the values of the flags controlling the HTTP trace of the CLI invocation.
+var httpTraceOptions struct {
+	Debug bool
+	File  string
+}
+
 func prettyPrintRPError(err error) string {
 	if new := clientv2.TryUnfoldResponseError(err); new != nil {
 		m, err := prettyPrintJSON(new)
@@ -151,6 +162,10 @@ func Execute() error {
 	ctx, span := tr.Start(ctx, spanName)
 	defer span.End()
 	err = RootCmd.ExecuteContext(ctx)
+
+	// Print the trace summary after the output of the command and its error.
+	defer printHTTPTrace()
+
 	if clierrors.IsFriendlyError(err) {
 		fmt.Println(err.Error())
 		fmt.Println("") // Output an extra blank line for readability
@@ -173,12 +188,14 @@ func Execute() error {
 }
 
 func init() {
-	cobra.OnInitialize(initConfig)
+	cobra.OnInitialize(initConfig, initHTTPTrace)
 
 	// Must set the default logger to use controller-runtime.
 	runtimelog.SetLogger(zap.New())
 
 	RootCmd.PersistentFlags().StringVar(&ConfigHolder.ConfigFilePath, "config", "", "config file (default \"$HOME/.rad/config.yaml\")")
+	RootCmd.PersistentFlags().BoolVar(&httpTraceOptions.Debug, "debug", false, "record the HTTP requests and responses of the command to a sanitized trace file (default \"$HOME/.rad/traces/rad-<time>.jsonl\")")
+	RootCmd.PersistentFlags().StringVar(&httpTraceOptions.File, "trace-file", "", "the trace file of '--debug'. Implies '--debug'")
 
 	outputDescription := fmt.Sprintf("output format (supported formats are %s)", strings.Join(output.SupportedFormats(), ", "))
 	RootCmd.PersistentFlags().StringP("output", "o", output.DefaultFormat, outputDescription)
@@ -238,6 +255,15 @@ func initSubCommands() {
 	groupCmd := group.NewCommand(framework)
 	RootCmd.AddCommand(groupCmd)
 
+	planeCmd := plane.NewCommand(framework)
+	RootCmd.AddCommand(planeCmd)
+
+	bundleCmd := bundle.NewCommand(framework)
+	RootCmd.AddCommand(bundleCmd)
+
+	containerCmd := container.NewCommand(framework)
+	RootCmd.AddCommand(containerCmd)
+
 	initCmd, _ := radinit.NewCommand(framework)
 	RootCmd.AddCommand(initCmd)
 
@@ -303,6 +329,9 @@ func initSubCommands() {
 
 	uninstallKubernetesCmd, _ := uninstall_kubernetes.NewCommand(framework)
 	uninstallCmd.AddCommand(uninstallKubernetesCmd)
+
+	// Complete environment, application and resource type flags from UCP. This must run once all commands are added.
+	completion.RegisterFlags(RootCmd, framework)
 }
 
 // The dance we do with config is kinda complex. We want commands to be able to retrieve a config (*viper.Viper)
@@ -335,6 +364,53 @@ func initConfig() {
 	ConfigHolder.DirectoryConfig = dc
 }
 
+// initHTTPTrace starts recording the HTTP requests of the CLI invocation when '--debug' or '--trace-file' is set.
+func initHTTPTrace() {
+	if !httpTraceOptions.Debug && httpTraceOptions.File == "" {
+		return
+	}
+
+	path := httpTraceOptions.File
+	if path == "" {
+		var err error
+		path, err = httptrace.DefaultPath()
+		if err != nil {
+			fmt.Printf("Error: failed to find the trace file path: %v\n", err)
+			os.Exit(1) //nolint:forbidigo // this is OK inside the CLI startup.
+		}
+	}
+
+	_, err := httptrace.Enable(path)
+	if err != nil {
+		fmt.Printf("Error: failed to create the trace file: %v\n", err)
+		os.Exit(1) //nolint:forbidigo // this is OK inside the CLI startup.
+	}
+}
+
+// printHTTPTrace closes the HTTP trace of the CLI invocation and prints its location, correlation ID and failed requests
+// to stderr, so that it does not interfere with the output of the command.
+func printHTTPTrace() {
+	recorder := httptrace.Active()
+	if recorder == nil {
+		return
+	}
+
+	if err := recorder.Close(); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: failed to write the trace file %q: %v\n", recorder.Path(), err)
+	}
+
+	fmt.Fprintf(os.Stderr, "HTTP trace written to %s\n", recorder.Path())
+	fmt.Fprintf(os.Stderr, "Correlation ID: %s\n", recorder.CorrelationID)
+
+	failures := recorder.Failures()
+	if len(failures) > 0 {
+		fmt.Fprintln(os.Stderr, "Failed requests:")
+		for _, failure := range failures {
+			fmt.Fprintf(os.Stderr, "  %s\n", failure)
+		}
+	}
+}
+
 // TODO: Deprecate once all the commands are moved to new framework
 func ConfigFromContext(ctx context.Context) *viper.Viper {
 	holder := ctx.Value(framework.NewContextKey("config")).(*framework.ConfigHolder)
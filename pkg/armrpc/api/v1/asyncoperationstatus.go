@@ -39,4 +39,12 @@ type AsyncOperationStatus struct {
 
 	// Error represents the error occurred during provisioning.
 	Error *ErrorDetails `json:"error,omitempty"`
+
+	// PercentComplete represents the progress of the async operation from 0 to 100. It is optional and reported by
+	// the controller processing the operation.
+	PercentComplete float64 `json:"percentComplete,omitempty"`
+
+	// Stage represents the current stage of the async operation, such as "Deploying recipe". It is optional and
+	// reported by the controller processing the operation.
+	Stage string `json:"stage,omitempty"`
 }
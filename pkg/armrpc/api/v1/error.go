@@ -19,6 +19,7 @@ package v1
 import (
 	"errors"
 	"fmt"
+	"strings"
 )
 
 var (
@@ -67,3 +68,53 @@ func NewClientErrInvalidRequest(message string) *ErrClientRP {
 	err.Code = CodeInvalid
 	return err
 }
+
+// ErrValidation represents the validation errors of the properties of a request payload. Each error identifies the
+// invalid property by the JSON pointer to the property in the payload.
+type ErrValidation struct {
+	Details []ErrorDetails
+}
+
+// Add records that the property at the given JSON pointer is invalid.
+func (e *ErrValidation) Add(pointer string, message string) {
+	e.Details = append(e.Details, ErrorDetails{
+		Code:    CodeInvalidProperties,
+		Target:  pointer,
+		Message: message,
+	})
+}
+
+// Err returns e if at least one validation error was recorded, or nil otherwise.
+func (e *ErrValidation) Err() error {
+	if len(e.Details) == 0 {
+		return nil
+	}
+	return e
+}
+
+// Error returns an error string listing the invalid properties.
+func (e *ErrValidation) Error() string {
+	messages := []string{}
+	for _, d := range e.Details {
+		messages = append(messages, fmt.Sprintf("%s: %s", d.Target, d.Message))
+	}
+	return fmt.Sprintf("request payload has %d invalid properties: %s", len(e.Details), strings.Join(messages, "; "))
+}
+
+// Is checks if the target error is of type ErrValidation.
+func (e *ErrValidation) Is(target error) bool {
+	_, ok := target.(*ErrValidation)
+	return ok
+}
+
+// JSONPointer returns the JSON pointer (RFC 6901) made of the given reference tokens, escaping "~" and "/" in each token.
+//
+//	JSONPointer("properties", "container", "ports", "web") // "/properties/container/ports/web"
+func JSONPointer(tokens ...string) string {
+	b := strings.Builder{}
+	for _, t := range tokens {
+		b.WriteString("/")
+		b.WriteString(strings.ReplaceAll(strings.ReplaceAll(t, "~", "~0"), "/", "~1"))
+	}
+	return b.String()
+}
@@ -0,0 +1,59 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestErrValidation(t *testing.T) {
+	t.Run("no error", func(t *testing.T) {
+		verr := &ErrValidation{}
+		require.NoError(t, verr.Err())
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		verr := &ErrValidation{}
+		verr.Add("/properties/container/image", "image is required")
+		verr.Add("/properties/container/ports/web/port", "port must be between 1 and 65535")
+
+		err := verr.Err()
+		require.ErrorIs(t, err, &ErrValidation{})
+		require.Equal(t, "request payload has 2 invalid properties: /properties/container/image: image is required; /properties/container/ports/web/port: port must be between 1 and 65535", err.Error())
+		require.Equal(t, CodeInvalidProperties, verr.Details[0].Code)
+	})
+}
+
+func TestJSONPointer(t *testing.T) {
+	tests := []struct {
+		tokens   []string
+		expected string
+	}{
+		{[]string{}, ""},
+		{[]string{"properties", "container", "image"}, "/properties/container/image"},
+		{[]string{"properties", "container", "env", "a/b"}, "/properties/container/env/a~1b"},
+		{[]string{"properties", "container", "env", "a~b"}, "/properties/container/env/a~0b"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.expected, func(t *testing.T) {
+			require.Equal(t, tc.expected, JSONPointer(tc.tokens...))
+		})
+	}
+}
@@ -105,6 +105,12 @@ const (
 	// OperationProxy is used for controllers that proxy the underlying request without classifying the type of operation.
 	OperationProxy OperationMethod = "PROXY"
 
+	// OperationCancel is used for the custom action that cancels an async operation.
+	OperationCancel OperationMethod = "CANCEL"
+
+	// OperationRequeue is used for the custom action that requeues a dead-lettered async operation.
+	OperationRequeue OperationMethod = "REQUEUE"
+
 	Separator = "|"
 )
 
@@ -18,6 +18,7 @@ package controller
 
 import (
 	"context"
+	"errors"
 
 	"github.com/radius-project/radius/pkg/corerp/backend/deployment"
 	"github.com/radius-project/radius/pkg/ucp/dataprovider"
@@ -26,6 +27,17 @@ import (
 	runtimeclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
+var (
+	// ErrOperationCanceled is the cause of the cancellation of the context passed to Run when the operation is
+	// canceled by the user. Controllers can use context.Cause to tell it apart from other cancellations.
+	ErrOperationCanceled = errors.New("operation was canceled by the user")
+
+	// ErrOperationLost is the cause of the cancellation of the context passed to Run when the queue message of the
+	// operation was deleted, leased by another worker or its lock expired before it could be extended. The operation
+	// must stop because this worker no longer owns it.
+	ErrOperationLost = errors.New("operation message was deleted or leased by another worker")
+)
+
 // Options represents controller options.
 type Options struct {
 	// StorageClient is the data storage client.
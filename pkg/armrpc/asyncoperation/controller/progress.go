@@ -0,0 +1,48 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+)
+
+// ProgressReporter records the progress of the async operation being processed.
+type ProgressReporter func(ctx context.Context, percentComplete float64, stage string) error
+
+type progressReporterKey struct{}
+
+// WithProgressReporter returns a copy of the context with the reporter of the progress of the async operation.
+func WithProgressReporter(ctx context.Context, reporter ProgressReporter) context.Context {
+	return context.WithValue(ctx, progressReporterKey{}, reporter)
+}
+
+// ReportProgress records the progress of the async operation processed with the given context, which is surfaced
+// as the percentComplete and stage of its operation status. percentComplete must be between 0 and 100. Reporting
+// progress is a no-op when the context has no progress reporter.
+func ReportProgress(ctx context.Context, percentComplete float64, stage string) error {
+	if percentComplete < 0 || percentComplete > 100 {
+		return fmt.Errorf("percentComplete must be between 0 and 100, got %v", percentComplete)
+	}
+
+	reporter, ok := ctx.Value(progressReporterKey{}).(ProgressReporter)
+	if !ok || reporter == nil {
+		return nil
+	}
+
+	return reporter(ctx, percentComplete, stage)
+}
@@ -0,0 +1,55 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestReportProgress(t *testing.T) {
+	t.Run("no reporter", func(t *testing.T) {
+		err := ReportProgress(context.Background(), 50, "Deploying")
+		require.NoError(t, err)
+	})
+
+	t.Run("reporter", func(t *testing.T) {
+		var percent float64
+		var stage string
+		ctx := WithProgressReporter(context.Background(), func(ctx context.Context, percentComplete float64, s string) error {
+			percent, stage = percentComplete, s
+			return nil
+		})
+
+		err := ReportProgress(ctx, 50, "Deploying")
+		require.NoError(t, err)
+		require.Equal(t, float64(50), percent)
+		require.Equal(t, "Deploying", stage)
+	})
+
+	t.Run("invalid percentage", func(t *testing.T) {
+		ctx := WithProgressReporter(context.Background(), func(ctx context.Context, percentComplete float64, s string) error {
+			require.Fail(t, "reporter must not be called")
+			return nil
+		})
+
+		err := ReportProgress(ctx, 101, "Deploying")
+		require.Error(t, err)
+	})
+}
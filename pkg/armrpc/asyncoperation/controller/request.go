@@ -53,9 +53,17 @@ type Request struct {
 	HomeTenantID string `json:"homeTenantID,omitempty"`
 	// ClientObjectID represents the client object id of caller.
 	ClientObjectID string `json:"clientObjectID,omitempty"`
+	// Partition represents the storage partition of the caller. The default partition is used if it is empty.
+	Partition string `json:"partition,omitempty"`
 
 	// OperationTimeout represents the timeout duration of async operation.
 	OperationTimeout *time.Duration `json:"asyncOperationTimeout"`
+
+	// Cancel is true when the message signals the cancellation of the operation rather than a request to process it.
+	Cancel bool `json:"cancel,omitempty"`
+
+	// RetryCount is the number of times the operation was processed before this message was queued to retry it.
+	RetryCount int `json:"retryCount,omitempty"`
 }
 
 // Timeout gets the operation timeout and returns the default timeout unless it specifies.
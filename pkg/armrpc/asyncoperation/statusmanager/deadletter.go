@@ -0,0 +1,45 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package statusmanager
+
+import (
+	"time"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	ctrl "github.com/radius-project/radius/pkg/armrpc/asyncoperation/controller"
+)
+
+// DeadLetter is the datamodel for an async operation request which was dropped after exceeding the maximum retry count.
+type DeadLetter struct {
+	// ID is the resource id of the dead letter.
+	ID string `json:"id"`
+
+	// Name is the operation id of the dropped request.
+	Name string `json:"name"`
+
+	// Request is the async operation request which could not be processed.
+	Request ctrl.Request `json:"request"`
+
+	// DequeueCount is the number of times the request was dequeued before it was dropped.
+	DequeueCount int `json:"dequeueCount"`
+
+	// Error is the last error of the async operation.
+	Error *v1.ErrorDetails `json:"error,omitempty"`
+
+	// CreatedTime represents the time when the request was dropped.
+	CreatedTime time.Time `json:"createdTime"`
+}
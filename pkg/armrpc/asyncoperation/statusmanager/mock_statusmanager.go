@@ -16,6 +16,7 @@ import (
 
 	uuid "github.com/google/uuid"
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	controller "github.com/radius-project/radius/pkg/armrpc/asyncoperation/controller"
 	resources "github.com/radius-project/radius/pkg/ucp/resources"
 	gomock "go.uber.org/mock/gomock"
 )
@@ -43,6 +44,82 @@ func (m *MockStatusManager) EXPECT() *MockStatusManagerMockRecorder {
 	return m.recorder
 }
 
+// Cancel mocks base method.
+func (m *MockStatusManager) Cancel(arg0 context.Context, arg1 resources.ID, arg2 uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Cancel", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Cancel indicates an expected call of Cancel.
+func (mr *MockStatusManagerMockRecorder) Cancel(arg0, arg1, arg2 any) *MockStatusManagerCancelCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Cancel", reflect.TypeOf((*MockStatusManager)(nil).Cancel), arg0, arg1, arg2)
+	return &MockStatusManagerCancelCall{Call: call}
+}
+
+// MockStatusManagerCancelCall wrap *gomock.Call
+type MockStatusManagerCancelCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStatusManagerCancelCall) Return(arg0 error) *MockStatusManagerCancelCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStatusManagerCancelCall) Do(f func(context.Context, resources.ID, uuid.UUID) error) *MockStatusManagerCancelCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStatusManagerCancelCall) DoAndReturn(f func(context.Context, resources.ID, uuid.UUID) error) *MockStatusManagerCancelCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// DeadLetter mocks base method.
+func (m *MockStatusManager) DeadLetter(arg0 context.Context, arg1 *controller.Request, arg2 int, arg3 *v1.ErrorDetails) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeadLetter", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeadLetter indicates an expected call of DeadLetter.
+func (mr *MockStatusManagerMockRecorder) DeadLetter(arg0, arg1, arg2, arg3 any) *MockStatusManagerDeadLetterCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeadLetter", reflect.TypeOf((*MockStatusManager)(nil).DeadLetter), arg0, arg1, arg2, arg3)
+	return &MockStatusManagerDeadLetterCall{Call: call}
+}
+
+// MockStatusManagerDeadLetterCall wrap *gomock.Call
+type MockStatusManagerDeadLetterCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStatusManagerDeadLetterCall) Return(arg0 error) *MockStatusManagerDeadLetterCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStatusManagerDeadLetterCall) Do(f func(context.Context, *controller.Request, int, *v1.ErrorDetails) error) *MockStatusManagerDeadLetterCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStatusManagerDeadLetterCall) DoAndReturn(f func(context.Context, *controller.Request, int, *v1.ErrorDetails) error) *MockStatusManagerDeadLetterCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // Delete mocks base method.
 func (m *MockStatusManager) Delete(arg0 context.Context, arg1 resources.ID, arg2 uuid.UUID) error {
 	m.ctrl.T.Helper()
@@ -120,6 +197,123 @@ func (c *MockStatusManagerGetCall) DoAndReturn(f func(context.Context, resources
 	return c
 }
 
+// GetDeadLetter mocks base method.
+func (m *MockStatusManager) GetDeadLetter(arg0 context.Context, arg1 resources.ID) (*DeadLetter, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDeadLetter", arg0, arg1)
+	ret0, _ := ret[0].(*DeadLetter)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDeadLetter indicates an expected call of GetDeadLetter.
+func (mr *MockStatusManagerMockRecorder) GetDeadLetter(arg0, arg1 any) *MockStatusManagerGetDeadLetterCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeadLetter", reflect.TypeOf((*MockStatusManager)(nil).GetDeadLetter), arg0, arg1)
+	return &MockStatusManagerGetDeadLetterCall{Call: call}
+}
+
+// MockStatusManagerGetDeadLetterCall wrap *gomock.Call
+type MockStatusManagerGetDeadLetterCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStatusManagerGetDeadLetterCall) Return(arg0 *DeadLetter, arg1 error) *MockStatusManagerGetDeadLetterCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStatusManagerGetDeadLetterCall) Do(f func(context.Context, resources.ID) (*DeadLetter, error)) *MockStatusManagerGetDeadLetterCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStatusManagerGetDeadLetterCall) DoAndReturn(f func(context.Context, resources.ID) (*DeadLetter, error)) *MockStatusManagerGetDeadLetterCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ListDeadLetters mocks base method.
+func (m *MockStatusManager) ListDeadLetters(arg0 context.Context, arg1 resources.ID) ([]*DeadLetter, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDeadLetters", arg0, arg1)
+	ret0, _ := ret[0].([]*DeadLetter)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDeadLetters indicates an expected call of ListDeadLetters.
+func (mr *MockStatusManagerMockRecorder) ListDeadLetters(arg0, arg1 any) *MockStatusManagerListDeadLettersCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDeadLetters", reflect.TypeOf((*MockStatusManager)(nil).ListDeadLetters), arg0, arg1)
+	return &MockStatusManagerListDeadLettersCall{Call: call}
+}
+
+// MockStatusManagerListDeadLettersCall wrap *gomock.Call
+type MockStatusManagerListDeadLettersCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStatusManagerListDeadLettersCall) Return(arg0 []*DeadLetter, arg1 error) *MockStatusManagerListDeadLettersCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStatusManagerListDeadLettersCall) Do(f func(context.Context, resources.ID) ([]*DeadLetter, error)) *MockStatusManagerListDeadLettersCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStatusManagerListDeadLettersCall) DoAndReturn(f func(context.Context, resources.ID) ([]*DeadLetter, error)) *MockStatusManagerListDeadLettersCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// PurgeExpired mocks base method.
+func (m *MockStatusManager) PurgeExpired(arg0 context.Context, arg1 string, arg2 time.Time) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgeExpired", arg0, arg1, arg2)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PurgeExpired indicates an expected call of PurgeExpired.
+func (mr *MockStatusManagerMockRecorder) PurgeExpired(arg0, arg1, arg2 any) *MockStatusManagerPurgeExpiredCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeExpired", reflect.TypeOf((*MockStatusManager)(nil).PurgeExpired), arg0, arg1, arg2)
+	return &MockStatusManagerPurgeExpiredCall{Call: call}
+}
+
+// MockStatusManagerPurgeExpiredCall wrap *gomock.Call
+type MockStatusManagerPurgeExpiredCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStatusManagerPurgeExpiredCall) Return(arg0 int, arg1 error) *MockStatusManagerPurgeExpiredCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStatusManagerPurgeExpiredCall) Do(f func(context.Context, string, time.Time) (int, error)) *MockStatusManagerPurgeExpiredCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStatusManagerPurgeExpiredCall) DoAndReturn(f func(context.Context, string, time.Time) (int, error)) *MockStatusManagerPurgeExpiredCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // QueueAsyncOperation mocks base method.
 func (m *MockStatusManager) QueueAsyncOperation(arg0 context.Context, arg1 *v1.ARMRequestContext, arg2 QueueOperationOptions) error {
 	m.ctrl.T.Helper()
@@ -158,6 +352,44 @@ func (c *MockStatusManagerQueueAsyncOperationCall) DoAndReturn(f func(context.Co
 	return c
 }
 
+// Requeue mocks base method.
+func (m *MockStatusManager) Requeue(arg0 context.Context, arg1 resources.ID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Requeue", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Requeue indicates an expected call of Requeue.
+func (mr *MockStatusManagerMockRecorder) Requeue(arg0, arg1 any) *MockStatusManagerRequeueCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Requeue", reflect.TypeOf((*MockStatusManager)(nil).Requeue), arg0, arg1)
+	return &MockStatusManagerRequeueCall{Call: call}
+}
+
+// MockStatusManagerRequeueCall wrap *gomock.Call
+type MockStatusManagerRequeueCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStatusManagerRequeueCall) Return(arg0 error) *MockStatusManagerRequeueCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStatusManagerRequeueCall) Do(f func(context.Context, resources.ID) error) *MockStatusManagerRequeueCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStatusManagerRequeueCall) DoAndReturn(f func(context.Context, resources.ID) error) *MockStatusManagerRequeueCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // Update mocks base method.
 func (m *MockStatusManager) Update(arg0 context.Context, arg1 resources.ID, arg2 uuid.UUID, arg3 v1.ProvisioningState, arg4 *time.Time, arg5 *v1.ErrorDetails) error {
 	m.ctrl.T.Helper()
@@ -195,3 +427,41 @@ func (c *MockStatusManagerUpdateCall) DoAndReturn(f func(context.Context, resour
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
+
+// UpdateProgress mocks base method.
+func (m *MockStatusManager) UpdateProgress(arg0 context.Context, arg1 resources.ID, arg2 uuid.UUID, arg3 float64, arg4 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateProgress", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateProgress indicates an expected call of UpdateProgress.
+func (mr *MockStatusManagerMockRecorder) UpdateProgress(arg0, arg1, arg2, arg3, arg4 any) *MockStatusManagerUpdateProgressCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateProgress", reflect.TypeOf((*MockStatusManager)(nil).UpdateProgress), arg0, arg1, arg2, arg3, arg4)
+	return &MockStatusManagerUpdateProgressCall{Call: call}
+}
+
+// MockStatusManagerUpdateProgressCall wrap *gomock.Call
+type MockStatusManagerUpdateProgressCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStatusManagerUpdateProgressCall) Return(arg0 error) *MockStatusManagerUpdateProgressCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStatusManagerUpdateProgressCall) Do(f func(context.Context, resources.ID, uuid.UUID, float64, string) error) *MockStatusManagerUpdateProgressCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStatusManagerUpdateProgressCall) DoAndReturn(f func(context.Context, resources.ID, uuid.UUID, float64, string) error) *MockStatusManagerUpdateProgressCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
@@ -31,10 +31,20 @@ import (
 	queue "github.com/radius-project/radius/pkg/ucp/queue/client"
 	"github.com/radius-project/radius/pkg/ucp/resources"
 	"github.com/radius-project/radius/pkg/ucp/store"
+	"github.com/radius-project/radius/pkg/ucp/store/partition"
 
 	"github.com/google/uuid"
 )
 
+var (
+	// ErrOperationTerminal is returned when canceling an operation that has already completed.
+	ErrOperationTerminal = errors.New("the operation has already completed")
+
+	// ErrOperationNotRequeueable is returned when requeuing a dead-lettered operation that has not completed or has
+	// been superseded by a newer operation on the same resource.
+	ErrOperationNotRequeueable = errors.New("the operation is still running or a newer operation has been started on the resource")
+)
+
 // statusManager includes the necessary functions to manage asynchronous operations.
 type statusManager struct {
 	storeProvider dataprovider.DataStorageProvider
@@ -48,6 +58,9 @@ type QueueOperationOptions struct {
 	OperationTimeout time.Duration
 	// RetryAfter specifies the value of the Retry-After header that will be used for async operations.
 	RetryAfter time.Duration
+	// EnqueueAfter delays processing of the async operation by the given duration. The operation is processed
+	// immediately when this is zero.
+	EnqueueAfter time.Duration
 }
 
 //go:generate mockgen -typed -destination=./mock_statusmanager.go -package=statusmanager -self_package github.com/radius-project/radius/pkg/armrpc/asyncoperation/statusmanager github.com/radius-project/radius/pkg/armrpc/asyncoperation/statusmanager StatusManager
@@ -60,8 +73,23 @@ type StatusManager interface {
 	QueueAsyncOperation(ctx context.Context, sCtx *v1.ARMRequestContext, options QueueOperationOptions) error
 	// Update updates an async operation status.
 	Update(ctx context.Context, id resources.ID, operationID uuid.UUID, state v1.ProvisioningState, endTime *time.Time, opError *v1.ErrorDetails) error
+	// UpdateProgress updates the percentComplete and stage of a running async operation.
+	UpdateProgress(ctx context.Context, id resources.ID, operationID uuid.UUID, percentComplete float64, stage string) error
 	// Delete deletes an async operation status.
 	Delete(ctx context.Context, id resources.ID, operationID uuid.UUID) error
+	// Cancel marks an async operation as canceled and signals the worker processing it to stop.
+	Cancel(ctx context.Context, id resources.ID, operationID uuid.UUID) error
+	// DeadLetter saves an async operation request which could not be processed to the dead-letter collection.
+	DeadLetter(ctx context.Context, req *ctrl.Request, dequeueCount int, opError *v1.ErrorDetails) error
+	// GetDeadLetter gets the dead letter with the given resource id.
+	GetDeadLetter(ctx context.Context, id resources.ID) (*DeadLetter, error)
+	// ListDeadLetters lists the dead letters of the provider namespace and plane of the given resource id.
+	ListDeadLetters(ctx context.Context, id resources.ID) ([]*DeadLetter, error)
+	// Requeue queues the request of the dead letter with the given resource id again and deletes the dead letter.
+	Requeue(ctx context.Context, id resources.ID) error
+	// PurgeExpired deletes the completed async operation statuses of the provider namespace which were last updated
+	// before the given time and returns the number of deleted statuses.
+	PurgeExpired(ctx context.Context, providerNamespace string, before time.Time) (int, error)
 }
 
 // New creates statusManager instance.
@@ -78,6 +106,14 @@ func (aom *statusManager) operationStatusResourceID(id resources.ID, operationID
 	return fmt.Sprintf("%s/providers/%s/locations/%s/operationstatuses/%s", id.PlaneScope(), strings.ToLower(id.ProviderNamespace()), aom.location, operationID)
 }
 
+// deadLetterResourceID function is to build the dead letter resourceID.
+func (aom *statusManager) deadLetterResourceID(id resources.ID, operationID uuid.UUID) string {
+	return fmt.Sprintf("%s/providers/%s/locations/%s/deadletters/%s", id.PlaneScope(), strings.ToLower(id.ProviderNamespace()), aom.location, operationID)
+}
+
+// purgeRootScopes are the root scopes queried for expired operation statuses.
+var purgeRootScopes = []string{"/planes", "/subscriptions"}
+
 func (aom *statusManager) getClient(ctx context.Context, id resources.ID) (store.StorageClient, error) {
 	return aom.storeProvider.GetStorageClient(ctx, id.ProviderNamespace()+"/operationstatuses")
 }
@@ -125,7 +161,7 @@ func (aom *statusManager) QueueAsyncOperation(ctx context.Context, sCtx *v1.ARMR
 		return err
 	}
 
-	if err = aom.queueRequestMessage(ctx, sCtx, aos, options.OperationTimeout); err != nil {
+	if err = aom.queueRequestMessage(ctx, sCtx, aos, options); err != nil {
 		delErr := storeClient.Delete(ctx, opID)
 		if delErr != nil {
 			return delErr
@@ -186,6 +222,10 @@ func (aom *statusManager) Update(ctx context.Context, id resources.ID, operation
 		s.Error = opError
 	}
 
+	if state == v1.ProvisioningStateSucceeded {
+		s.PercentComplete = 100
+	}
+
 	s.LastUpdatedTime = time.Now().UTC()
 
 	obj.Data = s
@@ -193,6 +233,36 @@ func (aom *statusManager) Update(ctx context.Context, id resources.ID, operation
 	return storeClient.Save(ctx, obj, store.WithETag(obj.ETag))
 }
 
+// UpdateProgress retrieves an existing operation status resource from the store and saves it with the given
+// progress. The progress of an operation which has already completed is not updated.
+func (aom *statusManager) UpdateProgress(ctx context.Context, id resources.ID, operationID uuid.UUID, percentComplete float64, stage string) error {
+	storeClient, err := aom.getClient(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	obj, err := storeClient.Get(ctx, aom.operationStatusResourceID(id, operationID))
+	if err != nil {
+		return err
+	}
+
+	s := &Status{}
+	if err := obj.As(s); err != nil {
+		return err
+	}
+
+	if s.Status.IsTerminal() {
+		return nil
+	}
+
+	s.PercentComplete = percentComplete
+	s.Stage = stage
+	s.LastUpdatedTime = time.Now().UTC()
+	obj.Data = s
+
+	return storeClient.Save(ctx, obj, store.WithETag(obj.ETag))
+}
+
 // Delete deletes the operation status resource associated with the given ID and
 // operationID, and returns an error if unsuccessful.
 func (aom *statusManager) Delete(ctx context.Context, id resources.ID, operationID uuid.UUID) error {
@@ -203,8 +273,262 @@ func (aom *statusManager) Delete(ctx context.Context, id resources.ID, operation
 	return storeClient.Delete(ctx, aom.operationStatusResourceID(id, operationID))
 }
 
+// Cancel updates the status of a non-terminal operation to Canceled and queues a cancellation message so that
+// the worker processing the operation stops it. Returns ErrOperationTerminal if the operation has already completed.
+// The status is saved with its ETag so that an operation completing concurrently is never marked as canceled.
+func (aom *statusManager) Cancel(ctx context.Context, id resources.ID, operationID uuid.UUID) error {
+	if aom.queue == nil {
+		return errors.New("queue client is unset")
+	}
+
+	storeClient, err := aom.getClient(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	opID := aom.operationStatusResourceID(id, operationID)
+	for {
+		obj, err := storeClient.Get(ctx, opID)
+		if err != nil {
+			return err
+		}
+
+		s := &Status{}
+		if err := obj.As(s); err != nil {
+			return err
+		}
+
+		if s.Status.IsTerminal() {
+			return ErrOperationTerminal
+		}
+
+		now := time.Now().UTC()
+		s.Status = v1.ProvisioningStateCanceled
+		s.EndTime = &now
+		s.Error = &v1.ErrorDetails{
+			Code:    v1.CodeOperationCanceled,
+			Message: "Operation was canceled by the user.",
+			Target:  id.String(),
+		}
+		s.LastUpdatedTime = now
+		obj.Data = s
+
+		err = storeClient.Save(ctx, obj, store.WithETag(obj.ETag))
+		if errors.Is(err, &store.ErrConcurrency{}) {
+			// The status was updated by the worker in the meantime. Check whether it has completed.
+			continue
+		} else if err != nil {
+			return err
+		}
+
+		break
+	}
+
+	msg := &ctrl.Request{
+		OperationID:   operationID,
+		ResourceID:    id.String(),
+		TraceparentID: trace.ExtractTraceparent(ctx),
+		Cancel:        true,
+	}
+
+	return aom.queue.Enqueue(ctx, queue.NewMessage(msg))
+}
+
+// DeadLetter saves the given request, its dequeue count and the last error of the operation to the dead-letter
+// collection so that it can be inspected and requeued later.
+func (aom *statusManager) DeadLetter(ctx context.Context, req *ctrl.Request, dequeueCount int, opError *v1.ErrorDetails) error {
+	id, err := resources.ParseResource(req.ResourceID)
+	if err != nil {
+		return err
+	}
+
+	storeClient, err := aom.getClient(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	dlID := aom.deadLetterResourceID(id, req.OperationID)
+	dl := &DeadLetter{
+		ID:           dlID,
+		Name:         req.OperationID.String(),
+		Request:      *req,
+		DequeueCount: dequeueCount,
+		Error:        opError,
+		CreatedTime:  time.Now().UTC(),
+	}
+
+	return storeClient.Save(ctx, &store.Object{
+		Metadata: store.Metadata{ID: dlID},
+		Data:     dl,
+	})
+}
+
+// GetDeadLetter gets the dead letter with the given resource id from the datastore or an error if the retrieval fails.
+func (aom *statusManager) GetDeadLetter(ctx context.Context, id resources.ID) (*DeadLetter, error) {
+	storeClient, err := aom.getClient(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	obj, err := storeClient.Get(ctx, id.String())
+	if err != nil {
+		return nil, err
+	}
+
+	dl := &DeadLetter{}
+	if err := obj.As(dl); err != nil {
+		return nil, err
+	}
+
+	return dl, nil
+}
+
+// ListDeadLetters queries the datastore for the dead letters of the provider namespace in the plane of the given
+// resource id.
+func (aom *statusManager) ListDeadLetters(ctx context.Context, id resources.ID) ([]*DeadLetter, error) {
+	storeClient, err := aom.getClient(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := storeClient.Query(ctx, store.Query{
+		RootScope:    id.PlaneScope(),
+		ResourceType: strings.ToLower(id.ProviderNamespace()) + "/locations/deadletters",
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	dls := []*DeadLetter{}
+	for _, item := range result.Items {
+		dl := &DeadLetter{}
+		if err := item.As(dl); err != nil {
+			return nil, err
+		}
+		dls = append(dls, dl)
+	}
+
+	return dls, nil
+}
+
+// Requeue resets the status of the dead-lettered operation to Accepted, queues its request message again and
+// deletes the dead letter. Returns ErrOperationNotRequeueable if the operation has not completed or a newer
+// operation has been started on the resource since, because replaying the request would overwrite newer state.
+func (aom *statusManager) Requeue(ctx context.Context, id resources.ID) error {
+	if aom.queue == nil {
+		return errors.New("queue client is unset")
+	}
+
+	storeClient, err := aom.getClient(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	dlObj, err := storeClient.Get(ctx, id.String())
+	if err != nil {
+		return err
+	}
+
+	dl := &DeadLetter{}
+	if err := dlObj.As(dl); err != nil {
+		return err
+	}
+
+	linkedID, err := resources.ParseResource(dl.Request.ResourceID)
+	if err != nil {
+		return err
+	}
+
+	obj, err := storeClient.Get(ctx, aom.operationStatusResourceID(linkedID, dl.Request.OperationID))
+	if err != nil {
+		return err
+	}
+
+	s := &Status{}
+	if err := obj.As(s); err != nil {
+		return err
+	}
+
+	if !s.Status.IsTerminal() {
+		return ErrOperationNotRequeueable
+	}
+
+	latest, err := aom.isLatestOperation(ctx, storeClient, linkedID, s)
+	if err != nil {
+		return err
+	} else if !latest {
+		return ErrOperationNotRequeueable
+	}
+
+	// Delete the dead letter first so that concurrent requests cannot requeue it twice.
+	if err := storeClient.Delete(ctx, id.String(), store.WithETag(dlObj.ETag)); err != nil {
+		return err
+	}
+
+	previous := *s
+	s.Status = v1.ProvisioningStateAccepted
+	s.EndTime = nil
+	s.Error = nil
+	s.LastUpdatedTime = time.Now().UTC()
+	obj.Data = s
+
+	if err := storeClient.Save(ctx, obj, store.WithETag(obj.ETag)); err != nil {
+		return restoreDeadLetter(ctx, storeClient, id, dl, err)
+	}
+
+	msg := dl.Request
+	msg.TraceparentID = trace.ExtractTraceparent(ctx)
+	// The request exhausted its attempts before it was dead-lettered. Reset them, otherwise the worker would fail the
+	// requeued operation without processing it.
+	msg.RetryCount = 0
+	if err := aom.queue.Enqueue(ctx, queue.NewMessage(&msg)); err != nil {
+		// Restore the previous status, otherwise the operation stays Accepted although no request is queued for it.
+		obj.Data = &previous
+		if saveErr := storeClient.Save(ctx, obj, store.WithETag(obj.ETag)); saveErr != nil {
+			err = errors.Join(err, saveErr)
+		}
+		return restoreDeadLetter(ctx, storeClient, id, dl, err)
+	}
+
+	return nil
+}
+
+// restoreDeadLetter saves the dead letter of a request which failed to be requeued so that the request is not lost. It
+// returns the error of the requeue.
+func restoreDeadLetter(ctx context.Context, storeClient store.StorageClient, id resources.ID, dl *DeadLetter, err error) error {
+	if saveErr := storeClient.Save(ctx, &store.Object{Metadata: store.Metadata{ID: id.String()}, Data: dl}); saveErr != nil {
+		return errors.Join(err, saveErr)
+	}
+	return err
+}
+
+// isLatestOperation returns true if no operation was started on the linked resource after the given operation.
+func (aom *statusManager) isLatestOperation(ctx context.Context, storeClient store.StorageClient, linkedID resources.ID, status *Status) (bool, error) {
+	result, err := storeClient.Query(ctx, store.Query{
+		RootScope:    linkedID.PlaneScope(),
+		ResourceType: strings.ToLower(linkedID.ProviderNamespace()) + "/locations/operationstatuses",
+	})
+	if err != nil {
+		return false, err
+	}
+
+	for _, item := range result.Items {
+		other := &Status{}
+		if err := item.As(other); err != nil {
+			return false, err
+		}
+
+		// Resource IDs are case-insensitive.
+		if other.Name != status.Name && strings.EqualFold(other.LinkedResourceID, status.LinkedResourceID) && other.StartTime.After(status.StartTime) {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
+
 // queueRequestMessage function is to put the async operation message to the queue to be worked on.
-func (aom *statusManager) queueRequestMessage(ctx context.Context, sCtx *v1.ARMRequestContext, aos *Status, operationTimeout time.Duration) error {
+func (aom *statusManager) queueRequestMessage(ctx context.Context, sCtx *v1.ARMRequestContext, aos *Status, options QueueOperationOptions) error {
 	msg := &ctrl.Request{
 		APIVersion:       sCtx.APIVersion,
 		OperationID:      sCtx.OperationID,
@@ -215,8 +539,88 @@ func (aom *statusManager) queueRequestMessage(ctx context.Context, sCtx *v1.ARMR
 		AcceptLanguage:   sCtx.AcceptLanguage,
 		HomeTenantID:     sCtx.HomeTenantID,
 		ClientObjectID:   sCtx.ClientObjectID,
-		OperationTimeout: &operationTimeout,
+		Partition:        partition.FromContext(ctx),
+		OperationTimeout: &options.OperationTimeout,
 	}
 
-	return aom.queue.Enqueue(ctx, queue.NewMessage(msg))
+	opts := []queue.EnqueueOptions{}
+	if options.EnqueueAfter > 0 {
+		opts = append(opts, queue.WithEnqueueAfter(options.EnqueueAfter))
+	}
+
+	return aom.queue.Enqueue(ctx, queue.NewMessage(msg), opts...)
+}
+
+// PurgeExpired deletes the operation statuses of the provider namespace which have completed and were last updated
+// before the given time. Statuses of operations which are still running are kept regardless of their age. The statuses
+// of every storage partition are purged.
+func (aom *statusManager) PurgeExpired(ctx context.Context, providerNamespace string, before time.Time) (int, error) {
+	storeClient, err := aom.storeProvider.GetStorageClient(ctx, providerNamespace+"/operationstatuses")
+	if err != nil {
+		return 0, err
+	}
+
+	resourceType := strings.ToLower(providerNamespace) + "/locations/operationstatuses"
+	partitions, err := partition.Partitions(ctx, storeClient, resourceType)
+	if err != nil {
+		return 0, err
+	}
+
+	purged := 0
+	for _, p := range partitions {
+		n, err := purgeExpiredStatuses(partition.WithPartition(ctx, p), storeClient, resourceType, before)
+		purged += n
+		if err != nil {
+			return purged, err
+		}
+	}
+
+	return purged, nil
+}
+
+// purgeExpiredStatuses deletes the operation statuses of the resource type in the partition of the context which have
+// completed and were last updated before the given time.
+func purgeExpiredStatuses(ctx context.Context, storeClient store.StorageClient, resourceType string, before time.Time) (int, error) {
+	purged := 0
+	for _, rootScope := range purgeRootScopes {
+		query := store.Query{
+			RootScope:      rootScope,
+			ScopeRecursive: true,
+			ResourceType:   resourceType,
+		}
+
+		token := ""
+		for {
+			result, err := storeClient.Query(ctx, query, store.WithPaginationToken(token))
+			if err != nil {
+				return purged, err
+			}
+
+			for _, item := range result.Items {
+				s := &Status{}
+				if err := item.As(s); err != nil {
+					return purged, err
+				}
+
+				if !s.Status.IsTerminal() || !s.LastUpdatedTime.Before(before) {
+					continue
+				}
+
+				err = storeClient.Delete(ctx, item.ID)
+				if errors.Is(err, &store.ErrNotFound{}) {
+					continue
+				} else if err != nil {
+					return purged, err
+				}
+				purged++
+			}
+
+			if result.PaginationToken == "" {
+				break
+			}
+			token = result.PaginationToken
+		}
+	}
+
+	return purged, nil
 }
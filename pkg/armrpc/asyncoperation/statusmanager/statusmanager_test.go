@@ -18,12 +18,16 @@ package statusmanager
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/google/uuid"
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	ctrl "github.com/radius-project/radius/pkg/armrpc/asyncoperation/controller"
 	"github.com/radius-project/radius/pkg/armrpc/rpctest"
 	"github.com/radius-project/radius/pkg/ucp/dataprovider"
 	queue "github.com/radius-project/radius/pkg/ucp/queue/client"
@@ -191,6 +195,27 @@ func TestCreateAsyncOperationStatus(t *testing.T) {
 	}
 }
 
+func TestCreateAsyncOperationStatus_EnqueueAfter(t *testing.T) {
+	aomTest, mctrl := setup(t)
+	defer mctrl.Finish()
+
+	aomTest.storeClient.EXPECT().Save(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+	aomTest.queue.EXPECT().Enqueue(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, msg *queue.Message, opts ...queue.EnqueueOptions) error {
+			cfg := queue.NewEnqueueConfig(opts...)
+			require.Equal(t, time.Minute, cfg.Delay)
+			return nil
+		})
+
+	options := QueueOperationOptions{
+		OperationTimeout: operationTimeoutDuration,
+		RetryAfter:       opererationRetryAfterDuration,
+		EnqueueAfter:     time.Minute,
+	}
+	err := aomTest.manager.QueueAsyncOperation(context.TODO(), reqCtx, options)
+	require.NoError(t, err)
+}
+
 func TestDeleteAsyncOperationStatus(t *testing.T) {
 	deleteCases := []struct {
 		Desc      string
@@ -328,3 +353,410 @@ func TestUpdateAsyncOperationStatus(t *testing.T) {
 		})
 	}
 }
+
+func TestCancelAsyncOperationStatus(t *testing.T) {
+	cancelCases := []struct {
+		Desc        string
+		Status      v1.ProvisioningState
+		Completed   bool
+		ExpectedErr error
+	}{
+		{
+			Desc:   "cancel_success",
+			Status: v1.ProvisioningStateUpdating,
+		},
+		{
+			Desc:        "cancel_terminal",
+			Status:      v1.ProvisioningStateSucceeded,
+			ExpectedErr: ErrOperationTerminal,
+		},
+		{
+			Desc:        "cancel_completed_concurrently",
+			Status:      v1.ProvisioningStateUpdating,
+			Completed:   true,
+			ExpectedErr: ErrOperationTerminal,
+		},
+	}
+
+	for _, tt := range cancelCases {
+		t.Run(fmt.Sprint(tt.Desc), func(t *testing.T) {
+			aomTest, mctrl := setup(t)
+			defer mctrl.Finish()
+
+			rid, err := resources.ParseResource(ucpEnvResourceID)
+			require.NoError(t, err)
+
+			obj := &store.Object{
+				Metadata: store.Metadata{ID: opID.String(), ETag: "etag"},
+				Data: &Status{
+					AsyncOperationStatus: v1.AsyncOperationStatus{ID: opID.String(), Name: opID.String(), Status: tt.Status},
+					LinkedResourceID:     rid.String(),
+				},
+			}
+
+			aomTest.storeClient.
+				EXPECT().
+				Get(gomock.Any(), gomock.Any(), gomock.Any()).
+				Return(obj, nil)
+
+			if tt.Completed {
+				// The worker completes the operation between Get and Save.
+				completed := &store.Object{
+					Metadata: store.Metadata{ID: opID.String(), ETag: "etag2"},
+					Data: &Status{
+						AsyncOperationStatus: v1.AsyncOperationStatus{ID: opID.String(), Name: opID.String(), Status: v1.ProvisioningStateSucceeded},
+						LinkedResourceID:     rid.String(),
+					},
+				}
+				aomTest.storeClient.
+					EXPECT().
+					Save(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(&store.ErrConcurrency{})
+				aomTest.storeClient.
+					EXPECT().
+					Get(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(completed, nil)
+			}
+
+			if tt.ExpectedErr == nil {
+				aomTest.storeClient.
+					EXPECT().
+					Save(gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, obj *store.Object, options ...store.SaveOptions) error {
+						status := obj.Data.(*Status)
+						require.Equal(t, v1.ProvisioningStateCanceled, status.Status)
+						require.Equal(t, v1.CodeOperationCanceled, status.Error.Code)
+						require.NotNil(t, status.EndTime)
+						return nil
+					})
+				aomTest.queue.
+					EXPECT().
+					Enqueue(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, msg *queue.Message, options ...queue.EnqueueOptions) error {
+						req := &ctrl.Request{}
+						require.NoError(t, json.Unmarshal(msg.Data, req))
+						require.True(t, req.Cancel)
+						require.Equal(t, opID, req.OperationID)
+						require.Equal(t, rid.String(), req.ResourceID)
+						return nil
+					})
+			}
+
+			err = aomTest.manager.Cancel(context.TODO(), rid, opID)
+			if tt.ExpectedErr != nil {
+				require.ErrorIs(t, err, tt.ExpectedErr)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestDeadLetterAsyncOperation(t *testing.T) {
+	aomTest, mctrl := setup(t)
+	defer mctrl.Finish()
+
+	req := &ctrl.Request{
+		OperationID:   opID,
+		OperationType: "APPLICATIONS.CORE/ENVIRONMENTS|PUT",
+		ResourceID:    ucpEnvResourceID,
+	}
+	opErr := &v1.ErrorDetails{Code: v1.CodeInternal, Message: "exceeded max retry count"}
+
+	aomTest.storeClient.
+		EXPECT().
+		Save(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, obj *store.Object, options ...store.SaveOptions) error {
+			expectedID := "/planes/radius/local/providers/applications.core/locations/test-location/deadletters/" + opID.String()
+			require.Equal(t, expectedID, obj.ID)
+
+			dl := obj.Data.(*DeadLetter)
+			require.Equal(t, expectedID, dl.ID)
+			require.Equal(t, opID.String(), dl.Name)
+			require.Equal(t, *req, dl.Request)
+			require.Equal(t, 4, dl.DequeueCount)
+			require.Equal(t, opErr, dl.Error)
+			return nil
+		})
+
+	err := aomTest.manager.DeadLetter(context.TODO(), req, 4, opErr)
+	require.NoError(t, err)
+}
+
+func TestListDeadLetters(t *testing.T) {
+	aomTest, mctrl := setup(t)
+	defer mctrl.Finish()
+
+	aomTest.storeClient.
+		EXPECT().
+		Query(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, query store.Query, options ...store.QueryOptions) (*store.ObjectQueryResult, error) {
+			require.Equal(t, "/planes/radius/local", query.RootScope)
+			require.Equal(t, "applications.core/locations/deadletters", query.ResourceType)
+			return &store.ObjectQueryResult{
+				Items: []store.Object{
+					{Data: &DeadLetter{Name: opID.String(), DequeueCount: 4}},
+				},
+			}, nil
+		})
+
+	id := resources.MustParse("/planes/radius/local/providers/Applications.Core/locations/test-location/deadletters")
+	dls, err := aomTest.manager.ListDeadLetters(context.TODO(), id)
+	require.NoError(t, err)
+	require.Len(t, dls, 1)
+	require.Equal(t, opID.String(), dls[0].Name)
+	require.Equal(t, 4, dls[0].DequeueCount)
+}
+
+func TestRequeueDeadLetter(t *testing.T) {
+	dlID := resources.MustParse("/planes/radius/local/providers/Applications.Core/locations/test-location/deadletters/" + opID.String())
+	req := ctrl.Request{
+		OperationID:   opID,
+		OperationType: "APPLICATIONS.CORE/ENVIRONMENTS|PUT",
+		ResourceID:    ucpEnvResourceID,
+		RetryCount:    3,
+	}
+	startTime := time.Now().UTC().Add(-time.Hour)
+
+	newStatus := func(name string, state v1.ProvisioningState, linkedID string, startTime time.Time) *Status {
+		return &Status{
+			AsyncOperationStatus: v1.AsyncOperationStatus{
+				ID:        name,
+				Name:      name,
+				Status:    state,
+				StartTime: startTime,
+			},
+			LinkedResourceID: linkedID,
+		}
+	}
+
+	requeueCases := []struct {
+		Desc        string
+		Status      v1.ProvisioningState
+		Others      []*Status
+		EnqueueErr  error
+		ExpectedErr error
+	}{
+		{
+			Desc:   "requeue_success",
+			Status: v1.ProvisioningStateFailed,
+			Others: []*Status{
+				newStatus(uuid.NewString(), v1.ProvisioningStateSucceeded, ucpEnvResourceID, startTime.Add(-time.Minute)),
+				newStatus(uuid.NewString(), v1.ProvisioningStateSucceeded, "/planes/radius/local/resourceGroups/radius-test-rg/providers/Applications.Core/environments/other", startTime.Add(time.Minute)),
+			},
+		},
+		{
+			Desc:        "requeue_not_terminal",
+			Status:      v1.ProvisioningStateUpdating,
+			ExpectedErr: ErrOperationNotRequeueable,
+		},
+		{
+			Desc:   "requeue_superseded",
+			Status: v1.ProvisioningStateFailed,
+			Others: []*Status{
+				newStatus(uuid.NewString(), v1.ProvisioningStateSucceeded, strings.ToUpper(ucpEnvResourceID), startTime.Add(time.Minute)),
+			},
+			ExpectedErr: ErrOperationNotRequeueable,
+		},
+		{
+			Desc:        "requeue_enqueue_failure",
+			Status:      v1.ProvisioningStateFailed,
+			EnqueueErr:  errors.New("enqueue failed"),
+			ExpectedErr: errors.New("enqueue failed"),
+		},
+	}
+
+	for _, tt := range requeueCases {
+		t.Run(tt.Desc, func(t *testing.T) {
+			aomTest, mctrl := setup(t)
+			defer mctrl.Finish()
+
+			dl := &DeadLetter{ID: dlID.String(), Name: opID.String(), Request: req, DequeueCount: 4}
+			aomTest.storeClient.
+				EXPECT().
+				Get(gomock.Any(), dlID.String(), gomock.Any()).
+				Return(&store.Object{Metadata: store.Metadata{ID: dlID.String(), ETag: "dl-etag"}, Data: dl}, nil)
+
+			status := newStatus(opID.String(), tt.Status, ucpEnvResourceID, startTime)
+			status.Error = &v1.ErrorDetails{Code: v1.CodeInternal}
+			aomTest.storeClient.
+				EXPECT().
+				Get(gomock.Any(), gomock.Any(), gomock.Any()).
+				Return(&store.Object{Metadata: store.Metadata{ETag: "etag"}, Data: status}, nil)
+
+			if !tt.Status.IsTerminal() {
+				err := aomTest.manager.Requeue(context.TODO(), dlID)
+				require.ErrorIs(t, err, tt.ExpectedErr)
+				return
+			}
+
+			items := []store.Object{{Data: status}}
+			for _, other := range tt.Others {
+				items = append(items, store.Object{Data: other})
+			}
+			aomTest.storeClient.
+				EXPECT().
+				Query(gomock.Any(), gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, query store.Query, options ...store.QueryOptions) (*store.ObjectQueryResult, error) {
+					require.Equal(t, "/planes/radius/local", query.RootScope)
+					require.Equal(t, "applications.core/locations/operationstatuses", query.ResourceType)
+					return &store.ObjectQueryResult{Items: items}, nil
+				})
+
+			if errors.Is(tt.ExpectedErr, ErrOperationNotRequeueable) {
+				err := aomTest.manager.Requeue(context.TODO(), dlID)
+				require.ErrorIs(t, err, tt.ExpectedErr)
+				return
+			}
+
+			aomTest.storeClient.
+				EXPECT().
+				Delete(gomock.Any(), dlID.String(), gomock.Any()).
+				Return(nil)
+			aomTest.storeClient.
+				EXPECT().
+				Save(gomock.Any(), gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, obj *store.Object, options ...store.SaveOptions) error {
+					status := obj.Data.(*Status)
+					require.Equal(t, v1.ProvisioningStateAccepted, status.Status)
+					require.Nil(t, status.Error)
+					require.Nil(t, status.EndTime)
+					return nil
+				})
+			aomTest.queue.
+				EXPECT().
+				Enqueue(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, msg *queue.Message, options ...queue.EnqueueOptions) error {
+					actual := &ctrl.Request{}
+					require.NoError(t, json.Unmarshal(msg.Data, actual))
+					require.Equal(t, opID, actual.OperationID)
+					require.Equal(t, ucpEnvResourceID, actual.ResourceID)
+					require.False(t, actual.Cancel)
+					require.Equal(t, 0, actual.RetryCount)
+					return tt.EnqueueErr
+				})
+
+			if tt.EnqueueErr != nil {
+				// The previous status is restored.
+				aomTest.storeClient.
+					EXPECT().
+					Save(gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, obj *store.Object, options ...store.SaveOptions) error {
+						require.Equal(t, "etag", store.NewSaveConfig(options...).ETag)
+						status := obj.Data.(*Status)
+						require.Equal(t, v1.ProvisioningStateFailed, status.Status)
+						require.Equal(t, &v1.ErrorDetails{Code: v1.CodeInternal}, status.Error)
+						return nil
+					})
+
+				// The dead letter is restored.
+				aomTest.storeClient.
+					EXPECT().
+					Save(gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, obj *store.Object, options ...store.SaveOptions) error {
+						require.Equal(t, dlID.String(), obj.ID)
+						require.Equal(t, opID, obj.Data.(*DeadLetter).Request.OperationID)
+						return nil
+					})
+			}
+
+			err := aomTest.manager.Requeue(context.TODO(), dlID)
+			if tt.ExpectedErr != nil {
+				require.EqualError(t, err, tt.ExpectedErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestPurgeExpired(t *testing.T) {
+	aomTest, mctrl := setup(t)
+	defer mctrl.Finish()
+
+	now := time.Now().UTC()
+	before := now.Add(-time.Hour)
+	newStatus := func(name string, state v1.ProvisioningState, lastUpdated time.Time) store.Object {
+		return store.Object{
+			Metadata: store.Metadata{ID: "/planes/radius/local/providers/applications.core/locations/test-location/operationstatuses/" + name},
+			Data: &Status{
+				AsyncOperationStatus: v1.AsyncOperationStatus{Name: name, Status: state},
+				LastUpdatedTime:      lastUpdated,
+			},
+		}
+	}
+
+	expired := newStatus("expired", v1.ProvisioningStateSucceeded, now.Add(-2*time.Hour))
+	expiredPage2 := newStatus("expired-page2", v1.ProvisioningStateFailed, now.Add(-2*time.Hour))
+	running := newStatus("running", v1.ProvisioningStateUpdating, now.Add(-2*time.Hour))
+	recent := newStatus("recent", v1.ProvisioningStateSucceeded, now)
+	deleted := newStatus("deleted", v1.ProvisioningStateCanceled, now.Add(-2*time.Hour))
+
+	aomTest.storeClient.
+		EXPECT().
+		Query(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, query store.Query, options ...store.QueryOptions) (*store.ObjectQueryResult, error) {
+			require.True(t, query.ScopeRecursive)
+			require.Equal(t, "applications.core/locations/operationstatuses", query.ResourceType)
+			if query.RootScope != "/planes" {
+				return &store.ObjectQueryResult{}, nil
+			}
+
+			if store.NewQueryConfig(options...).PaginationToken == "" {
+				return &store.ObjectQueryResult{Items: []store.Object{expired, running, recent, deleted}, PaginationToken: "page2"}, nil
+			}
+			return &store.ObjectQueryResult{Items: []store.Object{expiredPage2}}, nil
+		}).
+		Times(3)
+
+	aomTest.storeClient.EXPECT().Delete(gomock.Any(), expired.ID).Return(nil)
+	aomTest.storeClient.EXPECT().Delete(gomock.Any(), expiredPage2.ID).Return(nil)
+	aomTest.storeClient.EXPECT().Delete(gomock.Any(), deleted.ID).Return(&store.ErrNotFound{ID: deleted.ID})
+
+	purged, err := aomTest.manager.PurgeExpired(context.TODO(), "Applications.Core", before)
+	require.NoError(t, err)
+	require.Equal(t, 2, purged)
+}
+
+func TestUpdateProgress(t *testing.T) {
+	tests := []struct {
+		Desc        string
+		State       v1.ProvisioningState
+		ExpectSaved bool
+	}{
+		{Desc: "running operation", State: v1.ProvisioningStateUpdating, ExpectSaved: true},
+		{Desc: "completed operation", State: v1.ProvisioningStateSucceeded, ExpectSaved: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.Desc, func(t *testing.T) {
+			aomTest, mctrl := setup(t)
+			defer mctrl.Finish()
+
+			status := *testAos
+			status.Status = tt.State
+			aomTest.storeClient.
+				EXPECT().
+				Get(gomock.Any(), gomock.Any(), gomock.Any()).
+				Return(&store.Object{Metadata: store.Metadata{ETag: "etag"}, Data: &status}, nil)
+
+			if tt.ExpectSaved {
+				aomTest.storeClient.
+					EXPECT().
+					Save(gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, obj *store.Object, options ...store.SaveOptions) error {
+						require.Equal(t, "etag", store.NewSaveConfig(options...).ETag)
+						saved := obj.Data.(*Status)
+						require.Equal(t, float64(40), saved.PercentComplete)
+						require.Equal(t, "Deploying", saved.Stage)
+						return nil
+					})
+			}
+
+			rid, err := resources.ParseResource(azureEnvResourceID)
+			require.NoError(t, err)
+			err = aomTest.manager.UpdateProgress(context.TODO(), rid, uuid.New(), 40, "Deploying")
+			require.NoError(t, err)
+		})
+	}
+}
@@ -18,6 +18,8 @@ package worker
 
 import (
 	"context"
+	"sort"
+	"strings"
 	"sync"
 
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
@@ -32,18 +34,26 @@ type ControllerRegistry struct {
 	ctrlMap   map[string]ctrl.Controller
 	ctrlMapMu sync.RWMutex
 	sp        dataprovider.DataStorageProvider
+
+	// namespaces holds the provider namespaces of the registered resource types, keyed by the lower-cased namespace.
+	namespaces map[string]string
+
+	// retryPolicies holds the retry policies of the registered controllers, keyed by operation type.
+	retryPolicies map[string]RetryPolicy
 }
 
 // NewControllerRegistry creates an ControllerRegistry instance.
 func NewControllerRegistry(sp dataprovider.DataStorageProvider) *ControllerRegistry {
 	return &ControllerRegistry{
-		ctrlMap: map[string]ctrl.Controller{},
-		sp:      sp,
+		ctrlMap:       map[string]ctrl.Controller{},
+		sp:            sp,
+		namespaces:    map[string]string{},
+		retryPolicies: map[string]RetryPolicy{},
 	}
 }
 
 // Register registers controller.
-func (h *ControllerRegistry) Register(ctx context.Context, resourceType string, method v1.OperationMethod, factoryFn ControllerFactoryFunc, opts ctrl.Options) error {
+func (h *ControllerRegistry) Register(ctx context.Context, resourceType string, method v1.OperationMethod, factoryFn ControllerFactoryFunc, opts ctrl.Options, regOpts ...RegisterOptions) error {
 	h.ctrlMapMu.Lock()
 	defer h.ctrlMapMu.Unlock()
 
@@ -62,6 +72,19 @@ func (h *ControllerRegistry) Register(ctx context.Context, resourceType string,
 	}
 
 	h.ctrlMap[ot.String()] = ctrl
+
+	cfg := &registerConfig{}
+	for _, o := range regOpts {
+		o(cfg)
+	}
+	if cfg.retryPolicy != nil {
+		h.retryPolicies[ot.String()] = *cfg.retryPolicy
+	} else {
+		delete(h.retryPolicies, ot.String())
+	}
+
+	namespace, _, _ := strings.Cut(resourceType, "/")
+	h.namespaces[strings.ToLower(namespace)] = namespace
 	return nil
 }
 
@@ -76,3 +99,27 @@ func (h *ControllerRegistry) Get(operationType v1.OperationType) ctrl.Controller
 
 	return nil
 }
+
+// RetryPolicy gets the retry policy registered with the controller of the operation type. It returns false if the
+// controller was registered without a retry policy.
+func (h *ControllerRegistry) RetryPolicy(operationType v1.OperationType) (RetryPolicy, bool) {
+	h.ctrlMapMu.RLock()
+	defer h.ctrlMapMu.RUnlock()
+
+	policy, ok := h.retryPolicies[operationType.String()]
+	return policy, ok
+}
+
+// ProviderNamespaces returns the sorted provider namespaces of the resource types with registered controllers.
+func (h *ControllerRegistry) ProviderNamespaces() []string {
+	h.ctrlMapMu.RLock()
+	defer h.ctrlMapMu.RUnlock()
+
+	namespaces := []string{}
+	for _, namespace := range h.namespaces {
+		namespaces = append(namespaces, namespace)
+	}
+
+	sort.Strings(namespaces)
+	return namespaces
+}
@@ -67,4 +67,6 @@ func TestRegister_Get(t *testing.T) {
 	require.NotNil(t, ctrl)
 	ctrl = registry.Get(opPut)
 	require.NotNil(t, ctrl)
+
+	require.Equal(t, []string{"Applications.Core"}, registry.ProviderNamespaces())
 }
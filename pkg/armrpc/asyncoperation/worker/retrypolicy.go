@@ -0,0 +1,75 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package worker
+
+import (
+	"math"
+	"time"
+)
+
+// RetryPolicy configures how an async operation is retried when its controller requests a requeue.
+type RetryPolicy struct {
+	// InitialDelay is the delay before the first retry. When it is zero, the operation is retried once its message
+	// lock expires.
+	InitialDelay time.Duration
+
+	// Multiplier is the factor applied to the delay of each subsequent retry. Values less than 1 keep the delay
+	// constant.
+	Multiplier float64
+
+	// MaxDelay is the upper bound of the delay between retries. The delay is not bounded when it is zero.
+	MaxDelay time.Duration
+
+	// MaxAttempts is the maximum number of times the operation is processed before it fails. The worker's
+	// MaxOperationRetryCount is used when it is zero.
+	MaxAttempts int
+}
+
+// Delay returns the delay before the given retry, where the first retry is 1.
+func (p RetryPolicy) Delay(retry int) time.Duration {
+	if p.InitialDelay <= 0 || retry < 1 {
+		return 0
+	}
+
+	multiplier := p.Multiplier
+	if multiplier < 1 {
+		multiplier = 1
+	}
+
+	delay := float64(p.InitialDelay) * math.Pow(multiplier, float64(retry-1))
+	if p.MaxDelay > 0 && delay > float64(p.MaxDelay) {
+		return p.MaxDelay
+	}
+	if delay > math.MaxInt64 {
+		return time.Duration(math.MaxInt64)
+	}
+	return time.Duration(delay)
+}
+
+// RegisterOptions configures the registration of a controller.
+type RegisterOptions func(*registerConfig)
+
+type registerConfig struct {
+	retryPolicy *RetryPolicy
+}
+
+// WithRetryPolicy sets the retry policy of the operations processed by the registered controller.
+func WithRetryPolicy(policy RetryPolicy) RegisterOptions {
+	return func(cfg *registerConfig) {
+		cfg.retryPolicy = &policy
+	}
+}
@@ -0,0 +1,251 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package worker
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	ctrl "github.com/radius-project/radius/pkg/armrpc/asyncoperation/controller"
+	manager "github.com/radius-project/radius/pkg/armrpc/asyncoperation/statusmanager"
+	"github.com/radius-project/radius/pkg/ucp/dataprovider"
+	queue "github.com/radius-project/radius/pkg/ucp/queue/client"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/radius-project/radius/pkg/ucp/store"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestRetryPolicy_Delay(t *testing.T) {
+	tests := []struct {
+		name   string
+		policy RetryPolicy
+		retry  int
+		out    time.Duration
+	}{
+		{name: "no delay", policy: RetryPolicy{}, retry: 1, out: 0},
+		{name: "first retry", policy: RetryPolicy{InitialDelay: time.Second, Multiplier: 2}, retry: 1, out: time.Second},
+		{name: "third retry", policy: RetryPolicy{InitialDelay: time.Second, Multiplier: 2}, retry: 3, out: 4 * time.Second},
+		{name: "constant delay", policy: RetryPolicy{InitialDelay: time.Second}, retry: 3, out: time.Second},
+		{name: "max delay", policy: RetryPolicy{InitialDelay: time.Second, Multiplier: 10, MaxDelay: 30 * time.Second}, retry: 3, out: 30 * time.Second},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.out, tt.policy.Delay(tt.retry))
+		})
+	}
+}
+
+func TestRequeueOperation(t *testing.T) {
+	tCtx, mctrl := newTestContext(t, defaultTestLockTime)
+	defer mctrl.Finish()
+
+	mockSP := dataprovider.NewMockDataStorageProvider(mctrl)
+	mockSP.EXPECT().GetStorageClient(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+	registry := NewControllerRegistry(mockSP)
+
+	policy := RetryPolicy{InitialDelay: 50 * time.Millisecond, Multiplier: 2, MaxAttempts: 5}
+	err := registry.Register(context.TODO(), testResourceType, v1.OperationPut, func(opts ctrl.Options) (ctrl.Controller, error) {
+		return &testAsyncController{BaseController: ctrl.NewBaseAsyncController(opts)}, nil
+	}, ctrl.Options{}, WithRetryPolicy(policy))
+	require.NoError(t, err)
+
+	worker := New(Options{}, tCtx.mockSM, tCtx.testQueue, registry)
+	require.Equal(t, 5, worker.retryPolicy(v1.OperationType{Type: testResourceType, Method: v1.OperationPut}).MaxAttempts)
+	require.Equal(t, defaultMaxOperationRetryCount, worker.retryPolicy(v1.OperationType{Type: testResourceType, Method: v1.OperationDelete}).MaxAttempts)
+
+	err = tCtx.testQueue.Enqueue(tCtx.ctx, genTestMessage(uuid.New(), ctrl.DefaultAsyncOperationTimeout))
+	require.NoError(t, err)
+
+	msg, err := tCtx.testQueue.Dequeue(tCtx.ctx, queue.QueueClientConfig{})
+	require.NoError(t, err)
+	req := &ctrl.Request{}
+	require.NoError(t, json.Unmarshal(msg.Data, req))
+
+	worker.requeueOperation(tCtx.ctx, msg, req)
+
+	// The message is finished and the retry is not visible until the delay has elapsed.
+	_, err = tCtx.testQueue.Dequeue(tCtx.ctx, queue.QueueClientConfig{})
+	require.ErrorIs(t, err, queue.ErrMessageNotFound)
+
+	var retry *queue.Message
+	require.Eventually(t, func() bool {
+		retry, err = tCtx.testQueue.Dequeue(tCtx.ctx, queue.QueueClientConfig{})
+		return err == nil
+	}, 5*time.Second, 10*time.Millisecond)
+
+	retryReq := &ctrl.Request{}
+	require.NoError(t, json.Unmarshal(retry.Data, retryReq))
+	require.Equal(t, req.OperationID, retryReq.OperationID)
+	require.Equal(t, 1, retryReq.RetryCount)
+}
+
+// objectStore keeps the objects of a mock storage client in a map.
+type objectStore struct {
+	mu      sync.Mutex
+	objects map[string]store.Object
+}
+
+func (s *objectStore) get(id string) (store.Object, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	obj, ok := s.objects[strings.ToLower(id)]
+	return obj, ok
+}
+
+func (s *objectStore) setup(sc *store.MockStorageClient) {
+	sc.EXPECT().
+		Get(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, id string, _ ...store.GetOptions) (*store.Object, error) {
+			obj, ok := s.get(id)
+			if !ok {
+				return nil, &store.ErrNotFound{ID: id}
+			}
+			return &obj, nil
+		}).AnyTimes()
+	sc.EXPECT().
+		Save(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, obj *store.Object, _ ...store.SaveOptions) error {
+			s.mu.Lock()
+			defer s.mu.Unlock()
+			s.objects[strings.ToLower(obj.ID)] = *obj
+			return nil
+		}).AnyTimes()
+	sc.EXPECT().
+		Delete(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, id string, _ ...store.DeleteOptions) error {
+			s.mu.Lock()
+			defer s.mu.Unlock()
+			delete(s.objects, strings.ToLower(id))
+			return nil
+		}).AnyTimes()
+	sc.EXPECT().
+		Query(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, query store.Query, _ ...store.QueryOptions) (*store.ObjectQueryResult, error) {
+			s.mu.Lock()
+			defer s.mu.Unlock()
+			result := &store.ObjectQueryResult{}
+			for id, obj := range s.objects {
+				if strings.Contains(id, "/operationstatuses/") {
+					result.Items = append(result.Items, obj)
+				}
+			}
+			return result, nil
+		}).AnyTimes()
+}
+
+func TestStart_RequeuedDeadLetter(t *testing.T) {
+	tCtx, mctrl := newTestContext(t, defaultTestLockTime)
+	defer mctrl.Finish()
+
+	objects := &objectStore{objects: map[string]store.Object{}}
+	objects.setup(tCtx.mockSC)
+	tCtx.mockSP.EXPECT().GetStorageClient(gomock.Any(), gomock.Any()).Return(store.StorageClient(tCtx.mockSC), nil).AnyTimes()
+
+	const resourceID = "/planes/radius/local/resourceGroups/radius-test-rg/providers/Applications.Core/environments/env0"
+	operationID := uuid.New()
+	statusID := "/planes/radius/local/providers/applications.core/locations/test-location/operationstatuses/" + operationID.String()
+	deadLetterID := "/planes/radius/local/providers/applications.core/locations/test-location/deadletters/" + operationID.String()
+
+	// The operation failed after exhausting its attempts, and its request was dead-lettered.
+	_ = tCtx.mockSC.Save(tCtx.ctx, &store.Object{
+		Metadata: store.Metadata{ID: resourceID},
+		Data:     map[string]any{"name": "env0", "provisioningState": "Failed"},
+	})
+	_ = tCtx.mockSC.Save(tCtx.ctx, &store.Object{
+		Metadata: store.Metadata{ID: statusID},
+		Data: &manager.Status{
+			AsyncOperationStatus: v1.AsyncOperationStatus{
+				ID:        statusID,
+				Name:      operationID.String(),
+				Status:    v1.ProvisioningStateFailed,
+				StartTime: time.Now().UTC().Add(-time.Hour),
+			},
+			LinkedResourceID: resourceID,
+		},
+	})
+	_ = tCtx.mockSC.Save(tCtx.ctx, &store.Object{
+		Metadata: store.Metadata{ID: deadLetterID},
+		Data: &manager.DeadLetter{
+			ID:   deadLetterID,
+			Name: operationID.String(),
+			Request: ctrl.Request{
+				OperationID:   operationID,
+				OperationType: "APPLICATIONS.CORE/ENVIRONMENTS|PUT",
+				ResourceID:    resourceID,
+				RetryCount:    defaultMaxOperationRetryCount,
+			},
+			DequeueCount: 1,
+		},
+	})
+
+	sm := manager.New(tCtx.mockSP, tCtx.testQueue, "test-location")
+	registry := NewControllerRegistry(tCtx.mockSP)
+	worker := New(Options{DequeueIntervalDuration: defaultTestDequeueInterval}, sm, tCtx.testQueue, registry)
+
+	called := make(chan struct{})
+	err := registry.Register(tCtx.ctx, testResourceType, v1.OperationPut, func(opts ctrl.Options) (ctrl.Controller, error) {
+		return &testAsyncController{
+			BaseController: ctrl.NewBaseAsyncController(opts),
+			fn: func(ctx context.Context) (ctrl.Result, error) {
+				close(called)
+				return ctrl.Result{}, nil
+			},
+		}, nil
+	}, ctrl.Options{DataProvider: tCtx.mockSP})
+	require.NoError(t, err)
+
+	err = sm.Requeue(tCtx.ctx, resources.MustParse(deadLetterID))
+	require.NoError(t, err)
+	_, ok := objects.get(deadLetterID)
+	require.False(t, ok, "the dead letter is deleted")
+
+	ctx, cancel := tCtx.cancellable(0)
+	done := make(chan struct{})
+	go func() {
+		err := worker.Start(ctx)
+		require.NoError(t, err)
+		close(done)
+	}()
+
+	// The requeued operation is processed rather than failed again for exceeding its attempts.
+	select {
+	case <-called:
+	case <-time.After(10 * time.Second):
+		require.Fail(t, "the requeued operation was not processed")
+	}
+	tCtx.drainQueueOrAssert(t)
+
+	cancel()
+	<-done
+
+	obj, ok := objects.get(statusID)
+	require.True(t, ok)
+	status := &manager.Status{}
+	require.NoError(t, obj.As(status))
+	require.Equal(t, v1.ProvisioningStateSucceeded, status.Status)
+
+	_, ok = objects.get(deadLetterID)
+	require.False(t, ok, "the operation is not dead-lettered again")
+}
@@ -0,0 +1,62 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package worker
+
+import (
+	"context"
+	"time"
+
+	"github.com/radius-project/radius/pkg/metrics"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+)
+
+// runOperationStatusSweeper purges the expired operation statuses of the provider namespaces with registered
+// controllers every OperationStatusSweepInterval until the context is canceled.
+func (w *AsyncRequestProcessWorker) runOperationStatusSweeper(ctx context.Context) {
+	ticker := time.NewTicker(w.options.OperationStatusSweepInterval)
+	defer ticker.Stop()
+
+	for {
+		w.sweepOperationStatuses(ctx, time.Now().UTC())
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// sweepOperationStatuses purges the operation statuses of completed operations which were last updated longer than
+// OperationStatusRetention before now. Purging is best-effort, failures are logged and retried on the next sweep.
+func (w *AsyncRequestProcessWorker) sweepOperationStatuses(ctx context.Context, now time.Time) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+	before := now.Add(-w.options.OperationStatusRetention)
+
+	for _, namespace := range w.registry.ProviderNamespaces() {
+		purged, err := w.sm.PurgeExpired(ctx, namespace, before)
+		if purged > 0 {
+			metrics.DefaultAsyncOperationMetrics.RecordPurgedOperationStatuses(ctx, namespace, purged)
+		}
+		if err != nil {
+			logger.Error(err, "failed to purge expired operation statuses", "provider", namespace)
+			continue
+		}
+
+		logger.V(ucplog.LevelDebug).Info("purged expired operation statuses", "provider", namespace, "count", purged)
+	}
+}
@@ -0,0 +1,56 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package worker
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	ctrl "github.com/radius-project/radius/pkg/armrpc/asyncoperation/controller"
+	manager "github.com/radius-project/radius/pkg/armrpc/asyncoperation/statusmanager"
+	"github.com/radius-project/radius/pkg/ucp/dataprovider"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestSweepOperationStatuses(t *testing.T) {
+	mctrl := gomock.NewController(t)
+	defer mctrl.Finish()
+
+	mockSP := dataprovider.NewMockDataStorageProvider(mctrl)
+	mockSP.EXPECT().GetStorageClient(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+	registry := NewControllerRegistry(mockSP)
+
+	factory := func(opts ctrl.Options) (ctrl.Controller, error) {
+		return &testAsyncController{BaseController: ctrl.NewBaseAsyncController(opts)}, nil
+	}
+	for _, resourceType := range []string{"Applications.Core/containers", "Applications.Core/environments", "Applications.Dapr/stateStores"} {
+		err := registry.Register(context.TODO(), resourceType, v1.OperationPut, factory, ctrl.Options{})
+		require.NoError(t, err)
+	}
+
+	now := time.Now().UTC()
+	sm := manager.NewMockStatusManager(mctrl)
+	sm.EXPECT().PurgeExpired(gomock.Any(), "Applications.Core", now.Add(-time.Hour)).Return(2, nil)
+	sm.EXPECT().PurgeExpired(gomock.Any(), "Applications.Dapr", now.Add(-time.Hour)).Return(0, errors.New("query failed"))
+
+	worker := New(Options{OperationStatusRetention: time.Hour}, sm, nil, registry)
+	worker.sweepOperationStatuses(context.Background(), now)
+}
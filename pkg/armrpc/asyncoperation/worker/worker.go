@@ -23,6 +23,7 @@ import (
 	"fmt"
 	"runtime/debug"
 	"strings"
+	"sync"
 	"time"
 
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
@@ -34,6 +35,7 @@ import (
 	queue "github.com/radius-project/radius/pkg/ucp/queue/client"
 	"github.com/radius-project/radius/pkg/ucp/resources"
 	"github.com/radius-project/radius/pkg/ucp/store"
+	"github.com/radius-project/radius/pkg/ucp/store/partition"
 	"github.com/radius-project/radius/pkg/ucp/ucplog"
 
 	"github.com/google/uuid"
@@ -58,6 +60,17 @@ const (
 
 	// defaultDequeueInterval is the default duration for the dequeue interval.
 	defaultDequeueInterval = time.Duration(200) * time.Millisecond
+
+	// defaultOperationStatusSweepInterval is the default interval between purges of expired operation statuses.
+	defaultOperationStatusSweepInterval = time.Hour
+
+	// defaultDrainTimeout is the default duration that the in-flight operations are given to complete when the worker
+	// is stopped. It is shorter than the shutdown timeout of the host.
+	defaultDrainTimeout = time.Duration(5) * time.Second
+
+	// operationStopTimeout is the duration to wait for a stopped controller to return so that its operation can be
+	// queued again.
+	operationStopTimeout = time.Second
 )
 
 // Options configures AsyncRequestProcessorWorker
@@ -79,6 +92,23 @@ type Options struct {
 
 	// DequeueIntervalDuration is the duration for the dequeue interval.
 	DequeueIntervalDuration time.Duration
+
+	// OperationConcurrencyLimits is the maximum concurrency to process async operations keyed by resource type
+	// (e.g. Applications.Core/containers) or operation type (e.g. Applications.Core/containers|PUT). Operation type
+	// limits take precedence over resource type limits. These limits apply within MaxOperationConcurrency, and an
+	// operation waiting for its limit keeps its MaxOperationConcurrency slot.
+	OperationConcurrencyLimits map[string]int
+
+	// OperationStatusRetention is the duration that the status of a completed async operation is kept for. Expired
+	// operation statuses are not purged when the retention is not set.
+	OperationStatusRetention time.Duration
+
+	// OperationStatusSweepInterval is the interval between purges of expired operation statuses.
+	OperationStatusSweepInterval time.Duration
+
+	// DrainTimeout is the duration that the in-flight operations are given to complete when the worker is stopped.
+	// Operations which do not complete in time are stopped and queued again to be processed by another worker.
+	DrainTimeout time.Duration
 }
 
 // AsyncRequestProcessWorker is the worker to process async requests.
@@ -89,6 +119,13 @@ type AsyncRequestProcessWorker struct {
 	requestQueue queue.Client
 
 	sem *semaphore.Weighted
+
+	// typeSems limits the concurrency of the operations configured in Options.OperationConcurrencyLimits, keyed by
+	// the upper-cased resource type or operation type.
+	typeSems map[string]*semaphore.Weighted
+
+	// running holds the cancel function of each operation being processed by this worker, keyed by operation ID.
+	running sync.Map
 }
 
 // New creates AsyncRequestProcessWorker server instance.
@@ -115,6 +152,19 @@ func New(
 	if options.DequeueIntervalDuration == time.Duration(0) {
 		options.DequeueIntervalDuration = defaultDequeueInterval
 	}
+	if options.OperationStatusSweepInterval == time.Duration(0) {
+		options.OperationStatusSweepInterval = defaultOperationStatusSweepInterval
+	}
+	if options.DrainTimeout == time.Duration(0) {
+		options.DrainTimeout = defaultDrainTimeout
+	}
+
+	typeSems := map[string]*semaphore.Weighted{}
+	for key, limit := range options.OperationConcurrencyLimits {
+		if limit > 0 {
+			typeSems[strings.ToUpper(key)] = semaphore.NewWeighted(int64(limit))
+		}
+	}
 
 	return &AsyncRequestProcessWorker{
 		options:      options,
@@ -122,6 +172,7 @@ func New(
 		registry:     ctrlRegistry,
 		requestQueue: qu,
 		sem:          semaphore.NewWeighted(int64(options.MaxOperationConcurrency)),
+		typeSems:     typeSems,
 	}
 }
 
@@ -134,6 +185,16 @@ func (w *AsyncRequestProcessWorker) Start(ctx context.Context) error {
 		return err
 	}
 
+	if w.options.OperationStatusRetention > 0 {
+		go w.runOperationStatusSweeper(ctx)
+	}
+
+	// The operations are processed with a context which is not canceled with ctx, so that the in-flight operations
+	// can complete while the worker drains.
+	opCtx, stopOperations := context.WithCancel(context.WithoutCancel(ctx))
+	defer stopOperations()
+	inflight := &sync.WaitGroup{}
+
 	// this loop will run until msgCh is closed (or when ctx is canceled)
 	for msg := range msgCh {
 		// This semaphore will maintain the number of go routines to process the messages concurrently.
@@ -141,7 +202,9 @@ func (w *AsyncRequestProcessWorker) Start(ctx context.Context) error {
 			break
 		}
 
+		inflight.Add(1)
 		go func(msgreq *queue.Message) {
+			defer inflight.Done()
 			defer w.sem.Release(1)
 
 			op := &ctrl.Request{}
@@ -150,7 +213,12 @@ func (w *AsyncRequestProcessWorker) Start(ctx context.Context) error {
 				return
 			}
 
-			reqCtx := trace.WithTraceparent(ctx, op.TraceparentID)
+			if op.Cancel {
+				w.cancelOperation(opCtx, msgreq, op)
+				return
+			}
+
+			reqCtx := trace.WithTraceparent(opCtx, op.TraceparentID)
 
 			// Populate the default attributes in the current context so all logs will have these fields.
 			reqCtx = ucplog.WrapLogContext(reqCtx,
@@ -167,6 +235,7 @@ func (w *AsyncRequestProcessWorker) Start(ctx context.Context) error {
 				return
 			}
 			reqCtx = v1.WithARMRequestContext(reqCtx, armReqCtx)
+			reqCtx = partition.WithPartition(reqCtx, op.Partition)
 
 			asyncCtrl := w.registry.Get(armReqCtx.OperationType)
 			if asyncCtrl == nil {
@@ -177,17 +246,32 @@ func (w *AsyncRequestProcessWorker) Start(ctx context.Context) error {
 				return
 			}
 
-			if msgreq.DequeueCount > w.options.MaxOperationRetryCount {
-				errMsg := fmt.Sprintf("exceeded max retry count to process async operation message: %d", msgreq.DequeueCount)
+			if attempts := op.RetryCount + msgreq.DequeueCount; attempts > w.retryPolicy(armReqCtx.OperationType).MaxAttempts {
+				errMsg := fmt.Sprintf("exceeded max retry count to process async operation message: %d", attempts)
 				opLogger.Error(nil, errMsg)
 				failed := ctrl.NewFailedResult(v1.ErrorDetails{
 					Code:    v1.CodeInternal,
 					Message: errMsg,
 				})
+
+				// Keep the request so that it can be inspected and requeued once the cause of the failure is fixed.
+				if err := w.sm.DeadLetter(reqCtx, op, msgreq.DequeueCount, failed.Error); err != nil {
+					opLogger.Error(err, "failed to save the request to the dead-letter collection")
+				}
 				w.completeOperation(reqCtx, msgreq, failed, asyncCtrl.StorageClient())
 				return
 			}
 
+			if typeSem := w.operationSemaphore(armReqCtx.OperationType); typeSem != nil {
+				// Keep the worker-wide slot while waiting so that this worker does not lease more messages than it
+				// can process.
+				if err := w.waitForOperationSlot(reqCtx, msgreq, typeSem); err != nil {
+					opLogger.Info("Stopped waiting for the operation concurrency limit. This operation will be reprocessed.", "reason", err.Error())
+					return
+				}
+				defer typeSem.Release(1)
+			}
+
 			// TODO: Handle the edge cases:
 			// 1. The same message is delivered twice in multiple instances.
 			// 2. provisioningState is not matched between resource and operationStatuses
@@ -199,6 +283,7 @@ func (w *AsyncRequestProcessWorker) Start(ctx context.Context) error {
 			}
 			if dup {
 				opLogger.Info("duplicated message detected")
+				w.finishCanceledOperation(reqCtx, msgreq, op, asyncCtrl.StorageClient())
 				return
 			}
 
@@ -210,10 +295,30 @@ func (w *AsyncRequestProcessWorker) Start(ctx context.Context) error {
 		}(msg)
 	}
 
-	logger.Info("Message loop stopped...")
+	logger.Info("Message loop stopped. Draining in-flight operations...", "timeout", w.options.DrainTimeout)
+	w.drain(inflight, stopOperations)
 	return nil
 }
 
+// drain waits for the in-flight operations to complete. The operations which are still running when the drain
+// timeout elapses are stopped, and drain waits for them to be queued again.
+func (w *AsyncRequestProcessWorker) drain(inflight *sync.WaitGroup, stopOperations context.CancelFunc) {
+	done := make(chan struct{})
+	go func() {
+		inflight.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return
+	case <-time.After(w.options.DrainTimeout):
+	}
+
+	stopOperations()
+	<-done
+}
+
 func (w *AsyncRequestProcessWorker) runOperation(ctx context.Context, message *queue.Message, asyncCtrl ctrl.Controller) {
 	ctx, span := trace.StartConsumerSpan(ctx, "worker.runOperation receive", trace.BackendTracerName)
 	defer span.End()
@@ -224,12 +329,18 @@ func (w *AsyncRequestProcessWorker) runOperation(ctx context.Context, message *q
 		logger.Error(err, "failed to unmarshal queue message.")
 		return
 	}
-	asyncReqCtx, opCancel := context.WithCancel(ctx)
+	asyncReqCtx, opCancelCause := context.WithCancelCause(ctx)
+	opCancel := func() { opCancelCause(nil) }
+	asyncReqCtx = ctrl.WithProgressReporter(asyncReqCtx, w.progressReporter(asyncReq))
 	// Ensure that asyncReqCtx context is cancelled when runOperation returns.
 	// That is, cancelling asyncReqCtx signals to ctrl.Run() to cancel the execution,
 	// resulting in completing the go-routine calling ctrl.Run() when runOperation returns.
 	defer opCancel()
 
+	// Register the operation so that a cancellation message can stop it.
+	w.running.Store(asyncReq.OperationID, opCancelCause)
+	defer w.running.Delete(asyncReq.OperationID)
+
 	opDone := make(chan struct{}, 1)
 	opStartAt := time.Now()
 
@@ -261,31 +372,68 @@ func (w *AsyncRequestProcessWorker) runOperation(ctx context.Context, message *q
 
 		logger.Info("Operation returned", "success", result.Error == nil, "provisioningState", result.ProvisioningState(), "err", result.Error)
 
-		// There are two cases when asyncReqCtx is canceled.
-		// 1. When the operation is timed out, w.completeOperation will be called in L186
-		// 2. When parent context is canceled or done, we need to requeue the operation to reprocess the request.
-		// Such cases should not call w.completeOperation.
-		if !errors.Is(asyncReqCtx.Err(), context.Canceled) {
+		// There are four cases when asyncReqCtx is canceled.
+		// 1. When the operation is canceled by the user, the operation is completed as canceled.
+		// 2. When the operation is timed out, w.completeOperation will be called in L186
+		// 3. When parent context is canceled or done, we need to requeue the operation to reprocess the request.
+		// 4. When the message was deleted or leased by another worker, this worker no longer owns the operation.
+		// The last three cases should not call w.completeOperation.
+		if errors.Is(context.Cause(asyncReqCtx), ctrl.ErrOperationCanceled) {
+			result = ctrl.NewCanceledResult("Operation was canceled by the user.")
+			result.Error.Target = asyncReq.ResourceID
+			w.completeOperation(ctx, message, result, asyncCtrl.StorageClient())
+		} else if ctx.Err() != nil && !errors.Is(context.Cause(asyncReqCtx), ctrl.ErrOperationLost) {
+			// The worker is stopping, so queue the operation again rather than waiting for the message lock to expire.
+			// The interrupted attempt is not counted as a retry.
+			retry := max(asyncReq.RetryCount, asyncReq.RetryCount+message.DequeueCount-1)
+			w.queueRetry(context.WithoutCancel(ctx), message, asyncReq, retry, 0)
+		} else if !errors.Is(asyncReqCtx.Err(), context.Canceled) {
 			w.completeOperation(ctx, message, result, asyncCtrl.StorageClient())
 		}
 		trace.SetAsyncResultStatus(result, span)
 	}()
 
 	operationTimeoutAfter := time.After(asyncReq.Timeout())
-	messageExtendAfter := w.getMessageExtendDuration(message.NextVisibleAt)
+	messageExtendAfter := time.After(w.getMessageExtendDuration(message.NextVisibleAt))
 
 	for {
 		select {
-		case <-time.After(messageExtendAfter):
-			if err := w.requestQueue.ExtendMessage(ctx, message); err != nil {
+		case <-messageExtendAfter:
+			err := w.requestQueue.ExtendMessage(ctx, message)
+			if errors.Is(err, queue.ErrInvalidMessage) || errors.Is(err, queue.ErrDequeuedMessage) {
+				// The message is gone, so the controller must stop. Stop extending the lock and wait for the
+				// controller to return.
+				logger.Info("Message was deleted or leased by another worker. Stopping async operation.", "err", err.Error())
+				opCancelCause(ctrl.ErrOperationLost)
+				messageExtendAfter = nil
+				continue
+			} else if err != nil && !time.Now().Before(message.NextVisibleAt) {
+				// The lock expired before it could be extended, so the message may already be processed by another
+				// worker. Stop the controller rather than running the operation twice.
+				logger.Error(err, "message lock expired before it could be extended. Stopping async operation.")
+				opCancelCause(ctrl.ErrOperationLost)
+				messageExtendAfter = nil
+				continue
+			} else if err != nil {
 				logger.Error(err, "fails to extend message lock")
 			} else {
 				logger.Info("Extended message lock duration.", "nextVisibleTime", message.NextVisibleAt.UTC().String())
 				metrics.DefaultAsyncOperationMetrics.RecordExtendedAsyncOperation(ctx, asyncReq)
 			}
-			messageExtendAfter = w.getMessageExtendDuration(message.NextVisibleAt)
+			messageExtendAfter = time.After(w.getMessageExtendDuration(message.NextVisibleAt))
+
+			// The cancellation message may have been received by another worker, so check the status as well.
+			if w.isCanceled(ctx, asyncReq) {
+				logger.Info("Operation was canceled by the user.")
+				opCancelCause(ctrl.ErrOperationCanceled)
+			}
 
 		case <-operationTimeoutAfter:
+			if errors.Is(context.Cause(asyncReqCtx), ctrl.ErrOperationLost) {
+				// The operation is owned by another worker, which is responsible for completing it.
+				return
+			}
+
 			logger.Info("Cancelling async operation.")
 
 			opCancel()
@@ -297,6 +445,12 @@ func (w *AsyncRequestProcessWorker) runOperation(ctx context.Context, message *q
 
 		case <-ctx.Done():
 			logger.Info("Stopping processing async operation. This operation will be reprocessed.")
+
+			// Wait for the controller to return so that the operation is queued again before the worker exits.
+			select {
+			case <-opDone:
+			case <-time.After(operationStopTimeout):
+			}
 			return
 
 		case <-opDone:
@@ -337,11 +491,75 @@ func (w *AsyncRequestProcessWorker) completeOperation(ctx context.Context, messa
 		if err := w.requestQueue.FinishMessage(ctx, message); err != nil {
 			logger.Error(err, "failed to finish the message")
 		}
+	} else {
+		w.requeueOperation(ctx, message, req)
 	}
 
 	metrics.DefaultAsyncOperationMetrics.RecordAsyncOperation(ctx, req, &result)
 }
 
+// progressReporter returns the reporter which saves the progress reported by the controller to the status of the
+// operation.
+func (w *AsyncRequestProcessWorker) progressReporter(req *ctrl.Request) ctrl.ProgressReporter {
+	return func(ctx context.Context, percentComplete float64, stage string) error {
+		rID, err := resources.ParseResource(req.ResourceID)
+		if err != nil {
+			return err
+		}
+
+		return w.sm.UpdateProgress(ctx, rID, req.OperationID, percentComplete, stage)
+	}
+}
+
+// retryPolicy returns the retry policy registered for the operation type. MaxOperationRetryCount is used when the
+// policy does not set MaxAttempts.
+func (w *AsyncRequestProcessWorker) retryPolicy(operationType v1.OperationType) RetryPolicy {
+	policy := RetryPolicy{}
+	if w.registry != nil {
+		policy, _ = w.registry.RetryPolicy(operationType)
+	}
+	if policy.MaxAttempts == 0 {
+		policy.MaxAttempts = w.options.MaxOperationRetryCount
+	}
+	return policy
+}
+
+// requeueOperation schedules the retry of the operation after the delay of its retry policy. The request is queued
+// as a new message which carries the number of attempts, and the current message is finished. When the policy has
+// no delay, or the request cannot be queued, the message is left to be redelivered once its lock expires.
+func (w *AsyncRequestProcessWorker) requeueOperation(ctx context.Context, message *queue.Message, req *ctrl.Request) {
+	opType, ok := v1.ParseOperationType(req.OperationType)
+	if !ok {
+		return
+	}
+
+	retry := req.RetryCount + message.DequeueCount
+	delay := w.retryPolicy(opType).Delay(retry)
+	if delay <= 0 {
+		return
+	}
+
+	w.queueRetry(ctx, message, req, retry, delay)
+}
+
+// queueRetry queues the request as a new message which becomes visible after the delay and finishes the current
+// message. When the request cannot be queued, the message is left to be redelivered once its lock expires.
+func (w *AsyncRequestProcessWorker) queueRetry(ctx context.Context, message *queue.Message, req *ctrl.Request, retryCount int, delay time.Duration) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	next := *req
+	next.RetryCount = retryCount
+	if err := w.requestQueue.Enqueue(ctx, queue.NewMessage(&next), queue.WithEnqueueAfter(delay)); err != nil {
+		logger.Error(err, "failed to queue the operation again. The message will be redelivered once its lock expires.")
+		return
+	}
+
+	logger.Info("Queued the operation again.", "retryCount", retryCount, "delay", delay)
+	if err := w.requestQueue.FinishMessage(ctx, message); err != nil {
+		logger.Error(err, "failed to finish the message")
+	}
+}
+
 func (w *AsyncRequestProcessWorker) updateResourceAndOperationStatus(ctx context.Context, sc store.StorageClient, req *ctrl.Request, state v1.ProvisioningState, opErr *v1.ErrorDetails) error {
 	logger := ucplog.FromContextOrDiscard(ctx)
 
@@ -392,6 +610,108 @@ func (w *AsyncRequestProcessWorker) isDuplicated(ctx context.Context, resourceID
 	return false, nil
 }
 
+// cancelOperation stops the given operation if it is being processed by this worker and finishes the cancellation message.
+func (w *AsyncRequestProcessWorker) cancelOperation(ctx context.Context, message *queue.Message, req *ctrl.Request) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+	if cancel, ok := w.running.Load(req.OperationID); ok {
+		logger.Info("Canceling async operation.", logging.LogFieldOperationID, req.OperationID)
+		cancel.(context.CancelCauseFunc)(ctrl.ErrOperationCanceled)
+	}
+
+	if err := w.requestQueue.FinishMessage(ctx, message); err != nil {
+		logger.Error(err, "failed to finish the message")
+	}
+}
+
+// finishCanceledOperation finishes the message of an operation that was canceled before it was processed, so that it
+// is not redelivered, and moves the resource out of the Accepted state so that it can be updated or deleted again.
+func (w *AsyncRequestProcessWorker) finishCanceledOperation(ctx context.Context, message *queue.Message, req *ctrl.Request, sc store.StorageClient) {
+	if !w.isCanceled(ctx, req) {
+		return
+	}
+
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	// A resource in Accepted state is waiting for this operation, which will never run. Otherwise the operation was
+	// already started and the worker processing it has updated the resource.
+	obj, err := sc.Get(ctx, req.ResourceID)
+	if errors.Is(err, &store.ErrNotFound{}) {
+		logger.Info("failed to update the provisioningState in resource because it no longer exists.")
+	} else if err != nil {
+		logger.Error(err, "failed to get the resource of the canceled operation.")
+		return
+	} else if pState, ok := obj.Data.(map[string]any)["provisioningState"].(string); ok && strings.EqualFold(pState, string(v1.ProvisioningStateAccepted)) {
+		if err := updateResourceState(ctx, sc, req.ResourceID, v1.ProvisioningStateCanceled); err != nil {
+			logger.Error(err, "failed to update the provisioningState in resource.")
+			return
+		}
+	}
+
+	if err := w.requestQueue.FinishMessage(ctx, message); err != nil {
+		logger.Error(err, "failed to finish the message")
+	}
+}
+
+// isCanceled returns true if the status of the operation is Canceled.
+func (w *AsyncRequestProcessWorker) isCanceled(ctx context.Context, req *ctrl.Request) bool {
+	rID, err := resources.ParseResource(req.ResourceID)
+	if err != nil {
+		return false
+	}
+
+	status, err := w.sm.Get(ctx, rID, req.OperationID)
+	if err != nil {
+		return false
+	}
+
+	return status.Status == v1.ProvisioningStateCanceled
+}
+
+// operationSemaphore returns the semaphore limiting the concurrency of the given operation type, or nil if the
+// operation type is not limited.
+func (w *AsyncRequestProcessWorker) operationSemaphore(operationType v1.OperationType) *semaphore.Weighted {
+	if sem, ok := w.typeSems[operationType.String()]; ok {
+		return sem
+	}
+	if sem, ok := w.typeSems[strings.ToUpper(operationType.Type)]; ok {
+		return sem
+	}
+	return nil
+}
+
+// waitForOperationSlot blocks until a slot is acquired from sem, extending the message lock while waiting so that
+// the message is not redelivered to another worker. It returns an error if ctx is done or the lock is lost.
+func (w *AsyncRequestProcessWorker) waitForOperationSlot(ctx context.Context, message *queue.Message, sem *semaphore.Weighted) error {
+	if sem.TryAcquire(1) {
+		return nil
+	}
+
+	waitCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	// Acquire serves waiters in order, so an operation cannot be starved by the operations dequeued after it.
+	acquired := make(chan error, 1)
+	go func() {
+		acquired <- sem.Acquire(waitCtx, 1)
+	}()
+
+	for {
+		select {
+		case err := <-acquired:
+			return err
+
+		case <-time.After(w.getMessageExtendDuration(message.NextVisibleAt)):
+			if err := w.requestQueue.ExtendMessage(ctx, message); err != nil {
+				cancel()
+				if acqErr := <-acquired; acqErr == nil {
+					sem.Release(1)
+				}
+				return err
+			}
+		}
+	}
+}
+
 func (w *AsyncRequestProcessWorker) getMessageExtendDuration(visibleAt time.Time) time.Duration {
 	d := time.Until(visibleAt.Add(-w.options.MessageExtendMargin))
 	if d <= 0 {
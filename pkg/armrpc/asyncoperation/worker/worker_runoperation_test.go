@@ -18,6 +18,7 @@ package worker
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"strings"
@@ -208,6 +209,8 @@ func TestStart_MaxDequeueCount(t *testing.T) {
 	tCtx, mctrl := newTestContext(t, 1*time.Minute)
 	defer mctrl.Finish()
 
+	expectedDequeueCount := 2
+
 	// set up mocks
 	tCtx.mockSC.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).
 		DoAndReturn(func(ctx context.Context, id string, _ ...store.GetOptions) (*store.Object, error) {
@@ -215,10 +218,9 @@ func TestStart_MaxDequeueCount(t *testing.T) {
 		}).AnyTimes()
 	tCtx.mockSC.EXPECT().Save(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).Times(1)
 	tCtx.mockSM.EXPECT().Update(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Eq(v1.ProvisioningStateFailed), gomock.Any(), gomock.Any()).Return(nil).Times(1)
+	tCtx.mockSM.EXPECT().DeadLetter(gomock.Any(), gomock.Any(), gomock.Eq(expectedDequeueCount+2), gomock.Any()).Return(nil).Times(1)
 	tCtx.mockSP.EXPECT().GetStorageClient(gomock.Any(), gomock.Any()).Return(store.StorageClient(tCtx.mockSC), nil).Times(1)
 
-	expectedDequeueCount := 2
-
 	registry := NewControllerRegistry(tCtx.mockSP)
 	worker := New(Options{MaxOperationRetryCount: expectedDequeueCount, DequeueIntervalDuration: defaultTestDequeueInterval}, tCtx.mockSM, tCtx.testQueue, registry)
 
@@ -418,6 +420,92 @@ func TestStart_RunOperation(t *testing.T) {
 	require.Equal(t, 1, testMessage.DequeueCount)
 }
 
+func TestStart_Drain(t *testing.T) {
+	tests := []struct {
+		name         string
+		drainTimeout time.Duration
+		// block is true when the controller runs until its context is canceled.
+		block bool
+	}{
+		{name: "operation completes while draining", drainTimeout: 5 * time.Second},
+		{name: "operation is requeued after drain timeout", drainTimeout: 50 * time.Millisecond, block: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tCtx, mctrl := newTestContext(t, defaultTestLockTime)
+			defer mctrl.Finish()
+
+			tCtx.mockSC.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, id string, _ ...store.GetOptions) (*store.Object, error) {
+					return newTestResourceObject(), nil
+				}).AnyTimes()
+			tCtx.mockSC.EXPECT().Save(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+			tCtx.mockSM.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).Return(testOperationStatus, nil).AnyTimes()
+			tCtx.mockSM.EXPECT().Update(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+			tCtx.mockSP.EXPECT().GetStorageClient(gomock.Any(), gomock.Any()).Return(store.StorageClient(tCtx.mockSC), nil).AnyTimes()
+
+			registry := NewControllerRegistry(tCtx.mockSP)
+			worker := New(Options{DequeueIntervalDuration: defaultTestDequeueInterval, DrainTimeout: tt.drainTimeout}, tCtx.mockSM, tCtx.testQueue, registry)
+
+			started := make(chan struct{})
+			release := make(chan struct{})
+			completed := atomic.NewBool(false)
+			testCtrl := &testAsyncController{
+				BaseController: ctrl.NewBaseAsyncController(ctrl.Options{StorageClient: tCtx.mockSC}),
+				fn: func(ctx context.Context) (ctrl.Result, error) {
+					close(started)
+					if tt.block {
+						<-ctx.Done()
+						return ctrl.Result{}, ctx.Err()
+					}
+
+					<-release
+					completed.Store(true)
+					return ctrl.Result{}, nil
+				},
+			}
+
+			ctx, cancel := tCtx.cancellable(time.Duration(0))
+			err := registry.Register(ctx, testResourceType, v1.OperationPut, func(opts ctrl.Options) (ctrl.Controller, error) {
+				return testCtrl, nil
+			}, ctrl.Options{})
+			require.NoError(t, err)
+
+			done := make(chan struct{})
+			go func() {
+				err := worker.Start(ctx)
+				require.NoError(t, err)
+				close(done)
+			}()
+
+			testMessage := genTestMessage(uuid.New(), ctrl.DefaultAsyncOperationTimeout)
+			err = tCtx.testQueue.Enqueue(ctx, testMessage)
+			require.NoError(t, err)
+			<-started
+
+			// Stop the worker while the operation is running.
+			cancel()
+			if !tt.block {
+				time.Sleep(50 * time.Millisecond)
+				close(release)
+			}
+			<-done
+
+			if tt.block {
+				// The message is finished and the operation is queued again to be processed by another worker.
+				require.Equal(t, 1, tCtx.internalQ.Len())
+				requeued, err := tCtx.testQueue.Dequeue(tCtx.ctx, queue.QueueClientConfig{})
+				require.NoError(t, err)
+				require.NotEqual(t, testMessage.ID, requeued.ID)
+			} else {
+				require.True(t, completed.Load())
+				require.Equal(t, 0, tCtx.internalQ.Len(), "message is finished")
+			}
+		})
+	}
+}
+
 func TestRunOperation_Successfully(t *testing.T) {
 	tCtx, mctrl := newTestContext(t, defaultTestLockTime)
 	defer mctrl.Finish()
@@ -457,6 +545,166 @@ func TestRunOperation_Successfully(t *testing.T) {
 	require.Equal(t, 0, tCtx.internalQ.Len(), "message is finished")
 }
 
+func TestRunOperation_ReportProgress(t *testing.T) {
+	tCtx, mctrl := newTestContext(t, defaultTestLockTime)
+	defer mctrl.Finish()
+
+	// set up mocks
+	tCtx.mockSC.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, id string, _ ...store.GetOptions) (*store.Object, error) {
+			return newTestResourceObject(), nil
+		}).AnyTimes()
+	tCtx.mockSC.EXPECT().Save(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	tCtx.mockSM.EXPECT().Update(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	tCtx.mockSM.EXPECT().UpdateProgress(gomock.Any(), gomock.Any(), gomock.Any(), float64(50), "Deploying").Return(nil)
+
+	testMessage := genTestMessage(uuid.New(), ctrl.DefaultAsyncOperationTimeout)
+	err := tCtx.testQueue.Enqueue(tCtx.ctx, testMessage)
+	require.NoError(t, err)
+	worker := New(Options{}, tCtx.mockSM, tCtx.testQueue, nil)
+
+	testCtrl := &testAsyncController{
+		BaseController: ctrl.NewBaseAsyncController(ctrl.Options{StorageClient: tCtx.mockSC}),
+		fn: func(ctx context.Context) (ctrl.Result, error) {
+			return ctrl.Result{}, ctrl.ReportProgress(ctx, 50, "Deploying")
+		},
+	}
+
+	msg, err := tCtx.testQueue.Dequeue(tCtx.ctx, queue.QueueClientConfig{})
+	require.NoError(t, err)
+	worker.runOperation(context.Background(), msg, testCtrl)
+
+	require.Equal(t, 0, tCtx.internalQ.Len(), "message is finished")
+}
+
+func TestRunOperation_Canceled(t *testing.T) {
+	tCtx, mctrl := newTestContext(t, defaultTestLockTime)
+	defer mctrl.Finish()
+
+	// set up mocks
+	tCtx.mockSC.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, id string, _ ...store.GetOptions) (*store.Object, error) {
+			return newTestResourceObject(), nil
+		}).AnyTimes()
+	tCtx.mockSC.EXPECT().Save(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	tCtx.mockSM.EXPECT().Update(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Eq(v1.ProvisioningStateCanceled), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, id resources.ID, operationID uuid.UUID, state v1.ProvisioningState, endTime *time.Time, opError *v1.ErrorDetails) error {
+			require.Equal(t, v1.CodeOperationCanceled, opError.Code)
+			return nil
+		}).Times(1)
+
+	operationID := uuid.New()
+	testMessage := genTestMessage(operationID, ctrl.DefaultAsyncOperationTimeout)
+	err := tCtx.testQueue.Enqueue(tCtx.ctx, testMessage)
+	require.NoError(t, err)
+
+	worker := New(Options{}, tCtx.mockSM, tCtx.testQueue, nil)
+
+	opts := ctrl.Options{
+		StorageClient: tCtx.mockSC,
+		DataProvider:  tCtx.mockSP,
+		GetDeploymentProcessor: func() deployment.DeploymentProcessor {
+			return deployment.NewMockDeploymentProcessor(mctrl)
+		},
+	}
+
+	started := make(chan struct{})
+	testCtrl := &testAsyncController{
+		BaseController: ctrl.NewBaseAsyncController(opts),
+		fn: func(ctx context.Context) (ctrl.Result, error) {
+			close(started)
+			<-ctx.Done()
+			return ctrl.Result{}, ctx.Err()
+		},
+	}
+
+	msg, err := tCtx.testQueue.Dequeue(tCtx.ctx, queue.QueueClientConfig{})
+	require.NoError(t, err)
+
+	done := make(chan struct{})
+	go func() {
+		worker.runOperation(context.Background(), msg, testCtrl)
+		close(done)
+	}()
+	<-started
+
+	cancelMessage := queue.NewMessage(&ctrl.Request{OperationID: operationID, Cancel: true})
+	err = tCtx.testQueue.Enqueue(tCtx.ctx, cancelMessage)
+	require.NoError(t, err)
+	cancelMessage, err = tCtx.testQueue.Dequeue(tCtx.ctx, queue.QueueClientConfig{})
+	require.NoError(t, err)
+
+	worker.cancelOperation(tCtx.ctx, cancelMessage, &ctrl.Request{OperationID: operationID, Cancel: true})
+	<-done
+
+	require.Equal(t, 0, tCtx.internalQ.Len(), "messages are finished")
+}
+
+func TestFinishCanceledOperation(t *testing.T) {
+	canceledStatus := &manager.Status{
+		AsyncOperationStatus: v1.AsyncOperationStatus{
+			Status: v1.ProvisioningStateCanceled,
+		},
+	}
+
+	t.Run("operation was not started", func(t *testing.T) {
+		tCtx, mctrl := newTestContext(t, defaultTestLockTime)
+		defer mctrl.Finish()
+
+		tCtx.mockSM.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).Return(canceledStatus, nil)
+		tCtx.mockSC.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, id string, _ ...store.GetOptions) (*store.Object, error) {
+				return newTestResourceObject(), nil
+			}).Times(2)
+		tCtx.mockSC.EXPECT().Save(gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, obj *store.Object, _ ...store.SaveOptions) error {
+				require.Equal(t, string(v1.ProvisioningStateCanceled), obj.Data.(map[string]any)["provisioningState"])
+				return nil
+			}).Times(1)
+
+		testMessage := genTestMessage(uuid.New(), ctrl.DefaultAsyncOperationTimeout)
+		err := tCtx.testQueue.Enqueue(tCtx.ctx, testMessage)
+		require.NoError(t, err)
+
+		msg, err := tCtx.testQueue.Dequeue(tCtx.ctx, queue.QueueClientConfig{})
+		require.NoError(t, err)
+		req := &ctrl.Request{}
+		require.NoError(t, json.Unmarshal(msg.Data, req))
+
+		worker := New(Options{}, tCtx.mockSM, tCtx.testQueue, nil)
+		worker.finishCanceledOperation(tCtx.ctx, msg, req, tCtx.mockSC)
+
+		require.Equal(t, 0, tCtx.internalQ.Len(), "message is finished")
+	})
+
+	t.Run("operation was started", func(t *testing.T) {
+		tCtx, mctrl := newTestContext(t, defaultTestLockTime)
+		defer mctrl.Finish()
+
+		tCtx.mockSM.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).Return(canceledStatus, nil)
+		tCtx.mockSC.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, id string, _ ...store.GetOptions) (*store.Object, error) {
+				obj := newTestResourceObject()
+				obj.Data.(map[string]any)["provisioningState"] = string(v1.ProvisioningStateCanceled)
+				return obj, nil
+			}).Times(1)
+
+		testMessage := genTestMessage(uuid.New(), ctrl.DefaultAsyncOperationTimeout)
+		err := tCtx.testQueue.Enqueue(tCtx.ctx, testMessage)
+		require.NoError(t, err)
+
+		msg, err := tCtx.testQueue.Dequeue(tCtx.ctx, queue.QueueClientConfig{})
+		require.NoError(t, err)
+		req := &ctrl.Request{}
+		require.NoError(t, json.Unmarshal(msg.Data, req))
+
+		worker := New(Options{}, tCtx.mockSM, tCtx.testQueue, nil)
+		worker.finishCanceledOperation(tCtx.ctx, msg, req, tCtx.mockSC)
+
+		require.Equal(t, 0, tCtx.internalQ.Len(), "message is finished")
+	})
+}
+
 func TestRunOperation_ExtendMessageLock(t *testing.T) {
 	tCtx, mctrl := newTestContext(t, defaultTestLockTime)
 	defer mctrl.Finish()
@@ -468,6 +716,7 @@ func TestRunOperation_ExtendMessageLock(t *testing.T) {
 		}).AnyTimes()
 	tCtx.mockSC.EXPECT().Save(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
 	tCtx.mockSM.EXPECT().Update(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	tCtx.mockSM.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).Return(testOperationStatus, nil).AnyTimes()
 
 	testMessage := genTestMessage(uuid.New(), ctrl.DefaultAsyncOperationTimeout)
 	err := tCtx.testQueue.Enqueue(tCtx.ctx, testMessage)
@@ -504,6 +753,72 @@ func TestRunOperation_ExtendMessageLock(t *testing.T) {
 	require.Greater(t, msg.NextVisibleAt.UnixNano(), old.UnixNano(), "message lock is extended")
 }
 
+func TestRunOperation_MessageLost(t *testing.T) {
+	tCtx, mctrl := newTestContext(t, defaultTestLockTime)
+	defer mctrl.Finish()
+
+	// The operation is owned by another worker, so neither the resource nor the operation status are updated.
+	testMessage := genTestMessage(uuid.New(), ctrl.DefaultAsyncOperationTimeout)
+	err := tCtx.testQueue.Enqueue(tCtx.ctx, testMessage)
+	require.NoError(t, err)
+
+	worker := New(Options{}, tCtx.mockSM, tCtx.testQueue, nil)
+
+	opts := ctrl.Options{
+		StorageClient: tCtx.mockSC,
+		DataProvider:  tCtx.mockSP,
+		GetDeploymentProcessor: func() deployment.DeploymentProcessor {
+			return deployment.NewMockDeploymentProcessor(mctrl)
+		},
+	}
+
+	var cause error
+	testCtrl := &testAsyncController{
+		BaseController: ctrl.NewBaseAsyncController(opts),
+		fn: func(ctx context.Context) (ctrl.Result, error) {
+			<-ctx.Done()
+			cause = context.Cause(ctx)
+			return ctrl.Result{}, ctx.Err()
+		},
+	}
+
+	msg, err := tCtx.testQueue.Dequeue(tCtx.ctx, queue.QueueClientConfig{})
+	require.NoError(t, err)
+
+	// Delete the message so that the message lock cannot be extended.
+	tCtx.internalQ.DeleteAll()
+
+	worker.runOperation(context.Background(), msg, testCtrl)
+
+	require.ErrorIs(t, cause, ctrl.ErrOperationLost)
+}
+
+func TestRunOperation_LockExpired(t *testing.T) {
+	mctrl := gomock.NewController(t)
+	defer mctrl.Finish()
+
+	// The lock can not be extended before it expires, so the message may be redelivered to another worker.
+	mockQ := queue.NewMockClient(mctrl)
+	mockQ.EXPECT().ExtendMessage(gomock.Any(), gomock.Any()).Return(errors.New("queue is unavailable")).MinTimes(1)
+
+	worker := New(Options{MinMessageLockDuration: 100 * time.Millisecond}, manager.NewMockStatusManager(mctrl), mockQ, nil)
+
+	var cause error
+	testCtrl := &testAsyncController{
+		BaseController: ctrl.NewBaseAsyncController(ctrl.Options{StorageClient: store.NewMockStorageClient(mctrl)}),
+		fn: func(ctx context.Context) (ctrl.Result, error) {
+			<-ctx.Done()
+			cause = context.Cause(ctx)
+			return ctrl.Result{}, ctx.Err()
+		},
+	}
+
+	msg := genTestMessage(uuid.New(), ctrl.DefaultAsyncOperationTimeout)
+	worker.runOperation(context.Background(), msg, testCtrl)
+
+	require.ErrorIs(t, cause, ctrl.ErrOperationLost)
+}
+
 func TestRunOperation_CancelContext(t *testing.T) {
 	tCtx, _ := newTestContext(t, defaultTestLockTime)
 
@@ -542,7 +857,17 @@ func TestRunOperation_CancelContext(t *testing.T) {
 	<-done
 	cancel()
 
-	require.Equal(t, 1, tCtx.internalQ.Len(), "ensure that message is not finished")
+	// The message is finished and the operation is queued again to be processed by another worker.
+	require.Equal(t, 1, tCtx.internalQ.Len())
+	requeued, err := tCtx.testQueue.Dequeue(tCtx.ctx, queue.QueueClientConfig{})
+	require.NoError(t, err)
+	require.NotEqual(t, msg.ID, requeued.ID)
+
+	original, req := &ctrl.Request{}, &ctrl.Request{}
+	require.NoError(t, json.Unmarshal(msg.Data, original))
+	require.NoError(t, json.Unmarshal(requeued.Data, req))
+	require.Equal(t, original.OperationID, req.OperationID)
+	require.Equal(t, 0, req.RetryCount, "the interrupted attempt is not counted")
 }
 
 func TestRunOperation_Timeout(t *testing.T) {
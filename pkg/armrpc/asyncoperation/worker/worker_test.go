@@ -23,9 +23,11 @@ import (
 	"time"
 
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	queue "github.com/radius-project/radius/pkg/ucp/queue/client"
 	"github.com/radius-project/radius/pkg/ucp/store"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
+	"golang.org/x/sync/semaphore"
 )
 
 func TestDefaultOptions(t *testing.T) {
@@ -37,6 +39,80 @@ func TestDefaultOptions(t *testing.T) {
 	require.Equal(t, defaultMaxOperationConcurrency, worker.options.MaxOperationConcurrency)
 }
 
+func TestOperationSemaphore(t *testing.T) {
+	worker := New(Options{
+		OperationConcurrencyLimits: map[string]int{
+			"Applications.Core/containers":               2,
+			"Applications.Core/containers|PUT":           1,
+			"Applications.Datastores/redisCaches":        1,
+			"Applications.Datastores/sqlDatabases":       0,
+			"Applications.Messaging/rabbitMQQueues|LIST": -1,
+		},
+	}, nil, nil, nil)
+
+	putSem := worker.operationSemaphore(v1.OperationType{Type: "Applications.Core/containers", Method: v1.OperationPut})
+	deleteSem := worker.operationSemaphore(v1.OperationType{Type: "applications.core/containers", Method: v1.OperationDelete})
+	require.NotNil(t, putSem)
+	require.NotNil(t, deleteSem)
+	require.NotSame(t, putSem, deleteSem)
+
+	// Operation type limit of 1.
+	require.True(t, putSem.TryAcquire(1))
+	require.False(t, putSem.TryAcquire(1))
+
+	// Resource type limit of 2.
+	require.True(t, deleteSem.TryAcquire(2))
+	require.False(t, deleteSem.TryAcquire(1))
+
+	require.NotNil(t, worker.operationSemaphore(v1.OperationType{Type: "Applications.Datastores/redisCaches", Method: v1.OperationDelete}))
+	require.Nil(t, worker.operationSemaphore(v1.OperationType{Type: "Applications.Datastores/sqlDatabases", Method: v1.OperationPut}))
+	require.Nil(t, worker.operationSemaphore(v1.OperationType{Type: "Applications.Core/gateways", Method: v1.OperationPut}))
+}
+
+func TestWaitForOperationSlot(t *testing.T) {
+	mctrl := gomock.NewController(t)
+	mockQueue := queue.NewMockClient(mctrl)
+
+	worker := New(Options{MinMessageLockDuration: time.Millisecond}, nil, mockQueue, nil)
+	sem := semaphore.NewWeighted(1)
+
+	t.Run("slot is released", func(t *testing.T) {
+		require.True(t, sem.TryAcquire(1))
+		go func() {
+			time.Sleep(10 * time.Millisecond)
+			sem.Release(1)
+		}()
+
+		msg := &queue.Message{Metadata: queue.Metadata{NextVisibleAt: time.Now().Add(time.Hour)}}
+		err := worker.waitForOperationSlot(context.Background(), msg, sem)
+		require.NoError(t, err)
+		sem.Release(1)
+	})
+
+	t.Run("message lock is extended while waiting", func(t *testing.T) {
+		require.True(t, sem.TryAcquire(1))
+		defer sem.Release(1)
+
+		msg := &queue.Message{Metadata: queue.Metadata{NextVisibleAt: time.Now()}}
+		mockQueue.EXPECT().ExtendMessage(gomock.Any(), msg).Return(errors.New("lock lost"))
+
+		err := worker.waitForOperationSlot(context.Background(), msg, sem)
+		require.EqualError(t, err, "lock lost")
+	})
+
+	t.Run("context is canceled", func(t *testing.T) {
+		require.True(t, sem.TryAcquire(1))
+		defer sem.Release(1)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		msg := &queue.Message{Metadata: queue.Metadata{NextVisibleAt: time.Now().Add(time.Hour)}}
+		err := worker.waitForOperationSlot(ctx, msg, sem)
+		require.ErrorIs(t, err, context.Canceled)
+	})
+}
+
 func TestUpdateResourceState(t *testing.T) {
 	updateStates := []struct {
 		tc          string
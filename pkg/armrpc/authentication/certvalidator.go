@@ -55,6 +55,9 @@ func ClientCertValidator(armCertMgr *ArmCertManager) func(http.Handler) http.Han
 				handleErr(r.Context(), w, r)
 				return
 			}
+
+			// The tenant headers are set by ARM, so they can be trusted once the request is known to come from ARM.
+			r = r.WithContext(withTenantID(r.Context(), r.Header.Get(v1.HomeTenantIDHeader)))
 			next.ServeHTTP(w, r)
 		})
 	}
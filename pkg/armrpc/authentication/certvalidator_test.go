@@ -32,6 +32,7 @@ import (
 	"github.com/go-chi/chi/v5"
 	"github.com/go-logr/logr"
 
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
 	"github.com/radius-project/radius/pkg/middleware"
 	"github.com/stretchr/testify/require"
 )
@@ -45,6 +46,7 @@ func TestCertValidation(t *testing.T) {
 		fakeCertThumbprint string
 		headerThumbprint   string
 		expected           string
+		expectedTenantID   string
 	}{
 		{
 			name:               "unauthorized",
@@ -59,6 +61,7 @@ func TestCertValidation(t *testing.T) {
 			fakeCertThumbprint: "934367bf1c97033f877db0f15cb1b586957d313",
 			headerThumbprint:   "934367bf1c97033f877db0f15cb1b586957d313",
 			expected:           "/subscriptions/1f43aef5-7868-4c56-8a7f-cb6822a75c0e/resourcegroups/proxy-rg/providers/microsoft.kubernetes/connectedclusters/mvm2a",
+			expectedTenantID:   "test-home-tenant-id",
 		},
 	}
 
@@ -88,6 +91,7 @@ func TestCertValidation(t *testing.T) {
 				http.MethodPost,
 				"/subscriptions/{subscriptionID}/resourcegroups/{resourceGroup}/providers/{providerName}/{resourceType}/{resourceName}",
 				func(w http.ResponseWriter, r *http.Request) {
+					require.Equal(t, tc.expectedTenantID, TenantIDFromContext(r.Context()))
 					_, _ = w.Write([]byte(r.URL.Path))
 				})
 
@@ -95,6 +99,7 @@ func TestCertValidation(t *testing.T) {
 			req, err := http.NewRequestWithContext(context.Background(), http.MethodPost, tc.armid, nil)
 			require.NoError(t, err)
 			req.Header.Set(IngressCertThumbprintHeader, tc.headerThumbprint)
+			req.Header.Set(v1.HomeTenantIDHeader, "test-home-tenant-id")
 			handler.ServeHTTP(w, req)
 
 			parsed := w.Body.String()
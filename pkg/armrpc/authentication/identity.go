@@ -0,0 +1,33 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package authentication
+
+import "context"
+
+type tenantIDKey struct{}
+
+// withTenantID returns a copy of the context which holds the tenant id of the authenticated caller.
+func withTenantID(ctx context.Context, tenantID string) context.Context {
+	return context.WithValue(ctx, tenantIDKey{}, tenantID)
+}
+
+// TenantIDFromContext returns the tenant id of the caller of an authenticated request. It returns an empty string
+// if the request was not authenticated, since the tenant headers of such a request are supplied by the client.
+func TenantIDFromContext(ctx context.Context) string {
+	tenantID, _ := ctx.Value(tenantIDKey{}).(string)
+	return tenantID
+}
@@ -73,6 +73,14 @@ func defaultHandlerOptions(
 		ControllerFactory: defaultoperation.NewGetOperationStatus,
 	})
 
+	handlers = append(handlers, server.HandlerOptions{
+		ParentRouter:      rootRouter,
+		Path:              fmt.Sprintf("%s/providers/%s/locations/{location}/operationstatuses/{operationId}/cancel", rootScopePath, namespace),
+		ResourceType:      statusType,
+		Method:            v1.OperationCancel,
+		ControllerFactory: defaultoperation.NewCancelOperation,
+	})
+
 	handlers = append(handlers, server.HandlerOptions{
 		ParentRouter:      rootRouter,
 		Path:              fmt.Sprintf("%s/providers/%s/locations/{location}/operationresults/{operationId}", rootScopePath, namespace),
@@ -81,6 +89,31 @@ func defaultHandlerOptions(
 		ControllerFactory: defaultoperation.NewGetOperationResult,
 	})
 
+	deadLetterType := namespace + "/deadletters"
+	handlers = append(handlers, server.HandlerOptions{
+		ParentRouter:      rootRouter,
+		Path:              fmt.Sprintf("%s/providers/%s/locations/{location}/deadletters", rootScopePath, namespace),
+		ResourceType:      deadLetterType,
+		Method:            v1.OperationList,
+		ControllerFactory: defaultoperation.NewListDeadLetters,
+	})
+
+	handlers = append(handlers, server.HandlerOptions{
+		ParentRouter:      rootRouter,
+		Path:              fmt.Sprintf("%s/providers/%s/locations/{location}/deadletters/{operationId}", rootScopePath, namespace),
+		ResourceType:      deadLetterType,
+		Method:            v1.OperationGet,
+		ControllerFactory: defaultoperation.NewGetDeadLetter,
+	})
+
+	handlers = append(handlers, server.HandlerOptions{
+		ParentRouter:      rootRouter,
+		Path:              fmt.Sprintf("%s/providers/%s/locations/{location}/deadletters/{operationId}/requeue", rootScopePath, namespace),
+		ResourceType:      deadLetterType,
+		Method:            v1.OperationRequeue,
+		ControllerFactory: defaultoperation.NewRequeueDeadLetter,
+	})
+
 	return handlers
 }
 
@@ -139,10 +139,11 @@ func GetNextLinkURL(ctx context.Context, req *http.Request, paginationToken stri
 
 	serviceCtx := v1.ARMRequestContextFromContext(ctx)
 
-	qps := url.Values{}
-	qps.Add("api-version", serviceCtx.APIVersion)
-	qps.Add("skipToken", paginationToken)
-	qps.Add("top", strconv.Itoa(serviceCtx.Top))
+	// The other query parameters of the request, such as filters, apply to every page.
+	qps := req.URL.Query()
+	qps.Set("api-version", serviceCtx.APIVersion)
+	qps.Set("skipToken", paginationToken)
+	qps.Set("top", strconv.Itoa(serviceCtx.Top))
 
 	return GetURLFromReqWithQueryParameters(req, qps).String()
 }
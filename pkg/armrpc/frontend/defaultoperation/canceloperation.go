@@ -0,0 +1,78 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package defaultoperation
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/google/uuid"
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	manager "github.com/radius-project/radius/pkg/armrpc/asyncoperation/statusmanager"
+	ctrl "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/radius-project/radius/pkg/ucp/store"
+)
+
+var _ ctrl.Controller = (*CancelOperation)(nil)
+
+// CancelOperation is the controller implementation to cancel an async operation.
+type CancelOperation struct {
+	ctrl.BaseController
+}
+
+// NewCancelOperation creates a new CancelOperation.
+func NewCancelOperation(opts ctrl.Options) (ctrl.Controller, error) {
+	return &CancelOperation{ctrl.NewBaseController(opts)}, nil
+}
+
+// Run marks an asynchronous operation as canceled and signals the worker processing it to stop. It returns a NotFound
+// error if the operation is not found, or a Conflict error if the operation has already completed.
+func (e *CancelOperation) Run(ctx context.Context, w http.ResponseWriter, req *http.Request) (rest.Response, error) {
+	serviceCtx := v1.ARMRequestContextFromContext(ctx)
+
+	os := &manager.Status{}
+	_, err := e.GetResource(ctx, serviceCtx.ResourceID.String(), os)
+	if err != nil && errors.Is(&store.ErrNotFound{ID: serviceCtx.ResourceID.String()}, err) {
+		return rest.NewNotFoundResponse(serviceCtx.ResourceID), nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	operationID, err := uuid.Parse(serviceCtx.ResourceID.Name())
+	if err != nil {
+		return rest.NewBadRequestResponse(fmt.Sprintf("%q is not a valid operation ID.", serviceCtx.ResourceID.Name())), nil
+	}
+
+	linkedID, err := resources.ParseResource(os.LinkedResourceID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = e.StatusManager().Cancel(ctx, linkedID, operationID)
+	if errors.Is(err, manager.ErrOperationTerminal) {
+		return rest.NewConflictResponse(fmt.Sprintf("The operation %q has already completed with status %q.", operationID, os.Status)), nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	os.Status = v1.ProvisioningStateCanceled
+	return rest.NewOKResponse(os.AsyncOperationStatus), nil
+}
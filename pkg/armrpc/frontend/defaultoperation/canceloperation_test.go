@@ -0,0 +1,112 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package defaultoperation
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/uuid"
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	manager "github.com/radius-project/radius/pkg/armrpc/asyncoperation/statusmanager"
+	ctrl "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/armrpc/rpctest"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/radius-project/radius/pkg/ucp/store"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+const operationStatusCancelTestHeaderFile = "operationstatus_cancel_requestheaders.json"
+
+func TestCancelOperationRun(t *testing.T) {
+	linkedResourceID := "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/test-rg/providers/Applications.Core/environments/env0"
+	operationID := uuid.MustParse("00000000-0000-0000-0000-000000000000")
+
+	setup := func(t *testing.T, status v1.ProvisioningState) (*store.MockStorageClient, *manager.MockStatusManager, *httptest.ResponseRecorder, *http.Request, context.Context) {
+		mctrl := gomock.NewController(t)
+		mStorageClient := store.NewMockStorageClient(mctrl)
+		mStatusManager := manager.NewMockStatusManager(mctrl)
+
+		w := httptest.NewRecorder()
+		req, err := rpctest.NewHTTPRequestFromJSON(context.Background(), http.MethodPost, operationStatusCancelTestHeaderFile, nil)
+		require.NoError(t, err)
+		ctx := rpctest.NewARMRequestContext(req)
+
+		if status != "" {
+			mStorageClient.
+				EXPECT().
+				Get(gomock.Any(), gomock.Any()).
+				Return(&store.Object{Data: &manager.Status{
+					AsyncOperationStatus: v1.AsyncOperationStatus{Name: operationID.String(), Status: status},
+					LinkedResourceID:     linkedResourceID,
+				}}, nil)
+		}
+
+		return mStorageClient, mStatusManager, w, req, ctx
+	}
+
+	t.Run("cancel non-existing operation", func(t *testing.T) {
+		mStorageClient, mStatusManager, w, req, ctx := setup(t, "")
+		mStorageClient.
+			EXPECT().
+			Get(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, id string, _ ...store.GetOptions) (*store.Object, error) {
+				return nil, &store.ErrNotFound{ID: id}
+			})
+
+		ctl, err := NewCancelOperation(ctrl.Options{StorageClient: mStorageClient, StatusManager: mStatusManager})
+		require.NoError(t, err)
+		resp, err := ctl.Run(ctx, w, req)
+		require.NoError(t, err)
+		_ = resp.Apply(ctx, w, req)
+		require.Equal(t, http.StatusNotFound, w.Result().StatusCode)
+	})
+
+	t.Run("cancel running operation", func(t *testing.T) {
+		mStorageClient, mStatusManager, w, req, ctx := setup(t, v1.ProvisioningStateUpdating)
+		mStatusManager.
+			EXPECT().
+			Cancel(gomock.Any(), resources.MustParse(linkedResourceID), operationID).
+			Return(nil)
+
+		ctl, err := NewCancelOperation(ctrl.Options{StorageClient: mStorageClient, StatusManager: mStatusManager})
+		require.NoError(t, err)
+		resp, err := ctl.Run(ctx, w, req)
+		require.NoError(t, err)
+		_ = resp.Apply(ctx, w, req)
+		require.Equal(t, http.StatusOK, w.Result().StatusCode)
+	})
+
+	t.Run("cancel completed operation", func(t *testing.T) {
+		mStorageClient, mStatusManager, w, req, ctx := setup(t, v1.ProvisioningStateSucceeded)
+		mStatusManager.
+			EXPECT().
+			Cancel(gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(manager.ErrOperationTerminal)
+
+		ctl, err := NewCancelOperation(ctrl.Options{StorageClient: mStorageClient, StatusManager: mStatusManager})
+		require.NoError(t, err)
+		resp, err := ctl.Run(ctx, w, req)
+		require.NoError(t, err)
+		_ = resp.Apply(ctx, w, req)
+		require.Equal(t, http.StatusConflict, w.Result().StatusCode)
+	})
+}
@@ -0,0 +1,81 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package defaultoperation
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	manager "github.com/radius-project/radius/pkg/armrpc/asyncoperation/statusmanager"
+	ctrl "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/armrpc/rpctest"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/radius-project/radius/pkg/ucp/store"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+const deadLetterTestHeaderFile = "deadletter_requestheaders.json"
+
+func TestGetDeadLetterRun(t *testing.T) {
+	setup := func(t *testing.T) (*manager.MockStatusManager, *httptest.ResponseRecorder, *http.Request, context.Context) {
+		mctrl := gomock.NewController(t)
+		mStatusManager := manager.NewMockStatusManager(mctrl)
+
+		w := httptest.NewRecorder()
+		req, err := rpctest.NewHTTPRequestFromJSON(context.Background(), http.MethodGet, deadLetterTestHeaderFile, nil)
+		require.NoError(t, err)
+		ctx := rpctest.NewARMRequestContext(req)
+
+		return mStatusManager, w, req, ctx
+	}
+
+	t.Run("get non-existing dead letter", func(t *testing.T) {
+		mStatusManager, w, req, ctx := setup(t)
+		mStatusManager.
+			EXPECT().
+			GetDeadLetter(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, id resources.ID) (*manager.DeadLetter, error) {
+				return nil, &store.ErrNotFound{ID: id.String()}
+			})
+
+		ctl, err := NewGetDeadLetter(ctrl.Options{StatusManager: mStatusManager})
+		require.NoError(t, err)
+		resp, err := ctl.Run(ctx, w, req)
+		require.NoError(t, err)
+		_ = resp.Apply(ctx, w, req)
+		require.Equal(t, http.StatusNotFound, w.Result().StatusCode)
+	})
+
+	t.Run("get existing dead letter", func(t *testing.T) {
+		mStatusManager, w, req, ctx := setup(t)
+		mStatusManager.
+			EXPECT().
+			GetDeadLetter(gomock.Any(), gomock.Any()).
+			Return(&manager.DeadLetter{Name: "00000000-0000-0000-0000-000000000000"}, nil)
+
+		ctl, err := NewGetDeadLetter(ctrl.Options{StatusManager: mStatusManager})
+		require.NoError(t, err)
+		resp, err := ctl.Run(ctx, w, req)
+		require.NoError(t, err)
+		_ = resp.Apply(ctx, w, req)
+		require.Equal(t, http.StatusOK, w.Result().StatusCode)
+	})
+}
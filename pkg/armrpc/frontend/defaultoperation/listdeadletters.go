@@ -0,0 +1,55 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package defaultoperation
+
+import (
+	"context"
+	"net/http"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	ctrl "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/armrpc/rest"
+)
+
+var _ ctrl.Controller = (*ListDeadLetters)(nil)
+
+// ListDeadLetters is the controller implementation to list the async operation requests in the dead-letter collection.
+type ListDeadLetters struct {
+	ctrl.BaseController
+}
+
+// NewListDeadLetters creates a new ListDeadLetters.
+func NewListDeadLetters(opts ctrl.Options) (ctrl.Controller, error) {
+	return &ListDeadLetters{ctrl.NewBaseController(opts)}, nil
+}
+
+// Run returns the async operation requests which were dropped after exceeding the maximum retry count.
+func (e *ListDeadLetters) Run(ctx context.Context, w http.ResponseWriter, req *http.Request) (rest.Response, error) {
+	serviceCtx := v1.ARMRequestContextFromContext(ctx)
+
+	dls, err := e.StatusManager().ListDeadLetters(ctx, serviceCtx.ResourceID)
+	if err != nil {
+		return nil, err
+	}
+
+	items := []any{}
+	for _, dl := range dls {
+		items = append(items, dl)
+	}
+
+	return rest.NewOKResponse(&v1.PaginatedList{Value: items}), nil
+}
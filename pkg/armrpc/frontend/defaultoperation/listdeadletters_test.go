@@ -0,0 +1,66 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package defaultoperation
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/uuid"
+	manager "github.com/radius-project/radius/pkg/armrpc/asyncoperation/statusmanager"
+	ctrl "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/armrpc/rpctest"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+const deadLetterListTestHeaderFile = "deadletter_list_requestheaders.json"
+
+func TestListDeadLettersRun(t *testing.T) {
+	mctrl := gomock.NewController(t)
+	mStatusManager := manager.NewMockStatusManager(mctrl)
+
+	w := httptest.NewRecorder()
+	req, err := rpctest.NewHTTPRequestFromJSON(context.Background(), http.MethodGet, deadLetterListTestHeaderFile, nil)
+	require.NoError(t, err)
+	ctx := rpctest.NewARMRequestContext(req)
+
+	operationID := uuid.New()
+	mStatusManager.
+		EXPECT().
+		ListDeadLetters(gomock.Any(), gomock.Any()).
+		Return([]*manager.DeadLetter{{Name: operationID.String(), DequeueCount: 4}}, nil)
+
+	ctl, err := NewListDeadLetters(ctrl.Options{StatusManager: mStatusManager})
+	require.NoError(t, err)
+	resp, err := ctl.Run(ctx, w, req)
+	require.NoError(t, err)
+	_ = resp.Apply(ctx, w, req)
+	require.Equal(t, http.StatusOK, w.Result().StatusCode)
+
+	actual := struct {
+		Value []manager.DeadLetter `json:"value"`
+	}{}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &actual))
+	require.Len(t, actual.Value, 1)
+	require.Equal(t, operationID.String(), actual.Value[0].Name)
+	require.Equal(t, 4, actual.Value[0].DequeueCount)
+}
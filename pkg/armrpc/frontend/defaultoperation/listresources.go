@@ -26,6 +26,16 @@ import (
 	"github.com/radius-project/radius/pkg/ucp/store"
 )
 
+const (
+	// ApplicationParameterName is the optional query parameter that selects the resources of the application with the
+	// given resource ID.
+	ApplicationParameterName = "application"
+
+	// EnvironmentParameterName is the optional query parameter that selects the resources of the environment with the
+	// given resource ID.
+	EnvironmentParameterName = "environment"
+)
+
 // ListResources is the controller implementation to get the list of resources in resource group.
 type ListResources[P interface {
 	*T
@@ -43,8 +53,9 @@ func NewListResources[P interface {
 	return &ListResources[P, T]{ctrl.NewOperation[P](opts, ctrlOpts), ctrlOpts.ListRecursiveQuery}, nil
 }
 
-// Run queries the resource data store with a given type and scope and returns the paginated resource list. An internal error
-// is returned if the query fails.
+// Run queries the resource data store with a given type and scope and returns the paginated resource list. The list can be
+// filtered by application and environment using the ApplicationParameterName and EnvironmentParameterName query parameters,
+// which are evaluated by the data store. An internal error is returned if the query fails.
 func (e *ListResources[P, T]) Run(ctx context.Context, w http.ResponseWriter, req *http.Request) (rest.Response, error) {
 	serviceCtx := v1.ARMRequestContextFromContext(ctx)
 
@@ -52,6 +63,10 @@ func (e *ListResources[P, T]) Run(ctx context.Context, w http.ResponseWriter, re
 		RootScope:      serviceCtx.ResourceID.RootScope(),
 		ResourceType:   serviceCtx.ResourceID.Type(),
 		ScopeRecursive: e.listRecursiveQuery,
+		Selector: store.FieldSelector{
+			Application: req.URL.Query().Get(ApplicationParameterName),
+			Environment: req.URL.Query().Get(EnvironmentParameterName),
+		},
 	}
 
 	result, err := e.StorageClient().Query(ctx, query, store.WithPaginationToken(serviceCtx.SkipToken), store.WithMaxQueryItemCount(serviceCtx.Top))
@@ -22,6 +22,7 @@ import (
 	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
 	"testing"
 
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
@@ -105,6 +106,66 @@ func TestListResourcesRun(t *testing.T) {
 		{"list-envs-plane-scope-more-items-than-top", "resource_planescope_requestheaders.json", 5, 5, "", false, false},
 	}
 
+	t.Run("list resources of application and environment", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		req, err := rpctest.NewHTTPRequestFromJSON(ctx, http.MethodGet, resourceTestHeaderFile, nil)
+		require.NoError(t, err)
+
+		applicationID := "/planes/radius/local/resourceGroups/test-rg/providers/Applications.Core/applications/test-app"
+		environmentID := "/planes/radius/local/resourceGroups/test-rg/providers/Applications.Core/environments/test-env"
+		q := req.URL.Query()
+		q.Add(ApplicationParameterName, applicationID)
+		q.Add(EnvironmentParameterName, environmentID)
+		req.URL.RawQuery = q.Encode()
+
+		ctx := rpctest.NewARMRequestContext(req)
+		serviceCtx := v1.ARMRequestContextFromContext(ctx)
+
+		expectedQuery := store.Query{
+			RootScope:    serviceCtx.ResourceID.RootScope(),
+			ResourceType: serviceCtx.ResourceID.Type(),
+			Selector: store.FieldSelector{
+				Application: applicationID,
+				Environment: environmentID,
+			},
+		}
+
+		mStorageClient.
+			EXPECT().
+			Query(gomock.Any(), expectedQuery, gomock.Any()).
+			Return(&store.ObjectQueryResult{
+				Items:           []store.Object{{Metadata: store.Metadata{ID: uuid.New().String()}, Data: testResourceDataModel}},
+				PaginationToken: "nextLink",
+			}, nil)
+
+		opts := ctrl.Options{
+			StorageClient: mStorageClient,
+		}
+
+		ctrlOpts := ctrl.ResourceOptions[testDataModel]{
+			ResponseConverter: resourceToVersioned,
+		}
+
+		ctl, err := NewListResources(opts, ctrlOpts)
+		require.NoError(t, err)
+		resp, err := ctl.Run(ctx, w, req)
+		require.NoError(t, err)
+		_ = resp.Apply(ctx, w, req)
+		require.Equal(t, http.StatusOK, w.Result().StatusCode)
+
+		actualOutput := &testResourceList{}
+		_ = json.Unmarshal(w.Body.Bytes(), actualOutput)
+		require.Equal(t, []*testVersionedModel{expectedOutput}, actualOutput.Value)
+
+		// The filters apply to every page.
+		require.NotNil(t, actualOutput.NextLink)
+		nextLink, err := url.Parse(*actualOutput.NextLink)
+		require.NoError(t, err)
+		require.Equal(t, applicationID, nextLink.Query().Get(ApplicationParameterName))
+		require.Equal(t, environmentID, nextLink.Query().Get(EnvironmentParameterName))
+		require.Equal(t, "nextLink", nextLink.Query().Get(v1.SkipTokenParameterName))
+	})
+
 	for _, tt := range listEnvsCases {
 		t.Run(fmt.Sprint(tt.desc), func(t *testing.T) {
 			w := httptest.NewRecorder()
@@ -0,0 +1,60 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package defaultoperation
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	manager "github.com/radius-project/radius/pkg/armrpc/asyncoperation/statusmanager"
+	ctrl "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/ucp/store"
+)
+
+var _ ctrl.Controller = (*RequeueDeadLetter)(nil)
+
+// RequeueDeadLetter is the controller implementation to requeue an async operation request in the dead-letter collection.
+type RequeueDeadLetter struct {
+	ctrl.BaseController
+}
+
+// NewRequeueDeadLetter creates a new RequeueDeadLetter.
+func NewRequeueDeadLetter(opts ctrl.Options) (ctrl.Controller, error) {
+	return &RequeueDeadLetter{ctrl.NewBaseController(opts)}, nil
+}
+
+// Run queues the dead-lettered async operation request again and removes it from the dead-letter collection. It
+// returns a NotFound error if the dead letter is not found, and a Conflict error if replaying the request would
+// overwrite a newer state of the resource.
+func (e *RequeueDeadLetter) Run(ctx context.Context, w http.ResponseWriter, req *http.Request) (rest.Response, error) {
+	serviceCtx := v1.ARMRequestContextFromContext(ctx)
+
+	err := e.StatusManager().Requeue(ctx, serviceCtx.ResourceID)
+	if errors.Is(&store.ErrNotFound{ID: serviceCtx.ResourceID.String()}, err) {
+		return rest.NewNotFoundResponse(serviceCtx.ResourceID), nil
+	} else if errors.Is(err, manager.ErrOperationNotRequeueable) {
+		return rest.NewConflictResponse(fmt.Sprintf("The dead letter %q cannot be requeued because %s.", serviceCtx.ResourceID.Name(), err.Error())), nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	return rest.NewNoContentResponse(), nil
+}
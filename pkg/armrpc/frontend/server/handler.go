@@ -241,6 +241,17 @@ func ConfigureDefaultHandlers(
 		return err
 	}
 
+	err = RegisterHandler(ctx, HandlerOptions{
+		ParentRouter:      rootRouter,
+		Path:              opStatus + "/cancel",
+		ResourceType:      statusRT,
+		Method:            v1.OperationCancel,
+		ControllerFactory: defaultoperation.NewCancelOperation,
+	}, ctrlOpts)
+	if err != nil {
+		return err
+	}
+
 	opResult := fmt.Sprintf("%s/providers/%s/locations/{location}/operationresults/{operationId}", rootScopePath, providerNamespace)
 	err = RegisterHandler(ctx, HandlerOptions{
 		ParentRouter:      rootRouter,
@@ -253,6 +264,41 @@ func ConfigureDefaultHandlers(
 		return err
 	}
 
+	deadLetterRT := providerNamespace + "/deadletters"
+	deadLetters := fmt.Sprintf("%s/providers/%s/locations/{location}/deadletters", rootScopePath, providerNamespace)
+	err = RegisterHandler(ctx, HandlerOptions{
+		ParentRouter:      rootRouter,
+		Path:              deadLetters,
+		ResourceType:      deadLetterRT,
+		Method:            v1.OperationList,
+		ControllerFactory: defaultoperation.NewListDeadLetters,
+	}, ctrlOpts)
+	if err != nil {
+		return err
+	}
+
+	err = RegisterHandler(ctx, HandlerOptions{
+		ParentRouter:      rootRouter,
+		Path:              deadLetters + "/{operationId}",
+		ResourceType:      deadLetterRT,
+		Method:            v1.OperationGet,
+		ControllerFactory: defaultoperation.NewGetDeadLetter,
+	}, ctrlOpts)
+	if err != nil {
+		return err
+	}
+
+	err = RegisterHandler(ctx, HandlerOptions{
+		ParentRouter:      rootRouter,
+		Path:              deadLetters + "/{operationId}/requeue",
+		ResourceType:      deadLetterRT,
+		Method:            v1.OperationRequeue,
+		ControllerFactory: defaultoperation.NewRequeueDeadLetter,
+	}, ctrlOpts)
+	if err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -279,6 +325,14 @@ func HandleError(ctx context.Context, w http.ResponseWriter, req *http.Request,
 				Message: v.Message,
 			},
 		})
+	case *v1.ErrValidation:
+		response = rest.NewBadRequestARMResponse(v1.ErrorResponse{
+			Error: v1.ErrorDetails{
+				Code:    v1.CodeInvalidProperties,
+				Message: "The request payload has one or more invalid properties. Please see details for more information.",
+				Details: v.Details,
+			},
+		})
 	default:
 		if errors.Is(err, v1.ErrInvalidModelConversion) {
 			response = rest.NewBadRequestARMResponse(v1.ErrorResponse{
@@ -242,6 +242,35 @@ func Test_HandlerErrInvalidModelConversion(t *testing.T) {
 	require.Equal(t, armerr.Error.Message, "invalid model conversion")
 }
 
+func Test_HandlerErrValidation(t *testing.T) {
+	var handlerTest = struct {
+		url    string
+		method string
+	}{
+		url:    "/resourcegroups/testrg/providers/applications.core/containers/test?api-version=2023-10-01-preview",
+		method: http.MethodPut,
+	}
+
+	req := httptest.NewRequest(handlerTest.method, handlerTest.url, nil)
+	responseWriter := httptest.NewRecorder()
+	err := &v1.ErrValidation{}
+	err.Add("/properties/container/image", "image is required")
+	err.Add("/properties/container/ports/web/containerPort", "containerPort must be between 1 and 65535")
+	HandleError(context.Background(), responseWriter, req, err)
+
+	require.Equal(t, http.StatusBadRequest, responseWriter.Code)
+	bodyBytes, e := io.ReadAll(responseWriter.Body)
+	require.NoError(t, e)
+	armerr := v1.ErrorResponse{}
+	e = json.Unmarshal(bodyBytes, &armerr)
+	require.NoError(t, e)
+	require.Equal(t, v1.CodeInvalidProperties, armerr.Error.Code)
+	require.Equal(t, []v1.ErrorDetails{
+		{Code: v1.CodeInvalidProperties, Target: "/properties/container/image", Message: "image is required"},
+		{Code: v1.CodeInvalidProperties, Target: "/properties/container/ports/web/containerPort", Message: "containerPort must be between 1 and 65535"},
+	}, armerr.Error.Details)
+}
+
 func Test_HandlerErrInternal(t *testing.T) {
 	var handlerTest = struct {
 		url    string
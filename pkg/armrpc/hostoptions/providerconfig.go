@@ -17,6 +17,8 @@ limitations under the License.
 package hostoptions
 
 import (
+	"time"
+
 	metricsprovider "github.com/radius-project/radius/pkg/metrics/provider"
 	profilerprovider "github.com/radius-project/radius/pkg/profiler/provider"
 	"github.com/radius-project/radius/pkg/trace"
@@ -69,6 +71,16 @@ type WorkerServerOptions struct {
 	MaxOperationConcurrency *int `yaml:"maxOperationConcurrency,omitempty"`
 	// MaxOperationRetryCount is the maximum retry count to process async request operation.
 	MaxOperationRetryCount *int `yaml:"maxOperationRetryCount,omitempty"`
+	// OperationConcurrencyLimits is the maximum concurrency to process async request operations keyed by
+	// resource type (e.g. Applications.Core/containers) or operation type (e.g. Applications.Core/containers|PUT).
+	OperationConcurrencyLimits map[string]int `yaml:"operationConcurrencyLimits,omitempty"`
+	// OperationStatusRetention is the duration that the status of a completed async operation is kept for. Expired
+	// operation statuses are not purged when it is not set.
+	OperationStatusRetention time.Duration `yaml:"operationStatusRetention,omitempty"`
+	// OperationStatusSweepInterval is the interval between purges of expired operation statuses.
+	OperationStatusSweepInterval time.Duration `yaml:"operationStatusSweepInterval,omitempty"`
+	// DrainTimeout is the duration that the in-flight operations are given to complete when the worker is stopped.
+	DrainTimeout time.Duration `yaml:"drainTimeout,omitempty"`
 }
 
 // BicepOptions includes options required for bicep execution.
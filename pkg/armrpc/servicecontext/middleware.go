@@ -21,7 +21,9 @@ import (
 	"net/http"
 
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/armrpc/authentication"
 	"github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/ucp/store/partition"
 )
 
 // ARMRequestCtx is a middleware handler that adds an ARM request context to an incoming request. It takes in a pathBase
@@ -55,7 +57,10 @@ func ARMRequestCtx(pathBase, location string) func(h http.Handler) http.Handler
 				return
 			}
 
-			r = r.WithContext(v1.WithARMRequestContext(r.Context(), rpcContext))
+			// The data of the request is isolated in the partition of the tenant when partitioning is enabled. The
+			// tenant is only known for authenticated requests, other requests use the default partition.
+			ctx := partition.WithPartition(r.Context(), authentication.TenantIDFromContext(r.Context()))
+			r = r.WithContext(v1.WithARMRequestContext(ctx, rpcContext))
 			h.ServeHTTP(w, r)
 		}
 
@@ -26,6 +26,7 @@ import (
 
 	"github.com/go-chi/chi/v5"
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/ucp/store/partition"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -109,6 +110,23 @@ func TestARMRequestCtx(t *testing.T) {
 	}
 }
 
+func TestARMRequestCtx_Partition(t *testing.T) {
+	var partitionName string
+	r := chi.NewRouter()
+	r.Get("/planes/radius/local/resourcegroups/rg", func(w http.ResponseWriter, r *http.Request) {
+		partitionName = partition.FromContext(r.Context())
+	})
+
+	req, err := http.NewRequestWithContext(context.Background(), http.MethodGet, "/planes/radius/local/resourcegroups/rg", nil)
+	require.NoError(t, err)
+	// The tenant header of a request which is not authenticated is supplied by the client, so it does not select
+	// the partition.
+	req.Header.Set(v1.HomeTenantIDHeader, "other-tenant")
+
+	ARMRequestCtx("", v1.LocationGlobal)(r).ServeHTTP(httptest.NewRecorder(), req)
+	require.Equal(t, "", partitionName)
+}
+
 func Test_ARMRequestCtx_with_empty_location_causes_panic(t *testing.T) {
 	require.Panics(t, func() {
 		ARMRequestCtx("/some/base/path", "") // Empty location
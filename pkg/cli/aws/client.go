@@ -19,6 +19,7 @@ import (
 	"context"
 
 	"github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/credentials"
 	"github.com/aws/aws-sdk-go-v2/service/ec2"
 	"github.com/aws/aws-sdk-go-v2/service/sts"
 )
@@ -31,8 +32,15 @@ type Client interface {
 	GetCallerIdentity(ctx context.Context) (*sts.GetCallerIdentityOutput, error)
 	// ListRegions lists the AWS regions available (fetched from EC2.DescribeRegions API).
 	ListRegions(ctx context.Context) (*ec2.DescribeRegionsOutput, error)
+	// ValidateAccessKey checks that the given IAM access key can authenticate with AWS.
+	ValidateAccessKey(ctx context.Context, accessKeyID string, secretAccessKey string) error
 }
 
+const (
+	// defaultSTSRegion is the region used to call STS when no region is configured.
+	defaultSTSRegion = "us-east-1"
+)
+
 // NewClient returns a new Client.
 func NewClient() Client {
 	return &client{}
@@ -77,3 +85,22 @@ func (c *client) ListRegions(ctx context.Context) (*ec2.DescribeRegionsOutput, e
 
 	return result, nil
 }
+
+// ValidateAccessKey checks that the given IAM access key can authenticate with AWS by getting the caller identity of the
+// access key. The credentials configured for the AWS SDK are not used.
+func (c *client) ValidateAccessKey(ctx context.Context, accessKeyID string, secretAccessKey string) error {
+	cfg, err := config.LoadDefaultConfig(ctx, config.WithCredentialsProvider(credentials.NewStaticCredentialsProvider(accessKeyID, secretAccessKey, "")))
+	if err != nil {
+		return err
+	}
+
+	// STS is a global service, any region will do if none is configured.
+	if cfg.Region == "" {
+		cfg.Region = defaultSTSRegion
+	}
+
+	stsClient := sts.NewFromConfig(cfg)
+
+	_, err = stsClient.GetCallerIdentity(ctx, &sts.GetCallerIdentityInput{})
+	return err
+}
@@ -118,3 +118,41 @@ func (c *MockClientListRegionsCall) DoAndReturn(f func(context.Context) (*ec2.De
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
+
+// ValidateAccessKey mocks base method.
+func (m *MockClient) ValidateAccessKey(arg0 context.Context, arg1, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ValidateAccessKey", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ValidateAccessKey indicates an expected call of ValidateAccessKey.
+func (mr *MockClientMockRecorder) ValidateAccessKey(arg0, arg1, arg2 any) *MockClientValidateAccessKeyCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateAccessKey", reflect.TypeOf((*MockClient)(nil).ValidateAccessKey), arg0, arg1, arg2)
+	return &MockClientValidateAccessKeyCall{Call: call}
+}
+
+// MockClientValidateAccessKeyCall wrap *gomock.Call
+type MockClientValidateAccessKeyCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockClientValidateAccessKeyCall) Return(arg0 error) *MockClientValidateAccessKeyCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockClientValidateAccessKeyCall) Do(f func(context.Context, string, string) error) *MockClientValidateAccessKeyCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockClientValidateAccessKeyCall) DoAndReturn(f func(context.Context, string, string) error) *MockClientValidateAccessKeyCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
@@ -18,8 +18,11 @@ package azure
 
 import (
 	"context"
+	"fmt"
 	"sync"
 
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
+	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/resources/armresources"
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/resources/armsubscriptions"
 	"github.com/radius-project/radius/pkg/azure/armauth"
@@ -39,8 +42,19 @@ type Client interface {
 	CheckResourceGroupExistence(ctx context.Context, subscriptionID string, resourceGroupName string) (bool, error)
 	// CreateOrUpdateResourceGroup creates or updates a resource group.
 	CreateOrUpdateResourceGroup(ctx context.Context, subscriptionID string, resourceGroupName string, location string) error
+	// ValidateServicePrincipal checks that the given service principal can authenticate with Azure.
+	ValidateServicePrincipal(ctx context.Context, tenantID string, clientID string, clientSecret string) error
+	// EnableWorkloadIdentity enables the OIDC issuer and workload identity on the AKS cluster serving the given API server
+	// host, and returns the URL of the OIDC issuer of the cluster. ErrManagedClusterNotFound is returned if no AKS
+	// cluster of the subscription serves the host.
+	EnableWorkloadIdentity(ctx context.Context, subscriptionID string, apiServerHost string) (string, error)
 }
 
+const (
+	// armManagementScope is the OAuth scope of Azure Resource Manager.
+	armManagementScope = "https://management.azure.com/.default"
+)
+
 // NewClient returns a new Client.
 func NewClient() Client {
 	return &client{}
@@ -206,3 +220,57 @@ func (c *client) CreateOrUpdateResourceGroup(ctx context.Context, subscriptionID
 
 	return nil
 }
+
+// ValidateServicePrincipal checks that the given service principal can authenticate with Azure by requesting a token
+// for Azure Resource Manager. The credentials of the user are not used.
+func (c *client) ValidateServicePrincipal(ctx context.Context, tenantID string, clientID string, clientSecret string) error {
+	credential, err := azidentity.NewClientSecretCredential(tenantID, clientID, clientSecret, nil)
+	if err != nil {
+		return err
+	}
+
+	_, err = credential.GetToken(ctx, policy.TokenRequestOptions{Scopes: []string{armManagementScope}})
+	return err
+}
+
+// EnableWorkloadIdentity enables the OIDC issuer and workload identity on the AKS cluster serving the given API server
+// host, and returns the URL of the OIDC issuer of the cluster.
+//
+// The cluster is found by matching the host with the FQDN of the AKS clusters of the subscription. The cluster is
+// only updated if the OIDC issuer or workload identity are not enabled yet.
+func (c *client) EnableWorkloadIdentity(ctx context.Context, subscriptionID string, apiServerHost string) (string, error) {
+	err := c.initializeCredentials()
+	if err != nil {
+		return "", err
+	}
+
+	client, err := armresources.NewClient(subscriptionID, c.config.ClientOptions.Cred, nil)
+	if err != nil {
+		return "", err
+	}
+
+	cluster, err := findManagedCluster(ctx, client, apiServerHost)
+	if err != nil {
+		return "", err
+	}
+
+	if enableWorkloadIdentity(cluster) {
+		poller, err := client.BeginCreateOrUpdateByID(ctx, *cluster.ID, managedClusterAPIVersion, *cluster, nil)
+		if err != nil {
+			return "", err
+		}
+
+		response, err := poller.PollUntilDone(ctx, nil)
+		if err != nil {
+			return "", err
+		}
+		cluster = &response.GenericResource
+	}
+
+	issuerURL := oidcIssuerURL(cluster)
+	if issuerURL == "" {
+		return "", fmt.Errorf("the OIDC issuer URL of the AKS cluster %q is not available", *cluster.ID)
+	}
+
+	return issuerURL, nil
+}
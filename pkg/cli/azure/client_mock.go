@@ -118,6 +118,45 @@ func (c *MockClientCreateOrUpdateResourceGroupCall) DoAndReturn(f func(context.C
 	return c
 }
 
+// EnableWorkloadIdentity mocks base method.
+func (m *MockClient) EnableWorkloadIdentity(arg0 context.Context, arg1, arg2 string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnableWorkloadIdentity", arg0, arg1, arg2)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EnableWorkloadIdentity indicates an expected call of EnableWorkloadIdentity.
+func (mr *MockClientMockRecorder) EnableWorkloadIdentity(arg0, arg1, arg2 any) *MockClientEnableWorkloadIdentityCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnableWorkloadIdentity", reflect.TypeOf((*MockClient)(nil).EnableWorkloadIdentity), arg0, arg1, arg2)
+	return &MockClientEnableWorkloadIdentityCall{Call: call}
+}
+
+// MockClientEnableWorkloadIdentityCall wrap *gomock.Call
+type MockClientEnableWorkloadIdentityCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockClientEnableWorkloadIdentityCall) Return(arg0 string, arg1 error) *MockClientEnableWorkloadIdentityCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockClientEnableWorkloadIdentityCall) Do(f func(context.Context, string, string) (string, error)) *MockClientEnableWorkloadIdentityCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockClientEnableWorkloadIdentityCall) DoAndReturn(f func(context.Context, string, string) (string, error)) *MockClientEnableWorkloadIdentityCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // Locations mocks base method.
 func (m *MockClient) Locations(arg0 context.Context, arg1 string) ([]armsubscriptions.Location, error) {
 	m.ctrl.T.Helper()
@@ -234,3 +273,41 @@ func (c *MockClientSubscriptionsCall) DoAndReturn(f func(context.Context) (*Subs
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
+
+// ValidateServicePrincipal mocks base method.
+func (m *MockClient) ValidateServicePrincipal(arg0 context.Context, arg1, arg2, arg3 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ValidateServicePrincipal", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ValidateServicePrincipal indicates an expected call of ValidateServicePrincipal.
+func (mr *MockClientMockRecorder) ValidateServicePrincipal(arg0, arg1, arg2, arg3 any) *MockClientValidateServicePrincipalCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateServicePrincipal", reflect.TypeOf((*MockClient)(nil).ValidateServicePrincipal), arg0, arg1, arg2, arg3)
+	return &MockClientValidateServicePrincipalCall{Call: call}
+}
+
+// MockClientValidateServicePrincipalCall wrap *gomock.Call
+type MockClientValidateServicePrincipalCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockClientValidateServicePrincipalCall) Return(arg0 error) *MockClientValidateServicePrincipalCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockClientValidateServicePrincipalCall) Do(f func(context.Context, string, string, string) error) *MockClientValidateServicePrincipalCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockClientValidateServicePrincipalCall) DoAndReturn(f func(context.Context, string, string, string) error) *MockClientValidateServicePrincipalCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
@@ -0,0 +1,112 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package azure
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/resources/armresources"
+	"github.com/radius-project/radius/pkg/to"
+)
+
+const (
+	// managedClusterResourceType is the resource type of AKS clusters.
+	managedClusterResourceType = "Microsoft.ContainerService/managedClusters"
+
+	// managedClusterAPIVersion is the API version used to read and update AKS clusters.
+	managedClusterAPIVersion = "2023-08-01"
+)
+
+// ErrManagedClusterNotFound is returned when no AKS cluster serves the API server host of the Kubernetes cluster.
+var ErrManagedClusterNotFound = errors.New("the Kubernetes cluster is not an AKS cluster of the subscription")
+
+// findManagedCluster returns the AKS cluster of the subscription whose FQDN or private FQDN matches the API server
+// host. The list operation does not return the properties of the clusters, so each cluster is read individually.
+func findManagedCluster(ctx context.Context, client *armresources.Client, apiServerHost string) (*armresources.GenericResource, error) {
+	pager := client.NewListPager(&armresources.ClientListOptions{
+		Filter: to.Ptr("resourceType eq '" + managedClusterResourceType + "'"),
+	})
+	for pager.More() {
+		next, err := pager.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, resource := range next.Value {
+			if resource.ID == nil {
+				continue
+			}
+
+			response, err := client.GetByID(ctx, *resource.ID, managedClusterAPIVersion, nil)
+			if err != nil {
+				return nil, err
+			}
+
+			properties := asMap(response.Properties)
+			for _, key := range []string{"fqdn", "privateFQDN"} {
+				if fqdn, ok := properties[key].(string); ok && strings.EqualFold(fqdn, apiServerHost) {
+					return &response.GenericResource, nil
+				}
+			}
+		}
+	}
+
+	return nil, ErrManagedClusterNotFound
+}
+
+// enableWorkloadIdentity enables the OIDC issuer and workload identity in the properties of the AKS cluster. It returns
+// true if the properties were changed and the cluster must be updated.
+func enableWorkloadIdentity(cluster *armresources.GenericResource) bool {
+	properties := asMap(cluster.Properties)
+	if properties == nil {
+		properties = map[string]any{}
+		cluster.Properties = properties
+	}
+
+	changed := false
+	for _, path := range [][]string{{"oidcIssuerProfile"}, {"securityProfile", "workloadIdentity"}} {
+		profile := properties
+		for _, key := range path {
+			next := asMap(profile[key])
+			if next == nil {
+				next = map[string]any{}
+				profile[key] = next
+			}
+			profile = next
+		}
+
+		if enabled, _ := profile["enabled"].(bool); !enabled {
+			profile["enabled"] = true
+			changed = true
+		}
+	}
+
+	return changed
+}
+
+// oidcIssuerURL returns the URL of the OIDC issuer of the AKS cluster, or an empty string if it is not available.
+func oidcIssuerURL(cluster *armresources.GenericResource) string {
+	issuerURL, _ := asMap(asMap(cluster.Properties)["oidcIssuerProfile"])["issuerURL"].(string)
+	return issuerURL
+}
+
+func asMap(value any) map[string]any {
+	m, _ := value.(map[string]any)
+	return m
+}
@@ -0,0 +1,89 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bundle
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ArchiveAndExtract(t *testing.T) {
+	src := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(src, "manifests"), 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(src, "bundle.json"), []byte(`{"version":"1"}`), 0644))
+	require.NoError(t, os.WriteFile(filepath.Join(src, "manifests", "test.yaml"), []byte("name: Test.Resources"), 0644))
+
+	buf := &bytes.Buffer{}
+	err := Archive(src, buf)
+	require.NoError(t, err)
+
+	dst := t.TempDir()
+	err = Extract(buf, dst)
+	require.NoError(t, err)
+
+	b, err := os.ReadFile(filepath.Join(dst, "bundle.json"))
+	require.NoError(t, err)
+	require.Equal(t, `{"version":"1"}`, string(b))
+
+	b, err = os.ReadFile(filepath.Join(dst, "manifests", "test.yaml"))
+	require.NoError(t, err)
+	require.Equal(t, "name: Test.Resources", string(b))
+}
+
+func Test_Extract_Invalid(t *testing.T) {
+	tests := []struct {
+		name   string
+		header tar.Header
+		err    string
+	}{
+		{
+			name:   "path outside of the bundle",
+			header: tar.Header{Name: "../evil.yaml", Typeflag: tar.TypeReg},
+			err:    "bundle entry \"../evil.yaml\" is outside of the bundle",
+		},
+		{
+			name:   "absolute path",
+			header: tar.Header{Name: "/etc/evil.yaml", Typeflag: tar.TypeReg},
+			err:    "bundle entry \"/etc/evil.yaml\" is outside of the bundle",
+		},
+		{
+			name:   "symbolic link",
+			header: tar.Header{Name: "evil.yaml", Typeflag: tar.TypeSymlink, Linkname: "/etc/passwd"},
+			err:    "bundle entry \"evil.yaml\" is not a regular file or directory",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			buf := &bytes.Buffer{}
+			gz := gzip.NewWriter(buf)
+			tw := tar.NewWriter(gz)
+			require.NoError(t, tw.WriteHeader(&tt.header))
+			require.NoError(t, tw.Close())
+			require.NoError(t, gz.Close())
+
+			err := Extract(buf, t.TempDir())
+			require.EqualError(t, err, tt.err)
+		})
+	}
+}
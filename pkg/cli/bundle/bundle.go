@@ -0,0 +1,255 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package bundle implements the bundle format used by `rad bundle export` and `rad bundle import` to move resource
+// provider manifests, recipe templates and environment definitions into disconnected environments.
+//
+// A bundle is a gzipped tarball with the following layout:
+//
+//	bundle.json               the bundle index
+//	manifests/                resource provider manifests
+//	environments/<name>.json  environment definitions
+//	recipes/                  an OCI image layout holding the recipe templates, tagged by their original template path
+package bundle
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"oras.land/oras-go/v2/registry"
+
+	corerp "github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/pkg/to"
+)
+
+const (
+	// Version is the version of the bundle format.
+	Version = "1"
+
+	// IndexFile is the name of the bundle index file.
+	IndexFile = "bundle.json"
+
+	// ManifestsDirectory is the directory of the bundle holding the resource provider manifests.
+	ManifestsDirectory = "manifests"
+
+	// EnvironmentsDirectory is the directory of the bundle holding the environment definitions.
+	EnvironmentsDirectory = "environments"
+
+	// RecipesDirectory is the directory of the bundle holding the OCI image layout of the recipe templates.
+	RecipesDirectory = "recipes"
+)
+
+// Index describes the content of a bundle.
+type Index struct {
+	// Version is the version of the bundle format.
+	Version string `json:"version"`
+
+	// Manifests is the list of resource provider manifest files in the manifests directory.
+	Manifests []string `json:"manifests,omitempty"`
+
+	// Environments is the list of environment names in the environments directory.
+	Environments []string `json:"environments,omitempty"`
+
+	// Recipes is the list of recipe template paths in the recipes directory.
+	Recipes []string `json:"recipes,omitempty"`
+}
+
+// ReadIndex reads the index of the bundle extracted to the directory.
+func ReadIndex(dir string) (*Index, error) {
+	b, err := os.ReadFile(filepath.Join(dir, IndexFile))
+	if err != nil {
+		return nil, err
+	}
+
+	index := &Index{}
+	if err := json.Unmarshal(b, index); err != nil {
+		return nil, err
+	}
+
+	if index.Version != Version {
+		return nil, fmt.Errorf("bundle version %q is not supported", index.Version)
+	}
+
+	return index, nil
+}
+
+// WriteIndex writes the index of the bundle to the directory.
+func WriteIndex(dir string, index *Index) error {
+	b, err := json.MarshalIndent(index, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(filepath.Join(dir, IndexFile), b, 0644)
+}
+
+// ReadEnvironment reads the definition of the named environment from the bundle extracted to the directory.
+func ReadEnvironment(dir string, name string) (*corerp.EnvironmentResource, error) {
+	b, err := os.ReadFile(filepath.Join(dir, EnvironmentsDirectory, name+".json"))
+	if err != nil {
+		return nil, err
+	}
+
+	env := &corerp.EnvironmentResource{}
+	if err := json.Unmarshal(b, env); err != nil {
+		return nil, err
+	}
+
+	return env, nil
+}
+
+// WriteEnvironment writes the definition of the environment to the bundle directory. The resource ID and system data are
+// not written because they are specific to the environment the definition was exported from.
+func WriteEnvironment(dir string, env corerp.EnvironmentResource) error {
+	name := to.String(env.Name)
+	env.ID = nil
+	env.SystemData = nil
+
+	b, err := json.MarshalIndent(env, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Join(dir, EnvironmentsDirectory), 0755); err != nil {
+		return err
+	}
+
+	return os.WriteFile(filepath.Join(dir, EnvironmentsDirectory, name+".json"), b, 0644)
+}
+
+// CopyManifests copies the YAML files of the source directory to the destination directory and returns their names.
+func CopyManifests(src string, dst string) ([]string, error) {
+	entries, err := os.ReadDir(src)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := os.MkdirAll(dst, 0755); err != nil {
+		return nil, err
+	}
+
+	names := []string{}
+	for _, entry := range entries {
+		if entry.IsDir() || !slices.Contains([]string{".yaml", ".yml"}, filepath.Ext(entry.Name())) {
+			continue
+		}
+
+		b, err := os.ReadFile(filepath.Join(src, entry.Name()))
+		if err != nil {
+			return nil, err
+		}
+
+		if err := os.WriteFile(filepath.Join(dst, entry.Name()), b, 0644); err != nil {
+			return nil, err
+		}
+		names = append(names, entry.Name())
+	}
+
+	return names, nil
+}
+
+// TemplatePaths returns the sorted template paths of the bicep recipes of the environment.
+func TemplatePaths(env *corerp.EnvironmentResource) []string {
+	templatePaths := []string{}
+	if env.Properties == nil {
+		return templatePaths
+	}
+
+	for _, resourceTypeRecipes := range env.Properties.Recipes {
+		for _, recipe := range resourceTypeRecipes {
+			bicep, ok := recipe.(*corerp.BicepRecipeProperties)
+			if !ok || bicep.TemplatePath == nil || slices.Contains(templatePaths, *bicep.TemplatePath) {
+				continue
+			}
+
+			templatePaths = append(templatePaths, *bicep.TemplatePath)
+		}
+	}
+
+	slices.Sort(templatePaths)
+	return templatePaths
+}
+
+// UnsupportedRecipes returns the sorted names of the recipes of the environment which cannot be bundled, in the format
+// <resource type>/<recipe name>. Only bicep recipes can be bundled.
+func UnsupportedRecipes(env *corerp.EnvironmentResource) []string {
+	names := []string{}
+	if env.Properties == nil {
+		return names
+	}
+
+	for resourceType, resourceTypeRecipes := range env.Properties.Recipes {
+		for name, recipe := range resourceTypeRecipes {
+			if to.String(recipe.GetRecipeProperties().TemplateKind) != recipes.TemplateKindBicep {
+				names = append(names, resourceType+"/"+name)
+			}
+		}
+	}
+
+	slices.Sort(names)
+	return names
+}
+
+// MirrorTemplatePath returns the path of the recipe template once it is pushed to the registry. The registry is a host
+// optionally followed by a repository prefix, for example "myregistry.azurecr.io/mirror".
+func MirrorTemplatePath(templatePath string, registryPath string) (string, error) {
+	ref, err := registry.ParseReference(templatePath)
+	if err != nil {
+		return "", err
+	}
+
+	host, prefix, _ := strings.Cut(strings.TrimSuffix(registryPath, "/"), "/")
+	mirror := registry.Reference{
+		Registry:   host,
+		Repository: path.Join(prefix, ref.Repository),
+		Reference:  ref.Reference,
+	}
+	if err := mirror.Validate(); err != nil {
+		return "", err
+	}
+
+	return mirror.String(), nil
+}
+
+// RewriteTemplatePaths replaces the template paths of the bicep recipes of the environment using the mapping of
+// original template paths to mirrored template paths.
+func RewriteTemplatePaths(env *corerp.EnvironmentResource, mapping map[string]string, plainHTTP bool) {
+	if env.Properties == nil {
+		return
+	}
+
+	for _, resourceTypeRecipes := range env.Properties.Recipes {
+		for _, recipe := range resourceTypeRecipes {
+			bicep, ok := recipe.(*corerp.BicepRecipeProperties)
+			if !ok || bicep.TemplatePath == nil {
+				continue
+			}
+
+			if mirrored, ok := mapping[*bicep.TemplatePath]; ok {
+				bicep.TemplatePath = to.Ptr(mirrored)
+				if plainHTTP {
+					bicep.PlainHTTP = to.Ptr(true)
+				}
+			}
+		}
+	}
+}
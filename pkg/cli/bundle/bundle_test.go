@@ -0,0 +1,190 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bundle
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	corerp "github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/to"
+)
+
+func testEnvironment() *corerp.EnvironmentResource {
+	return &corerp.EnvironmentResource{
+		ID:   to.Ptr("/planes/radius/local/resourceGroups/default/providers/Applications.Core/environments/prod"),
+		Name: to.Ptr("prod"),
+		Properties: &corerp.EnvironmentProperties{
+			Recipes: map[string]map[string]corerp.RecipePropertiesClassification{
+				"Applications.Datastores/redisCaches": {
+					"default": &corerp.BicepRecipeProperties{
+						TemplateKind: to.Ptr("bicep"),
+						TemplatePath: to.Ptr("ghcr.io/radius-project/recipes/local-dev/rediscaches:latest"),
+					},
+					"cloud": &corerp.TerraformRecipeProperties{
+						TemplateKind: to.Ptr("terraform"),
+						TemplatePath: to.Ptr("Azure/cache/azurerm"),
+					},
+				},
+				"Applications.Datastores/sqlDatabases": {
+					"default": &corerp.BicepRecipeProperties{
+						TemplateKind: to.Ptr("bicep"),
+						TemplatePath: to.Ptr("ghcr.io/radius-project/recipes/local-dev/sqldatabases:latest"),
+					},
+				},
+			},
+		},
+	}
+}
+
+func Test_Index(t *testing.T) {
+	dir := t.TempDir()
+	expected := &Index{
+		Version:      Version,
+		Manifests:    []string{"test.yaml"},
+		Environments: []string{"prod"},
+		Recipes:      []string{"ghcr.io/radius-project/recipes/local-dev/rediscaches:latest"},
+	}
+
+	err := WriteIndex(dir, expected)
+	require.NoError(t, err)
+
+	index, err := ReadIndex(dir)
+	require.NoError(t, err)
+	require.Equal(t, expected, index)
+}
+
+func Test_ReadIndex_UnsupportedVersion(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, IndexFile), []byte(`{"version":"2"}`), 0644))
+
+	_, err := ReadIndex(dir)
+	require.EqualError(t, err, "bundle version \"2\" is not supported")
+}
+
+func Test_Environment(t *testing.T) {
+	dir := t.TempDir()
+
+	err := WriteEnvironment(dir, *testEnvironment())
+	require.NoError(t, err)
+
+	env, err := ReadEnvironment(dir, "prod")
+	require.NoError(t, err)
+	require.Nil(t, env.ID)
+	require.Equal(t, "prod", to.String(env.Name))
+	require.Equal(t, TemplatePaths(testEnvironment()), TemplatePaths(env))
+}
+
+func Test_CopyManifests(t *testing.T) {
+	src := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(src, "a.yaml"), []byte("name: A"), 0644))
+	require.NoError(t, os.WriteFile(filepath.Join(src, "b.yml"), []byte("name: B"), 0644))
+	require.NoError(t, os.WriteFile(filepath.Join(src, "README.md"), []byte("readme"), 0644))
+
+	dst := filepath.Join(t.TempDir(), "manifests")
+	names, err := CopyManifests(src, dst)
+	require.NoError(t, err)
+	require.Equal(t, []string{"a.yaml", "b.yml"}, names)
+
+	b, err := os.ReadFile(filepath.Join(dst, "b.yml"))
+	require.NoError(t, err)
+	require.Equal(t, "name: B", string(b))
+	require.NoFileExists(t, filepath.Join(dst, "README.md"))
+}
+
+func Test_TemplatePaths(t *testing.T) {
+	expected := []string{
+		"ghcr.io/radius-project/recipes/local-dev/rediscaches:latest",
+		"ghcr.io/radius-project/recipes/local-dev/sqldatabases:latest",
+	}
+	require.Equal(t, expected, TemplatePaths(testEnvironment()))
+}
+
+func Test_UnsupportedRecipes(t *testing.T) {
+	require.Equal(t, []string{"Applications.Datastores/redisCaches/cloud"}, UnsupportedRecipes(testEnvironment()))
+}
+
+func Test_MirrorTemplatePath(t *testing.T) {
+	tests := []struct {
+		name         string
+		templatePath string
+		registry     string
+		expected     string
+		err          bool
+	}{
+		{
+			name:         "registry host",
+			templatePath: "ghcr.io/radius-project/recipes/local-dev/rediscaches:latest",
+			registry:     "myregistry.local:5000",
+			expected:     "myregistry.local:5000/radius-project/recipes/local-dev/rediscaches:latest",
+		},
+		{
+			name:         "registry with repository prefix",
+			templatePath: "ghcr.io/radius-project/recipes/local-dev/rediscaches:latest",
+			registry:     "myregistry.azurecr.io/mirror/",
+			expected:     "myregistry.azurecr.io/mirror/radius-project/recipes/local-dev/rediscaches:latest",
+		},
+		{
+			name:         "digest",
+			templatePath: "ghcr.io/radius-project/recipes/rediscaches@sha256:9b42d7ab5b2e9ef0fa8b5e4b7ad25f0b5b1a2c1e1f2d0a1b3c4d5e6f708192a3",
+			registry:     "myregistry.local:5000",
+			expected:     "myregistry.local:5000/radius-project/recipes/rediscaches@sha256:9b42d7ab5b2e9ef0fa8b5e4b7ad25f0b5b1a2c1e1f2d0a1b3c4d5e6f708192a3",
+		},
+		{
+			name:         "invalid registry",
+			templatePath: "ghcr.io/radius-project/recipes/local-dev/rediscaches:latest",
+			registry:     "my registry",
+			err:          true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mirrored, err := MirrorTemplatePath(tt.templatePath, tt.registry)
+			if tt.err {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, mirrored)
+		})
+	}
+}
+
+func Test_RewriteTemplatePaths(t *testing.T) {
+	env := testEnvironment()
+	mapping := map[string]string{
+		"ghcr.io/radius-project/recipes/local-dev/rediscaches:latest": "myregistry.local:5000/radius-project/recipes/local-dev/rediscaches:latest",
+	}
+
+	RewriteTemplatePaths(env, mapping, true)
+
+	redis := env.Properties.Recipes["Applications.Datastores/redisCaches"]["default"].(*corerp.BicepRecipeProperties)
+	require.Equal(t, "myregistry.local:5000/radius-project/recipes/local-dev/rediscaches:latest", to.String(redis.TemplatePath))
+	require.True(t, to.Bool(redis.PlainHTTP))
+
+	sql := env.Properties.Recipes["Applications.Datastores/sqlDatabases"]["default"].(*corerp.BicepRecipeProperties)
+	require.Equal(t, "ghcr.io/radius-project/recipes/local-dev/sqldatabases:latest", to.String(sql.TemplatePath))
+	require.Nil(t, sql.PlainHTTP)
+
+	terraform := env.Properties.Recipes["Applications.Datastores/redisCaches"]["cloud"].(*corerp.TerraformRecipeProperties)
+	require.Equal(t, "Azure/cache/azurerm", to.String(terraform.TemplatePath))
+}
@@ -71,6 +71,12 @@ const (
 type ResourceProgress struct {
 	Resource ucpresources.ID
 	Status   ResourceStatus
+
+	// State is the provisioning state of the resource reported by the deployment.
+	State string
+
+	// Message is the status message of the resource reported by the deployment, such as the error of a failed resource.
+	Message string
 }
 
 type DeploymentOutput struct {
@@ -90,19 +96,31 @@ type DeploymentClient interface {
 
 //go:generate mockgen -typed -destination=./mock_diagnosticsclient.go -package=clients -self_package github.com/radius-project/radius/pkg/cli/clients github.com/radius-project/radius/pkg/cli/clients DiagnosticsClient
 
-// DiagnosticsClient is used to interface with diagnostics features like logs and port-forwards.
+// DiagnosticsClient is used to interface with diagnostics features like logs, port-forwards and exec sessions.
 type DiagnosticsClient interface {
+	Exec(ctx context.Context, options ExecOptions) error
 	Expose(ctx context.Context, options ExposeOptions) (failed chan error, stop chan struct{}, signals chan os.Signal, err error)
 	Logs(ctx context.Context, options LogsOptions) ([]LogStream, error)
+	PortForward(ctx context.Context, options PortForwardOptions) error
 	GetPublicEndpoint(ctx context.Context, options EndpointOptions) (*string, error)
 }
 
 type ApplicationStatus struct {
 	Name          string
 	ResourceCount int
+	Resources     []ApplicationResourceStatus
 	Gateways      []GatewayStatus
 }
 
+type ApplicationResourceStatus struct {
+	Name              string
+	Type              string
+	ProvisioningState string
+	HealthState       string
+	// ComputedValues is the list of endpoints and computed values of the resource, formatted as "name=value".
+	ComputedValues string
+}
+
 type GatewayStatus struct {
 	Name     string
 	Endpoint string
@@ -120,6 +138,37 @@ type ExposeOptions struct {
 	Replica     string
 }
 
+type ExecOptions struct {
+	Application string
+	Resource    string
+	Container   string
+	Replica     string
+
+	// Command is the command to run in the container.
+	Command []string
+
+	// TTY configures whether the session is interactive. Stdin is put in raw mode for the duration of the session.
+	TTY    bool
+	Stdin  io.Reader
+	Stdout io.Writer
+	Stderr io.Writer
+}
+
+type PortForwardOptions struct {
+	Application string
+	Resource    string
+	Replica     string
+
+	// Ports configures the ports to forward. The ports declared by the container are forwarded to the same local
+	// ports if empty.
+	Ports []PortMapping
+}
+
+type PortMapping struct {
+	LocalPort  int
+	RemotePort int
+}
+
 type LogsOptions struct {
 	Application string
 	Resource    string
@@ -135,6 +184,20 @@ type LogStream struct {
 
 //go:generate mockgen -typed -destination=./mock_applicationsclient.go -package=clients -self_package github.com/radius-project/radius/pkg/cli/clients github.com/radius-project/radius/pkg/cli/clients ApplicationsManagementClient
 
+const (
+	// PlaneTypeRadius is the type of radius planes.
+	PlaneTypeRadius = "radius"
+
+	// PlaneTypeAzure is the type of azure planes.
+	PlaneTypeAzure = "azure"
+
+	// PlaneTypeAWS is the type of aws planes.
+	PlaneTypeAWS = "aws"
+)
+
+// SupportedPlaneTypes is the list of plane types which can be managed with ApplicationsManagementClient.
+var SupportedPlaneTypes = []string{PlaneTypeRadius, PlaneTypeAzure, PlaneTypeAWS}
+
 // ApplicationsManagementClient is used to interface with management features like listing resources by app, show details of a resource.
 type ApplicationsManagementClient interface {
 	// ListResourcesOfType lists all resources of a given type in the configured scope.
@@ -167,6 +230,9 @@ type ApplicationsManagementClient interface {
 	// GetApplicationGraph retrieves the application graph of an application by its name (or id).
 	GetApplicationGraph(ctx context.Context, applicationNameOrID string) (corerp.ApplicationGraphResponse, error)
 
+	// GetApplicationStatus retrieves the status of the resources of an application by its name (or id).
+	GetApplicationStatus(ctx context.Context, applicationNameOrID string) (corerp.ApplicationStatusResponse, error)
+
 	// CreateOrUpdateApplication creates or updates an application by its name (or id).
 	CreateOrUpdateApplication(ctx context.Context, applicationNameOrID string, resource *corerp.ApplicationResource) error
 
@@ -205,6 +271,20 @@ type ApplicationsManagementClient interface {
 
 	// DeleteResourceGroup deletes a resource group by its name.
 	DeleteResourceGroup(ctx context.Context, planeName string, resourceGroupName string) (bool, error)
+
+	// ListPlanes lists all planes.
+	ListPlanes(ctx context.Context) ([]ucp_v20231001preview.GenericPlaneResource, error)
+
+	// GetPlane retrieves a plane by its type and name. The plane is returned as the resource type of the plane type,
+	// eg: ucp_v20231001preview.RadiusPlaneResource for radius planes.
+	GetPlane(ctx context.Context, planeType string, planeName string) (any, error)
+
+	// CreateOrUpdatePlane creates or updates a plane by its type and name. The resource must be a pointer to the
+	// resource type of the plane type, eg: *ucp_v20231001preview.RadiusPlaneResource for radius planes.
+	CreateOrUpdatePlane(ctx context.Context, planeType string, planeName string, resource any) error
+
+	// DeletePlane deletes a plane by its type and name.
+	DeletePlane(ctx context.Context, planeType string, planeName string) (bool, error)
 }
 
 // ShallowCopy creates a shallow copy of the DeploymentParameters object by iterating through the original object and
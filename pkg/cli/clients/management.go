@@ -18,6 +18,7 @@ package clients
 
 import (
 	"context"
+	"fmt"
 	"net/http"
 	"strings"
 
@@ -71,13 +72,19 @@ var (
 
 // ListResourcesOfType lists all resources of a given type in the configured scope.
 func (amc *UCPApplicationsManagementClient) ListResourcesOfType(ctx context.Context, resourceType string) ([]generated.GenericResource, error) {
+	return amc.listResourcesOfType(ctx, resourceType, &generated.GenericResourcesClientListByRootScopeOptions{})
+}
+
+// listResourcesOfType lists the resources of a given type in the configured scope, one page at a time. The options
+// filter the resources on the server.
+func (amc *UCPApplicationsManagementClient) listResourcesOfType(ctx context.Context, resourceType string, options *generated.GenericResourcesClientListByRootScopeOptions) ([]generated.GenericResource, error) {
 	client, err := amc.createGenericClient(amc.RootScope, resourceType)
 	if err != nil {
 		return nil, err
 	}
 
 	results := []generated.GenericResource{}
-	pager := client.NewListByRootScopePager(&generated.GenericResourcesClientListByRootScopeOptions{})
+	pager := client.NewListByRootScopePager(options)
 	for pager.More() {
 		page, err := pager.NextPage(ctx)
 		if err != nil {
@@ -99,11 +106,12 @@ func (amc *UCPApplicationsManagementClient) ListResourcesOfTypeInApplication(ctx
 		return nil, err
 	}
 
-	resources, err := amc.ListResourcesOfType(ctx, resourceType)
+	resources, err := amc.listResourcesOfType(ctx, resourceType, &generated.GenericResourcesClientListByRootScopeOptions{Application: &applicationID})
 	if err != nil {
 		return nil, err
 	}
 
+	// Servers that do not support filtering return every resource of the type, so the results are filtered again.
 	results := []generated.GenericResource{}
 	for _, resource := range resources {
 		if isResourceInApplication(resource, applicationID) {
@@ -121,11 +129,12 @@ func (amc *UCPApplicationsManagementClient) ListResourcesOfTypeInEnvironment(ctx
 		return nil, err
 	}
 
-	resources, err := amc.ListResourcesOfType(ctx, resourceType)
+	resources, err := amc.listResourcesOfType(ctx, resourceType, &generated.GenericResourcesClientListByRootScopeOptions{Environment: &environmentID})
 	if err != nil {
 		return nil, err
 	}
 
+	// Servers that do not support filtering return every resource of the type, so the results are filtered again.
 	results := []generated.GenericResource{}
 	for _, resource := range resources {
 		if isResourceInEnvironment(resource, environmentID) {
@@ -307,6 +316,26 @@ func (amc *UCPApplicationsManagementClient) GetApplicationGraph(ctx context.Cont
 	return getResponse.ApplicationGraphResponse, nil
 }
 
+// GetApplicationStatus retrieves the status of the resources of an application by its name (or id).
+func (amc *UCPApplicationsManagementClient) GetApplicationStatus(ctx context.Context, applicationNameOrID string) (corerpv20231001.ApplicationStatusResponse, error) {
+	scope, name, err := amc.extractScopeAndName(applicationNameOrID)
+	if err != nil {
+		return corerpv20231001.ApplicationStatusResponse{}, err
+	}
+
+	client, err := amc.createApplicationClient(scope)
+	if err != nil {
+		return corerpv20231001.ApplicationStatusResponse{}, err
+	}
+
+	response, err := client.GetStatus(ctx, name, map[string]any{}, &corerpv20231001.ApplicationsClientGetStatusOptions{})
+	if err != nil {
+		return corerpv20231001.ApplicationStatusResponse{}, err
+	}
+
+	return response.ApplicationStatusResponse, nil
+}
+
 // CreateOrUpdateApplication creates or updates an application by its name (or id).
 func (amc *UCPApplicationsManagementClient) CreateOrUpdateApplication(ctx context.Context, applicationNameOrID string, resource *corerpv20231001.ApplicationResource) error {
 	scope, name, err := amc.extractScopeAndName(applicationNameOrID)
@@ -655,6 +684,194 @@ func (amc *UCPApplicationsManagementClient) DeleteResourceGroup(ctx context.Cont
 	return response.StatusCode != 204, nil
 }
 
+// ListPlanes lists all planes.
+func (amc *UCPApplicationsManagementClient) ListPlanes(ctx context.Context) ([]ucpv20231001.GenericPlaneResource, error) {
+	client, err := ucpv20231001.NewPlanesClient(&aztoken.AnonymousCredential{}, amc.ClientOptions)
+	if err != nil {
+		return nil, err
+	}
+
+	results := []ucpv20231001.GenericPlaneResource{}
+	pager := client.NewListPlanesPager(&ucpv20231001.PlanesClientListPlanesOptions{})
+	for pager.More() {
+		page, err := pager.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, plane := range page.Value {
+			results = append(results, *plane)
+		}
+	}
+
+	return results, nil
+}
+
+// GetPlane retrieves a plane by its type and name.
+func (amc *UCPApplicationsManagementClient) GetPlane(ctx context.Context, planeType string, planeName string) (any, error) {
+	switch planeType {
+	case PlaneTypeRadius:
+		client, err := ucpv20231001.NewRadiusPlanesClient(&aztoken.AnonymousCredential{}, amc.ClientOptions)
+		if err != nil {
+			return nil, err
+		}
+
+		response, err := client.Get(ctx, planeName, &ucpv20231001.RadiusPlanesClientGetOptions{})
+		if err != nil {
+			return nil, err
+		}
+
+		return response.RadiusPlaneResource, nil
+	case PlaneTypeAzure:
+		client, err := ucpv20231001.NewAzurePlanesClient(&aztoken.AnonymousCredential{}, amc.ClientOptions)
+		if err != nil {
+			return nil, err
+		}
+
+		response, err := client.Get(ctx, planeName, &ucpv20231001.AzurePlanesClientGetOptions{})
+		if err != nil {
+			return nil, err
+		}
+
+		return response.AzurePlaneResource, nil
+	case PlaneTypeAWS:
+		client, err := ucpv20231001.NewAwsPlanesClient(&aztoken.AnonymousCredential{}, amc.ClientOptions)
+		if err != nil {
+			return nil, err
+		}
+
+		response, err := client.Get(ctx, planeName, &ucpv20231001.AwsPlanesClientGetOptions{})
+		if err != nil {
+			return nil, err
+		}
+
+		return response.AwsPlaneResource, nil
+	default:
+		return nil, fmt.Errorf("plane type %q is not supported", planeType)
+	}
+}
+
+// CreateOrUpdatePlane creates or updates a plane by its type and name.
+func (amc *UCPApplicationsManagementClient) CreateOrUpdatePlane(ctx context.Context, planeType string, planeName string, resource any) error {
+	switch plane := resource.(type) {
+	case *ucpv20231001.RadiusPlaneResource:
+		if planeType != PlaneTypeRadius {
+			break
+		}
+
+		client, err := ucpv20231001.NewRadiusPlanesClient(&aztoken.AnonymousCredential{}, amc.ClientOptions)
+		if err != nil {
+			return err
+		}
+
+		// The server can return invalid system data, which fails to roundtrip. See CreateOrUpdateResourceGroup.
+		plane.SystemData = nil
+
+		poller, err := client.BeginCreateOrUpdate(ctx, planeName, *plane, &ucpv20231001.RadiusPlanesClientBeginCreateOrUpdateOptions{})
+		if err != nil {
+			return err
+		}
+
+		_, err = poller.PollUntilDone(ctx, nil)
+		return err
+	case *ucpv20231001.AzurePlaneResource:
+		if planeType != PlaneTypeAzure {
+			break
+		}
+
+		client, err := ucpv20231001.NewAzurePlanesClient(&aztoken.AnonymousCredential{}, amc.ClientOptions)
+		if err != nil {
+			return err
+		}
+
+		plane.SystemData = nil
+
+		poller, err := client.BeginCreateOrUpdate(ctx, planeName, *plane, &ucpv20231001.AzurePlanesClientBeginCreateOrUpdateOptions{})
+		if err != nil {
+			return err
+		}
+
+		_, err = poller.PollUntilDone(ctx, nil)
+		return err
+	case *ucpv20231001.AwsPlaneResource:
+		if planeType != PlaneTypeAWS {
+			break
+		}
+
+		client, err := ucpv20231001.NewAwsPlanesClient(&aztoken.AnonymousCredential{}, amc.ClientOptions)
+		if err != nil {
+			return err
+		}
+
+		plane.SystemData = nil
+
+		poller, err := client.BeginCreateOrUpdate(ctx, planeName, *plane, &ucpv20231001.AwsPlanesClientBeginCreateOrUpdateOptions{})
+		if err != nil {
+			return err
+		}
+
+		_, err = poller.PollUntilDone(ctx, nil)
+		return err
+	}
+
+	return fmt.Errorf("resource of type %T is not a plane of type %q", resource, planeType)
+}
+
+// DeletePlane deletes a plane by its type and name.
+func (amc *UCPApplicationsManagementClient) DeletePlane(ctx context.Context, planeType string, planeName string) (bool, error) {
+	var response *http.Response
+	ctx = amc.captureResponse(ctx, &response)
+
+	switch planeType {
+	case PlaneTypeRadius:
+		client, err := ucpv20231001.NewRadiusPlanesClient(&aztoken.AnonymousCredential{}, amc.ClientOptions)
+		if err != nil {
+			return false, err
+		}
+
+		poller, err := client.BeginDelete(ctx, planeName, &ucpv20231001.RadiusPlanesClientBeginDeleteOptions{})
+		if err != nil {
+			return false, err
+		}
+
+		if _, err = poller.PollUntilDone(ctx, nil); err != nil {
+			return false, err
+		}
+	case PlaneTypeAzure:
+		client, err := ucpv20231001.NewAzurePlanesClient(&aztoken.AnonymousCredential{}, amc.ClientOptions)
+		if err != nil {
+			return false, err
+		}
+
+		poller, err := client.BeginDelete(ctx, planeName, &ucpv20231001.AzurePlanesClientBeginDeleteOptions{})
+		if err != nil {
+			return false, err
+		}
+
+		if _, err = poller.PollUntilDone(ctx, nil); err != nil {
+			return false, err
+		}
+	case PlaneTypeAWS:
+		client, err := ucpv20231001.NewAwsPlanesClient(&aztoken.AnonymousCredential{}, amc.ClientOptions)
+		if err != nil {
+			return false, err
+		}
+
+		poller, err := client.BeginDelete(ctx, planeName, &ucpv20231001.AwsPlanesClientBeginDeleteOptions{})
+		if err != nil {
+			return false, err
+		}
+
+		if _, err = poller.PollUntilDone(ctx, nil); err != nil {
+			return false, err
+		}
+	default:
+		return false, fmt.Errorf("plane type %q is not supported", planeType)
+	}
+
+	return response.StatusCode != 204, nil
+}
+
 func (amc *UCPApplicationsManagementClient) createApplicationClient(scope string) (applicationResourceClient, error) {
 	if amc.applicationResourceClientFactory == nil {
 		// Generated client doesn't like the leading '/' in the scope.
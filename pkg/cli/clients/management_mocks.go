@@ -52,6 +52,7 @@ type applicationResourceClient interface {
 	NewListByScopePager(options *corerpv20231001.ApplicationsClientListByScopeOptions) *runtime.Pager[corerpv20231001.ApplicationsClientListByScopeResponse]
 
 	GetGraph(ctx context.Context, applicationName string, body map[string]any, options *corerpv20231001.ApplicationsClientGetGraphOptions) (corerpv20231001.ApplicationsClientGetGraphResponse, error)
+	GetStatus(ctx context.Context, applicationName string, body map[string]any, options *corerpv20231001.ApplicationsClientGetStatusOptions) (corerpv20231001.ApplicationsClientGetStatusResponse, error)
 }
 
 // environmentResourceClient is an interface for mocking the generated SDK client for environment resources.
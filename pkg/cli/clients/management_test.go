@@ -136,7 +136,9 @@ func Test_Resource(t *testing.T) {
 		client := createClient(mock)
 
 		mock.EXPECT().
-			NewListByRootScopePager(gomock.Any()).
+			NewListByRootScopePager(&generated.GenericResourcesClientListByRootScopeOptions{
+				Application: to.Ptr(testScope + "/providers/Applications.Core/applications/test-application"),
+			}).
 			Return(pager(listPages))
 
 		expectedResourceList := []generated.GenericResource{*listPages[0].Value[0]}
@@ -151,7 +153,9 @@ func Test_Resource(t *testing.T) {
 		client := createClient(mock)
 
 		mock.EXPECT().
-			NewListByRootScopePager(gomock.Any()).
+			NewListByRootScopePager(&generated.GenericResourcesClientListByRootScopeOptions{
+				Environment: to.Ptr(testScope + "/providers/Applications.Core/environments/test-environment"),
+			}).
 			Return(pager(listPages))
 
 		expectedResourceList := []generated.GenericResource{*listPages[0].Value[0], *listPages[0].Value[1]}
@@ -373,6 +377,28 @@ func Test_Application(t *testing.T) {
 		require.Equal(t, expectedGraph, graph)
 	})
 
+	t.Run("GetApplicationStatus", func(t *testing.T) {
+		mock := NewMockapplicationResourceClient(gomock.NewController(t))
+		client := createClient(mock)
+
+		expectedStatus := corerp.ApplicationStatusResponse{
+			Resources: []*corerp.ApplicationStatusResource{
+				{
+					ID:          &testResourceID,
+					HealthState: to.Ptr(corerp.HealthStateHealthy),
+				},
+			},
+		}
+
+		mock.EXPECT().
+			GetStatus(gomock.Any(), testResourceName, gomock.Any(), gomock.Any()).
+			Return(corerp.ApplicationsClientGetStatusResponse{ApplicationStatusResponse: expectedStatus}, nil)
+
+		status, err := client.GetApplicationStatus(context.Background(), testResourceID)
+		require.NoError(t, err)
+		require.Equal(t, expectedStatus, status)
+	})
+
 	t.Run("CreateOrUpdateApplication", func(t *testing.T) {
 		mock := NewMockapplicationResourceClient(gomock.NewController(t))
 		client := createClient(mock)
@@ -156,6 +156,44 @@ func (c *MockApplicationsManagementClientCreateOrUpdateEnvironmentCall) DoAndRet
 	return c
 }
 
+// CreateOrUpdatePlane mocks base method.
+func (m *MockApplicationsManagementClient) CreateOrUpdatePlane(arg0 context.Context, arg1, arg2 string, arg3 any) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateOrUpdatePlane", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateOrUpdatePlane indicates an expected call of CreateOrUpdatePlane.
+func (mr *MockApplicationsManagementClientMockRecorder) CreateOrUpdatePlane(arg0, arg1, arg2, arg3 any) *MockApplicationsManagementClientCreateOrUpdatePlaneCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOrUpdatePlane", reflect.TypeOf((*MockApplicationsManagementClient)(nil).CreateOrUpdatePlane), arg0, arg1, arg2, arg3)
+	return &MockApplicationsManagementClientCreateOrUpdatePlaneCall{Call: call}
+}
+
+// MockApplicationsManagementClientCreateOrUpdatePlaneCall wrap *gomock.Call
+type MockApplicationsManagementClientCreateOrUpdatePlaneCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockApplicationsManagementClientCreateOrUpdatePlaneCall) Return(arg0 error) *MockApplicationsManagementClientCreateOrUpdatePlaneCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockApplicationsManagementClientCreateOrUpdatePlaneCall) Do(f func(context.Context, string, string, any) error) *MockApplicationsManagementClientCreateOrUpdatePlaneCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockApplicationsManagementClientCreateOrUpdatePlaneCall) DoAndReturn(f func(context.Context, string, string, any) error) *MockApplicationsManagementClientCreateOrUpdatePlaneCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // CreateOrUpdateResourceGroup mocks base method.
 func (m *MockApplicationsManagementClient) CreateOrUpdateResourceGroup(arg0 context.Context, arg1, arg2 string, arg3 *v20231001preview0.ResourceGroupResource) error {
 	m.ctrl.T.Helper()
@@ -272,6 +310,45 @@ func (c *MockApplicationsManagementClientDeleteEnvironmentCall) DoAndReturn(f fu
 	return c
 }
 
+// DeletePlane mocks base method.
+func (m *MockApplicationsManagementClient) DeletePlane(arg0 context.Context, arg1, arg2 string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletePlane", arg0, arg1, arg2)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeletePlane indicates an expected call of DeletePlane.
+func (mr *MockApplicationsManagementClientMockRecorder) DeletePlane(arg0, arg1, arg2 any) *MockApplicationsManagementClientDeletePlaneCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePlane", reflect.TypeOf((*MockApplicationsManagementClient)(nil).DeletePlane), arg0, arg1, arg2)
+	return &MockApplicationsManagementClientDeletePlaneCall{Call: call}
+}
+
+// MockApplicationsManagementClientDeletePlaneCall wrap *gomock.Call
+type MockApplicationsManagementClientDeletePlaneCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockApplicationsManagementClientDeletePlaneCall) Return(arg0 bool, arg1 error) *MockApplicationsManagementClientDeletePlaneCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockApplicationsManagementClientDeletePlaneCall) Do(f func(context.Context, string, string) (bool, error)) *MockApplicationsManagementClientDeletePlaneCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockApplicationsManagementClientDeletePlaneCall) DoAndReturn(f func(context.Context, string, string) (bool, error)) *MockApplicationsManagementClientDeletePlaneCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // DeleteResource mocks base method.
 func (m *MockApplicationsManagementClient) DeleteResource(arg0 context.Context, arg1, arg2 string) (bool, error) {
 	m.ctrl.T.Helper()
@@ -428,6 +505,45 @@ func (c *MockApplicationsManagementClientGetApplicationGraphCall) DoAndReturn(f
 	return c
 }
 
+// GetApplicationStatus mocks base method.
+func (m *MockApplicationsManagementClient) GetApplicationStatus(arg0 context.Context, arg1 string) (v20231001preview.ApplicationStatusResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetApplicationStatus", arg0, arg1)
+	ret0, _ := ret[0].(v20231001preview.ApplicationStatusResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetApplicationStatus indicates an expected call of GetApplicationStatus.
+func (mr *MockApplicationsManagementClientMockRecorder) GetApplicationStatus(arg0, arg1 any) *MockApplicationsManagementClientGetApplicationStatusCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetApplicationStatus", reflect.TypeOf((*MockApplicationsManagementClient)(nil).GetApplicationStatus), arg0, arg1)
+	return &MockApplicationsManagementClientGetApplicationStatusCall{Call: call}
+}
+
+// MockApplicationsManagementClientGetApplicationStatusCall wrap *gomock.Call
+type MockApplicationsManagementClientGetApplicationStatusCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockApplicationsManagementClientGetApplicationStatusCall) Return(arg0 v20231001preview.ApplicationStatusResponse, arg1 error) *MockApplicationsManagementClientGetApplicationStatusCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockApplicationsManagementClientGetApplicationStatusCall) Do(f func(context.Context, string) (v20231001preview.ApplicationStatusResponse, error)) *MockApplicationsManagementClientGetApplicationStatusCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockApplicationsManagementClientGetApplicationStatusCall) DoAndReturn(f func(context.Context, string) (v20231001preview.ApplicationStatusResponse, error)) *MockApplicationsManagementClientGetApplicationStatusCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // GetEnvironment mocks base method.
 func (m *MockApplicationsManagementClient) GetEnvironment(arg0 context.Context, arg1 string) (v20231001preview.EnvironmentResource, error) {
 	m.ctrl.T.Helper()
@@ -467,6 +583,45 @@ func (c *MockApplicationsManagementClientGetEnvironmentCall) DoAndReturn(f func(
 	return c
 }
 
+// GetPlane mocks base method.
+func (m *MockApplicationsManagementClient) GetPlane(arg0 context.Context, arg1, arg2 string) (any, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPlane", arg0, arg1, arg2)
+	ret0, _ := ret[0].(any)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPlane indicates an expected call of GetPlane.
+func (mr *MockApplicationsManagementClientMockRecorder) GetPlane(arg0, arg1, arg2 any) *MockApplicationsManagementClientGetPlaneCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPlane", reflect.TypeOf((*MockApplicationsManagementClient)(nil).GetPlane), arg0, arg1, arg2)
+	return &MockApplicationsManagementClientGetPlaneCall{Call: call}
+}
+
+// MockApplicationsManagementClientGetPlaneCall wrap *gomock.Call
+type MockApplicationsManagementClientGetPlaneCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockApplicationsManagementClientGetPlaneCall) Return(arg0 any, arg1 error) *MockApplicationsManagementClientGetPlaneCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockApplicationsManagementClientGetPlaneCall) Do(f func(context.Context, string, string) (any, error)) *MockApplicationsManagementClientGetPlaneCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockApplicationsManagementClientGetPlaneCall) DoAndReturn(f func(context.Context, string, string) (any, error)) *MockApplicationsManagementClientGetPlaneCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // GetRecipeMetadata mocks base method.
 func (m *MockApplicationsManagementClient) GetRecipeMetadata(arg0 context.Context, arg1 string, arg2 v20231001preview.RecipeGetMetadata) (v20231001preview.RecipeGetMetadataResponse, error) {
 	m.ctrl.T.Helper()
@@ -701,6 +856,45 @@ func (c *MockApplicationsManagementClientListEnvironmentsAllCall) DoAndReturn(f
 	return c
 }
 
+// ListPlanes mocks base method.
+func (m *MockApplicationsManagementClient) ListPlanes(arg0 context.Context) ([]v20231001preview0.GenericPlaneResource, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPlanes", arg0)
+	ret0, _ := ret[0].([]v20231001preview0.GenericPlaneResource)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPlanes indicates an expected call of ListPlanes.
+func (mr *MockApplicationsManagementClientMockRecorder) ListPlanes(arg0 any) *MockApplicationsManagementClientListPlanesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPlanes", reflect.TypeOf((*MockApplicationsManagementClient)(nil).ListPlanes), arg0)
+	return &MockApplicationsManagementClientListPlanesCall{Call: call}
+}
+
+// MockApplicationsManagementClientListPlanesCall wrap *gomock.Call
+type MockApplicationsManagementClientListPlanesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockApplicationsManagementClientListPlanesCall) Return(arg0 []v20231001preview0.GenericPlaneResource, arg1 error) *MockApplicationsManagementClientListPlanesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockApplicationsManagementClientListPlanesCall) Do(f func(context.Context) ([]v20231001preview0.GenericPlaneResource, error)) *MockApplicationsManagementClientListPlanesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockApplicationsManagementClientListPlanesCall) DoAndReturn(f func(context.Context) ([]v20231001preview0.GenericPlaneResource, error)) *MockApplicationsManagementClientListPlanesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // ListResourceGroups mocks base method.
 func (m *MockApplicationsManagementClient) ListResourceGroups(arg0 context.Context, arg1 string) ([]v20231001preview0.ResourceGroupResource, error) {
 	m.ctrl.T.Helper()
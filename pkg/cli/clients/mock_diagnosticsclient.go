@@ -40,6 +40,44 @@ func (m *MockDiagnosticsClient) EXPECT() *MockDiagnosticsClientMockRecorder {
 	return m.recorder
 }
 
+// Exec mocks base method.
+func (m *MockDiagnosticsClient) Exec(arg0 context.Context, arg1 ExecOptions) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Exec", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Exec indicates an expected call of Exec.
+func (mr *MockDiagnosticsClientMockRecorder) Exec(arg0, arg1 any) *MockDiagnosticsClientExecCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Exec", reflect.TypeOf((*MockDiagnosticsClient)(nil).Exec), arg0, arg1)
+	return &MockDiagnosticsClientExecCall{Call: call}
+}
+
+// MockDiagnosticsClientExecCall wrap *gomock.Call
+type MockDiagnosticsClientExecCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockDiagnosticsClientExecCall) Return(arg0 error) *MockDiagnosticsClientExecCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockDiagnosticsClientExecCall) Do(f func(context.Context, ExecOptions) error) *MockDiagnosticsClientExecCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockDiagnosticsClientExecCall) DoAndReturn(f func(context.Context, ExecOptions) error) *MockDiagnosticsClientExecCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // Expose mocks base method.
 func (m *MockDiagnosticsClient) Expose(arg0 context.Context, arg1 ExposeOptions) (chan error, chan struct{}, chan os.Signal, error) {
 	m.ctrl.T.Helper()
@@ -158,3 +196,41 @@ func (c *MockDiagnosticsClientLogsCall) DoAndReturn(f func(context.Context, Logs
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
+
+// PortForward mocks base method.
+func (m *MockDiagnosticsClient) PortForward(arg0 context.Context, arg1 PortForwardOptions) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PortForward", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PortForward indicates an expected call of PortForward.
+func (mr *MockDiagnosticsClientMockRecorder) PortForward(arg0, arg1 any) *MockDiagnosticsClientPortForwardCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PortForward", reflect.TypeOf((*MockDiagnosticsClient)(nil).PortForward), arg0, arg1)
+	return &MockDiagnosticsClientPortForwardCall{Call: call}
+}
+
+// MockDiagnosticsClientPortForwardCall wrap *gomock.Call
+type MockDiagnosticsClientPortForwardCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockDiagnosticsClientPortForwardCall) Return(arg0 error) *MockDiagnosticsClientPortForwardCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockDiagnosticsClientPortForwardCall) Do(f func(context.Context, PortForwardOptions) error) *MockDiagnosticsClientPortForwardCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockDiagnosticsClientPortForwardCall) DoAndReturn(f func(context.Context, PortForwardOptions) error) *MockDiagnosticsClientPortForwardCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
@@ -377,6 +377,45 @@ func (c *MockapplicationResourceClientGetGraphCall) DoAndReturn(f func(context.C
 	return c
 }
 
+// GetStatus mocks base method.
+func (m *MockapplicationResourceClient) GetStatus(ctx context.Context, applicationName string, body map[string]any, options *v20231001preview.ApplicationsClientGetStatusOptions) (v20231001preview.ApplicationsClientGetStatusResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStatus", ctx, applicationName, body, options)
+	ret0, _ := ret[0].(v20231001preview.ApplicationsClientGetStatusResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStatus indicates an expected call of GetStatus.
+func (mr *MockapplicationResourceClientMockRecorder) GetStatus(ctx, applicationName, body, options any) *MockapplicationResourceClientGetStatusCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStatus", reflect.TypeOf((*MockapplicationResourceClient)(nil).GetStatus), ctx, applicationName, body, options)
+	return &MockapplicationResourceClientGetStatusCall{Call: call}
+}
+
+// MockapplicationResourceClientGetStatusCall wrap *gomock.Call
+type MockapplicationResourceClientGetStatusCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockapplicationResourceClientGetStatusCall) Return(arg0 v20231001preview.ApplicationsClientGetStatusResponse, arg1 error) *MockapplicationResourceClientGetStatusCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockapplicationResourceClientGetStatusCall) Do(f func(context.Context, string, map[string]any, *v20231001preview.ApplicationsClientGetStatusOptions) (v20231001preview.ApplicationsClientGetStatusResponse, error)) *MockapplicationResourceClientGetStatusCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockapplicationResourceClientGetStatusCall) DoAndReturn(f func(context.Context, string, map[string]any, *v20231001preview.ApplicationsClientGetStatusOptions) (v20231001preview.ApplicationsClientGetStatusResponse, error)) *MockapplicationResourceClientGetStatusCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // NewListByScopePager mocks base method.
 func (m *MockapplicationResourceClient) NewListByScopePager(options *v20231001preview.ApplicationsClientListByScopeOptions) *runtime.Pager[v20231001preview.ApplicationsClientListByScopeResponse] {
 	m.ctrl.T.Helper()
@@ -266,6 +266,12 @@ func (client *GenericResourcesClient) listByRootScopeCreateRequest(ctx context.C
 	}
 	reqQP := req.Raw().URL.Query()
 	reqQP.Set("api-version", "2023-10-01-preview")
+	if options != nil && options.Application != nil {
+		reqQP.Set("application", *options.Application)
+	}
+	if options != nil && options.Environment != nil {
+		reqQP.Set("environment", *options.Environment)
+	}
 	req.Raw().URL.RawQuery = reqQP.Encode()
 	req.Raw().Header["Accept"] = []string{"application/json"}
 	return req, nil
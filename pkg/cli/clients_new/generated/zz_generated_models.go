@@ -89,7 +89,10 @@ type GenericResourcesClientGetOptions struct {
 // GenericResourcesClientListByRootScopeOptions contains the optional parameters for the GenericResourcesClient.ListByRootScope
 // method.
 type GenericResourcesClientListByRootScopeOptions struct {
-	// placeholder for future optional parameters
+	// The resource ID of the application to list the resources of.
+	Application *string
+	// The resource ID of the environment to list the resources of.
+	Environment *string
 }
 
 // GenericResourcesClientListSecretsOptions contains the optional parameters for the GenericResourcesClient.ListSecrets method.
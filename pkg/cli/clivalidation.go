@@ -27,6 +27,7 @@ import (
 	"github.com/radius-project/radius/pkg/cli/clierrors"
 	"github.com/radius-project/radius/pkg/cli/cmd/commonflags"
 	"github.com/radius-project/radius/pkg/cli/config"
+	"github.com/radius-project/radius/pkg/cli/output"
 	"github.com/radius-project/radius/pkg/cli/workspaces"
 	"github.com/radius-project/radius/pkg/ucp/resources"
 	resources_radius "github.com/radius-project/radius/pkg/ucp/resources/radius"
@@ -345,8 +346,20 @@ func RequireAzureSubscriptionId(cmd *cobra.Command) (string, error) {
 	return subscriptionId, err
 }
 
+// RequireOutput reads the output format from the command flags and returns an error if the format is not supported.
 func RequireOutput(cmd *cobra.Command) (string, error) {
-	return cmd.Flags().GetString("output")
+	format, err := cmd.Flags().GetString("output")
+	if err != nil {
+		return "", err
+	}
+
+	for _, supported := range output.SupportedFormats() {
+		if strings.EqualFold(strings.TrimSpace(format), supported) {
+			return format, nil
+		}
+	}
+
+	return "", clierrors.Message("Unsupported output format %q. Supported formats are %s.", format, strings.Join(output.SupportedFormats(), ", "))
 }
 
 // RequireWorkspace is used by commands that require an existing workspace either set as the default,
@@ -23,6 +23,8 @@ import (
 	"testing"
 
 	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/cli/cmd/commonflags"
+	"github.com/spf13/cobra"
 	"github.com/stretchr/testify/require"
 )
 
@@ -80,3 +82,33 @@ func Test_RequireResourceType(t *testing.T) {
 		})
 	}
 }
+
+func Test_RequireOutput(t *testing.T) {
+	tests := []struct {
+		name    string
+		format  string
+		wantErr bool
+	}{
+		{name: "json", format: "json"},
+		{name: "table", format: "table"},
+		{name: "yaml", format: "yaml"},
+		{name: "case insensitive", format: "YAML"},
+		{name: "unsupported", format: "xml", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmd := &cobra.Command{}
+			commonflags.AddOutputFlag(cmd)
+			require.NoError(t, cmd.Flags().Set("output", tt.format))
+
+			got, err := RequireOutput(cmd)
+			if tt.wantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tt.format, got)
+			}
+		})
+	}
+}
@@ -25,6 +25,7 @@ import (
 	"github.com/radius-project/radius/pkg/cli/clients"
 	"github.com/radius-project/radius/pkg/cli/clierrors"
 	"github.com/radius-project/radius/pkg/cli/cmd/commonflags"
+	"github.com/radius-project/radius/pkg/cli/completion"
 	"github.com/radius-project/radius/pkg/cli/connections"
 	"github.com/radius-project/radius/pkg/cli/framework"
 	"github.com/radius-project/radius/pkg/cli/output"
@@ -64,8 +65,9 @@ rad app delete my-app
 # Delete specified application in a specified resource group
 rad app delete my-app --group my-group
 `,
-		Args: cobra.MaximumNArgs(1),
-		RunE: framework.RunCommand(runner),
+		Args:              cobra.MaximumNArgs(1),
+		ValidArgsFunction: completion.FirstArg(completion.ApplicationNames(factory)),
+		RunE:              framework.RunCommand(runner),
 	}
 
 	commonflags.AddWorkspaceFlag(cmd)
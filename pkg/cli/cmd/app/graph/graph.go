@@ -12,6 +12,7 @@ import (
 	"github.com/radius-project/radius/pkg/cli/clients"
 	"github.com/radius-project/radius/pkg/cli/clierrors"
 	"github.com/radius-project/radius/pkg/cli/cmd/commonflags"
+	"github.com/radius-project/radius/pkg/cli/completion"
 	"github.com/radius-project/radius/pkg/cli/connections"
 	"github.com/radius-project/radius/pkg/cli/framework"
 	"github.com/radius-project/radius/pkg/cli/output"
@@ -24,10 +25,11 @@ import (
 func NewCommand(factory framework.Factory) (*cobra.Command, framework.Runner) {
 	runner := NewRunner(factory)
 	cmd := &cobra.Command{
-		Use:   "graph",
-		Short: "Shows the application graph for an application.",
-		Long:  `Shows the application graph for an application.`,
-		Args:  cobra.MaximumNArgs(1),
+		Use:               "graph",
+		Short:             "Shows the application graph for an application.",
+		Long:              `Shows the application graph for an application.`,
+		Args:              cobra.MaximumNArgs(1),
+		ValidArgsFunction: completion.FirstArg(completion.ApplicationNames(factory)),
 		Example: `
 # Show graph for current application
 rad app graph
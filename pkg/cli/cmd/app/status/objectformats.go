@@ -34,6 +34,34 @@ func statusFormat() output.FormatterOptions {
 	}
 }
 
+// resourceFormat returns the columns and headings for a table to display the status of the resources of an application.
+func resourceFormat() output.FormatterOptions {
+	return output.FormatterOptions{
+		Columns: []output.Column{
+			{
+				Heading:  "RESOURCE",
+				JSONPath: "{ .Name }",
+			},
+			{
+				Heading:  "TYPE",
+				JSONPath: "{ .Type }",
+			},
+			{
+				Heading:  "STATE",
+				JSONPath: "{ .ProvisioningState }",
+			},
+			{
+				Heading:  "HEALTH",
+				JSONPath: "{ .HealthState }",
+			},
+			{
+				Heading:  "COMPUTED VALUES",
+				JSONPath: "{ .ComputedValues }",
+			},
+		},
+	}
+}
+
 // gatewayFormat returns a FormatterOptions object which contains a list of columns to be used for
 // formatting the output of a list of application gateways.
 func gatewayFormat() output.FormatterOptions {
@@ -18,28 +18,37 @@ package status
 
 import (
 	"context"
+	"sort"
+	"strings"
 
 	"github.com/radius-project/radius/pkg/cli"
 	"github.com/radius-project/radius/pkg/cli/clients"
 	"github.com/radius-project/radius/pkg/cli/clierrors"
 	"github.com/radius-project/radius/pkg/cli/cmd/commonflags"
+	"github.com/radius-project/radius/pkg/cli/completion"
 	"github.com/radius-project/radius/pkg/cli/connections"
 	"github.com/radius-project/radius/pkg/cli/framework"
 	"github.com/radius-project/radius/pkg/cli/output"
 	"github.com/radius-project/radius/pkg/cli/workspaces"
-	"github.com/radius-project/radius/pkg/ucp/resources"
+	corerp "github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/to"
 	"github.com/spf13/cobra"
 )
 
+const (
+	gatewayResourceType = "Applications.Core/gateways"
+)
+
 // NewCommand creates an instance of the `rad app status` command and runner.
 func NewCommand(factory framework.Factory) (*cobra.Command, framework.Runner) {
 	runner := NewRunner(factory)
 
 	cmd := &cobra.Command{
-		Use:   "status",
-		Short: "Show Radius Application status",
-		Long:  `Show Radius Application status, such as public endpoints and resource count. Shows details for the user's default application (if configured) by default.`,
-		Args:  cobra.MaximumNArgs(1),
+		Use:               "status",
+		Short:             "Show Radius Application status",
+		Long:              `Show Radius Application status, such as public endpoints, resource count and the provisioning state, health and computed values of each resource. Shows details for the user's default application (if configured) by default.`,
+		Args:              cobra.MaximumNArgs(1),
+		ValidArgsFunction: completion.FirstArg(completion.ApplicationNames(factory)),
 		Example: `
 # Show status of current application
 rad app status
@@ -118,7 +127,8 @@ func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
 // Run runs the `rad app status` command.
 //
 
-// Run() retrieves the application status and its associated gateways from the given workspace and returns it in the specified format.
+// Run() retrieves the status of the application and its resources with a single request, and returns it in the specified
+// format together with the gateways of the application.
 // It returns an error if the application is not found or if there is an error while retrieving the application status.
 func (r *Runner) Run(ctx context.Context) error {
 	client, err := r.ConnectionFactory.CreateApplicationsManagementClient(ctx, *r.Workspace)
@@ -133,38 +143,36 @@ func (r *Runner) Run(ctx context.Context) error {
 		return err
 	}
 
-	resourceList, err := client.ListResourcesInApplication(ctx, r.ApplicationName)
+	status, err := client.GetApplicationStatus(ctx, r.ApplicationName)
 	if err != nil {
 		return err
 	}
 
 	applicationStatus := clients.ApplicationStatus{
 		Name:          *application.Name,
-		ResourceCount: len(resourceList),
-	}
-
-	diagnosticsClient, err := r.ConnectionFactory.CreateDiagnosticsClient(ctx, *r.Workspace)
-	if err != nil {
-		return err
+		ResourceCount: len(status.Resources),
 	}
 
-	for _, resource := range resourceList {
-		resourceID, err := resources.ParseResource(*resource.ID)
-		if err != nil {
-			return err
+	for _, resource := range status.Resources {
+		healthState := corerp.HealthStateUnknown
+		if resource.HealthState != nil {
+			healthState = *resource.HealthState
 		}
 
-		publicEndpoint, err := diagnosticsClient.GetPublicEndpoint(ctx, clients.EndpointOptions{
-			ResourceID: resourceID,
+		computedValues := to.StringMap(resource.ComputedValues)
+		applicationStatus.Resources = append(applicationStatus.Resources, clients.ApplicationResourceStatus{
+			Name:              to.String(resource.Name),
+			Type:              to.String(resource.Type),
+			ProvisioningState: to.String(resource.ProvisioningState),
+			HealthState:       string(healthState),
+			ComputedValues:    formatComputedValues(computedValues),
 		})
-		if err != nil {
-			return err
-		}
 
-		if publicEndpoint != nil {
+		url := computedValues["url"]
+		if strings.EqualFold(to.String(resource.Type), gatewayResourceType) && url != "" {
 			applicationStatus.Gateways = append(applicationStatus.Gateways, clients.GatewayStatus{
-				Name:     *resource.Name,
-				Endpoint: *publicEndpoint,
+				Name:     to.String(resource.Name),
+				Endpoint: url,
 			})
 		}
 	}
@@ -174,6 +182,16 @@ func (r *Runner) Run(ctx context.Context) error {
 		return err
 	}
 
+	if r.Format == output.FormatTable && len(applicationStatus.Resources) > 0 {
+		// Print newline for readability
+		r.Output.LogInfo("")
+
+		err = r.Output.WriteFormatted(r.Format, applicationStatus.Resources, resourceFormat())
+		if err != nil {
+			return err
+		}
+	}
+
 	if r.Format == output.FormatTable && len(applicationStatus.Gateways) > 0 {
 		// Print newline for readability
 		r.Output.LogInfo("")
@@ -186,3 +204,14 @@ func (r *Runner) Run(ctx context.Context) error {
 
 	return nil
 }
+
+// formatComputedValues formats the computed values of a resource as a sorted, comma-separated list of "name=value".
+func formatComputedValues(values map[string]string) string {
+	formatted := []string{}
+	for name, value := range values {
+		formatted = append(formatted, name+"="+value)
+	}
+
+	sort.Strings(formatted)
+	return strings.Join(formatted, ", ")
+}
@@ -21,7 +21,6 @@ import (
 	"testing"
 
 	"github.com/radius-project/radius/pkg/cli/clients"
-	"github.com/radius-project/radius/pkg/cli/clients_new/generated"
 	"github.com/radius-project/radius/pkg/cli/clierrors"
 	"github.com/radius-project/radius/pkg/cli/config"
 	"github.com/radius-project/radius/pkg/cli/connections"
@@ -30,7 +29,6 @@ import (
 	"github.com/radius-project/radius/pkg/cli/workspaces"
 	"github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
 	"github.com/radius-project/radius/pkg/to"
-	"github.com/radius-project/radius/pkg/ucp/resources"
 	"github.com/radius-project/radius/test/radcli"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
@@ -111,31 +109,33 @@ func Test_Run(t *testing.T) {
 			Return(application, nil).
 			Times(1)
 
-		resourceList := []generated.GenericResource{
-			{
-				Name: to.Ptr("test-container"),
-				ID:   to.Ptr("/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/containers/test-container"),
-			},
-			{
-				Name: to.Ptr("test-gateway"),
-				ID:   to.Ptr("/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/gateways/test-gateway"),
+		status := v20231001preview.ApplicationStatusResponse{
+			Resources: []*v20231001preview.ApplicationStatusResource{
+				{
+					Name:              to.Ptr("test-container"),
+					ID:                to.Ptr("/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/containers/test-container"),
+					Type:              to.Ptr("Applications.Core/containers"),
+					ProvisioningState: to.Ptr("Succeeded"),
+					HealthState:       to.Ptr(v20231001preview.HealthStateUnhealthy),
+					ComputedValues:    map[string]*string{},
+				},
+				{
+					Name:              to.Ptr("test-gateway"),
+					ID:                to.Ptr("/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/gateways/test-gateway"),
+					Type:              to.Ptr("Applications.Core/gateways"),
+					ProvisioningState: to.Ptr("Succeeded"),
+					HealthState:       to.Ptr(v20231001preview.HealthStateHealthy),
+					ComputedValues: map[string]*string{
+						"url":  to.Ptr("http://some-url.example.com"),
+						"host": to.Ptr("some-url.example.com"),
+					},
+				},
 			},
 		}
 
 		appManagementClient.EXPECT().
-			ListResourcesInApplication(gomock.Any(), "test-app").
-			Return(resourceList, nil).
-			Times(1)
-
-		diagnosticsClient := clients.NewMockDiagnosticsClient(ctrl)
-		diagnosticsClient.EXPECT().
-			GetPublicEndpoint(gomock.Any(), clients.EndpointOptions{ResourceID: mustParse(t, "/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/containers/test-container")}).
-			Return(nil, nil).
-			Times(1)
-
-		diagnosticsClient.EXPECT().
-			GetPublicEndpoint(gomock.Any(), clients.EndpointOptions{ResourceID: mustParse(t, "/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/gateways/test-gateway")}).
-			Return(to.Ptr("http://some-url.example.com"), nil).
+			GetApplicationStatus(gomock.Any(), "test-app").
+			Return(status, nil).
 			Times(1)
 
 		workspace := &workspaces.Workspace{
@@ -150,7 +150,6 @@ func Test_Run(t *testing.T) {
 		runner := &Runner{
 			ConnectionFactory: &connections.MockFactory{
 				ApplicationsManagementClient: appManagementClient,
-				DiagnosticsClient:            diagnosticsClient,
 			},
 			Workspace:       workspace,
 			Format:          "table",
@@ -164,6 +163,22 @@ func Test_Run(t *testing.T) {
 		applicationStatus := clients.ApplicationStatus{
 			Name:          "test-app",
 			ResourceCount: 2,
+			Resources: []clients.ApplicationResourceStatus{
+				{
+					Name:              "test-container",
+					Type:              "Applications.Core/containers",
+					ProvisioningState: "Succeeded",
+					HealthState:       "Unhealthy",
+					ComputedValues:    "",
+				},
+				{
+					Name:              "test-gateway",
+					Type:              "Applications.Core/gateways",
+					ProvisioningState: "Succeeded",
+					HealthState:       "Healthy",
+					ComputedValues:    "host=some-url.example.com, url=http://some-url.example.com",
+				},
+			},
 			Gateways: []clients.GatewayStatus{
 				{
 					Name:     "test-gateway",
@@ -181,6 +196,14 @@ func Test_Run(t *testing.T) {
 			output.LogOutput{
 				Format: "",
 			},
+			output.FormattedOutput{
+				Format:  "table",
+				Obj:     applicationStatus.Resources,
+				Options: resourceFormat(),
+			},
+			output.LogOutput{
+				Format: "",
+			},
 			output.FormattedOutput{
 				Format:  "table",
 				Obj:     applicationStatus.Gateways,
@@ -225,10 +248,3 @@ func Test_Run(t *testing.T) {
 		require.Empty(t, outputSink.Writes)
 	})
 }
-
-func mustParse(t *testing.T, s string) resources.ID {
-	t.Helper()
-	id, err := resources.Parse(s)
-	require.NoError(t, err)
-	return id
-}
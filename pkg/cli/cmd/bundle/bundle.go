@@ -0,0 +1,53 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bundle
+
+import (
+	bundle_import "github.com/radius-project/radius/pkg/cli/cmd/bundle/bundleimport"
+	bundle_export "github.com/radius-project/radius/pkg/cli/cmd/bundle/export"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/spf13/cobra"
+)
+
+// NewCommand creates a new cobra command for moving Radius configuration into disconnected environments, with
+// subcommands for exporting and importing bundles.
+func NewCommand(factory framework.Factory) *cobra.Command {
+	// This command is not runnable, and thus has no runner.
+	cmd := &cobra.Command{
+		Use:   "bundle",
+		Short: "Export and import bundles for disconnected environments",
+		Long: `Export and import bundles for disconnected environments
+
+A bundle packages environment definitions, recipe templates and resource provider manifests into a single file. Export a bundle where the registries of the recipe templates are reachable, then copy it into the disconnected environment and import it.
+`,
+		Example: `
+# Export all environments of the current workspace
+rad bundle export bundle.tar.gz
+
+# Import a bundle, pushing its recipe templates to a local registry
+rad bundle import bundle.tar.gz --registry myregistry.local:5000
+`,
+	}
+
+	export, _ := bundle_export.NewCommand(factory)
+	cmd.AddCommand(export)
+
+	imp, _ := bundle_import.NewCommand(factory)
+	cmd.AddCommand(imp)
+
+	return cmd
+}
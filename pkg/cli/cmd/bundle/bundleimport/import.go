@@ -0,0 +1,238 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bundleimport
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+	"oras.land/oras-go/v2"
+	"oras.land/oras-go/v2/content/oci"
+
+	"github.com/radius-project/radius/pkg/cli"
+	"github.com/radius-project/radius/pkg/cli/bundle"
+	"github.com/radius-project/radius/pkg/cli/clierrors"
+	"github.com/radius-project/radius/pkg/cli/cmd/commonflags"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+)
+
+const (
+	registryFlag  = "registry"
+	manifestsFlag = "manifests"
+	plainHTTPFlag = "plain-http"
+)
+
+// NewCommand creates an instance of the command and runner for the `rad bundle import` command.
+func NewCommand(factory framework.Factory) (*cobra.Command, framework.Runner) {
+	runner := NewRunner(factory)
+
+	cmd := &cobra.Command{
+		Use:   "import file",
+		Short: "Import a bundle created with 'rad bundle export'",
+		Long: `Import a bundle created with 'rad bundle export'.
+
+Importing a bundle:
+
+- Pushes the recipe templates of the bundle to the registry given with '--registry', keeping their repository and tag. The recipes of the imported environments are updated to use the pushed templates.
+- Creates or updates the environments of the bundle in the current workspace.
+- Copies the resource provider manifests of the bundle to the directory given with '--manifests'. The manifests are loaded by UCP from its manifest directory when it starts.
+`,
+		Example: `
+# Import a bundle, pushing its recipe templates to a local registry
+rad bundle import bundle.tar.gz --registry myregistry.local:5000/radius --plain-http
+
+# Import a bundle and copy its resource provider manifests to the manifest directory of UCP
+rad bundle import bundle.tar.gz --registry myregistry.azurecr.io --manifests /etc/ucp/manifests`,
+		Args: cobra.ExactArgs(1),
+		RunE: framework.RunCommand(runner),
+	}
+
+	commonflags.AddWorkspaceFlag(cmd)
+	commonflags.AddResourceGroupFlag(cmd)
+	cmd.Flags().String(registryFlag, "", "The registry to push the recipe templates to, optionally followed by a repository prefix. Required if the bundle contains recipe templates.")
+	cmd.Flags().String(manifestsFlag, "", "The directory to copy the resource provider manifests to.")
+	cmd.Flags().Bool(plainHTTPFlag, false, "Connect to the registry using HTTP (not-HTTPS).")
+
+	return cmd, runner
+}
+
+// Runner is the runner implementation for the `rad bundle import` command.
+type Runner struct {
+	ConfigHolder      *framework.ConfigHolder
+	ConnectionFactory connections.Factory
+	Output            output.Interface
+	Workspace         *workspaces.Workspace
+
+	FilePath          string
+	Registry          string
+	ManifestDirectory string
+	PlainHTTP         bool
+}
+
+// NewRunner creates a new instance of the `rad bundle import` runner.
+func NewRunner(factory framework.Factory) *Runner {
+	return &Runner{
+		ConnectionFactory: factory.GetConnectionFactory(),
+		ConfigHolder:      factory.GetConfigHolder(),
+		Output:            factory.GetOutput(),
+	}
+}
+
+// Validate runs validation for the `rad bundle import` command.
+func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
+	workspace, err := cli.RequireWorkspace(cmd, r.ConfigHolder.Config, r.ConfigHolder.DirectoryConfig)
+	if err != nil {
+		return err
+	}
+	r.Workspace = workspace
+
+	scope, err := cli.RequireScope(cmd, *r.Workspace)
+	if err != nil {
+		return err
+	}
+	r.Workspace.Scope = scope
+
+	info, err := os.Stat(args[0])
+	if err != nil || info.IsDir() {
+		return clierrors.Message("The bundle %q does not exist or is not a file.", args[0])
+	}
+
+	registry, err := cmd.Flags().GetString(registryFlag)
+	if err != nil {
+		return err
+	}
+
+	manifestDirectory, err := cmd.Flags().GetString(manifestsFlag)
+	if err != nil {
+		return err
+	}
+
+	plainHTTP, err := cmd.Flags().GetBool(plainHTTPFlag)
+	if err != nil {
+		return err
+	}
+
+	r.FilePath = args[0]
+	r.Registry = registry
+	r.ManifestDirectory = manifestDirectory
+	r.PlainHTTP = plainHTTP
+
+	return nil
+}
+
+// Run runs the `rad bundle import` command.
+func (r *Runner) Run(ctx context.Context) error {
+	dir, err := os.MkdirTemp("", "rad-bundle-")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(dir)
+
+	index, err := r.extract(dir)
+	if err != nil {
+		return clierrors.MessageWithCause(err, "The bundle %q is invalid.", r.FilePath)
+	}
+
+	if len(index.Recipes) > 0 && r.Registry == "" {
+		return clierrors.Message("The bundle contains recipe templates. Specify the registry to push them to with '--%s'.", registryFlag)
+	}
+
+	if len(index.Manifests) > 0 {
+		if r.ManifestDirectory == "" {
+			r.Output.LogInfo("Skipping %d resource provider manifests. Specify the manifest directory of UCP with '--%s' to install them.", len(index.Manifests), manifestsFlag)
+		} else {
+			r.Output.LogInfo("Copying resource provider manifests to %q...", r.ManifestDirectory)
+			_, err = bundle.CopyManifests(filepath.Join(dir, bundle.ManifestsDirectory), r.ManifestDirectory)
+			if err != nil {
+				return clierrors.MessageWithCause(err, "Failed to copy the resource provider manifests to %q.", r.ManifestDirectory)
+			}
+		}
+	}
+
+	mapping := map[string]string{}
+	if len(index.Recipes) > 0 {
+		store, err := oci.New(filepath.Join(dir, bundle.RecipesDirectory))
+		if err != nil {
+			return err
+		}
+
+		for _, templatePath := range index.Recipes {
+			mirrored, err := bundle.MirrorTemplatePath(templatePath, r.Registry)
+			if err != nil {
+				return clierrors.MessageWithCause(err, "The registry %q is invalid.", r.Registry)
+			}
+
+			r.Output.LogInfo("Pushing recipe template %q to %q...", templatePath, mirrored)
+
+			repo, ref, err := bundle.NewRepository(mirrored, r.PlainHTTP)
+			if err != nil {
+				return err
+			}
+
+			_, err = oras.Copy(ctx, store, templatePath, repo, ref.Reference, oras.DefaultCopyOptions)
+			if err != nil {
+				return clierrors.MessageWithCause(err, "Failed to push the recipe template %q.", mirrored)
+			}
+
+			mapping[templatePath] = mirrored
+		}
+	}
+
+	if len(index.Environments) > 0 {
+		client, err := r.ConnectionFactory.CreateApplicationsManagementClient(ctx, *r.Workspace)
+		if err != nil {
+			return err
+		}
+
+		for _, name := range index.Environments {
+			env, err := bundle.ReadEnvironment(dir, name)
+			if err != nil {
+				return clierrors.MessageWithCause(err, "The bundle %q is invalid.", r.FilePath)
+			}
+
+			bundle.RewriteTemplatePaths(env, mapping, r.PlainHTTP)
+
+			r.Output.LogInfo("Importing environment %q...", name)
+			err = client.CreateOrUpdateEnvironment(ctx, name, env)
+			if err != nil {
+				return clierrors.MessageWithCause(err, "Failed to import the environment %q.", name)
+			}
+		}
+	}
+
+	r.Output.LogInfo("Bundle %q imported", r.FilePath)
+	return nil
+}
+
+func (r *Runner) extract(dir string) (*bundle.Index, error) {
+	f, err := os.Open(r.FilePath)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	if err := bundle.Extract(f, dir); err != nil {
+		return nil, err
+	}
+
+	return bundle.ReadIndex(dir)
+}
@@ -0,0 +1,205 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bundleimport
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/radius-project/radius/pkg/cli/bundle"
+	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/cli/clierrors"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	"github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/test/radcli"
+)
+
+// writeBundle writes a bundle with the index and environments, and a manifest for each manifest of the index.
+func writeBundle(t *testing.T, index *bundle.Index, environments ...v20231001preview.EnvironmentResource) string {
+	dir := t.TempDir()
+	for _, env := range environments {
+		require.NoError(t, bundle.WriteEnvironment(dir, env))
+	}
+
+	if len(index.Manifests) > 0 {
+		require.NoError(t, os.MkdirAll(filepath.Join(dir, bundle.ManifestsDirectory), 0755))
+		for _, manifest := range index.Manifests {
+			require.NoError(t, os.WriteFile(filepath.Join(dir, bundle.ManifestsDirectory, manifest), []byte("name: Test.Resources"), 0644))
+		}
+	}
+
+	require.NoError(t, bundle.WriteIndex(dir, index))
+
+	filePath := filepath.Join(t.TempDir(), "bundle.tar.gz")
+	f, err := os.Create(filePath)
+	require.NoError(t, err)
+	defer f.Close()
+
+	require.NoError(t, bundle.Archive(dir, f))
+	return filePath
+}
+
+func Test_CommandValidation(t *testing.T) {
+	radcli.SharedCommandValidation(t, NewCommand)
+}
+
+func Test_Validate(t *testing.T) {
+	configWithWorkspace := radcli.LoadConfigWithWorkspace(t)
+	filePath := writeBundle(t, &bundle.Index{Version: bundle.Version})
+
+	testcases := []radcli.ValidateInput{
+		{
+			Name:          "Import bundle",
+			Input:         []string{filePath, "--registry", "myregistry.local:5000", "--manifests", "/etc/ucp/manifests", "--plain-http"},
+			ExpectedValid: true,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+			ValidateCallback: func(t *testing.T, runner framework.Runner) {
+				r := runner.(*Runner)
+				require.Equal(t, filePath, r.FilePath)
+				require.Equal(t, "myregistry.local:5000", r.Registry)
+				require.Equal(t, "/etc/ucp/manifests", r.ManifestDirectory)
+				require.True(t, r.PlainHTTP)
+			},
+		},
+		{
+			Name:          "Import non-existent bundle",
+			Input:         []string{filepath.Join(filepath.Dir(filePath), "missing.tar.gz")},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+		{
+			Name:          "Import without file",
+			Input:         []string{},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+	}
+	radcli.SharedValidateValidation(t, NewCommand, testcases)
+}
+
+func Test_Run(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+
+		environment := v20231001preview.EnvironmentResource{
+			Name:     to.Ptr("prod"),
+			Location: to.Ptr("global"),
+			Properties: &v20231001preview.EnvironmentProperties{
+				Compute: &v20231001preview.KubernetesCompute{
+					Kind:      to.Ptr("kubernetes"),
+					Namespace: to.Ptr("prod"),
+				},
+			},
+		}
+		filePath := writeBundle(t, &bundle.Index{Version: bundle.Version, Manifests: []string{"test.yaml"}, Environments: []string{"prod"}}, environment)
+
+		appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
+		appManagementClient.EXPECT().
+			CreateOrUpdateEnvironment(gomock.Any(), "prod", &environment).
+			Return(nil).
+			Times(1)
+
+		manifestDirectory := t.TempDir()
+		outputSink := &output.MockOutput{}
+		runner := &Runner{
+			ConnectionFactory: &connections.MockFactory{ApplicationsManagementClient: appManagementClient},
+			Workspace:         &workspaces.Workspace{},
+			Output:            outputSink,
+			FilePath:          filePath,
+			ManifestDirectory: manifestDirectory,
+		}
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+
+		expected := []any{
+			output.LogOutput{
+				Format: "Copying resource provider manifests to %q...",
+				Params: []any{manifestDirectory},
+			},
+			output.LogOutput{
+				Format: "Importing environment %q...",
+				Params: []any{"prod"},
+			},
+			output.LogOutput{
+				Format: "Bundle %q imported",
+				Params: []any{filePath},
+			},
+		}
+		require.Equal(t, expected, outputSink.Writes)
+		require.FileExists(t, filepath.Join(manifestDirectory, "test.yaml"))
+	})
+
+	t.Run("Skip manifests", func(t *testing.T) {
+		filePath := writeBundle(t, &bundle.Index{Version: bundle.Version, Manifests: []string{"test.yaml"}})
+
+		outputSink := &output.MockOutput{}
+		runner := &Runner{
+			Workspace: &workspaces.Workspace{},
+			Output:    outputSink,
+			FilePath:  filePath,
+		}
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+
+		expected := []any{
+			output.LogOutput{
+				Format: "Skipping %d resource provider manifests. Specify the manifest directory of UCP with '--%s' to install them.",
+				Params: []any{1, "manifests"},
+			},
+			output.LogOutput{
+				Format: "Bundle %q imported",
+				Params: []any{filePath},
+			},
+		}
+		require.Equal(t, expected, outputSink.Writes)
+	})
+
+	t.Run("Error: Recipes without registry", func(t *testing.T) {
+		filePath := writeBundle(t, &bundle.Index{Version: bundle.Version, Recipes: []string{"ghcr.io/radius-project/recipes/local-dev/rediscaches:latest"}})
+
+		outputSink := &output.MockOutput{}
+		runner := &Runner{
+			Workspace: &workspaces.Workspace{},
+			Output:    outputSink,
+			FilePath:  filePath,
+		}
+
+		err := runner.Run(context.Background())
+		require.Equal(t, clierrors.Message("The bundle contains recipe templates. Specify the registry to push them to with '--%s'.", "registry"), err)
+		require.Empty(t, outputSink.Writes)
+	})
+}
@@ -0,0 +1,257 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package export
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"slices"
+
+	"github.com/spf13/cobra"
+	"oras.land/oras-go/v2"
+	"oras.land/oras-go/v2/content/oci"
+
+	"github.com/radius-project/radius/pkg/cli"
+	"github.com/radius-project/radius/pkg/cli/bundle"
+	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/cli/clierrors"
+	"github.com/radius-project/radius/pkg/cli/cmd/commonflags"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	corerp "github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/to"
+)
+
+const (
+	environmentFlag = "environment"
+	manifestsFlag   = "manifests"
+	plainHTTPFlag   = "plain-http"
+)
+
+// NewCommand creates an instance of the command and runner for the `rad bundle export` command.
+func NewCommand(factory framework.Factory) (*cobra.Command, framework.Runner) {
+	runner := NewRunner(factory)
+
+	cmd := &cobra.Command{
+		Use:   "export file",
+		Short: "Export environments, recipe templates and resource provider manifests to a bundle",
+		Long: `Export environments, recipe templates and resource provider manifests to a bundle.
+
+The bundle is a gzipped tarball which can be copied into a disconnected environment and installed with 'rad bundle import'. It contains:
+
+- The definitions of the environments, including their recipes.
+- The templates of the bicep recipes of the environments, pulled from their registries.
+- The resource provider manifests of the directory given with '--manifests'.
+
+Terraform recipes are not bundled because their modules are not stored in an OCI registry. They are skipped with a warning.
+`,
+		Example: `
+# Export all environments of the current workspace
+rad bundle export bundle.tar.gz
+
+# Export an environment and the resource provider manifests of a directory
+rad bundle export bundle.tar.gz --environment prod --manifests ./manifests
+
+# Export environments whose recipe templates are stored in a registry served over HTTP
+rad bundle export bundle.tar.gz --environment dev --environment test --plain-http`,
+		Args: cobra.ExactArgs(1),
+		RunE: framework.RunCommand(runner),
+	}
+
+	commonflags.AddWorkspaceFlag(cmd)
+	commonflags.AddResourceGroupFlag(cmd)
+	cmd.Flags().StringArrayP(environmentFlag, "e", []string{}, "The name of an environment to export. May be specified multiple times. Defaults to all environments.")
+	cmd.Flags().String(manifestsFlag, "", "The directory of the resource provider manifests to export.")
+	cmd.Flags().Bool(plainHTTPFlag, false, "Connect to the registries of the recipe templates using HTTP (not-HTTPS).")
+
+	return cmd, runner
+}
+
+// Runner is the runner implementation for the `rad bundle export` command.
+type Runner struct {
+	ConfigHolder      *framework.ConfigHolder
+	ConnectionFactory connections.Factory
+	Output            output.Interface
+	Workspace         *workspaces.Workspace
+
+	FilePath          string
+	Environments      []string
+	ManifestDirectory string
+	PlainHTTP         bool
+}
+
+// NewRunner creates a new instance of the `rad bundle export` runner.
+func NewRunner(factory framework.Factory) *Runner {
+	return &Runner{
+		ConnectionFactory: factory.GetConnectionFactory(),
+		ConfigHolder:      factory.GetConfigHolder(),
+		Output:            factory.GetOutput(),
+	}
+}
+
+// Validate runs validation for the `rad bundle export` command.
+func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
+	workspace, err := cli.RequireWorkspace(cmd, r.ConfigHolder.Config, r.ConfigHolder.DirectoryConfig)
+	if err != nil {
+		return err
+	}
+	r.Workspace = workspace
+
+	scope, err := cli.RequireScope(cmd, *r.Workspace)
+	if err != nil {
+		return err
+	}
+	r.Workspace.Scope = scope
+
+	environments, err := cmd.Flags().GetStringArray(environmentFlag)
+	if err != nil {
+		return err
+	}
+
+	manifestDirectory, err := cmd.Flags().GetString(manifestsFlag)
+	if err != nil {
+		return err
+	}
+
+	if manifestDirectory != "" {
+		info, err := os.Stat(manifestDirectory)
+		if err != nil || !info.IsDir() {
+			return clierrors.Message("The manifest directory %q does not exist or is not a directory.", manifestDirectory)
+		}
+	}
+
+	plainHTTP, err := cmd.Flags().GetBool(plainHTTPFlag)
+	if err != nil {
+		return err
+	}
+
+	r.FilePath = args[0]
+	r.Environments = environments
+	r.ManifestDirectory = manifestDirectory
+	r.PlainHTTP = plainHTTP
+
+	return nil
+}
+
+// Run runs the `rad bundle export` command.
+func (r *Runner) Run(ctx context.Context) error {
+	client, err := r.ConnectionFactory.CreateApplicationsManagementClient(ctx, *r.Workspace)
+	if err != nil {
+		return err
+	}
+
+	environments, err := r.getEnvironments(ctx, client)
+	if err != nil {
+		return err
+	}
+
+	dir, err := os.MkdirTemp("", "rad-bundle-")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(dir)
+
+	index := &bundle.Index{Version: bundle.Version}
+
+	if r.ManifestDirectory != "" {
+		index.Manifests, err = bundle.CopyManifests(r.ManifestDirectory, filepath.Join(dir, bundle.ManifestsDirectory))
+		if err != nil {
+			return clierrors.MessageWithCause(err, "Failed to read the resource provider manifests of %q.", r.ManifestDirectory)
+		}
+	}
+
+	for _, env := range environments {
+		name := to.String(env.Name)
+		r.Output.LogInfo("Exporting environment %q...", name)
+
+		for _, recipe := range bundle.UnsupportedRecipes(&env) {
+			r.Output.LogInfo("Skipping recipe %q of environment %q. Only bicep recipes can be bundled.", recipe, name)
+		}
+
+		for _, templatePath := range bundle.TemplatePaths(&env) {
+			if !slices.Contains(index.Recipes, templatePath) {
+				index.Recipes = append(index.Recipes, templatePath)
+			}
+		}
+
+		if err := bundle.WriteEnvironment(dir, env); err != nil {
+			return err
+		}
+		index.Environments = append(index.Environments, name)
+	}
+
+	if len(index.Recipes) > 0 {
+		store, err := oci.New(filepath.Join(dir, bundle.RecipesDirectory))
+		if err != nil {
+			return err
+		}
+
+		for _, templatePath := range index.Recipes {
+			r.Output.LogInfo("Exporting recipe template %q...", templatePath)
+
+			repo, ref, err := bundle.NewRepository(templatePath, r.PlainHTTP)
+			if err != nil {
+				return clierrors.MessageWithCause(err, "The recipe template path %q is invalid.", templatePath)
+			}
+
+			_, err = oras.Copy(ctx, repo, ref.Reference, store, templatePath, oras.DefaultCopyOptions)
+			if err != nil {
+				return clierrors.MessageWithCause(err, "Failed to pull the recipe template %q.", templatePath)
+			}
+		}
+	}
+
+	if err := bundle.WriteIndex(dir, index); err != nil {
+		return err
+	}
+
+	f, err := os.Create(r.FilePath)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	if err := bundle.Archive(dir, f); err != nil {
+		return clierrors.MessageWithCause(err, "Failed to write the bundle %q.", r.FilePath)
+	}
+
+	r.Output.LogInfo("Bundle written to %q", r.FilePath)
+	return nil
+}
+
+func (r *Runner) getEnvironments(ctx context.Context, client clients.ApplicationsManagementClient) ([]corerp.EnvironmentResource, error) {
+	if len(r.Environments) == 0 {
+		return client.ListEnvironments(ctx)
+	}
+
+	environments := []corerp.EnvironmentResource{}
+	for _, name := range r.Environments {
+		env, err := client.GetEnvironment(ctx, name)
+		if clients.Is404Error(err) {
+			return nil, clierrors.Message("The environment %q was not found or has been deleted.", name)
+		} else if err != nil {
+			return nil, err
+		}
+
+		environments = append(environments, env)
+	}
+
+	return environments, nil
+}
@@ -0,0 +1,195 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package export
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/radius-project/radius/pkg/cli/bundle"
+	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/cli/clierrors"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	"github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/test/radcli"
+)
+
+func Test_CommandValidation(t *testing.T) {
+	radcli.SharedCommandValidation(t, NewCommand)
+}
+
+func Test_Validate(t *testing.T) {
+	configWithWorkspace := radcli.LoadConfigWithWorkspace(t)
+	manifestDirectory := t.TempDir()
+
+	testcases := []radcli.ValidateInput{
+		{
+			Name:          "Export all environments",
+			Input:         []string{"bundle.tar.gz"},
+			ExpectedValid: true,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+			ValidateCallback: func(t *testing.T, runner framework.Runner) {
+				r := runner.(*Runner)
+				require.Equal(t, "bundle.tar.gz", r.FilePath)
+				require.Empty(t, r.Environments)
+			},
+		},
+		{
+			Name:          "Export environments and manifests",
+			Input:         []string{"bundle.tar.gz", "-e", "dev", "--environment", "prod", "--manifests", manifestDirectory, "--plain-http"},
+			ExpectedValid: true,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+			ValidateCallback: func(t *testing.T, runner framework.Runner) {
+				r := runner.(*Runner)
+				require.Equal(t, []string{"dev", "prod"}, r.Environments)
+				require.Equal(t, manifestDirectory, r.ManifestDirectory)
+				require.True(t, r.PlainHTTP)
+			},
+		},
+		{
+			Name:          "Export with non-existent manifest directory",
+			Input:         []string{"bundle.tar.gz", "--manifests", filepath.Join(manifestDirectory, "missing")},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+		{
+			Name:          "Export without file",
+			Input:         []string{},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+	}
+	radcli.SharedValidateValidation(t, NewCommand, testcases)
+}
+
+func Test_Run(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+
+		environment := v20231001preview.EnvironmentResource{
+			ID:   to.Ptr("/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/environments/prod"),
+			Name: to.Ptr("prod"),
+			Properties: &v20231001preview.EnvironmentProperties{
+				Recipes: map[string]map[string]v20231001preview.RecipePropertiesClassification{
+					"Applications.Datastores/redisCaches": {
+						"cloud": &v20231001preview.TerraformRecipeProperties{
+							TemplateKind: to.Ptr("terraform"),
+							TemplatePath: to.Ptr("Azure/cache/azurerm"),
+						},
+					},
+				},
+			},
+		}
+
+		appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
+		appManagementClient.EXPECT().
+			ListEnvironments(gomock.Any()).
+			Return([]v20231001preview.EnvironmentResource{environment}, nil).
+			Times(1)
+
+		manifestDirectory := t.TempDir()
+		require.NoError(t, os.WriteFile(filepath.Join(manifestDirectory, "test.yaml"), []byte("name: Test.Resources"), 0644))
+
+		filePath := filepath.Join(t.TempDir(), "bundle.tar.gz")
+		outputSink := &output.MockOutput{}
+		runner := &Runner{
+			ConnectionFactory: &connections.MockFactory{ApplicationsManagementClient: appManagementClient},
+			Workspace:         &workspaces.Workspace{},
+			Output:            outputSink,
+			FilePath:          filePath,
+			ManifestDirectory: manifestDirectory,
+		}
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+
+		expected := []any{
+			output.LogOutput{
+				Format: "Exporting environment %q...",
+				Params: []any{"prod"},
+			},
+			output.LogOutput{
+				Format: "Skipping recipe %q of environment %q. Only bicep recipes can be bundled.",
+				Params: []any{"Applications.Datastores/redisCaches/cloud", "prod"},
+			},
+			output.LogOutput{
+				Format: "Bundle written to %q",
+				Params: []any{filePath},
+			},
+		}
+		require.Equal(t, expected, outputSink.Writes)
+
+		f, err := os.Open(filePath)
+		require.NoError(t, err)
+		defer f.Close()
+
+		dir := t.TempDir()
+		require.NoError(t, bundle.Extract(f, dir))
+
+		index, err := bundle.ReadIndex(dir)
+		require.NoError(t, err)
+		require.Equal(t, &bundle.Index{Version: bundle.Version, Manifests: []string{"test.yaml"}, Environments: []string{"prod"}}, index)
+
+		env, err := bundle.ReadEnvironment(dir, "prod")
+		require.NoError(t, err)
+		require.Nil(t, env.ID)
+	})
+
+	t.Run("Error: Environment Not Found", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+
+		appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
+		appManagementClient.EXPECT().
+			GetEnvironment(gomock.Any(), "prod").
+			Return(v20231001preview.EnvironmentResource{}, radcli.Create404Error()).
+			Times(1)
+
+		outputSink := &output.MockOutput{}
+		runner := &Runner{
+			ConnectionFactory: &connections.MockFactory{ApplicationsManagementClient: appManagementClient},
+			Workspace:         &workspaces.Workspace{},
+			Output:            outputSink,
+			FilePath:          filepath.Join(t.TempDir(), "bundle.tar.gz"),
+			Environments:      []string{"prod"},
+		}
+
+		err := runner.Run(context.Background())
+		require.Equal(t, clierrors.Message("The environment \"prod\" was not found or has been deleted."), err)
+		require.NoFileExists(t, runner.FilePath)
+	})
+}
@@ -0,0 +1,46 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package container
+
+import (
+	container_import "github.com/radius-project/radius/pkg/cli/cmd/container/containerimport"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/spf13/cobra"
+)
+
+// NewCommand creates a new cobra command for working with Radius containers, with a subcommand for importing existing
+// Kubernetes workloads.
+func NewCommand(factory framework.Factory) *cobra.Command {
+	// This command is not runnable, and thus has no runner.
+	cmd := &cobra.Command{
+		Use:   "container",
+		Short: "Work with Radius containers",
+		Long: `Work with Radius containers
+
+Containers are the compute resources of Radius applications. Existing Kubernetes workloads can be imported as container resources to migrate them to Radius.
+`,
+		Example: `
+# Import the deployment 'web' of the current Kubernetes context as a container resource
+rad container import deployment/web
+`,
+	}
+
+	imp, _ := container_import.NewCommand(factory)
+	cmd.AddCommand(imp)
+
+	return cmd
+}
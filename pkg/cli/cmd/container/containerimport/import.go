@@ -0,0 +1,178 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package containerimport
+
+import (
+	"context"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	runtimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/radius-project/radius/pkg/cli/clierrors"
+	"github.com/radius-project/radius/pkg/cli/cmd/commonflags"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/kubeimport"
+	"github.com/radius-project/radius/pkg/cli/kubernetes"
+	"github.com/radius-project/radius/pkg/cli/output"
+)
+
+const (
+	outputFileFlag   = "output-file"
+	defaultNamespace = "default"
+	deploymentPrefix = "deployment/"
+)
+
+// NewCommand creates an instance of the command and runner for the `rad container import` command.
+func NewCommand(factory framework.Factory) (*cobra.Command, framework.Runner) {
+	runner := NewRunner(factory)
+
+	cmd := &cobra.Command{
+		Use:   "import deployment/name",
+		Short: "Import a Kubernetes deployment as a Radius container",
+		Long: `Import a Kubernetes deployment as a Radius container.
+
+Reads the Deployment and the Service exposing it from the Kubernetes cluster, and writes a Bicep file declaring an equivalent container resource:
+
+- The image, command, arguments, working directory, environment variables and ports of the container of the Deployment are converted to properties of the container resource.
+- All other fields of the Deployment and Service are kept in 'runtimes.kubernetes.base', which Radius uses as the starting point of the resources it renders.
+
+Review the generated file before deploying it. Changes made to the workload, such as renaming the Service, are reported as warnings.
+`,
+		Example: `
+# Import the deployment 'web' of the default namespace to web.bicep
+rad container import deployment/web
+
+# Import a deployment of another namespace and Kubernetes context
+rad container import deployment/web --namespace shop --kubecontext aks
+
+# Import a deployment to a specific file
+rad container import deployment/web --output-file app.bicep`,
+		Args: cobra.ExactArgs(1),
+		RunE: framework.RunCommand(runner),
+	}
+
+	commonflags.AddKubeContextFlagVar(cmd, &runner.KubeContext)
+	commonflags.AddNamespaceFlag(cmd)
+	cmd.Flags().String(outputFileFlag, "", "The Bicep file to write. Defaults to '<name>.bicep'.")
+
+	return cmd, runner
+}
+
+// Runner is the runner implementation for the `rad container import` command.
+type Runner struct {
+	Output           output.Interface
+	KubernetesClient runtimeclient.Client
+
+	KubeContext    string
+	Namespace      string
+	DeploymentName string
+	OutputFile     string
+}
+
+// NewRunner creates a new instance of the `rad container import` runner.
+func NewRunner(factory framework.Factory) *Runner {
+	return &Runner{
+		Output: factory.GetOutput(),
+	}
+}
+
+// Validate runs validation for the `rad container import` command.
+func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
+	name, ok := strings.CutPrefix(args[0], deploymentPrefix)
+	if !ok || name == "" {
+		return clierrors.Message("The workload %q is not supported. Specify a deployment as 'deployment/<name>'.", args[0])
+	}
+
+	namespace, err := cmd.Flags().GetString("namespace")
+	if err != nil {
+		return err
+	}
+	if namespace == "" {
+		namespace = defaultNamespace
+	}
+
+	outputFile, err := cmd.Flags().GetString(outputFileFlag)
+	if err != nil {
+		return err
+	}
+	if outputFile == "" {
+		outputFile = name + ".bicep"
+	}
+
+	if _, err := os.Stat(outputFile); err == nil {
+		return clierrors.Message("The file %q already exists. Specify another file with '--%s'.", outputFile, outputFileFlag)
+	}
+
+	r.DeploymentName = name
+	r.Namespace = namespace
+	r.OutputFile = outputFile
+
+	return nil
+}
+
+// Run runs the `rad container import` command.
+func (r *Runner) Run(ctx context.Context) error {
+	if r.KubernetesClient == nil {
+		client, err := kubernetes.NewRuntimeClient(r.KubeContext, kubernetes.Scheme)
+		if err != nil {
+			return err
+		}
+		r.KubernetesClient = client
+	}
+
+	deployment := &appsv1.Deployment{}
+	err := r.KubernetesClient.Get(ctx, runtimeclient.ObjectKey{Namespace: r.Namespace, Name: r.DeploymentName}, deployment)
+	if apierrors.IsNotFound(err) {
+		return clierrors.Message("The deployment %q was not found in the namespace %q.", r.DeploymentName, r.Namespace)
+	} else if err != nil {
+		return err
+	}
+
+	services := &corev1.ServiceList{}
+	err = r.KubernetesClient.List(ctx, services, runtimeclient.InNamespace(r.Namespace))
+	if err != nil {
+		return err
+	}
+
+	service := kubeimport.FindService(deployment, services.Items)
+	container, err := kubeimport.Convert(deployment, service)
+	if err != nil {
+		return clierrors.MessageWithCause(err, "Failed to import the deployment %q.", r.DeploymentName)
+	}
+
+	content, err := kubeimport.Bicep(container)
+	if err != nil {
+		return clierrors.MessageWithCause(err, "Failed to import the deployment %q.", r.DeploymentName)
+	}
+
+	for _, warning := range container.Warnings {
+		r.Output.LogInfo("Warning: %s", warning)
+	}
+
+	err = os.WriteFile(r.OutputFile, []byte(content), 0644)
+	if err != nil {
+		return clierrors.MessageWithCause(err, "Failed to write the file %q.", r.OutputFile)
+	}
+
+	r.Output.LogInfo("Container %q written to %q", container.Name, r.OutputFile)
+	return nil
+}
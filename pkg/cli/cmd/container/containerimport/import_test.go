@@ -0,0 +1,173 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package containerimport
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/radius-project/radius/pkg/cli/clierrors"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/kubernetes"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/test/radcli"
+)
+
+func Test_CommandValidation(t *testing.T) {
+	radcli.SharedCommandValidation(t, NewCommand)
+}
+
+func Test_Validate(t *testing.T) {
+	existingFile := filepath.Join(t.TempDir(), "existing.bicep")
+	require.NoError(t, os.WriteFile(existingFile, []byte{}, 0644))
+
+	testcases := []radcli.ValidateInput{
+		{
+			Name:          "Import deployment",
+			Input:         []string{"deployment/web"},
+			ExpectedValid: true,
+			ConfigHolder:  framework.ConfigHolder{},
+			ValidateCallback: func(t *testing.T, runner framework.Runner) {
+				r := runner.(*Runner)
+				require.Equal(t, "web", r.DeploymentName)
+				require.Equal(t, "default", r.Namespace)
+				require.Equal(t, "web.bicep", r.OutputFile)
+			},
+		},
+		{
+			Name:          "Import deployment with flags",
+			Input:         []string{"deployment/web", "--namespace", "shop", "--kubecontext", "aks", "--output-file", "app.bicep"},
+			ExpectedValid: true,
+			ConfigHolder:  framework.ConfigHolder{},
+			ValidateCallback: func(t *testing.T, runner framework.Runner) {
+				r := runner.(*Runner)
+				require.Equal(t, "web", r.DeploymentName)
+				require.Equal(t, "shop", r.Namespace)
+				require.Equal(t, "aks", r.KubeContext)
+				require.Equal(t, "app.bicep", r.OutputFile)
+			},
+		},
+		{
+			Name:          "Import unsupported workload",
+			Input:         []string{"statefulset/db"},
+			ExpectedValid: false,
+			ConfigHolder:  framework.ConfigHolder{},
+		},
+		{
+			Name:          "Import deployment without name",
+			Input:         []string{"deployment/"},
+			ExpectedValid: false,
+			ConfigHolder:  framework.ConfigHolder{},
+		},
+		{
+			Name:          "Import to existing file",
+			Input:         []string{"deployment/web", "--output-file", existingFile},
+			ExpectedValid: false,
+			ConfigHolder:  framework.ConfigHolder{},
+		},
+		{
+			Name:          "Import without workload",
+			Input:         []string{},
+			ExpectedValid: false,
+			ConfigHolder:  framework.ConfigHolder{},
+		},
+	}
+	radcli.SharedValidateValidation(t, NewCommand, testcases)
+}
+
+func Test_Run(t *testing.T) {
+	deployment := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "default"},
+		Spec: appsv1.DeploymentSpec{
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"app": "web"}},
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{
+						{
+							Name:  "web",
+							Image: "nginx:1.25",
+							Ports: []corev1.ContainerPort{{Name: "http", ContainerPort: 80}},
+						},
+					},
+				},
+			},
+		},
+	}
+	service := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{Name: "web-svc", Namespace: "default"},
+		Spec: corev1.ServiceSpec{
+			Selector: map[string]string{"app": "web"},
+			Ports:    []corev1.ServicePort{{Name: "http", Port: 80}},
+		},
+	}
+
+	t.Run("Success", func(t *testing.T) {
+		outputFile := filepath.Join(t.TempDir(), "web.bicep")
+		outputSink := &output.MockOutput{}
+		runner := &Runner{
+			Output:           outputSink,
+			KubernetesClient: fake.NewClientBuilder().WithScheme(kubernetes.Scheme).WithObjects(deployment, service).Build(),
+			Namespace:        "default",
+			DeploymentName:   "web",
+			OutputFile:       outputFile,
+		}
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+
+		expected := []any{
+			output.LogOutput{
+				Format: "Warning: %s",
+				Params: []any{`The service "web-svc" is renamed to "web".`},
+			},
+			output.LogOutput{
+				Format: "Container %q written to %q",
+				Params: []any{"web", outputFile},
+			},
+		}
+		require.Equal(t, expected, outputSink.Writes)
+
+		b, err := os.ReadFile(outputFile)
+		require.NoError(t, err)
+		require.Contains(t, string(b), "resource web 'Applications.Core/containers@2023-10-01-preview' = {")
+		require.Contains(t, string(b), "image: 'nginx:1.25'")
+		require.Contains(t, string(b), "kind: Service")
+	})
+
+	t.Run("Error: Deployment not found", func(t *testing.T) {
+		outputFile := filepath.Join(t.TempDir(), "api.bicep")
+		runner := &Runner{
+			Output:           &output.MockOutput{},
+			KubernetesClient: fake.NewClientBuilder().WithScheme(kubernetes.Scheme).WithObjects(deployment, service).Build(),
+			Namespace:        "default",
+			DeploymentName:   "api",
+			OutputFile:       outputFile,
+		}
+
+		err := runner.Run(context.Background())
+		require.Equal(t, clierrors.Message("The deployment %q was not found in the namespace %q.", "api", "default"), err)
+		require.NoFileExists(t, outputFile)
+	})
+}
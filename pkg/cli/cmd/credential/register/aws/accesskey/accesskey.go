@@ -21,6 +21,7 @@ import (
 
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
 	"github.com/radius-project/radius/pkg/cli"
+	"github.com/radius-project/radius/pkg/cli/aws"
 	"github.com/radius-project/radius/pkg/cli/clierrors"
 	"github.com/radius-project/radius/pkg/cli/cmd/commonflags"
 	"github.com/radius-project/radius/pkg/cli/cmd/credential/common"
@@ -45,7 +46,8 @@ func NewCommand(factory framework.Factory) (*cobra.Command, framework.Runner) {
 This command is intended for scripting or advanced use-cases. See 'rad init' for a user-friendly way
 to configure these settings.
 
-Radius will use the provided IAM credential for all interactions with AWS. 
+Radius will use the provided IAM credential for all interactions with AWS. The credential is checked by
+authenticating with AWS before it is registered, use '--skip-validation' to skip the check.
 ` + common.LongDescriptionBlurb,
 		Example: `
 # Register (Add or update) cloud provider credential for AWS with IAM authentication
@@ -64,6 +66,8 @@ rad credential register aws access-key --access-key-id <access-key-id> --secret-
 	cmd.Flags().String("secret-access-key", "", "The AWS IAM secret access key.")
 	_ = cmd.MarkFlagRequired("secret-access-key")
 
+	cmd.Flags().Bool("skip-validation", false, "Register the credential without checking that it can authenticate with AWS.")
+
 	return cmd, runner
 }
 
@@ -71,6 +75,7 @@ rad credential register aws access-key --access-key-id <access-key-id> --secret-
 type Runner struct {
 	ConfigHolder      *framework.ConfigHolder
 	ConnectionFactory connections.Factory
+	AWSClient         aws.Client
 	Output            output.Interface
 	Format            string
 	Workspace         *workspaces.Workspace
@@ -78,6 +83,7 @@ type Runner struct {
 	AccessKeyID     string
 	SecretAccessKey string
 	KubeContext     string
+	SkipValidation  bool
 }
 
 // NewRunner creates a new instance of the `rad credential register aws` runner.
@@ -85,6 +91,7 @@ func NewRunner(factory framework.Factory) *Runner {
 	return &Runner{
 		ConfigHolder:      factory.GetConfigHolder(),
 		ConnectionFactory: factory.GetConnectionFactory(),
+		AWSClient:         factory.GetAWSClient(),
 		Output:            factory.GetOutput(),
 	}
 }
@@ -114,8 +121,13 @@ func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
 	if err != nil {
 		return err
 	}
+	skipValidation, err := cmd.Flags().GetBool("skip-validation")
+	if err != nil {
+		return err
+	}
 	r.AccessKeyID = accessKeyID
 	r.SecretAccessKey = secretAccessKey
+	r.SkipValidation = skipValidation
 
 	if r.AccessKeyID == "" {
 		return clierrors.Message("Access Key id %q cannot be empty.", r.AccessKeyID)
@@ -137,6 +149,14 @@ func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
 
 // Run registers an AWS credential with the given context and workspace, and returns an error if unsuccessful.
 func (r *Runner) Run(ctx context.Context) error {
+	if !r.SkipValidation {
+		r.Output.LogInfo("Validating credential for %q cloud provider...", "aws")
+		err := r.AWSClient.ValidateAccessKey(ctx, r.AccessKeyID, r.SecretAccessKey)
+		if err != nil {
+			return clierrors.MessageWithCause(err, "The credential for %q cloud provider could not authenticate. Use '--skip-validation' to register it anyway.", "aws")
+		}
+	}
+
 	r.Output.LogInfo("Registering credential for %q cloud provider in Radius installation %q...", "aws", r.Workspace.FmtConnection())
 	client, err := r.ConnectionFactory.CreateCredentialManagementClient(ctx, *r.Workspace)
 	if err != nil {
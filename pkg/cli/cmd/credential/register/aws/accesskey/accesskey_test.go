@@ -18,11 +18,14 @@ package accesskey
 
 import (
 	"context"
+	"errors"
 	"testing"
 
 	"go.uber.org/mock/gomock"
 
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/cli/aws"
+	"github.com/radius-project/radius/pkg/cli/clierrors"
 	"github.com/radius-project/radius/pkg/cli/connections"
 	cli_credential "github.com/radius-project/radius/pkg/cli/credential"
 	"github.com/radius-project/radius/pkg/cli/framework"
@@ -112,6 +115,12 @@ func Test_Run(t *testing.T) {
 				},
 			}
 
+			awsClient := aws.NewMockClient(ctrl)
+			awsClient.EXPECT().
+				ValidateAccessKey(gomock.Any(), testAccessKeyId, testSecretAccessKey).
+				Return(nil).
+				Times(1)
+
 			client := cli_credential.NewMockCredentialManagementClient(ctrl)
 			client.EXPECT().
 				PutAWS(gomock.Any(), expectedPut).
@@ -122,6 +131,7 @@ func Test_Run(t *testing.T) {
 
 			runner := &Runner{
 				ConnectionFactory: &connections.MockFactory{CredentialManagementClient: client},
+				AWSClient:         awsClient,
 				Output:            outputSink,
 				Workspace: &workspaces.Workspace{
 					Connection: map[string]any{
@@ -139,6 +149,10 @@ func Test_Run(t *testing.T) {
 			require.NoError(t, err)
 
 			expected := []any{
+				output.LogOutput{
+					Format: "Validating credential for %q cloud provider...",
+					Params: []any{"aws"},
+				},
 				output.LogOutput{
 					Format: "Registering credential for %q cloud provider in Radius installation %q...",
 					Params: []any{"aws", "Kubernetes (context=my-context)"},
@@ -150,5 +164,57 @@ func Test_Run(t *testing.T) {
 			}
 			require.Equal(t, expected, outputSink.Writes)
 		})
+
+		t.Run("Invalid credential", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+
+			validationErr := errors.New("InvalidClientTokenId")
+			awsClient := aws.NewMockClient(ctrl)
+			awsClient.EXPECT().
+				ValidateAccessKey(gomock.Any(), testAccessKeyId, testSecretAccessKey).
+				Return(validationErr).
+				Times(1)
+
+			// The credential must not be registered.
+			client := cli_credential.NewMockCredentialManagementClient(ctrl)
+
+			outputSink := &output.MockOutput{}
+
+			runner := &Runner{
+				ConnectionFactory: &connections.MockFactory{CredentialManagementClient: client},
+				AWSClient:         awsClient,
+				Output:            outputSink,
+				Workspace:         &workspaces.Workspace{},
+				Format:            "table",
+				AccessKeyID:       testAccessKeyId,
+				SecretAccessKey:   testSecretAccessKey,
+			}
+			err := runner.Run(context.Background())
+			require.Equal(t, clierrors.MessageWithCause(validationErr, "The credential for %q cloud provider could not authenticate. Use '--skip-validation' to register it anyway.", "aws"), err)
+		})
+
+		t.Run("Skip validation", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+
+			client := cli_credential.NewMockCredentialManagementClient(ctrl)
+			client.EXPECT().
+				PutAWS(gomock.Any(), gomock.Any()).
+				Return(nil).
+				Times(1)
+
+			outputSink := &output.MockOutput{}
+
+			runner := &Runner{
+				ConnectionFactory: &connections.MockFactory{CredentialManagementClient: client},
+				Output:            outputSink,
+				Workspace:         &workspaces.Workspace{},
+				Format:            "table",
+				AccessKeyID:       testAccessKeyId,
+				SecretAccessKey:   testSecretAccessKey,
+				SkipValidation:    true,
+			}
+			err := runner.Run(context.Background())
+			require.NoError(t, err)
+		})
 	})
 }
@@ -18,6 +18,9 @@ package irsa
 
 import (
 	"context"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
 
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
 	"github.com/radius-project/radius/pkg/cli"
@@ -112,6 +115,12 @@ func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
 		return clierrors.Message("IAM Role %q cannot be empty.", r.IAMRole)
 	}
 
+	// The role is assumed with the service account token of Radius, so it can only be checked from the cluster.
+	// Catch malformed ARNs early since UCP stores them as-is.
+	if !isIAMRoleARN(r.IAMRole) {
+		return clierrors.Message("IAM Role %q is not a valid role ARN. The ARN should have the format arn:aws:iam::<account-id>:role/<role-name>.", r.IAMRole)
+	}
+
 	kubeContext, ok := r.Workspace.KubernetesContext()
 	if !ok {
 		return clierrors.Message("A Kubernetes connection is required.")
@@ -151,3 +160,8 @@ func (r *Runner) Run(ctx context.Context) error {
 
 	return nil
 }
+
+func isIAMRoleARN(value string) bool {
+	parsed, err := arn.Parse(value)
+	return err == nil && parsed.Service == "iam" && parsed.AccountID != "" && strings.HasPrefix(parsed.Resource, "role/")
+}
@@ -35,7 +35,7 @@ import (
 )
 
 const (
-	roleARN = "role-arn"
+	roleARN = "arn:aws:iam::123456789012:role/radius-role"
 )
 
 func Test_CommandValidation(t *testing.T) {
@@ -70,6 +70,22 @@ func Test_Validate(t *testing.T) {
 			ExpectedValid: false,
 			ConfigHolder:  framework.ConfigHolder{Config: configWithWorkspace},
 		},
+		{
+			Name: "AWS command with invalid role ARN",
+			Input: []string{
+				"--iam-role", "role-arn",
+			},
+			ExpectedValid: false,
+			ConfigHolder:  framework.ConfigHolder{Config: configWithWorkspace},
+		},
+		{
+			Name: "AWS command with ARN of a user",
+			Input: []string{
+				"--iam-role", "arn:aws:iam::123456789012:user/radius-user",
+			},
+			ExpectedValid: false,
+			ConfigHolder:  framework.ConfigHolder{Config: configWithWorkspace},
+		},
 		{
 			Name: "AWS command without role ARN",
 			Input: []string{
@@ -22,6 +22,8 @@ import (
 
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
 	"github.com/radius-project/radius/pkg/cli"
+	"github.com/radius-project/radius/pkg/cli/azure"
+	"github.com/radius-project/radius/pkg/cli/clierrors"
 	"github.com/radius-project/radius/pkg/cli/cmd/commonflags"
 	"github.com/radius-project/radius/pkg/cli/cmd/credential/common"
 	"github.com/radius-project/radius/pkg/cli/connections"
@@ -54,6 +56,9 @@ Radius will use the provided subscription and resource group as the default targ
 The provided service principal must have the Contributor or Owner role assigned for the provided resource group
 in order to create or manage resources contained in the group. The resource group should be created before
 calling 'rad credential register azure sp'.
+
+The service principal is checked by authenticating with Azure before it is registered, use '--skip-validation'
+to skip the check.
 ` + common.LongDescriptionBlurb,
 		Example: `
 # Register (Add or update) cloud provider credential for Azure with service principal authentication
@@ -75,6 +80,8 @@ rad credential register azure sp --client-id <client id/app id> --client-secret
 	cmd.Flags().StringVar(&runner.TenantID, "tenant-id", "", "The tenant id of an Azure service principal.")
 	_ = cmd.MarkFlagRequired("tenant-id")
 
+	cmd.Flags().BoolVar(&runner.SkipValidation, "skip-validation", false, "Register the credential without checking that it can authenticate with Azure.")
+
 	return cmd, runner
 }
 
@@ -82,14 +89,16 @@ rad credential register azure sp --client-id <client id/app id> --client-secret
 type Runner struct {
 	ConfigHolder      *framework.ConfigHolder
 	ConnectionFactory connections.Factory
+	AzureClient       azure.Client
 	Output            output.Interface
 	Format            string
 	Workspace         *workspaces.Workspace
 
-	ClientID     string
-	ClientSecret string
-	TenantID     string
-	KubeContext  string
+	ClientID       string
+	ClientSecret   string
+	TenantID       string
+	KubeContext    string
+	SkipValidation bool
 }
 
 // NewRunner creates a new instance of the `rad credential register azure sp` runner.
@@ -97,6 +106,7 @@ func NewRunner(factory framework.Factory) *Runner {
 	return &Runner{
 		ConfigHolder:      factory.GetConfigHolder(),
 		ConnectionFactory: factory.GetConnectionFactory(),
+		AzureClient:       factory.GetAzureClient(),
 		Output:            factory.GetOutput(),
 	}
 }
@@ -128,6 +138,14 @@ func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
 // Run registers a credential for the Azure cloud provider in the Radius installation, updates the server-side
 // to add/change credentials. It returns an error if any of the steps fail.
 func (r *Runner) Run(ctx context.Context) error {
+	if !r.SkipValidation {
+		r.Output.LogInfo("Validating credential for %q cloud provider...", "azure")
+		err := r.AzureClient.ValidateServicePrincipal(ctx, r.TenantID, r.ClientID, r.ClientSecret)
+		if err != nil {
+			return clierrors.MessageWithCause(err, "The credential for %q cloud provider could not authenticate. Use '--skip-validation' to register it anyway.", "azure")
+		}
+	}
+
 	r.Output.LogInfo("Registering credential for %q cloud provider in Radius installation %q...", "azure", r.Workspace.FmtConnection())
 	client, err := r.ConnectionFactory.CreateCredentialManagementClient(ctx, *r.Workspace)
 	if err != nil {
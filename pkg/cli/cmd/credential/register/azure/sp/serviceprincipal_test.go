@@ -18,12 +18,15 @@ package sp
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"testing"
 
 	"go.uber.org/mock/gomock"
 
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/cli/azure"
+	"github.com/radius-project/radius/pkg/cli/clierrors"
 	"github.com/radius-project/radius/pkg/cli/cmd/credential/common"
 	"github.com/radius-project/radius/pkg/cli/connections"
 	cli_credential "github.com/radius-project/radius/pkg/cli/credential"
@@ -125,6 +128,12 @@ func Test_Run(t *testing.T) {
 				},
 			}
 
+			azureClient := azure.NewMockClient(ctrl)
+			azureClient.EXPECT().
+				ValidateServicePrincipal(gomock.Any(), "cool-tenant-id", "cool-client-id", "cool-client-secret").
+				Return(nil).
+				Times(1)
+
 			client := cli_credential.NewMockCredentialManagementClient(ctrl)
 			client.EXPECT().
 				PutAzure(gomock.Any(), expectedPut).
@@ -135,6 +144,7 @@ func Test_Run(t *testing.T) {
 
 			runner := &Runner{
 				ConnectionFactory: &connections.MockFactory{CredentialManagementClient: client},
+				AzureClient:       azureClient,
 				Output:            outputSink,
 				Workspace: &workspaces.Workspace{
 					Connection: map[string]any{
@@ -155,6 +165,10 @@ func Test_Run(t *testing.T) {
 			require.NoError(t, err)
 
 			expected := []any{
+				output.LogOutput{
+					Format: "Validating credential for %q cloud provider...",
+					Params: []any{"azure"},
+				},
 				output.LogOutput{
 					Format: "Registering credential for %q cloud provider in Radius installation %q...",
 					Params: []any{"azure", "Kubernetes (context=my-context)"},
@@ -166,5 +180,63 @@ func Test_Run(t *testing.T) {
 			}
 			require.Equal(t, expected, outputSink.Writes)
 		})
+
+		t.Run("Invalid credential", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+
+			validationErr := errors.New("AADSTS7000215: Invalid client secret provided")
+			azureClient := azure.NewMockClient(ctrl)
+			azureClient.EXPECT().
+				ValidateServicePrincipal(gomock.Any(), "cool-tenant-id", "cool-client-id", "cool-client-secret").
+				Return(validationErr).
+				Times(1)
+
+			// The credential must not be registered.
+			client := cli_credential.NewMockCredentialManagementClient(ctrl)
+
+			outputSink := &output.MockOutput{}
+
+			runner := &Runner{
+				ConnectionFactory: &connections.MockFactory{CredentialManagementClient: client},
+				AzureClient:       azureClient,
+				Output:            outputSink,
+				Workspace:         &workspaces.Workspace{},
+				Format:            "table",
+
+				ClientID:     "cool-client-id",
+				ClientSecret: "cool-client-secret",
+				TenantID:     "cool-tenant-id",
+			}
+
+			err := runner.Run(context.Background())
+			require.Equal(t, clierrors.MessageWithCause(validationErr, "The credential for %q cloud provider could not authenticate. Use '--skip-validation' to register it anyway.", "azure"), err)
+		})
+
+		t.Run("Skip validation", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+
+			client := cli_credential.NewMockCredentialManagementClient(ctrl)
+			client.EXPECT().
+				PutAzure(gomock.Any(), gomock.Any()).
+				Return(nil).
+				Times(1)
+
+			outputSink := &output.MockOutput{}
+
+			runner := &Runner{
+				ConnectionFactory: &connections.MockFactory{CredentialManagementClient: client},
+				Output:            outputSink,
+				Workspace:         &workspaces.Workspace{},
+				Format:            "table",
+
+				ClientID:       "cool-client-id",
+				ClientSecret:   "cool-client-secret",
+				TenantID:       "cool-tenant-id",
+				SkipValidation: true,
+			}
+
+			err := runner.Run(context.Background())
+			require.NoError(t, err)
+		})
 	})
 }
@@ -105,6 +105,9 @@ rad deploy myapp.bicep --parameters @myfile.json
 
 # specify parameters from multiple sources
 rad deploy myapp.bicep --parameters @myfile.json --parameters version=latest
+
+# display the state and status message of each resource while deploying
+rad deploy myapp.bicep --watch
 `,
 		Args: cobra.ExactArgs(1),
 		RunE: framework.RunCommand(runner),
@@ -115,6 +118,7 @@ rad deploy myapp.bicep --parameters @myfile.json --parameters version=latest
 	commonflags.AddEnvironmentNameFlag(cmd)
 	commonflags.AddApplicationNameFlag(cmd)
 	commonflags.AddParameterFlag(cmd)
+	cmd.Flags().Bool("watch", false, "Display a live table of the state and status message of each resource while deploying")
 
 	return cmd, runner
 }
@@ -133,6 +137,7 @@ type Runner struct {
 	Parameters          map[string]map[string]any
 	Workspace           *workspaces.Workspace
 	Providers           *clients.Providers
+	Watch               bool
 }
 
 // NewRunner creates a new instance of the `rad deploy` runner.
@@ -241,6 +246,11 @@ func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
+	r.Watch, err = cmd.Flags().GetBool("watch")
+	if err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -319,6 +329,7 @@ func (r *Runner) Run(ctx context.Context) error {
 		ProgressText:      progressText,
 		CompletionText:    "Deployment Complete",
 		Providers:         r.Providers,
+		Watch:             r.Watch,
 	})
 	if err != nil {
 		return err
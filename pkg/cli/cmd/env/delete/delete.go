@@ -23,6 +23,7 @@ import (
 
 	"github.com/radius-project/radius/pkg/cli"
 	"github.com/radius-project/radius/pkg/cli/cmd/commonflags"
+	"github.com/radius-project/radius/pkg/cli/completion"
 	"github.com/radius-project/radius/pkg/cli/connections"
 	"github.com/radius-project/radius/pkg/cli/framework"
 	"github.com/radius-project/radius/pkg/cli/output"
@@ -45,10 +46,11 @@ func NewCommand(factory framework.Factory) (*cobra.Command, framework.Runner) {
 	runner := NewRunner(factory)
 
 	cmd := &cobra.Command{
-		Use:   "delete",
-		Short: "Delete environment",
-		Long:  `Delete environment. Deletes the user's default environment by default.`,
-		Args:  cobra.MaximumNArgs(1),
+		Use:               "delete",
+		Short:             "Delete environment",
+		Long:              `Delete environment. Deletes the user's default environment by default.`,
+		Args:              cobra.MaximumNArgs(1),
+		ValidArgsFunction: completion.FirstArg(completion.EnvironmentNames(factory)),
 		Example: `
 # Delete current environment
 rad env delete
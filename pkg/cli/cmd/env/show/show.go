@@ -23,6 +23,7 @@ import (
 	"github.com/radius-project/radius/pkg/cli/clients"
 	"github.com/radius-project/radius/pkg/cli/clierrors"
 	"github.com/radius-project/radius/pkg/cli/cmd/commonflags"
+	"github.com/radius-project/radius/pkg/cli/completion"
 	"github.com/radius-project/radius/pkg/cli/connections"
 	"github.com/radius-project/radius/pkg/cli/framework"
 	"github.com/radius-project/radius/pkg/cli/objectformats"
@@ -40,10 +41,11 @@ func NewCommand(factory framework.Factory) (*cobra.Command, framework.Runner) {
 	runner := NewRunner(factory)
 
 	cmd := &cobra.Command{
-		Use:   "show",
-		Short: "Show environment details",
-		Long:  `Show environment details. Shows the user's default environment by default.`,
-		Args:  cobra.MaximumNArgs(1),
+		Use:               "show",
+		Short:             "Show environment details",
+		Long:              `Show environment details. Shows the user's default environment by default.`,
+		Args:              cobra.MaximumNArgs(1),
+		ValidArgsFunction: completion.FirstArg(completion.EnvironmentNames(factory)),
 		Example: `
 # Show current environment
 rad env show
@@ -25,6 +25,7 @@ import (
 	"github.com/radius-project/radius/pkg/cli/clients"
 	"github.com/radius-project/radius/pkg/cli/clierrors"
 	"github.com/radius-project/radius/pkg/cli/cmd/commonflags"
+	"github.com/radius-project/radius/pkg/cli/completion"
 	"github.com/radius-project/radius/pkg/cli/connections"
 	"github.com/radius-project/radius/pkg/cli/framework"
 	"github.com/radius-project/radius/pkg/cli/output"
@@ -64,7 +65,8 @@ Properties that can be updated include:
 		  
 All other properties require the environment to be deleted and recreated.
 `,
-		Args: cobra.ExactArgs(1),
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: completion.FirstArg(completion.EnvironmentNames(factory)),
 		Example: `
 ## Add Azure cloud provider for deploying Azure resources
 rad env update myenv --azure-subscription-id **** --azure-resource-group myrg
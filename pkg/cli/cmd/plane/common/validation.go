@@ -0,0 +1,45 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/cli/clierrors"
+)
+
+// RequirePlaneArgs reads the plane type and plane name from the positional arguments of plane commands and returns an
+// error if the plane type is not supported or the plane name is empty.
+func RequirePlaneArgs(args []string) (planeType string, planeName string, err error) {
+	if len(args) < 2 {
+		return "", "", clierrors.Message("The plane type and plane name are required.")
+	}
+
+	planeType = strings.ToLower(args[0])
+	if !slices.Contains(clients.SupportedPlaneTypes, planeType) {
+		return "", "", clierrors.Message("Plane type %q is not supported. Supported types are: %s.", args[0], strings.Join(clients.SupportedPlaneTypes, ", "))
+	}
+
+	planeName = args[1]
+	if planeName == "" || strings.Contains(planeName, "/") {
+		return "", "", clierrors.Message("Plane name %q is invalid.", planeName)
+	}
+
+	return planeType, planeName, nil
+}
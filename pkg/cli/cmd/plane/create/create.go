@@ -0,0 +1,205 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package create
+
+import (
+	"context"
+	"net/url"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/cli"
+	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/cli/clierrors"
+	"github.com/radius-project/radius/pkg/cli/cmd/commonflags"
+	"github.com/radius-project/radius/pkg/cli/cmd/plane/common"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+)
+
+const (
+	resourceProviderFlag = "resource-provider"
+	urlFlag              = "url"
+)
+
+// NewCommand creates an instance of the command and runner for the `rad plane create` command.
+func NewCommand(factory framework.Factory) (*cobra.Command, framework.Runner) {
+	runner := NewRunner(factory)
+
+	cmd := &cobra.Command{
+		Use:   "create type name",
+		Short: "Create or update a plane",
+		Long: `Create or update a plane.
+
+The plane type is one of radius, azure or aws:
+
+- Radius planes route the requests for each resource provider namespace to the URL given with '--resource-provider'.
+- Azure planes proxy requests to the URL given with '--url'.
+- AWS planes have no required properties.
+`,
+		Example: `
+# Create a radius plane
+rad plane create radius myplane --resource-provider Applications.Core=http://applications-rp.radius-system:5443 --resource-provider Microsoft.Resources=http://bicep-de.radius-system:6443
+
+# Create an azure plane
+rad plane create azure myplane --url https://management.azure.com
+
+# Create an aws plane
+rad plane create aws myplane`,
+		Args: cobra.ExactArgs(2),
+		RunE: framework.RunCommand(runner),
+	}
+
+	commonflags.AddWorkspaceFlag(cmd)
+	cmd.Flags().StringArray(resourceProviderFlag, []string{}, "The URL of a resource provider of a radius plane, in the format <namespace>=<url>. May be specified multiple times.")
+	cmd.Flags().String(urlFlag, "", "The URL used by an azure plane to proxy requests.")
+
+	return cmd, runner
+}
+
+// Runner is the runner implementation for the `rad plane create` command.
+type Runner struct {
+	ConfigHolder      *framework.ConfigHolder
+	ConnectionFactory connections.Factory
+	Output            output.Interface
+	Workspace         *workspaces.Workspace
+	PlaneType         string
+	PlaneName         string
+	ResourceProviders map[string]string
+	URL               string
+}
+
+// NewRunner creates a new instance of the `rad plane create` runner.
+func NewRunner(factory framework.Factory) *Runner {
+	return &Runner{
+		ConnectionFactory: factory.GetConnectionFactory(),
+		ConfigHolder:      factory.GetConfigHolder(),
+		Output:            factory.GetOutput(),
+	}
+}
+
+// Validate runs validation for the `rad plane create` command. It checks the plane type and name, and that the properties given with
+// flags are valid and supported by the plane type.
+func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
+	workspace, err := cli.RequireWorkspace(cmd, r.ConfigHolder.Config, r.ConfigHolder.DirectoryConfig)
+	if err != nil {
+		return err
+	}
+
+	planeType, planeName, err := common.RequirePlaneArgs(args)
+	if err != nil {
+		return err
+	}
+
+	resourceProviders, err := cmd.Flags().GetStringArray(resourceProviderFlag)
+	if err != nil {
+		return err
+	}
+
+	planeURL, err := cmd.Flags().GetString(urlFlag)
+	if err != nil {
+		return err
+	}
+
+	if planeType != clients.PlaneTypeRadius && len(resourceProviders) > 0 {
+		return clierrors.Message("The '--%s' flag is only supported for %s planes.", resourceProviderFlag, clients.PlaneTypeRadius)
+	}
+	if planeType != clients.PlaneTypeAzure && planeURL != "" {
+		return clierrors.Message("The '--%s' flag is only supported for %s planes.", urlFlag, clients.PlaneTypeAzure)
+	}
+
+	switch planeType {
+	case clients.PlaneTypeRadius:
+		if len(resourceProviders) == 0 {
+			return clierrors.Message("At least one resource provider is required for %s planes. Specify it with '--%s'.", clients.PlaneTypeRadius, resourceProviderFlag)
+		}
+
+		r.ResourceProviders = map[string]string{}
+		for _, resourceProvider := range resourceProviders {
+			namespace, address, ok := strings.Cut(resourceProvider, "=")
+			if !ok || namespace == "" || !isValidURL(address) {
+				return clierrors.Message("Resource provider %q is invalid. Resource providers must be specified in the format <namespace>=<url>.", resourceProvider)
+			}
+
+			r.ResourceProviders[namespace] = address
+		}
+	case clients.PlaneTypeAzure:
+		if !isValidURL(planeURL) {
+			return clierrors.Message("A valid URL is required for %s planes. Specify it with '--%s'.", clients.PlaneTypeAzure, urlFlag)
+		}
+
+		r.URL = planeURL
+	}
+
+	r.PlaneType = planeType
+	r.PlaneName = planeName
+	r.Workspace = workspace
+
+	return nil
+}
+
+// Run runs the `rad plane create` command.
+func (r *Runner) Run(ctx context.Context) error {
+	client, err := r.ConnectionFactory.CreateApplicationsManagementClient(ctx, *r.Workspace)
+	if err != nil {
+		return err
+	}
+
+	var resource any
+	switch r.PlaneType {
+	case clients.PlaneTypeRadius:
+		resource = &v20231001preview.RadiusPlaneResource{
+			Location: to.Ptr(v1.LocationGlobal),
+			Properties: &v20231001preview.RadiusPlaneResourceProperties{
+				ResourceProviders: *to.StringMapPtr(r.ResourceProviders),
+			},
+		}
+	case clients.PlaneTypeAzure:
+		resource = &v20231001preview.AzurePlaneResource{
+			Location: to.Ptr(v1.LocationGlobal),
+			Properties: &v20231001preview.AzurePlaneResourceProperties{
+				URL: to.Ptr(r.URL),
+			},
+		}
+	case clients.PlaneTypeAWS:
+		resource = &v20231001preview.AwsPlaneResource{
+			Location:   to.Ptr(v1.LocationGlobal),
+			Properties: &v20231001preview.AwsPlaneResourceProperties{},
+		}
+	}
+
+	r.Output.LogInfo("creating %s plane %q...", r.PlaneType, r.PlaneName)
+
+	err = client.CreateOrUpdatePlane(ctx, r.PlaneType, r.PlaneName, resource)
+	if err != nil {
+		return clierrors.MessageWithCause(err, "Failed to create the %s plane %q.", r.PlaneType, r.PlaneName)
+	}
+
+	r.Output.LogInfo("plane %q created", r.PlaneName)
+	return nil
+}
+
+func isValidURL(value string) bool {
+	u, err := url.Parse(value)
+	return err == nil && u.Scheme != "" && u.Host != ""
+}
@@ -0,0 +1,165 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package create
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+	"github.com/radius-project/radius/test/radcli"
+)
+
+func Test_CommandValidation(t *testing.T) {
+	radcli.SharedCommandValidation(t, NewCommand)
+}
+
+func Test_Validate(t *testing.T) {
+	configWithWorkspace := radcli.LoadConfigWithWorkspace(t)
+	testcases := []radcli.ValidateInput{
+		{
+			Name:          "Create radius plane",
+			Input:         []string{"radius", "myplane", "--resource-provider", "Applications.Core=http://localhost:8080"},
+			ExpectedValid: true,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+			ValidateCallback: func(t *testing.T, runner framework.Runner) {
+				r := runner.(*Runner)
+				require.Equal(t, "radius", r.PlaneType)
+				require.Equal(t, "myplane", r.PlaneName)
+				require.Equal(t, map[string]string{"Applications.Core": "http://localhost:8080"}, r.ResourceProviders)
+			},
+		},
+		{
+			Name:          "Create radius plane without resource providers",
+			Input:         []string{"radius", "myplane"},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+		{
+			Name:          "Create radius plane with invalid resource provider",
+			Input:         []string{"radius", "myplane", "--resource-provider", "Applications.Core"},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+		{
+			Name:          "Create azure plane",
+			Input:         []string{"azure", "myplane", "--url", "https://management.azure.com"},
+			ExpectedValid: true,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+		{
+			Name:          "Create azure plane without url",
+			Input:         []string{"azure", "myplane"},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+		{
+			Name:          "Create aws plane",
+			Input:         []string{"aws", "myplane"},
+			ExpectedValid: true,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+		{
+			Name:          "Create aws plane with url",
+			Input:         []string{"aws", "myplane", "--url", "https://management.azure.com"},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+		{
+			Name:          "Create plane of unsupported type",
+			Input:         []string{"kubernetes", "myplane"},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+	}
+	radcli.SharedValidateValidation(t, NewCommand, testcases)
+}
+
+func Test_Run(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	expectedResource := &v20231001preview.RadiusPlaneResource{
+		Location: to.Ptr(v1.LocationGlobal),
+		Properties: &v20231001preview.RadiusPlaneResourceProperties{
+			ResourceProviders: map[string]*string{
+				"Applications.Core": to.Ptr("http://localhost:8080"),
+			},
+		},
+	}
+
+	appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
+	appManagementClient.EXPECT().CreateOrUpdatePlane(gomock.Any(), "radius", "myplane", expectedResource).Return(nil).Times(1)
+
+	outputSink := &output.MockOutput{}
+	runner := &Runner{
+		ConnectionFactory: &connections.MockFactory{ApplicationsManagementClient: appManagementClient},
+		Workspace:         &workspaces.Workspace{},
+		PlaneType:         "radius",
+		PlaneName:         "myplane",
+		ResourceProviders: map[string]string{"Applications.Core": "http://localhost:8080"},
+		Output:            outputSink,
+	}
+
+	err := runner.Run(context.Background())
+	require.NoError(t, err)
+
+	expected := []any{
+		output.LogOutput{
+			Format: "creating %s plane %q...",
+			Params: []any{"radius", "myplane"},
+		},
+		output.LogOutput{
+			Format: "plane %q created",
+			Params: []any{"myplane"},
+		},
+	}
+	require.Equal(t, expected, outputSink.Writes)
+}
@@ -0,0 +1,141 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package delete
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/radius-project/radius/pkg/cli"
+	"github.com/radius-project/radius/pkg/cli/cmd/commonflags"
+	"github.com/radius-project/radius/pkg/cli/cmd/plane/common"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/prompt"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	"github.com/spf13/cobra"
+)
+
+// NewCommand creates an instance of the command and runner for the `rad plane delete` command.
+func NewCommand(factory framework.Factory) (*cobra.Command, framework.Runner) {
+	runner := NewRunner(factory)
+
+	cmd := &cobra.Command{
+		Use:   "delete type name",
+		Short: "Delete a plane",
+		Long: `Delete a plane.
+
+The plane type is one of radius, azure or aws. Requests to the resources of the plane fail once it is deleted.`,
+		Example: `
+# Delete an azure plane
+rad plane delete azure myplane
+
+# Delete a radius plane without prompting for confirmation
+rad plane delete radius myplane --yes`,
+		Args: cobra.ExactArgs(2),
+		RunE: framework.RunCommand(runner),
+	}
+
+	commonflags.AddWorkspaceFlag(cmd)
+	commonflags.AddConfirmationFlag(cmd)
+
+	return cmd, runner
+}
+
+// Runner is the runner implementation for the `rad plane delete` command.
+type Runner struct {
+	ConfigHolder      *framework.ConfigHolder
+	ConnectionFactory connections.Factory
+	Output            output.Interface
+	InputPrompter     prompt.Interface
+	Workspace         *workspaces.Workspace
+	PlaneType         string
+	PlaneName         string
+	Confirmation      bool
+}
+
+// NewRunner creates a new instance of the `rad plane delete` runner.
+func NewRunner(factory framework.Factory) *Runner {
+	return &Runner{
+		ConnectionFactory: factory.GetConnectionFactory(),
+		ConfigHolder:      factory.GetConfigHolder(),
+		Output:            factory.GetOutput(),
+		InputPrompter:     factory.GetPrompter(),
+	}
+}
+
+// Validate runs validation for the `rad plane delete` command.
+func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
+	workspace, err := cli.RequireWorkspace(cmd, r.ConfigHolder.Config, r.ConfigHolder.DirectoryConfig)
+	if err != nil {
+		return err
+	}
+
+	planeType, planeName, err := common.RequirePlaneArgs(args)
+	if err != nil {
+		return err
+	}
+
+	yes, err := cmd.Flags().GetBool("yes")
+	if err != nil {
+		return err
+	}
+
+	r.PlaneType = planeType
+	r.PlaneName = planeName
+	r.Workspace = workspace
+	r.Confirmation = yes
+
+	return nil
+}
+
+// Run runs the `rad plane delete` command.
+func (r *Runner) Run(ctx context.Context) error {
+	// Prompt user to confirm deletion
+	if !r.Confirmation {
+		confirmed, err := prompt.YesOrNoPrompt(
+			fmt.Sprintf("Are you sure you want to delete the %s plane '%v'?", r.PlaneType, r.PlaneName),
+			prompt.ConfirmNo,
+			r.InputPrompter)
+		if err != nil {
+			return err
+		}
+
+		if !confirmed {
+			r.Output.LogInfo("plane %q NOT deleted", r.PlaneName)
+			return nil
+		}
+	}
+
+	client, err := r.ConnectionFactory.CreateApplicationsManagementClient(ctx, *r.Workspace)
+	if err != nil {
+		return err
+	}
+
+	deleted, err := client.DeletePlane(ctx, r.PlaneType, r.PlaneName)
+	if err != nil {
+		return err
+	}
+
+	if deleted {
+		r.Output.LogInfo("plane %q deleted", r.PlaneName)
+	} else {
+		r.Output.LogInfo("plane %q does not exist or has already been deleted", r.PlaneName)
+	}
+	return nil
+}
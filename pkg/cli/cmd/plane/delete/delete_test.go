@@ -0,0 +1,158 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package delete
+
+import (
+	"context"
+	"testing"
+
+	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/prompt"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	"github.com/radius-project/radius/test/radcli"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_CommandValidation(t *testing.T) {
+	radcli.SharedCommandValidation(t, NewCommand)
+}
+
+func Test_Validate(t *testing.T) {
+	configWithWorkspace := radcli.LoadConfigWithWorkspace(t)
+	testcases := []radcli.ValidateInput{
+		{
+			Name:          "Delete Command with plane type and name",
+			Input:         []string{"azure", "myplane", "--yes"},
+			ExpectedValid: true,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+		{
+			Name:          "Delete Command without plane name",
+			Input:         []string{"azure"},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+		{
+			Name:          "Delete Command with unsupported plane type",
+			Input:         []string{"gcp", "myplane"},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+	}
+	radcli.SharedValidateValidation(t, NewCommand, testcases)
+}
+
+func Test_Run(t *testing.T) {
+	t.Run("Success (deleted)", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+
+		appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
+		appManagementClient.EXPECT().DeletePlane(gomock.Any(), "azure", "myplane").Return(true, nil).Times(1)
+
+		outputSink := &output.MockOutput{}
+		runner := &Runner{
+			ConnectionFactory: &connections.MockFactory{ApplicationsManagementClient: appManagementClient},
+			Workspace:         &workspaces.Workspace{},
+			PlaneType:         "azure",
+			PlaneName:         "myplane",
+			Confirmation:      true,
+			Output:            outputSink,
+		}
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+
+		expected := []any{
+			output.LogOutput{
+				Format: "plane %q deleted",
+				Params: []any{"myplane"},
+			},
+		}
+		require.Equal(t, expected, outputSink.Writes)
+	})
+
+	t.Run("Success (non-existent)", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+
+		appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
+		appManagementClient.EXPECT().DeletePlane(gomock.Any(), "azure", "myplane").Return(false, nil).Times(1)
+
+		outputSink := &output.MockOutput{}
+		runner := &Runner{
+			ConnectionFactory: &connections.MockFactory{ApplicationsManagementClient: appManagementClient},
+			Workspace:         &workspaces.Workspace{},
+			PlaneType:         "azure",
+			PlaneName:         "myplane",
+			Confirmation:      true,
+			Output:            outputSink,
+		}
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+
+		expected := []any{
+			output.LogOutput{
+				Format: "plane %q does not exist or has already been deleted",
+				Params: []any{"myplane"},
+			},
+		}
+		require.Equal(t, expected, outputSink.Writes)
+	})
+
+	t.Run("Answer no on confirmation", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+
+		prompter := prompt.NewMockInterface(ctrl)
+		prompter.EXPECT().
+			GetListInput([]string{prompt.ConfirmNo, prompt.ConfirmYes}, "Are you sure you want to delete the azure plane 'myplane'?").
+			Return(prompt.ConfirmNo, nil).
+			Times(1)
+
+		outputSink := &output.MockOutput{}
+		runner := &Runner{
+			PlaneType:     "azure",
+			PlaneName:     "myplane",
+			Confirmation:  false,
+			InputPrompter: prompter,
+			Output:        outputSink,
+		}
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+
+		expected := []any{
+			output.LogOutput{
+				Format: "plane %q NOT deleted",
+				Params: []any{"myplane"},
+			},
+		}
+		require.Equal(t, expected, outputSink.Writes)
+	})
+}
@@ -0,0 +1,67 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package plane
+
+import (
+	plane_create "github.com/radius-project/radius/pkg/cli/cmd/plane/create"
+	plane_delete "github.com/radius-project/radius/pkg/cli/cmd/plane/delete"
+	plane_list "github.com/radius-project/radius/pkg/cli/cmd/plane/list"
+	plane_show "github.com/radius-project/radius/pkg/cli/cmd/plane/show"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/spf13/cobra"
+)
+
+// NewCommand creates a new cobra command for managing planes, with subcommands for creating, deleting, listing and
+// showing planes.
+func NewCommand(factory framework.Factory) *cobra.Command {
+	// This command is not runnable, and thus has no runner.
+	cmd := &cobra.Command{
+		Use:   "plane",
+		Short: "Manage planes",
+		Long: `Manage planes
+
+Planes are the top-level scopes of UCP. A radius plane routes requests to Radius resource providers, while azure and aws planes route requests to the cloud providers.
+`,
+		Example: `
+# List planes
+rad plane list
+
+# Create a radius plane
+rad plane create radius myplane --resource-provider Applications.Core=http://applications-rp.radius-system:5443
+
+# Show details of a plane
+rad plane show aws aws
+
+# Delete a plane
+rad plane delete azure myplane
+`,
+	}
+
+	create, _ := plane_create.NewCommand(factory)
+	cmd.AddCommand(create)
+
+	delete, _ := plane_delete.NewCommand(factory)
+	cmd.AddCommand(delete)
+
+	list, _ := plane_list.NewCommand(factory)
+	cmd.AddCommand(list)
+
+	show, _ := plane_show.NewCommand(factory)
+	cmd.AddCommand(show)
+
+	return cmd
+}
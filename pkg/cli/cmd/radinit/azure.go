@@ -18,6 +18,7 @@ package radinit
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"sort"
 
@@ -27,6 +28,8 @@ import (
 	"github.com/radius-project/radius/pkg/cli/azure"
 	"github.com/radius-project/radius/pkg/cli/clierrors"
 	"github.com/radius-project/radius/pkg/cli/prompt"
+	corerp "github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/to"
 )
 
 const (
@@ -52,6 +55,7 @@ const (
 	azureServicePrincipalCreateInstructionsFmt    = "\nAn Azure service principal with a corresponding role assignment on your resource group is required to create Azure resources.\n\nFor example, you can create one using the following command:\n\033[36maz ad sp create-for-rbac --role Owner --scope /subscriptions/%s/resourceGroups/%s\033[0m\n\nFor more information refer to https://docs.microsoft.com/cli/azure/ad/sp?view=azure-cli-latest#az-ad-sp-create-for-rbac and https://aka.ms/azadsp-more\n\n"
 	azureServicePrincipalCredentialKind           = "Service Principal"
 	azureWorkloadIdenityCredentialKind            = "Workload Identity"
+	azureWorkloadIdentityNotAKSClusterFmt         = "\nThe Kubernetes cluster of context '%s' is not an AKS cluster of subscription '%s'. Enable the OIDC issuer and workload identity on the cluster and set the identity of the environment to use workload identity.\n\n"
 )
 
 func (r *Runner) enterAzureCloudProvider(ctx context.Context, options *initOptions) (*azure.Provider, error) {
@@ -310,3 +314,27 @@ func (r *Runner) buildAzureCredentialKind() ([]string, error) {
 		azureWorkloadIdenityCredentialKind,
 	}, nil
 }
+
+// enableAzureWorkloadIdentity enables the OIDC issuer and workload identity on the AKS cluster Radius is installed into,
+// and returns the identity settings of the environment. Nil is returned when the cluster is not an AKS cluster, in
+// which case workload identity must be set up manually.
+func (r *Runner) enableAzureWorkloadIdentity(ctx context.Context) (*corerp.IdentitySettings, error) {
+	host, err := r.getClusterHost(r.Options.Cluster.Context)
+	if err != nil {
+		return nil, err
+	}
+
+	subscriptionID := r.Options.CloudProviders.Azure.SubscriptionID
+	issuer, err := r.azureClient.EnableWorkloadIdentity(ctx, subscriptionID, host)
+	if errors.Is(err, azure.ErrManagedClusterNotFound) {
+		r.Output.LogInfo(azureWorkloadIdentityNotAKSClusterFmt, r.Options.Cluster.Context, subscriptionID)
+		return nil, nil
+	} else if err != nil {
+		return nil, clierrors.MessageWithCause(err, "Failed to enable workload identity on the AKS cluster.")
+	}
+
+	return &corerp.IdentitySettings{
+		Kind:       to.Ptr(corerp.IdentitySettingKindAzureComWorkload),
+		OidcIssuer: to.Ptr(issuer),
+	}, nil
+}
@@ -17,6 +17,7 @@ limitations under the License.
 package radinit
 
 import (
+	"net/url"
 	"sort"
 
 	"github.com/radius-project/radius/pkg/cli/clierrors"
@@ -93,3 +94,28 @@ func (r *Runner) buildClusterList(config *api.Config) []string {
 
 	return choices
 }
+
+// getClusterHost returns the host of the API server of the cluster of the given kubeconfig context.
+func (r *Runner) getClusterHost(kubeContext string) (string, error) {
+	config, err := r.KubernetesInterface.GetKubeContext()
+	if err != nil {
+		return "", clierrors.MessageWithCause(err, "Failed to read Kubernetes config.")
+	}
+
+	contextConfig, ok := config.Contexts[kubeContext]
+	if !ok {
+		return "", clierrors.Message("The kubeconfig context %q does not exist.", kubeContext)
+	}
+
+	cluster, ok := config.Clusters[contextConfig.Cluster]
+	if !ok {
+		return "", clierrors.Message("The cluster %q of kubeconfig context %q does not exist.", contextConfig.Cluster, kubeContext)
+	}
+
+	server, err := url.Parse(cluster.Server)
+	if err != nil {
+		return "", clierrors.MessageWithCause(err, "The server of cluster %q is invalid.", contextConfig.Cluster)
+	}
+
+	return server.Hostname(), nil
+}
@@ -22,6 +22,7 @@ import (
 	"strings"
 
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/cli/azure"
 	"github.com/radius-project/radius/pkg/cli/clierrors"
 	"github.com/radius-project/radius/pkg/cli/cmd"
 	"github.com/radius-project/radius/pkg/cli/prompt"
@@ -74,9 +75,18 @@ func (r *Runner) CreateEnvironment(ctx context.Context) error {
 		}
 	}
 
+	var identity *corerp.IdentitySettings
+	if azureProvider := r.Options.CloudProviders.Azure; azureProvider != nil && azureProvider.CredentialKind == azure.AzureCredentialKindWorkloadIdentity {
+		identity, err = r.enableAzureWorkloadIdentity(ctx)
+		if err != nil {
+			return err
+		}
+	}
+
 	envProperties := corerp.EnvironmentProperties{
 		Compute: &corerp.KubernetesCompute{
 			Namespace: to.Ptr(r.Options.Environment.Namespace),
+			Identity:  identity,
 		},
 		Providers: &providers,
 		Recipes:   recipes,
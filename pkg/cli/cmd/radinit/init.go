@@ -64,6 +64,11 @@ If an environment already exists, 'rad init' will prompt the user to use the exi
 By default, 'rad init' will optimize for a developer-focused environment with an environment named "default" and Recipes that support prototyping, development and testing using lightweight containers. These environments are great for building and testing your application.
 
 Specifying the '--full' flag will cause 'rad init' to prompt the user for all available configuration options such as Kubernetes context, environment name, and cloud providers. This is useful for fully customizing your environment.
+
+When setting up an application in the current directory, 'rad init' scaffolds it from a template. The built-in templates are:
+
+` + templateList() + `
+Organizations can publish their own templates to an OCI registry and configure it with the 'templates.registry' setting of the rad CLI configuration or the '--template-registry' flag. Each template is an artifact of type '` + setup.TemplateArtifactType + `' whose files are pushed as layers, for example with 'oras push <registry>/<template>:latest --artifact-type ` + setup.TemplateArtifactType + ` app.bicep'.
 `,
 		Example: `
 ## Create a new development environment named "default"
@@ -71,6 +76,12 @@ rad init
 
 ## Prompt the user for all available options to create a new environment
 rad init --full
+
+## Scaffold an application with a container, a gateway and a Redis cache provisioned by a recipe
+rad init --template web-redis
+
+## Scaffold an application from a template of your organization
+rad init --template web-api --template-registry myregistry.azurecr.io/templates
 `,
 		Args: cobra.ExactArgs(0),
 		RunE: framework.RunCommand(runner),
@@ -79,9 +90,21 @@ rad init --full
 	// Define your flags here
 	commonflags.AddOutputFlag(cmd)
 	cmd.Flags().Bool("full", false, "Prompt user for all available configuration options")
+	cmd.Flags().String(templateFlag, "", "The template of the application to scaffold. Defaults to '"+setup.DefaultTemplate+"'.")
+	cmd.Flags().String(templateRegistryFlag, "", "The OCI registry holding the templates of your organization, optionally followed by a repository prefix. Overrides the 'templates.registry' setting of the configuration.")
 	return cmd, runner
 }
 
+// templateList returns the list of built-in templates for the help text of the command.
+func templateList() string {
+	list := ""
+	for _, template := range setup.BuiltinTemplates {
+		list += fmt.Sprintf("- %s: %s\n", template.Name, template.Description)
+	}
+
+	return list
+}
+
 // Runner is the runner implementation for the `rad init` command.
 type Runner struct {
 	azureClient azure.Client
@@ -120,6 +143,12 @@ type Runner struct {
 	// Full determines whether or not we ask the user for all options.
 	Full bool
 
+	// Template is the name of the template of the scaffolded application.
+	Template string
+
+	// TemplateRegistry is the OCI registry holding the templates that are not built-in.
+	TemplateRegistry string
+
 	// Options provides the options to used for Radius initialization. This will be populated by Validate.
 	Options *initOptions
 }
@@ -163,6 +192,11 @@ func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
+	err = r.validateTemplate(cmd)
+	if err != nil {
+		return err
+	}
+
 	for {
 		options, workspace, err := r.enterInitOptions(cmd.Context())
 		if err != nil {
@@ -202,6 +236,16 @@ func (r *Runner) Run(ctx context.Context) error {
 	progressCompleteChan := make(chan error)
 	progress := progressMsg{}
 
+	// Read the template first so that an unavailable template does not leave a partial installation.
+	var template *setup.Template
+	if r.Options.Application.Scaffold {
+		var err error
+		template, err = r.getTemplate(ctx)
+		if err != nil {
+			return err
+		}
+	}
+
 	go func() {
 		// Show dynamic UI.
 		err := r.showProgress(ctx, r.Options, progressChan)
@@ -260,7 +304,7 @@ func (r *Runner) Run(ctx context.Context) error {
 			return err
 		}
 
-		err = setup.ScaffoldApplication(wd, r.Options.Application.Name)
+		err = setup.ScaffoldApplicationFromTemplate(wd, r.Options.Application.Name, template)
 		if err != nil {
 			return err
 		}
@@ -672,12 +672,14 @@ func Test_Validate(t *testing.T) {
 
 func Test_Run_InstallAndCreateEnvironment(t *testing.T) {
 	testCases := []struct {
-		name           string
-		full           bool
-		azureProvider  *azure.Provider
-		awsProvider    *aws.Provider
-		recipes        map[string]map[string]corerp.RecipePropertiesClassification
-		expectedOutput []any
+		name                      string
+		full                      bool
+		azureProvider             *azure.Provider
+		awsProvider               *aws.Provider
+		recipes                   map[string]map[string]corerp.RecipePropertiesClassification
+		enableWorkloadIdentityErr error
+		identity                  *corerp.IdentitySettings
+		expectedOutput            []any
 	}{
 		{
 			name:          "`rad init` with recipes",
@@ -730,10 +732,36 @@ func Test_Run_InstallAndCreateEnvironment(t *testing.T) {
 					ClientID: "test-clientId",
 				},
 			},
-			awsProvider:    nil,
-			recipes:        nil,
+			awsProvider: nil,
+			recipes:     nil,
+			identity: &corerp.IdentitySettings{
+				Kind:       to.Ptr(corerp.IdentitySettingKindAzureComWorkload),
+				OidcIssuer: to.Ptr("https://oidc.example.com/issuer"),
+			},
 			expectedOutput: []any{},
 		},
+		{
+			name: "`rad init --full` with Azure Provider - Workload Identity on a cluster which is not AKS",
+			full: true,
+			azureProvider: &azure.Provider{
+				SubscriptionID: "test-subscription",
+				ResourceGroup:  "test-rg",
+				CredentialKind: "WorkloadIdentity",
+				WorkloadIdentity: &azure.WorkloadIdentityCredential{
+					TenantID: "test-tenantId",
+					ClientID: "test-clientId",
+				},
+			},
+			awsProvider:               nil,
+			recipes:                   nil,
+			enableWorkloadIdentityErr: azure.ErrManagedClusterNotFound,
+			expectedOutput: []any{
+				output.LogOutput{
+					Format: azureWorkloadIdentityNotAKSClusterFmt,
+					Params: []any{"kind-kind", "test-subscription"},
+				},
+			},
+		},
 		{
 			name:          "`rad init` with AWS Provider",
 			full:          false,
@@ -822,9 +850,24 @@ func Test_Run_InstallAndCreateEnvironment(t *testing.T) {
 					Times(1)
 			}
 
+			azureClient := azure.NewMockClient(ctrl)
+			kubernetesClient := kubernetes.NewMockInterface(ctrl)
+			if tc.azureProvider != nil && tc.azureProvider.CredentialKind == azure.AzureCredentialKindWorkloadIdentity {
+				initGetKubeContextSuccess(kubernetesClient)
+				issuer := ""
+				if tc.identity != nil {
+					issuer = *tc.identity.OidcIssuer
+				}
+				azureClient.EXPECT().
+					EnableWorkloadIdentity(context.Background(), tc.azureProvider.SubscriptionID, "127.0.0.1").
+					Return(issuer, tc.enableWorkloadIdentityErr).
+					Times(1)
+			}
+
 			testEnvProperties := &corerp.EnvironmentProperties{
 				Compute: &corerp.KubernetesCompute{
 					Namespace: to.Ptr("defaultNamespace"),
+					Identity:  tc.identity,
 				},
 				Providers: buildProviders(tc.azureProvider, tc.awsProvider),
 				Recipes:   tc.recipes,
@@ -924,10 +967,12 @@ func Test_Run_InstallAndCreateEnvironment(t *testing.T) {
 				ConfigFileInterface: configFileInterface,
 				ConfigHolder:        &framework.ConfigHolder{ConfigFilePath: "filePath"},
 				HelmInterface:       helmInterface,
+				KubernetesInterface: kubernetesClient,
 				Output:              outputSink,
 				Prompter:            prompter,
 				DevRecipeClient:     devRecipeClient,
 				Options:             &options,
+				azureClient:         azureClient,
 				Workspace: &workspaces.Workspace{
 					Name: "default",
 				},
@@ -978,9 +1023,15 @@ func getTestKubeConfig() *api.Config {
 		"k3d-radius-dev": {Cluster: "k3d-radius-dev"},
 		"kind-kind":      {Cluster: "kind-kind"},
 	}
+	kubeClusters := map[string]*api.Cluster{
+		"docker-desktop": {Server: "https://kubernetes.docker.internal:6443"},
+		"k3d-radius-dev": {Server: "https://0.0.0.0:6443"},
+		"kind-kind":      {Server: "https://127.0.0.1:6443"},
+	}
 	return &api.Config{
 		CurrentContext: "kind-kind",
 		Contexts:       kubeContexts,
+		Clusters:       kubeClusters,
 	}
 }
 
@@ -0,0 +1,96 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package radinit
+
+import (
+	"context"
+
+	"github.com/spf13/cobra"
+
+	"github.com/radius-project/radius/pkg/cli"
+	"github.com/radius-project/radius/pkg/cli/bundle"
+	"github.com/radius-project/radius/pkg/cli/clierrors"
+	"github.com/radius-project/radius/pkg/cli/setup"
+)
+
+const (
+	templateFlag         = "template"
+	templateRegistryFlag = "template-registry"
+
+	templateNotFoundFmt = "The template %q is not a built-in template. Configure the registry of your organization's templates with '--%s' or the 'templates.registry' setting of the configuration."
+)
+
+// validateTemplate reads the template flags and the templates configuration, and validates that the template can be
+// found.
+func (r *Runner) validateTemplate(cmd *cobra.Command) error {
+	template, err := cmd.Flags().GetString(templateFlag)
+	if err != nil {
+		return err
+	}
+
+	registry, err := cmd.Flags().GetString(templateRegistryFlag)
+	if err != nil {
+		return err
+	}
+
+	if registry == "" && r.ConfigHolder.Config != nil {
+		section, err := cli.ReadTemplatesSection(r.ConfigHolder.Config)
+		if err != nil {
+			return err
+		}
+		registry = section.Registry
+	}
+
+	if template != "" && setup.GetBuiltinTemplate(template) == nil && registry == "" {
+		return clierrors.Message(templateNotFoundFmt, template, templateRegistryFlag)
+	}
+
+	r.Template = template
+	r.TemplateRegistry = registry
+	return nil
+}
+
+// getTemplate returns the template of the scaffolded application. Built-in templates take precedence over the templates
+// of the registry.
+func (r *Runner) getTemplate(ctx context.Context) (*setup.Template, error) {
+	name := r.Template
+	if name == "" {
+		name = setup.DefaultTemplate
+	}
+
+	if template := setup.GetBuiltinTemplate(name); template != nil {
+		return template, nil
+	}
+
+	if r.TemplateRegistry == "" {
+		return nil, clierrors.Message(templateNotFoundFmt, name, templateRegistryFlag)
+	}
+
+	reference := setup.TemplateReference(r.TemplateRegistry, name)
+	repo, ref, err := bundle.NewRepository(reference, false)
+	if err != nil {
+		return nil, clierrors.MessageWithCause(err, "The template reference %q is invalid.", reference)
+	}
+
+	template, err := setup.ReadTemplate(ctx, repo, ref.Reference)
+	if err != nil {
+		return nil, clierrors.MessageWithCause(err, "Failed to pull the template %q.", reference)
+	}
+	template.Name = name
+
+	return template, nil
+}
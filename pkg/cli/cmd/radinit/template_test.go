@@ -0,0 +1,117 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package radinit
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/radius-project/radius/pkg/cli/clierrors"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/setup"
+	"github.com/radius-project/radius/test/radcli"
+)
+
+func Test_validateTemplate(t *testing.T) {
+	configWithRegistry := radcli.LoadConfig(t, `
+templates:
+  registry: myregistry.azurecr.io/templates
+`)
+
+	testcases := []struct {
+		name             string
+		args             []string
+		config           framework.ConfigHolder
+		expectedErr      error
+		expectedTemplate string
+		expectedRegistry string
+	}{
+		{
+			name:   "default template",
+			args:   []string{},
+			config: framework.ConfigHolder{Config: radcli.LoadEmptyConfig(t)},
+		},
+		{
+			name:             "built-in template",
+			args:             []string{"--template", "web-redis"},
+			config:           framework.ConfigHolder{Config: radcli.LoadEmptyConfig(t)},
+			expectedTemplate: "web-redis",
+		},
+		{
+			name:             "template of configured registry",
+			args:             []string{"--template", "web-api"},
+			config:           framework.ConfigHolder{Config: configWithRegistry},
+			expectedTemplate: "web-api",
+			expectedRegistry: "myregistry.azurecr.io/templates",
+		},
+		{
+			name:             "template of registry flag",
+			args:             []string{"--template", "web-api", "--template-registry", "other.azurecr.io"},
+			config:           framework.ConfigHolder{Config: configWithRegistry},
+			expectedTemplate: "web-api",
+			expectedRegistry: "other.azurecr.io",
+		},
+		{
+			name:        "template without registry",
+			args:        []string{"--template", "web-api"},
+			config:      framework.ConfigHolder{Config: radcli.LoadEmptyConfig(t)},
+			expectedErr: clierrors.Message(templateNotFoundFmt, "web-api", templateRegistryFlag),
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			cmd, runner := NewCommand(&framework.Impl{ConfigHolder: &tc.config})
+			require.NoError(t, cmd.ParseFlags(tc.args))
+
+			r := runner.(*Runner)
+			err := r.validateTemplate(cmd)
+			if tc.expectedErr != nil {
+				require.Equal(t, tc.expectedErr, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedTemplate, r.Template)
+			require.Equal(t, tc.expectedRegistry, r.TemplateRegistry)
+		})
+	}
+}
+
+func Test_getTemplate(t *testing.T) {
+	t.Run("default template", func(t *testing.T) {
+		r := &Runner{}
+		template, err := r.getTemplate(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, setup.GetBuiltinTemplate(setup.DefaultTemplate), template)
+	})
+
+	t.Run("built-in template", func(t *testing.T) {
+		r := &Runner{Template: "web-sql", TemplateRegistry: "myregistry.azurecr.io/templates"}
+		template, err := r.getTemplate(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, setup.GetBuiltinTemplate("web-sql"), template)
+	})
+
+	t.Run("template without registry", func(t *testing.T) {
+		r := &Runner{Template: "web-api"}
+		_, err := r.getTemplate(context.Background())
+		require.Equal(t, clierrors.Message(templateNotFoundFmt, "web-api", templateRegistryFlag), err)
+	})
+}
@@ -18,6 +18,8 @@ package register
 
 import (
 	"context"
+	"slices"
+	"strings"
 
 	"github.com/radius-project/radius/pkg/cli"
 	"github.com/radius-project/radius/pkg/cli/bicep"
@@ -232,5 +234,15 @@ func requireRecipeProperties(cmd *cobra.Command) (templateKind, templatePath, te
 	if err != nil {
 		return "", "", "", err
 	}
+
+	if !slices.Contains(recipes.SupportedTemplateKind, templateKind) {
+		return "", "", "", clierrors.Message("Template kind %q is not supported. Supported kinds are: %s.", templateKind, strings.Join(recipes.SupportedTemplateKind, ", "))
+	}
+
+	// The template version is an option of the terraform driver. Bicep templates are versioned by their path.
+	if templateVersion != "" && templateKind != recipes.TemplateKindTerraform {
+		return "", "", "", clierrors.Message("The '--template-version' flag is only supported for template kind %q.", recipes.TemplateKindTerraform)
+	}
+
 	return templateKind, templatePath, templateVersion, nil
 }
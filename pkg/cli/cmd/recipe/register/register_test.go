@@ -117,6 +117,24 @@ func Test_Validate(t *testing.T) {
 				Config:         configWithWorkspace,
 			},
 		},
+		{
+			Name:          "Register Command with unsupported template kind",
+			Input:         []string{"test_recipe", "--template-kind", "helm", "--template-path", "test_template", "--resource-type", ds_ctrl.MongoDatabasesResourceType},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+		{
+			Name:          "Register Command with template version for bicep recipe",
+			Input:         []string{"test_recipe", "--template-kind", recipes.TemplateKindBicep, "--template-path", "test_template", "--resource-type", ds_ctrl.MongoDatabasesResourceType, "--template-version", "1.1.0"},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
 		{
 			Name:          "Register Command with too many args",
 			Input:         []string{"foo", "bar"},
@@ -23,6 +23,7 @@ import (
 	"github.com/radius-project/radius/pkg/cli"
 	"github.com/radius-project/radius/pkg/cli/clients"
 	"github.com/radius-project/radius/pkg/cli/cmd/commonflags"
+	"github.com/radius-project/radius/pkg/cli/completion"
 	"github.com/radius-project/radius/pkg/cli/connections"
 	"github.com/radius-project/radius/pkg/cli/framework"
 	"github.com/radius-project/radius/pkg/cli/output"
@@ -55,8 +56,9 @@ func NewCommand(factory framework.Factory) (*cobra.Command, framework.Runner) {
 		
 		# Delete a container named orders
 		rad resource delete containers orders`,
-		Args: cobra.ExactArgs(2),
-		RunE: framework.RunCommand(runner),
+		Args:              cobra.ExactArgs(2),
+		ValidArgsFunction: completion.FirstArg(completion.ResourceTypes(factory)),
+		RunE:              framework.RunCommand(runner),
 	}
 
 	commonflags.AddOutputFlag(cmd)
@@ -24,6 +24,7 @@ import (
 	"github.com/radius-project/radius/pkg/cli/clients_new/generated"
 	"github.com/radius-project/radius/pkg/cli/clierrors"
 	"github.com/radius-project/radius/pkg/cli/cmd/commonflags"
+	"github.com/radius-project/radius/pkg/cli/completion"
 	"github.com/radius-project/radius/pkg/cli/connections"
 	"github.com/radius-project/radius/pkg/cli/framework"
 	"github.com/radius-project/radius/pkg/cli/objectformats"
@@ -58,8 +59,9 @@ func NewCommand(factory framework.Factory) (*cobra.Command, framework.Runner) {
 	# list all resources of a specified type in an application (shorthand flag)
 	rad resource list containers -a icecream-store
 	`,
-		Args: cobra.ExactArgs(1),
-		RunE: framework.RunCommand(runner),
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: completion.FirstArg(completion.ResourceTypes(factory)),
+		RunE:              framework.RunCommand(runner),
 	}
 
 	commonflags.AddApplicationNameFlag(cmd)
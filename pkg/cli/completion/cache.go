@@ -0,0 +1,97 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package completion
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+const (
+	// DefaultTTL is the duration for which completion values are cached.
+	DefaultTTL = 30 * time.Second
+)
+
+// Cache is a file cache of completion values. The shell runs a new rad process for each completion, so the values
+// are cached on disk to avoid querying UCP on every keystroke.
+type Cache struct {
+	// Dir is the directory of the cache files.
+	Dir string
+
+	// TTL is the duration for which cached values are used.
+	TTL time.Duration
+
+	now func() time.Time
+}
+
+type cacheEntry struct {
+	Expires time.Time `json:"expires"`
+	Values  []string  `json:"values"`
+}
+
+// NewCache creates a cache in the user cache directory with the default TTL.
+func NewCache() *Cache {
+	dir, err := os.UserCacheDir()
+	if err != nil {
+		dir = os.TempDir()
+	}
+
+	return &Cache{
+		Dir: filepath.Join(dir, "rad", "completion"),
+		TTL: DefaultTTL,
+		now: time.Now,
+	}
+}
+
+// Get returns the cached values of the key if they have not expired. Otherwise it calls fetch and caches the values it
+// returns.
+func (c *Cache) Get(key string, fetch func() ([]string, error)) ([]string, error) {
+	hash := sha256.Sum256([]byte(key))
+	path := filepath.Join(c.Dir, hex.EncodeToString(hash[:])+".json")
+
+	entry := cacheEntry{}
+	if b, err := os.ReadFile(path); err == nil && json.Unmarshal(b, &entry) == nil && c.now().Before(entry.Expires) {
+		return entry.Values, nil
+	}
+
+	values, err := fetch()
+	if err != nil {
+		return nil, err
+	}
+
+	// Failing to write the cache only means that the next completion queries UCP again.
+	_ = c.write(path, cacheEntry{Expires: c.now().Add(c.TTL), Values: values})
+
+	return values, nil
+}
+
+func (c *Cache) write(path string, entry cacheEntry) error {
+	b, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(c.Dir, 0700); err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, b, 0600)
+}
@@ -0,0 +1,83 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package completion
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func newTestCache(t *testing.T, now *time.Time) *Cache {
+	return &Cache{
+		Dir: t.TempDir(),
+		TTL: DefaultTTL,
+		now: func() time.Time { return *now },
+	}
+}
+
+func Test_Cache_Get(t *testing.T) {
+	now := time.Now()
+	cache := newTestCache(t, &now)
+
+	calls := 0
+	fetch := func() ([]string, error) {
+		calls++
+		return []string{"dev", "prod"}, nil
+	}
+
+	values, err := cache.Get("environments", fetch)
+	require.NoError(t, err)
+	require.Equal(t, []string{"dev", "prod"}, values)
+	require.Equal(t, 1, calls)
+
+	// Cached
+	values, err = cache.Get("environments", fetch)
+	require.NoError(t, err)
+	require.Equal(t, []string{"dev", "prod"}, values)
+	require.Equal(t, 1, calls)
+
+	// Different key
+	_, err = cache.Get("applications", fetch)
+	require.NoError(t, err)
+	require.Equal(t, 2, calls)
+
+	// Expired
+	now = now.Add(DefaultTTL)
+	_, err = cache.Get("environments", fetch)
+	require.NoError(t, err)
+	require.Equal(t, 3, calls)
+}
+
+func Test_Cache_Get_Error(t *testing.T) {
+	now := time.Now()
+	cache := newTestCache(t, &now)
+
+	_, err := cache.Get("environments", func() ([]string, error) {
+		return nil, errors.New("connection refused")
+	})
+	require.EqualError(t, err, "connection refused")
+
+	// Errors are not cached
+	values, err := cache.Get("environments", func() ([]string, error) {
+		return []string{"dev"}, nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{"dev"}, values)
+}
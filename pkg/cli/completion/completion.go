@@ -0,0 +1,207 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package completion implements dynamic shell completion of the names of Radius resources, queried from UCP.
+package completion
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/radius-project/radius/pkg/cli"
+	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	"github.com/radius-project/radius/pkg/to"
+	ucp "github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	resources_radius "github.com/radius-project/radius/pkg/ucp/resources/radius"
+)
+
+const (
+	// queryTimeout is the timeout of the UCP queries of a completion. Completion must not block the shell when UCP is
+	// unreachable.
+	queryTimeout = 5 * time.Second
+
+	// defaultPlaneName is the name of the radius plane used when the workspace scope does not include one.
+	defaultPlaneName = "local"
+)
+
+// Func is a cobra completion function, as used by ValidArgsFunction and RegisterFlagCompletionFunc.
+type Func func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective)
+
+type listFunc func(ctx context.Context, client clients.ApplicationsManagementClient, workspace *workspaces.Workspace) ([]string, error)
+
+var defaultCache = NewCache()
+
+// EnvironmentNames returns a completion function for the names of the environments in the scope of the workspace.
+func EnvironmentNames(factory framework.Factory) Func {
+	return complete(factory, defaultCache, "environments", listEnvironmentNames)
+}
+
+// ApplicationNames returns a completion function for the names of the applications in the scope of the workspace.
+func ApplicationNames(factory framework.Factory) Func {
+	return complete(factory, defaultCache, "applications", listApplicationNames)
+}
+
+// ResourceTypes returns a completion function for the resource types served by the radius plane of the workspace.
+func ResourceTypes(factory framework.Factory) Func {
+	return complete(factory, defaultCache, "resourcetypes", listResourceTypes)
+}
+
+// FirstArg wraps a completion function so that it only completes the first positional argument.
+func FirstArg(f Func) Func {
+	return func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		if len(args) > 0 {
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		}
+
+		return f(cmd, args, toComplete)
+	}
+}
+
+// RegisterFlags registers the completion functions of the environment, application and resource type flags of the
+// command and all of its subcommands.
+func RegisterFlags(cmd *cobra.Command, factory framework.Factory) {
+	flags := map[string]Func{
+		"environment":        EnvironmentNames(factory),
+		"application":        ApplicationNames(factory),
+		cli.ResourceTypeFlag: ResourceTypes(factory),
+	}
+
+	for name, f := range flags {
+		if cmd.Flags().Lookup(name) != nil {
+			// An error means that a completion function is already registered for the flag.
+			_ = cmd.RegisterFlagCompletionFunc(name, f)
+		}
+	}
+
+	for _, child := range cmd.Commands() {
+		RegisterFlags(child, factory)
+	}
+}
+
+func complete(factory framework.Factory, cache *Cache, kind string, list listFunc) Func {
+	return func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		config := factory.GetConfigHolder()
+		workspace, err := cli.RequireWorkspace(cmd, config.Config, config.DirectoryConfig)
+		if err != nil {
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		}
+
+		// Commands without a '--group' flag complete in the scope of the workspace.
+		if scope, err := cli.RequireScope(cmd, *workspace); err == nil {
+			workspace.Scope = scope
+		}
+
+		ctx := cmd.Context()
+		if ctx == nil {
+			ctx = context.Background()
+		}
+		ctx, cancel := context.WithTimeout(ctx, queryTimeout)
+		defer cancel()
+
+		key := strings.Join([]string{kind, workspace.Name, fmt.Sprint(workspace.Connection), workspace.Scope}, "\n")
+		values, err := cache.Get(key, func() ([]string, error) {
+			client, err := factory.GetConnectionFactory().CreateApplicationsManagementClient(ctx, *workspace)
+			if err != nil {
+				return nil, err
+			}
+
+			return list(ctx, client, workspace)
+		})
+		if err != nil {
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		}
+
+		completions := []string{}
+		for _, value := range values {
+			if strings.HasPrefix(strings.ToLower(value), strings.ToLower(toComplete)) {
+				completions = append(completions, value)
+			}
+		}
+
+		return completions, cobra.ShellCompDirectiveNoFileComp
+	}
+}
+
+func listEnvironmentNames(ctx context.Context, client clients.ApplicationsManagementClient, workspace *workspaces.Workspace) ([]string, error) {
+	environments, err := client.ListEnvironments(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	names := []string{}
+	for _, environment := range environments {
+		names = append(names, to.String(environment.Name))
+	}
+
+	slices.Sort(names)
+	return names, nil
+}
+
+func listApplicationNames(ctx context.Context, client clients.ApplicationsManagementClient, workspace *workspaces.Workspace) ([]string, error) {
+	applications, err := client.ListApplications(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	names := []string{}
+	for _, application := range applications {
+		names = append(names, to.String(application.Name))
+	}
+
+	slices.Sort(names)
+	return names, nil
+}
+
+// listResourceTypes lists the resource types supported by the CLI whose namespace has a resource provider registered
+// in the radius plane of the workspace.
+func listResourceTypes(ctx context.Context, client clients.ApplicationsManagementClient, workspace *workspaces.Workspace) ([]string, error) {
+	planeName := defaultPlaneName
+	if id, err := resources.ParseScope(workspace.Scope); err == nil && id.FindScope(resources_radius.PlaneTypeRadius) != "" {
+		planeName = id.FindScope(resources_radius.PlaneTypeRadius)
+	}
+
+	resource, err := client.GetPlane(ctx, clients.PlaneTypeRadius, planeName)
+	if err != nil {
+		return nil, err
+	}
+
+	plane, ok := resource.(ucp.RadiusPlaneResource)
+	if !ok || plane.Properties == nil {
+		return nil, fmt.Errorf("unexpected plane resource %T", resource)
+	}
+
+	resourceTypes := []string{}
+	for _, resourceType := range clients.ResourceTypesList {
+		namespace, _, _ := strings.Cut(resourceType, "/")
+		for registered := range plane.Properties.ResourceProviders {
+			if strings.EqualFold(namespace, registered) {
+				resourceTypes = append(resourceTypes, resourceType)
+				break
+			}
+		}
+	}
+
+	slices.Sort(resourceTypes)
+	return resourceTypes, nil
+}
@@ -0,0 +1,165 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package completion
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/radius-project/radius/pkg/cli"
+	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/cli/cmd/commonflags"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	corerp "github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/to"
+	ucp "github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+	"github.com/radius-project/radius/test/radcli"
+)
+
+func newTestCommand() *cobra.Command {
+	cmd := &cobra.Command{Use: "test"}
+	commonflags.AddWorkspaceFlag(cmd)
+	commonflags.AddResourceGroupFlag(cmd)
+	commonflags.AddEnvironmentNameFlag(cmd)
+	commonflags.AddApplicationNameFlag(cmd)
+	commonflags.AddResourceTypeFlag(cmd)
+	return cmd
+}
+
+func Test_Complete(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
+	appManagementClient.EXPECT().
+		ListEnvironments(gomock.Any()).
+		Return([]corerp.EnvironmentResource{{Name: to.Ptr("prod")}, {Name: to.Ptr("dev")}, {Name: to.Ptr("Dev-2")}}, nil).
+		Times(1)
+
+	factory := &framework.Impl{
+		ConfigHolder:      &framework.ConfigHolder{Config: radcli.LoadConfigWithWorkspace(t)},
+		ConnectionFactory: &connections.MockFactory{ApplicationsManagementClient: appManagementClient},
+	}
+
+	now := time.Now()
+	f := complete(factory, newTestCache(t, &now), "environments", listEnvironmentNames)
+
+	cmd := newTestCommand()
+	cmd.SetContext(context.Background())
+
+	completions, directive := f(cmd, []string{}, "")
+	require.Equal(t, []string{"Dev-2", "dev", "prod"}, completions)
+	require.Equal(t, cobra.ShellCompDirectiveNoFileComp, directive)
+
+	// The second completion uses the cache.
+	completions, directive = f(cmd, []string{}, "de")
+	require.Equal(t, []string{"Dev-2", "dev"}, completions)
+	require.Equal(t, cobra.ShellCompDirectiveNoFileComp, directive)
+}
+
+func Test_Complete_Error(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
+	appManagementClient.EXPECT().
+		ListApplications(gomock.Any()).
+		Return(nil, errors.New("connection refused")).
+		Times(1)
+
+	factory := &framework.Impl{
+		ConfigHolder:      &framework.ConfigHolder{Config: radcli.LoadConfigWithWorkspace(t)},
+		ConnectionFactory: &connections.MockFactory{ApplicationsManagementClient: appManagementClient},
+	}
+
+	now := time.Now()
+	f := complete(factory, newTestCache(t, &now), "applications", listApplicationNames)
+
+	completions, directive := f(newTestCommand(), []string{}, "")
+	require.Empty(t, completions)
+	require.Equal(t, cobra.ShellCompDirectiveNoFileComp, directive)
+}
+
+func Test_FirstArg(t *testing.T) {
+	called := false
+	f := FirstArg(func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		called = true
+		return []string{"prod"}, cobra.ShellCompDirectiveNoFileComp
+	})
+
+	completions, _ := f(newTestCommand(), []string{"prod"}, "")
+	require.Empty(t, completions)
+	require.False(t, called)
+
+	completions, _ = f(newTestCommand(), []string{}, "")
+	require.Equal(t, []string{"prod"}, completions)
+	require.True(t, called)
+}
+
+func Test_RegisterFlags(t *testing.T) {
+	root := &cobra.Command{Use: "rad"}
+	child := newTestCommand()
+	root.AddCommand(child)
+
+	RegisterFlags(root, &framework.Impl{})
+
+	for _, name := range []string{"environment", "application", cli.ResourceTypeFlag} {
+		_, ok := child.GetFlagCompletionFunc(name)
+		require.True(t, ok, "flag %q has no completion function", name)
+	}
+}
+
+func Test_listResourceTypes(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	plane := ucp.RadiusPlaneResource{
+		Properties: &ucp.RadiusPlaneResourceProperties{
+			ResourceProviders: map[string]*string{
+				"Applications.Core":       to.Ptr("http://applications-rp.radius-system:5443"),
+				"Applications.Datastores": to.Ptr("http://applications-rp.radius-system:5443"),
+				"Microsoft.Resources":     to.Ptr("http://bicep-de.radius-system:6443"),
+			},
+		},
+	}
+
+	appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
+	appManagementClient.EXPECT().
+		GetPlane(gomock.Any(), "radius", "local").
+		Return(plane, nil).
+		Times(1)
+
+	workspace := &workspaces.Workspace{Scope: "/planes/radius/local/resourceGroups/test-group"}
+	resourceTypes, err := listResourceTypes(context.Background(), appManagementClient, workspace)
+	require.NoError(t, err)
+
+	expected := []string{
+		"Applications.Core/containers",
+		"Applications.Core/extenders",
+		"Applications.Core/gateways",
+		"Applications.Core/secretStores",
+		"Applications.Datastores/mongoDatabases",
+		"Applications.Datastores/redisCaches",
+		"Applications.Datastores/sqlDatabases",
+	}
+	require.Equal(t, expected, resourceTypes)
+}
@@ -41,6 +41,7 @@ import (
 const (
 	ApplicationKey string = "application"
 	WorkspacesKey  string = "workspaces"
+	TemplatesKey   string = "templates"
 )
 
 type WorkspaceSection struct {
@@ -132,6 +133,30 @@ func ReadWorkspaceSection(v *viper.Viper) (WorkspaceSection, error) {
 	return section, nil
 }
 
+// TemplatesSection is the configuration of the application templates scaffolded by `rad init`.
+type TemplatesSection struct {
+	// Registry is the OCI registry holding the application templates of the organization, optionally followed by a
+	// repository prefix. For example "myregistry.azurecr.io/templates".
+	Registry string `json:"registry" mapstructure:"registry" yaml:"registry"`
+}
+
+// ReadTemplatesSection reads the TemplatesSection from the given viper instance. If the TemplatesSection is not present,
+// an empty one is returned.
+func ReadTemplatesSection(v *viper.Viper) (TemplatesSection, error) {
+	section := TemplatesSection{}
+	s := v.Sub(TemplatesKey)
+	if s == nil {
+		return section, nil
+	}
+
+	err := s.UnmarshalExact(&section)
+	if err != nil {
+		return TemplatesSection{}, err
+	}
+
+	return section, nil
+}
+
 // UpdateWorkspaceSection updates the WorkspacesKey in the given viper instance with the given WorkspaceSection.
 func UpdateWorkspaceSection(v *viper.Viper, section WorkspaceSection) {
 	v.Set(WorkspacesKey, section)
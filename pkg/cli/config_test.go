@@ -61,6 +61,45 @@ workspaces:
 	require.Len(t, es.Items, 2)
 }
 
+func Test_ReadTemplatesSection(t *testing.T) {
+	t.Run("no content", func(t *testing.T) {
+		v, err := makeConfig(``)
+		require.NoError(t, err)
+
+		section, err := ReadTemplatesSection(v)
+		require.NoError(t, err)
+		require.Empty(t, section.Registry)
+	})
+
+	t.Run("registry", func(t *testing.T) {
+		var yaml = `
+templates:
+  registry: myregistry.azurecr.io/templates
+`
+
+		v, err := makeConfig(yaml)
+		require.NoError(t, err)
+
+		section, err := ReadTemplatesSection(v)
+		require.NoError(t, err)
+		require.Equal(t, "myregistry.azurecr.io/templates", section.Registry)
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		var yaml = `
+templates:
+  registry: myregistry.azurecr.io/templates
+  unknown: value
+`
+
+		v, err := makeConfig(yaml)
+		require.NoError(t, err)
+
+		_, err = ReadTemplatesSection(v)
+		require.Error(t, err)
+	})
+}
+
 func Test_ReadWorkspaceSection_Invalid_NoConnection(t *testing.T) {
 	var yaml = `
 workspaces:
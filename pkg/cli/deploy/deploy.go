@@ -41,7 +41,12 @@ func DeployWithProgress(ctx context.Context, options Options) (clients.Deploymen
 
 	// Watch for progress while we're deploying.
 	progressChan := make(chan clients.ResourceProgress, 1)
-	listener := NewProgressListener(progressChan)
+	var listener ProgressListener
+	if options.Watch {
+		listener = NewWatchProgressListener(progressChan)
+	} else {
+		listener = NewProgressListener(progressChan)
+	}
 	wg := &sync.WaitGroup{}
 	wg.Add(1)
 	go func() {
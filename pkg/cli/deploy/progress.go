@@ -20,6 +20,7 @@ import (
 	"fmt"
 	"os"
 	"sync"
+	"text/tabwriter"
 	"time"
 
 	"github.com/gosuri/uilive"
@@ -44,6 +45,17 @@ func NewProgressListener(progressChan <-chan clients.ResourceProgress) ProgressL
 	}
 }
 
+// NewWatchProgressListener creates a new ProgressListener which displays the state and status message of each resource.
+// It returns a TableListener which renders a live table if the output is a terminal, and a LogListener which logs
+// each change otherwise.
+func NewWatchProgressListener(progressChan <-chan clients.ResourceProgress) ProgressListener {
+	if isatty.IsTerminal(os.Stdout.Fd()) {
+		return &TableListener{progressChan: progressChan}
+	} else {
+		return &LogListener{progressChan: progressChan}
+	}
+}
+
 type ProgressListener interface {
 	// Run is called to print progress to the command line. This should be called from
 	// a goroutine because it blocks until the progress channel is closed.
@@ -169,3 +181,92 @@ func (listener *InteractiveListener) Run() {
 	close(progressDone)
 	<-writerDone
 }
+
+type TableListener struct {
+	progressChan <-chan clients.ResourceProgress
+	mutex        sync.Mutex
+	rows         []clients.ResourceProgress
+}
+
+// Run() renders a live table of the resources of the deployment with their state and status message, updating it
+// for each resource update received from the progressChan channel.
+func (listener *TableListener) Run() {
+	ticker := time.NewTicker(500 * time.Millisecond)
+
+	progressDone := make(chan struct{})
+	writerDone := make(chan struct{})
+
+	go func() {
+		writer := uilive.New()
+		writer.Start()
+
+		paint := func() {
+			listener.mutex.Lock()
+			defer listener.mutex.Unlock()
+
+			table := tabwriter.NewWriter(writer, 0, 0, 2, ' ', 0)
+			fmt.Fprintln(table, "RESOURCE\tSTATE\tMESSAGE")
+			for _, row := range listener.rows {
+				fmt.Fprintf(table, "%s\t%s\t%s\n", output.FormatResourceForDisplay(row.Resource), row.State, row.Message)
+			}
+			_ = table.Flush()
+		}
+
+	writer:
+		for {
+			select {
+			case <-progressDone:
+				paint() // Update UI once then terminate
+				break writer
+			case <-ticker.C:
+				paint()
+			}
+		}
+
+		writer.Stop()
+		close(writerDone)
+	}()
+
+	// Storage for resources we've already 'seen'. This doesn't need to be accessed concurrently.
+	resourceToRowIndexMap := map[string]int{}
+
+	for update := range listener.progressChan {
+		if !output.ShowResource(update.Resource) {
+			continue
+		}
+
+		listener.mutex.Lock()
+		row, found := resourceToRowIndexMap[update.Resource.String()]
+		if !found {
+			listener.rows = append(listener.rows, update)
+			resourceToRowIndexMap[update.Resource.String()] = len(listener.rows) - 1
+		} else {
+			listener.rows[row] = update
+		}
+		listener.mutex.Unlock()
+	}
+
+	// Force a final UI update and drain any updates in progress.
+	ticker.Stop()
+	close(progressDone)
+	<-writerDone
+}
+
+type LogListener struct {
+	progressChan <-chan clients.ResourceProgress
+}
+
+// Run() logs the state and status message of each resource update received from the progressChan channel.
+func (listener *LogListener) Run() {
+	for update := range listener.progressChan {
+		if !output.ShowResource(update.Resource) {
+			continue
+		}
+
+		if update.Message == "" {
+			output.LogInfo("%s %s", output.FormatResourceForDisplay(update.Resource), update.State)
+		} else {
+			output.LogInfo("%s %s: %s", output.FormatResourceForDisplay(update.Resource), update.State, update.Message)
+		}
+	}
+}
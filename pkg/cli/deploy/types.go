@@ -53,6 +53,9 @@ type Options struct {
 
 	// CompleteText is a message displayed on the console when deployment completes.
 	CompletionText string
+
+	// Watch configures the deployment to display the state and status message of each resource while deploying.
+	Watch bool
 }
 
 var _ Interface = (*Impl)(nil)
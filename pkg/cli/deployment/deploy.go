@@ -222,8 +222,8 @@ func (dc *ResourceDeploymentClient) monitorProgress(ctx context.Context, name st
 	// Also nothing listens to errors if we report them here. It's just a convenient way to degrade gracefully
 	// in the event of an issue.
 
-	// We need to track the status so we can report the deltas
-	status := map[string]clients.ResourceStatus{}
+	// We need to track the provisioning state so we can report the deltas
+	states := map[string]string{}
 
 	// Now loop forever for updates. We're relying on cancellation of the context to terminate.
 	for ctx.Err() == nil {
@@ -260,8 +260,6 @@ func (dc *ResourceDeploymentClient) monitorProgress(ctx context.Context, name st
 				}()
 			}
 
-			current := status[id.String()]
-
 			next := clients.StatusStarted
 			if v1.ProvisioningStateSucceeded == provisioningState {
 				next = clients.StatusCompleted
@@ -269,11 +267,13 @@ func (dc *ResourceDeploymentClient) monitorProgress(ctx context.Context, name st
 				next = clients.StatusFailed
 			}
 
-			if current != next && progressChan != nil {
-				status[id.String()] = next
+			if states[id.String()] != string(provisioningState) && progressChan != nil {
+				states[id.String()] = string(provisioningState)
 				progressChan <- clients.ResourceProgress{
 					Resource: id,
 					Status:   next,
+					State:    string(provisioningState),
+					Message:  operationMessage(operation),
 				}
 			}
 		}
@@ -282,6 +282,15 @@ func (dc *ResourceDeploymentClient) monitorProgress(ctx context.Context, name st
 	return nil
 }
 
+// operationMessage returns the error message of the operation, if any.
+func operationMessage(operation *armresources.DeploymentOperation) string {
+	statusMessage := operation.Properties.StatusMessage
+	if statusMessage == nil || statusMessage.Error == nil || statusMessage.Error.Message == nil {
+		return ""
+	}
+	return *statusMessage.Error.Message
+}
+
 func (dc *ResourceDeploymentClient) listOperations(ctx context.Context, name string) ([]*armresources.DeploymentOperation, error) {
 	var resourceId string
 
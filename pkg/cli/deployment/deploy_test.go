@@ -19,6 +19,8 @@ package deployment
 import (
 	"testing"
 
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/resources/armresources"
 	"github.com/radius-project/radius/pkg/cli/clients"
 	sdkclients "github.com/radius-project/radius/pkg/sdk/clients"
 	"github.com/stretchr/testify/require"
@@ -91,3 +93,13 @@ func Test_GetProviderConfigsWithAzProvider(t *testing.T) {
 	providerConfig := resourceDeploymentClient.GetProviderConfigs(options)
 	require.Equal(t, providerConfig, expectedConfig)
 }
+
+func Test_OperationMessage(t *testing.T) {
+	operation := &armresources.DeploymentOperation{Properties: &armresources.DeploymentOperationProperties{}}
+	require.Equal(t, "", operationMessage(operation))
+
+	operation.Properties.StatusMessage = &armresources.StatusMessage{
+		Error: &armresources.ErrorResponse{Message: to.Ptr("recipe failed")},
+	}
+	require.Equal(t, "recipe failed", operationMessage(operation))
+}
@@ -19,6 +19,7 @@ package deployment
 import (
 	"context"
 	"fmt"
+	"sort"
 	"strings"
 
 	"github.com/radius-project/radius/pkg/cli/clients"
@@ -35,9 +36,12 @@ import (
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	k8s "k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/scheme"
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/portforward"
+	"k8s.io/client-go/tools/remotecommand"
 	"k8s.io/client-go/transport/spdy"
+	"k8s.io/kubectl/pkg/util/term"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
@@ -106,13 +110,142 @@ func (dc *ARMDiagnosticsClient) Expose(ctx context.Context, options clients.Expo
 	ready := make(chan struct{})
 	stop = make(chan struct{}, 1)
 	go func() {
-		err := runPortforward(dc.RestConfig, dc.K8sTypedClient, replica, ready, stop, options.Port, options.RemotePort)
+		err := runPortforward(dc.RestConfig, dc.K8sTypedClient, replica, ready, stop, []string{fmt.Sprintf("%d:%d", options.Port, options.RemotePort)})
 		failed <- err
 	}()
 
 	return
 }
 
+// Exec finds a running replica of the container from the Kubernetes deployment in the output resources of the container,
+// and runs the command in the replica, streaming stdin, stdout and stderr until the command exits.
+func (dc *ARMDiagnosticsClient) Exec(ctx context.Context, options clients.ExecOptions) error {
+	namespace, deployment, err := dc.findDeploymentOfContainer(ctx, options.Resource)
+	if err != nil {
+		return err
+	}
+
+	var replica *corev1.Pod
+	if options.Replica != "" {
+		replica, err = getSpecificReplica(ctx, dc.K8sTypedClient, namespace, options.Resource, options.Replica)
+	} else {
+		replica, err = getRunningReplicaOfDeployment(ctx, dc.K8sTypedClient, namespace, deployment, options.Resource)
+	}
+	if err != nil {
+		return err
+	}
+
+	container := options.Container
+	if container == "" {
+		container = getAppContainerName(replica)
+		if container == "" {
+			return fmt.Errorf("failed to find the default container for resource '%s'. use '--container <name>' to specify the name", options.Resource)
+		}
+	}
+
+	return runExec(ctx, dc.RestConfig, dc.K8sTypedClient, replica, container, options)
+}
+
+// findDeploymentOfContainer returns the namespace and name of the Kubernetes deployment in the output resources of the
+// container.
+func (dc *ARMDiagnosticsClient) findDeploymentOfContainer(ctx context.Context, resourceName string) (string, string, error) {
+	containerResponse, err := dc.ContainerClient.Get(ctx, resourceName, nil)
+	if err != nil {
+		return "", "", fmt.Errorf("could not find container %q:%w", resourceName, err)
+	}
+
+	status, _ := containerResponse.Properties["status"].(map[string]any)
+	outputResources, _ := status["outputResources"].([]any)
+	for _, obj := range outputResources {
+		outputResource, _ := obj.(map[string]any)
+		id, ok := outputResource["id"].(string)
+		if !ok {
+			continue
+		}
+
+		parsed, err := resources.ParseResource(id)
+		if err != nil || !strings.EqualFold(parsed.Type(), "apps/Deployment") {
+			continue
+		}
+
+		return parsed.FindScope("namespaces"), parsed.Name(), nil
+	}
+
+	return "", "", fmt.Errorf("could not find a Kubernetes deployment for container %q. Exec is only supported for containers running on Kubernetes", resourceName)
+}
+
+// PortForward finds a healthy replica of the container and forwards the local ports to it until ctx is canceled. The
+// ports declared by the container are forwarded if no ports are specified.
+func (dc *ARMDiagnosticsClient) PortForward(ctx context.Context, options clients.PortForwardOptions) error {
+	namespace, err := dc.findNamespaceOfContainer(ctx, options.Resource)
+	if err != nil {
+		return err
+	}
+
+	mappings := options.Ports
+	if len(mappings) == 0 {
+		mappings, err = dc.findPortsOfContainer(ctx, options.Resource)
+		if err != nil {
+			return err
+		}
+	}
+
+	var replica *corev1.Pod
+	if options.Replica != "" {
+		replica, err = getSpecificReplica(ctx, dc.K8sTypedClient, namespace, options.Resource, options.Replica)
+	} else {
+		replica, err = getHealthyReplica(ctx, dc.K8sTypedClient, namespace, options.Application, options.Resource)
+	}
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Forwarding replica %s\n", replica.Name)
+
+	ports := []string{}
+	for _, mapping := range mappings {
+		ports = append(ports, fmt.Sprintf("%d:%d", mapping.LocalPort, mapping.RemotePort))
+	}
+
+	ready := make(chan struct{})
+	stop := make(chan struct{})
+	go func() {
+		<-ctx.Done()
+		close(stop)
+	}()
+
+	return runPortforward(dc.RestConfig, dc.K8sTypedClient, replica, ready, stop, ports)
+}
+
+// findPortsOfContainer returns the ports declared by the container, mapped to the same local ports.
+func (dc *ARMDiagnosticsClient) findPortsOfContainer(ctx context.Context, resourceName string) ([]clients.PortMapping, error) {
+	containerResponse, err := dc.ContainerClient.Get(ctx, resourceName, nil)
+	if err != nil {
+		return nil, fmt.Errorf("could not find container %q:%w", resourceName, err)
+	}
+
+	container, _ := containerResponse.Properties["container"].(map[string]any)
+	ports, _ := container["ports"].(map[string]any)
+
+	mappings := []clients.PortMapping{}
+	for _, obj := range ports {
+		port, _ := obj.(map[string]any)
+		containerPort, ok := port["containerPort"].(float64)
+		if !ok {
+			continue
+		}
+		mappings = append(mappings, clients.PortMapping{LocalPort: int(containerPort), RemotePort: int(containerPort)})
+	}
+
+	if len(mappings) == 0 {
+		return nil, fmt.Errorf("container %q does not declare any ports. use '--port <port>' to specify the ports", resourceName)
+	}
+
+	// Forward the ports in a stable order.
+	sort.Slice(mappings, func(i, j int) bool { return mappings[i].RemotePort < mappings[j].RemotePort })
+	return mappings, nil
+}
+
 // Logs() retrieves the running replicas of the container, and creates log streams for the replicas. If an error occurs,
 // it will close all the created streams before returning the error.
 func (dc *ARMDiagnosticsClient) Logs(ctx context.Context, options clients.LogsOptions) ([]clients.LogStream, error) {
@@ -268,6 +401,49 @@ func getRunningReplica(ctx context.Context, client *k8s.Clientset, namespace str
 	return nil, fmt.Errorf("failed to find a running replica for resource %v", resource)
 }
 
+// getHealthyReplica returns a running replica of the resource which is ready to receive traffic.
+func getHealthyReplica(ctx context.Context, client *k8s.Clientset, namespace string, application string, resource string) (*corev1.Pod, error) {
+	replicas, err := getRunningReplicas(ctx, client, namespace, application, resource)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, p := range replicas {
+		for _, condition := range p.Status.Conditions {
+			if condition.Type == corev1.PodReady && condition.Status == corev1.ConditionTrue {
+				return &p, nil
+			}
+		}
+	}
+
+	return nil, fmt.Errorf("failed to find a healthy replica for resource %v", resource)
+}
+
+func getRunningReplicaOfDeployment(ctx context.Context, client *k8s.Clientset, namespace string, deployment string, resource string) (*corev1.Pod, error) {
+	d, err := client.AppsV1().Deployments(namespace).Get(ctx, deployment, v1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get deployment %v for resource %v: %w", deployment, resource, err)
+	}
+
+	selector, err := v1.LabelSelectorAsSelector(d.Spec.Selector)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the replicas of deployment %v for resource %v: %w", deployment, resource, err)
+	}
+
+	pods, err := client.CoreV1().Pods(namespace).List(ctx, v1.ListOptions{LabelSelector: selector.String()})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list running replicas for resource %v: %w", resource, err)
+	}
+
+	for _, p := range pods.Items {
+		if p.Status.Phase == corev1.PodRunning {
+			return &p, nil
+		}
+	}
+
+	return nil, fmt.Errorf("failed to find a running replica for resource %v", resource)
+}
+
 func getRunningReplicas(ctx context.Context, client *k8s.Clientset, namespace string, application string, resource string) ([]corev1.Pod, error) {
 	// Right now this connects to a pod related to a resource. We can find the pods with the labels
 	// and then choose one that's in the running state.
@@ -290,7 +466,7 @@ func getRunningReplicas(ctx context.Context, client *k8s.Clientset, namespace st
 	return running, nil
 }
 
-func runPortforward(restconfig *rest.Config, client *k8s.Clientset, replica *corev1.Pod, ready chan struct{}, stop <-chan struct{}, localPort int, remotePort int) error {
+func runPortforward(restconfig *rest.Config, client *k8s.Clientset, replica *corev1.Pod, ready chan struct{}, stop <-chan struct{}, ports []string) error {
 	// Build URL so we can open a port-forward via SPDY
 	url := client.CoreV1().RESTClient().Post().
 		Resource("pods").
@@ -310,7 +486,6 @@ func runPortforward(restconfig *rest.Config, client *k8s.Clientset, replica *cor
 		errOut = os.Stderr
 	}
 
-	ports := []string{fmt.Sprintf("%d:%d", localPort, remotePort)}
 	dialer := spdy.NewDialer(upgrader, &http.Client{Transport: transport}, "POST", url)
 
 	fw, err := portforward.NewOnAddresses(dialer, []string{"localhost"}, ports, stop, ready, out, errOut)
@@ -321,6 +496,51 @@ func runPortforward(restconfig *rest.Config, client *k8s.Clientset, replica *cor
 	return fw.ForwardPorts()
 }
 
+func runExec(ctx context.Context, restconfig *rest.Config, client *k8s.Clientset, replica *corev1.Pod, container string, options clients.ExecOptions) error {
+	command := options.Command
+	if len(command) == 0 {
+		command = []string{"sh"}
+	}
+
+	// A terminal combines stdout and stderr into a single stream.
+	request := client.CoreV1().RESTClient().Post().
+		Resource("pods").
+		Namespace(replica.Namespace).
+		Name(replica.Name).
+		SubResource("exec").
+		VersionedParams(&corev1.PodExecOptions{
+			Container: container,
+			Command:   command,
+			Stdin:     options.Stdin != nil,
+			Stdout:    options.Stdout != nil,
+			Stderr:    options.Stderr != nil && !options.TTY,
+			TTY:       options.TTY,
+		}, scheme.ParameterCodec)
+
+	executor, err := remotecommand.NewSPDYExecutor(restconfig, http.MethodPost, request.URL())
+	if err != nil {
+		return err
+	}
+
+	streamOptions := remotecommand.StreamOptions{
+		Stdin:  options.Stdin,
+		Stdout: options.Stdout,
+		Tty:    options.TTY,
+	}
+	if !options.TTY {
+		streamOptions.Stderr = options.Stderr
+		return executor.StreamWithContext(ctx, streamOptions)
+	}
+
+	tty := term.TTY{In: options.Stdin, Out: options.Stdout, Raw: true}
+	streamOptions.TerminalSizeQueue = tty.MonitorSize(tty.GetSize())
+
+	// Safe restores the terminal when the session ends, even if it is interrupted.
+	return tty.Safe(func() error {
+		return executor.StreamWithContext(ctx, streamOptions)
+	})
+}
+
 func getAppContainerName(replica *corev1.Pod) string {
 	// The container name will be the resource name
 	resource := replica.Labels[k8slabels.LabelRadiusResource]
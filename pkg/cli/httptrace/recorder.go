@@ -0,0 +1,222 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package httptrace records the HTTP requests and responses made by a rad CLI invocation to a trace file. The
+// trace is sanitized so that it can be attached to support requests.
+package httptrace
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+)
+
+// requestIDHeader is the http header identifying the request on the server.
+const requestIDHeader = "X-Ms-Request-Id"
+
+// Entry is a recorded HTTP request and its response.
+type Entry struct {
+	Time     time.Time `json:"time"`
+	Duration string    `json:"duration"`
+	Request  Request   `json:"request"`
+	Response *Response `json:"response,omitempty"`
+	Error    string    `json:"error,omitempty"`
+}
+
+// Request is a recorded HTTP request.
+type Request struct {
+	Method string      `json:"method"`
+	URL    string      `json:"url"`
+	Header http.Header `json:"header,omitempty"`
+	Body   any         `json:"body,omitempty"`
+}
+
+// Response is a recorded HTTP response.
+type Response struct {
+	StatusCode int         `json:"statusCode"`
+	Header     http.Header `json:"header,omitempty"`
+	Body       any         `json:"body,omitempty"`
+}
+
+// Recorder records HTTP requests and responses to a trace file, one JSON entry per line. All requests sent through the
+// recorder share a correlation ID so that they can be found in the logs of Radius.
+type Recorder struct {
+	// CorrelationID is the correlation ID sent with each request which does not already have one.
+	CorrelationID string
+
+	path     string
+	mu       sync.Mutex
+	file     *os.File
+	encoder  *json.Encoder
+	failures []string
+	err      error
+}
+
+// NewRecorder creates a recorder writing to the trace file at path, creating its directory if needed.
+func NewRecorder(path string) (*Recorder, error) {
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return nil, err
+	}
+
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0600)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Recorder{
+		CorrelationID: uuid.New().String(),
+		path:          path,
+		file:          file,
+		encoder:       json.NewEncoder(file),
+	}, nil
+}
+
+// Path returns the path of the trace file.
+func (r *Recorder) Path() string {
+	return r.path
+}
+
+// Failures returns a description of each request which failed or returned an error status code.
+func (r *Recorder) Failures() []string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return append([]string{}, r.failures...)
+}
+
+// Close closes the trace file. It returns the first error that occurred while writing the trace.
+func (r *Recorder) Close() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := r.file.Close(); err != nil && r.err == nil {
+		r.err = err
+	}
+
+	return r.err
+}
+
+// Transport wraps the transport so that its requests and responses are recorded.
+func (r *Recorder) Transport(inner http.RoundTripper) http.RoundTripper {
+	if inner == nil {
+		inner = http.DefaultTransport
+	}
+
+	return &roundTripper{recorder: r, inner: inner}
+}
+
+func (r *Recorder) record(entry Entry, failure string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if failure != "" {
+		r.failures = append(r.failures, failure)
+	}
+
+	if err := r.encoder.Encode(entry); err != nil && r.err == nil {
+		r.err = err
+	}
+}
+
+var _ http.RoundTripper = (*roundTripper)(nil)
+
+type roundTripper struct {
+	recorder *Recorder
+	inner    http.RoundTripper
+}
+
+// RoundTrip is the implementation of http.RoundTripper. It sends the request with the correlation ID of the recorder
+// and records the request and its response.
+func (t *roundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	req = req.Clone(req.Context())
+	if req.Header.Get(v1.CorrelationRequestIDHeader) == "" {
+		req.Header.Set(v1.CorrelationRequestIDHeader, t.recorder.CorrelationID)
+	}
+
+	requestBody, err := readBody(&req.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	entry := Entry{
+		Time: time.Now().UTC(),
+		Request: Request{
+			Method: req.Method,
+			URL:    sanitizeURL(req.URL),
+			Header: sanitizeHeader(req.Header),
+			Body:   sanitizeBody(req.Header.Get("Content-Type"), requestBody),
+		},
+	}
+
+	res, err := t.inner.RoundTrip(req)
+	entry.Duration = time.Since(entry.Time).String()
+	if err != nil {
+		entry.Error = err.Error()
+		t.recorder.record(entry, fmt.Sprintf("%s %s: %v", entry.Request.Method, entry.Request.URL, err))
+		return nil, err
+	}
+
+	entry.Response = &Response{
+		StatusCode: res.StatusCode,
+		Header:     sanitizeHeader(res.Header),
+	}
+
+	// Streamed and non-JSON responses are not read, so that the caller can consume them as they arrive.
+	if contentType := res.Header.Get("Content-Type"); isJSON(contentType) {
+		responseBody, err := readBody(&res.Body)
+		if err != nil {
+			return nil, err
+		}
+		entry.Response.Body = sanitizeBody(contentType, responseBody)
+	}
+
+	failure := ""
+	if res.StatusCode >= 400 {
+		failure = fmt.Sprintf("%s %s: %d %s", entry.Request.Method, entry.Request.URL, res.StatusCode, http.StatusText(res.StatusCode))
+		if requestID := res.Header.Get(requestIDHeader); requestID != "" {
+			failure += fmt.Sprintf(" (request ID %s)", requestID)
+		}
+	}
+	t.recorder.record(entry, failure)
+
+	return res, nil
+}
+
+// readBody reads the body and replaces it with a reader of the same content.
+func readBody(body *io.ReadCloser) ([]byte, error) {
+	if *body == nil || *body == http.NoBody {
+		return nil, nil
+	}
+
+	b, err := io.ReadAll(*body)
+	_ = (*body).Close()
+	if err != nil {
+		return nil, err
+	}
+
+	*body = io.NopCloser(bytes.NewReader(b))
+	return b, nil
+}
@@ -0,0 +1,125 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package httptrace
+
+import (
+	"bufio"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+)
+
+func readEntries(t *testing.T, path string) []Entry {
+	f, err := os.Open(path)
+	require.NoError(t, err)
+	defer f.Close()
+
+	entries := []Entry{}
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		entry := Entry{}
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &entry))
+		entries = append(entries, entry)
+	}
+	require.NoError(t, scanner.Err())
+
+	return entries
+}
+
+func Test_Recorder(t *testing.T) {
+	correlationIDs := []string{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		correlationIDs = append(correlationIDs, r.Header.Get(v1.CorrelationRequestIDHeader))
+
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		require.Equal(t, `{"password":"abc"}`, string(body))
+
+		w.Header().Set("Content-Type", "application/json")
+		if r.Method == http.MethodDelete {
+			w.Header().Set(requestIDHeader, "request-1")
+			w.WriteHeader(http.StatusConflict)
+		}
+		_, _ = w.Write([]byte(`{"name":"prod","token":"abc"}`))
+	}))
+	defer server.Close()
+
+	path := filepath.Join(t.TempDir(), "traces", "trace.jsonl")
+	recorder, err := NewRecorder(path)
+	require.NoError(t, err)
+
+	client := &http.Client{Transport: recorder.Transport(nil)}
+	for _, method := range []string{http.MethodPut, http.MethodDelete} {
+		req, err := http.NewRequest(method, server.URL+"/environments/prod", strings.NewReader(`{"password":"abc"}`))
+		require.NoError(t, err)
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("Authorization", "Bearer abc")
+
+		res, err := client.Do(req)
+		require.NoError(t, err)
+
+		// The caller still reads the complete response.
+		body, err := io.ReadAll(res.Body)
+		require.NoError(t, err)
+		require.Equal(t, `{"name":"prod","token":"abc"}`, string(body))
+		require.NoError(t, res.Body.Close())
+	}
+
+	require.NoError(t, recorder.Close())
+	require.Equal(t, []string{recorder.CorrelationID, recorder.CorrelationID}, correlationIDs)
+	require.Equal(t, []string{"DELETE " + server.URL + "/environments/prod: 409 Conflict (request ID request-1)"}, recorder.Failures())
+
+	entries := readEntries(t, path)
+	require.Len(t, entries, 2)
+	require.Equal(t, http.MethodPut, entries[0].Request.Method)
+	require.Equal(t, []string{redacted}, entries[0].Request.Header["Authorization"])
+	require.Equal(t, map[string]any{"password": redacted}, entries[0].Request.Body)
+	require.Equal(t, http.StatusOK, entries[0].Response.StatusCode)
+	require.Equal(t, map[string]any{"name": "prod", "token": redacted}, entries[0].Response.Body)
+	require.Equal(t, http.StatusConflict, entries[1].Response.StatusCode)
+}
+
+func Test_Recorder_Error(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "trace.jsonl")
+	recorder, err := NewRecorder(path)
+	require.NoError(t, err)
+
+	server := httptest.NewServer(http.NotFoundHandler())
+	url := server.URL
+	server.Close()
+
+	client := &http.Client{Transport: recorder.Transport(nil)}
+	_, err = client.Get(url)
+	require.Error(t, err)
+
+	require.NoError(t, recorder.Close())
+	require.Len(t, recorder.Failures(), 1)
+
+	entries := readEntries(t, path)
+	require.Len(t, entries, 1)
+	require.Nil(t, entries[0].Response)
+	require.NotEmpty(t, entries[0].Error)
+}
@@ -0,0 +1,134 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package httptrace
+
+import (
+	"encoding/json"
+	"fmt"
+	"mime"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+const (
+	// redacted replaces the values of sensitive headers, query parameters and body properties.
+	redacted = "REDACTED"
+
+	// maxBodySize is the maximum size of a body recorded in the trace.
+	maxBodySize = 1 << 20
+)
+
+// sensitiveNames are the substrings of the names of headers, query parameters and body properties whose values are
+// redacted.
+var sensitiveNames = []string{"authorization", "cookie", "password", "secret", "token", "key", "credential", "connectionstring", "signature"}
+
+// sensitiveExactNames are the names of query parameters and body properties whose values are redacted. Secret stores
+// and environment variables hold their data in 'value' properties.
+var sensitiveExactNames = []string{"sig", "value"}
+
+func isSensitive(name string) bool {
+	name = strings.ToLower(name)
+	for _, exact := range sensitiveExactNames {
+		if name == exact {
+			return true
+		}
+	}
+
+	for _, sensitive := range sensitiveNames {
+		if strings.Contains(name, sensitive) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func sanitizeHeader(header http.Header) http.Header {
+	sanitized := http.Header{}
+	for name, values := range header {
+		if isSensitive(name) {
+			sanitized[name] = []string{redacted}
+		} else {
+			sanitized[name] = values
+		}
+	}
+
+	return sanitized
+}
+
+func sanitizeURL(u *url.URL) string {
+	sanitized := *u
+	sanitized.User = nil
+
+	query := sanitized.Query()
+	for name := range query {
+		if isSensitive(name) {
+			query.Set(name, redacted)
+		}
+	}
+	sanitized.RawQuery = query.Encode()
+
+	return sanitized.String()
+}
+
+// sanitizeBody returns the JSON body with the values of sensitive properties redacted. Other bodies are not recorded.
+func sanitizeBody(contentType string, body []byte) any {
+	if len(body) == 0 {
+		return nil
+	}
+
+	if !isJSON(contentType) {
+		return fmt.Sprintf("<%d bytes of %q content omitted>", len(body), contentType)
+	}
+
+	if len(body) > maxBodySize {
+		return fmt.Sprintf("<%d bytes of content omitted>", len(body))
+	}
+
+	var value any
+	if err := json.Unmarshal(body, &value); err != nil {
+		return fmt.Sprintf("<%d bytes of invalid JSON omitted>", len(body))
+	}
+
+	return sanitizeValue(value)
+}
+
+// isJSON returns true for JSON content types, excluding streams such as Kubernetes watches.
+func isJSON(contentType string) bool {
+	mediaType, params, err := mime.ParseMediaType(contentType)
+	return err == nil && strings.HasSuffix(mediaType, "json") && params["stream"] == ""
+}
+
+func sanitizeValue(value any) any {
+	switch v := value.(type) {
+	case map[string]any:
+		for name, property := range v {
+			if isSensitive(name) {
+				v[name] = redacted
+			} else {
+				v[name] = sanitizeValue(property)
+			}
+		}
+	case []any:
+		for i, item := range v {
+			v[i] = sanitizeValue(item)
+		}
+	}
+
+	return value
+}
@@ -0,0 +1,80 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package httptrace
+
+import (
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/radius-project/radius/pkg/sdk"
+)
+
+// active is the recorder of the CLI invocation, or nil if tracing is not enabled.
+var active *Recorder
+
+// DefaultPath returns the path of a new trace file in the traces directory of the rad configuration.
+func DefaultPath() (string, error) {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(home, ".rad", "traces", fmt.Sprintf("rad-%s.jsonl", time.Now().Format("20060102-150405"))), nil
+}
+
+// Enable starts recording the requests of the connections wrapped with WrapConnection to the trace file at path.
+func Enable(path string) (*Recorder, error) {
+	recorder, err := NewRecorder(path)
+	if err != nil {
+		return nil, err
+	}
+
+	active = recorder
+	return recorder, nil
+}
+
+// Active returns the recorder started by Enable, or nil if tracing is not enabled.
+func Active() *Recorder {
+	return active
+}
+
+// WrapConnection returns a connection whose client records its requests if tracing is enabled. Otherwise the
+// connection is returned unchanged.
+func WrapConnection(connection sdk.Connection) sdk.Connection {
+	if active == nil {
+		return connection
+	}
+
+	return &tracedConnection{Connection: connection, recorder: active}
+}
+
+var _ sdk.Connection = (*tracedConnection)(nil)
+
+type tracedConnection struct {
+	sdk.Connection
+	recorder *Recorder
+}
+
+// Client returns a copy of the client of the connection whose transport records its requests.
+func (c *tracedConnection) Client() *http.Client {
+	client := *c.Connection.Client()
+	client.Transport = c.recorder.Transport(client.Transport)
+	return &client
+}
@@ -0,0 +1,49 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package httptrace
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/radius-project/radius/pkg/sdk"
+)
+
+func Test_WrapConnection(t *testing.T) {
+	connection, err := sdk.NewDirectConnection("http://localhost:9000/apis/api.ucp.dev/v1alpha3")
+	require.NoError(t, err)
+
+	// Tracing is not enabled
+	require.Same(t, connection, WrapConnection(connection))
+
+	recorder, err := Enable(filepath.Join(t.TempDir(), "trace.jsonl"))
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		active = nil
+		_ = recorder.Close()
+	})
+	require.Same(t, recorder, Active())
+
+	wrapped := WrapConnection(connection)
+	require.Equal(t, connection.Endpoint(), wrapped.Endpoint())
+	require.IsType(t, &roundTripper{}, wrapped.Client().Transport)
+
+	// The client of the inner connection is not modified.
+	require.NotEqual(t, wrapped.Client().Transport, connection.Client().Transport)
+}
@@ -0,0 +1,188 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubeimport
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	"golang.org/x/exp/maps"
+)
+
+var identifierRegex = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_]*$`)
+
+// Bicep returns a Bicep file declaring the container resource. The application is a parameter of the file, injected by
+// the rad CLI when the file is deployed.
+func Bicep(container *Container) (string, error) {
+	if strings.Contains(container.Base, "'''") {
+		return "", fmt.Errorf("the base manifest of %q cannot be written as a Bicep string because it contains '''", container.Name)
+	}
+
+	w := &bicepWriter{}
+	w.line("extension radius")
+	w.line("")
+	w.line("@description('The Radius Application ID. Injected automatically by the rad CLI.')")
+	w.line("param application string")
+	w.line("")
+	w.line("resource %s 'Applications.Core/containers@2023-10-01-preview' = {", symbolicName(container.Name))
+	w.indent++
+	w.line("name: %s", quote(container.Name))
+	w.open("properties")
+	w.line("application: application")
+
+	w.open("container")
+	w.line("image: %s", quote(container.Image))
+	if container.ImagePullPolicy != "" {
+		w.line("imagePullPolicy: %s", quote(container.ImagePullPolicy))
+	}
+	w.array("command", container.Command)
+	w.array("args", container.Args)
+	if container.WorkingDir != "" {
+		w.line("workingDir: %s", quote(container.WorkingDir))
+	}
+
+	if len(container.Env) > 0 {
+		w.open("env")
+		for _, name := range sortedKeys(container.Env) {
+			w.open(key(name))
+			w.line("value: %s", quote(container.Env[name]))
+			w.close()
+		}
+		w.close()
+	}
+
+	if len(container.Ports) > 0 {
+		w.open("ports")
+		for _, name := range sortedKeys(container.Ports) {
+			port := container.Ports[name]
+			w.open(key(name))
+			w.line("containerPort: %d", port.ContainerPort)
+			if port.Port != 0 {
+				w.line("port: %d", port.Port)
+			}
+			if port.Protocol != "" {
+				w.line("protocol: %s", quote(port.Protocol))
+			}
+			w.close()
+		}
+		w.close()
+	}
+	w.close()
+
+	if container.Base != "" {
+		w.open("runtimes")
+		w.open("kubernetes")
+		w.line("base: '''")
+		w.raw(container.Base)
+		w.raw("'''\n")
+		w.close()
+		w.close()
+	}
+
+	w.close()
+	w.indent--
+	w.line("}")
+
+	return w.String(), nil
+}
+
+// bicepWriter writes indented Bicep declarations.
+type bicepWriter struct {
+	strings.Builder
+	indent int
+}
+
+func (w *bicepWriter) line(format string, args ...any) {
+	if format == "" {
+		w.WriteString("\n")
+		return
+	}
+
+	w.WriteString(strings.Repeat("  ", w.indent))
+	fmt.Fprintf(w, format, args...)
+	w.WriteString("\n")
+}
+
+func (w *bicepWriter) raw(s string) {
+	w.WriteString(s)
+}
+
+func (w *bicepWriter) open(name string) {
+	w.line("%s: {", name)
+	w.indent++
+}
+
+func (w *bicepWriter) close() {
+	w.indent--
+	w.line("}")
+}
+
+func (w *bicepWriter) array(name string, values []string) {
+	if len(values) == 0 {
+		return
+	}
+
+	w.line("%s: [", name)
+	w.indent++
+	for _, value := range values {
+		w.line("%s", quote(value))
+	}
+	w.indent--
+	w.line("]")
+}
+
+// quote returns the value as a single-quoted Bicep string.
+func quote(value string) string {
+	replacer := strings.NewReplacer(`\`, `\\`, `'`, `\'`, "\n", `\n`, "\r", `\r`, "\t", `\t`, "${", `\${`)
+	return "'" + replacer.Replace(value) + "'"
+}
+
+// key returns the name as a Bicep object key, quoted if it is not an identifier.
+func key(name string) string {
+	if identifierRegex.MatchString(name) {
+		return name
+	}
+
+	return quote(name)
+}
+
+// symbolicName returns the name as a Bicep symbolic name, for example "my-app" becomes "my_app".
+func symbolicName(name string) string {
+	var b strings.Builder
+	for _, r := range name {
+		if r == '_' || (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') || (r >= '0' && r <= '9') {
+			b.WriteRune(r)
+		} else {
+			b.WriteRune('_')
+		}
+	}
+
+	symbolic := b.String()
+	if symbolic == "" || (symbolic[0] >= '0' && symbolic[0] <= '9') {
+		symbolic = "_" + symbolic
+	}
+
+	return symbolic
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := maps.Keys(m)
+	slices.Sort(keys)
+	return keys
+}
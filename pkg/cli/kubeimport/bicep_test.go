@@ -0,0 +1,104 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubeimport
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Bicep(t *testing.T) {
+	container := &Container{
+		Name:            "my-app",
+		Image:           "nginx:1.25",
+		ImagePullPolicy: "Always",
+		Command:         []string{"/bin/sh", "-c"},
+		Args:            []string{"echo 'hello' ${HOME}"},
+		Env:             map[string]string{"MODE": "production", "app.url": "http://localhost"},
+		Ports: map[string]Port{
+			"http": {ContainerPort: 8080, Port: 80},
+			"dns":  {ContainerPort: 53, Protocol: "UDP"},
+		},
+		Base: "apiVersion: apps/v1\nkind: Deployment\n",
+	}
+
+	expected := `extension radius
+
+@description('The Radius Application ID. Injected automatically by the rad CLI.')
+param application string
+
+resource my_app 'Applications.Core/containers@2023-10-01-preview' = {
+  name: 'my-app'
+  properties: {
+    application: application
+    container: {
+      image: 'nginx:1.25'
+      imagePullPolicy: 'Always'
+      command: [
+        '/bin/sh'
+        '-c'
+      ]
+      args: [
+        'echo \'hello\' \${HOME}'
+      ]
+      env: {
+        MODE: {
+          value: 'production'
+        }
+        'app.url': {
+          value: 'http://localhost'
+        }
+      }
+      ports: {
+        dns: {
+          containerPort: 53
+          protocol: 'UDP'
+        }
+        http: {
+          containerPort: 8080
+          port: 80
+        }
+      }
+    }
+    runtimes: {
+      kubernetes: {
+        base: '''
+apiVersion: apps/v1
+kind: Deployment
+'''
+      }
+    }
+  }
+}
+`
+
+	actual, err := Bicep(container)
+	require.NoError(t, err)
+	require.Equal(t, expected, actual)
+}
+
+func Test_Bicep_InvalidBase(t *testing.T) {
+	_, err := Bicep(&Container{Name: "web", Image: "nginx", Base: "value: '''"})
+	require.Error(t, err)
+}
+
+func Test_symbolicName(t *testing.T) {
+	require.Equal(t, "web", symbolicName("web"))
+	require.Equal(t, "my_app", symbolicName("my-app"))
+	require.Equal(t, "_1app", symbolicName("1app"))
+}
@@ -0,0 +1,327 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package kubeimport converts existing Kubernetes workloads into Radius container resources, to ease the migration of
+// applications to Radius.
+//
+// The fields of the Deployment which are modeled by the container resource, such as the image, environment variables
+// and ports, are converted to properties of the resource. All other fields are kept in a base manifest set as
+// runtimes.kubernetes.base, which Radius uses as the starting point of the resources it renders.
+package kubeimport
+
+import (
+	"bytes"
+	"fmt"
+	"slices"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+// ignoredAnnotations is the list of annotations set by Kubernetes tooling which are not kept in the base manifest.
+var ignoredAnnotations = []string{
+	"deployment.kubernetes.io/revision",
+	"kubectl.kubernetes.io/last-applied-configuration",
+}
+
+// Container is a Radius container resource converted from a Kubernetes Deployment.
+type Container struct {
+	// Name is the name of the container resource.
+	Name string
+
+	// Image is the container image.
+	Image string
+
+	// ImagePullPolicy is the image pull policy of the container.
+	ImagePullPolicy string
+
+	// Command is the entrypoint of the container.
+	Command []string
+
+	// Args is the arguments of the entrypoint of the container.
+	Args []string
+
+	// WorkingDir is the working directory of the container.
+	WorkingDir string
+
+	// Env is the environment variables of the container which have a literal value. Environment variables referencing
+	// other values, such as secrets, are kept in the base manifest.
+	Env map[string]string
+
+	// Ports is the ports of the container keyed by name.
+	Ports map[string]Port
+
+	// Base is the YAML manifest of the fields of the Deployment and Service which are not modeled by the container
+	// resource.
+	Base string
+
+	// Warnings is the list of changes made to the workload which the user should review.
+	Warnings []string
+}
+
+// Port is a port of a container resource.
+type Port struct {
+	// ContainerPort is the port the container listens on.
+	ContainerPort int32
+
+	// Port is the port of the Service exposing the container port, or zero if it is the same as the container port.
+	Port int32
+
+	// Protocol is the protocol of the port, or empty for TCP.
+	Protocol string
+}
+
+// FindService returns the Service which selects the pods of the Deployment, or nil if there is none. A Service with
+// the same name as the Deployment is preferred.
+func FindService(deployment *appsv1.Deployment, services []corev1.Service) *corev1.Service {
+	var found *corev1.Service
+	for i := range services {
+		service := &services[i]
+		if len(service.Spec.Selector) == 0 || !selects(service.Spec.Selector, deployment.Spec.Template.Labels) {
+			continue
+		}
+
+		if service.Name == deployment.Name {
+			return service
+		}
+
+		if found == nil {
+			found = service
+		}
+	}
+
+	return found
+}
+
+// Convert converts the Deployment and the Service exposing it into a container resource. The Service may be nil.
+func Convert(deployment *appsv1.Deployment, service *corev1.Service) (*Container, error) {
+	deployment = deployment.DeepCopy()
+	if service != nil {
+		service = service.DeepCopy()
+	}
+
+	containers := deployment.Spec.Template.Spec.Containers
+	if len(containers) == 0 {
+		return nil, fmt.Errorf("the deployment %q has no containers", deployment.Name)
+	}
+
+	name := deployment.Name
+	primary := &containers[primaryContainerIndex(deployment)]
+
+	result := &Container{
+		Name:            name,
+		Image:           primary.Image,
+		ImagePullPolicy: string(primary.ImagePullPolicy),
+		Command:         primary.Command,
+		Args:            primary.Args,
+		WorkingDir:      primary.WorkingDir,
+		Env:             map[string]string{},
+		Ports:           map[string]Port{},
+	}
+
+	if len(containers) > 1 {
+		result.Warnings = append(result.Warnings, fmt.Sprintf("The deployment %q has %d containers. The container %q is converted and the other containers are kept in the base manifest.", name, len(containers), primary.Name))
+	}
+
+	env := []corev1.EnvVar{}
+	for _, variable := range primary.Env {
+		if variable.ValueFrom != nil {
+			env = append(env, variable)
+			continue
+		}
+		result.Env[variable.Name] = variable.Value
+	}
+
+	servicePorts := []corev1.ServicePort{}
+	if service != nil {
+		servicePorts = service.Spec.Ports
+	}
+
+	for _, containerPort := range primary.Ports {
+		portName := containerPort.Name
+		if portName == "" {
+			portName = fmt.Sprintf("port%d", containerPort.ContainerPort)
+		}
+
+		port := Port{ContainerPort: containerPort.ContainerPort}
+		if containerPort.Protocol != "" && containerPort.Protocol != corev1.ProtocolTCP {
+			port.Protocol = string(containerPort.Protocol)
+		}
+
+		for i, servicePort := range servicePorts {
+			if targetsPort(servicePort, containerPort) {
+				if servicePort.Port != containerPort.ContainerPort {
+					port.Port = servicePort.Port
+				}
+				servicePorts = append(servicePorts[:i], servicePorts[i+1:]...)
+				break
+			}
+		}
+
+		result.Ports[portName] = port
+	}
+
+	// The container resource names the container of the deployment after the resource, and sets the modeled fields.
+	primary.Name = name
+	primary.Image = ""
+	primary.ImagePullPolicy = ""
+	primary.Command = nil
+	primary.Args = nil
+	primary.WorkingDir = ""
+	primary.Env = env
+	primary.Ports = nil
+
+	deployment.TypeMeta = metav1.TypeMeta{APIVersion: "apps/v1", Kind: "Deployment"}
+	deployment.ObjectMeta = baseObjectMeta(deployment.ObjectMeta, name)
+	deployment.Status = appsv1.DeploymentStatus{}
+
+	objects := []runtime.Object{deployment}
+	if service != nil {
+		if service.Name != name {
+			result.Warnings = append(result.Warnings, fmt.Sprintf("The service %q is renamed to %q.", service.Name, name))
+		}
+		if service.Spec.Type != "" && service.Spec.Type != corev1.ServiceTypeClusterIP {
+			result.Warnings = append(result.Warnings, fmt.Sprintf("The service %q of type %s is converted to a ClusterIP service.", service.Name, service.Spec.Type))
+		}
+
+		// The selector, type and addresses of the service are set by Radius.
+		service.TypeMeta = metav1.TypeMeta{APIVersion: "v1", Kind: "Service"}
+		service.ObjectMeta = baseObjectMeta(service.ObjectMeta, name)
+		service.Spec.Selector = nil
+		service.Spec.Type = ""
+		service.Spec.ClusterIP = ""
+		service.Spec.ClusterIPs = nil
+		service.Spec.IPFamilies = nil
+		service.Spec.IPFamilyPolicy = nil
+		service.Spec.Ports = servicePorts
+		service.Status = corev1.ServiceStatus{}
+		objects = append(objects, service)
+	}
+
+	base, err := manifest(objects...)
+	if err != nil {
+		return nil, err
+	}
+	result.Base = base
+
+	return result, nil
+}
+
+// primaryContainerIndex returns the index of the container converted to the container resource: the container with
+// the same name as the deployment, or the first container.
+func primaryContainerIndex(deployment *appsv1.Deployment) int {
+	for i, container := range deployment.Spec.Template.Spec.Containers {
+		if container.Name == deployment.Name {
+			return i
+		}
+	}
+
+	return 0
+}
+
+// selects returns true if the selector matches the labels.
+func selects(selector map[string]string, labels map[string]string) bool {
+	for key, value := range selector {
+		if labels[key] != value {
+			return false
+		}
+	}
+
+	return true
+}
+
+// targetsPort returns true if the service port forwards traffic to the container port.
+func targetsPort(servicePort corev1.ServicePort, containerPort corev1.ContainerPort) bool {
+	switch servicePort.TargetPort.Type {
+	case intstr.String:
+		if servicePort.TargetPort.StrVal != "" {
+			return servicePort.TargetPort.StrVal == containerPort.Name
+		}
+	case intstr.Int:
+		if servicePort.TargetPort.IntVal != 0 {
+			return servicePort.TargetPort.IntVal == containerPort.ContainerPort
+		}
+	}
+
+	// The target port defaults to the port of the service.
+	return servicePort.Port == containerPort.ContainerPort
+}
+
+// baseObjectMeta returns the metadata of an object of the base manifest: the name of the container resource and the
+// labels and annotations set by the user. The namespace is set by Radius.
+func baseObjectMeta(meta metav1.ObjectMeta, name string) metav1.ObjectMeta {
+	annotations := map[string]string{}
+	for key, value := range meta.Annotations {
+		if !slices.Contains(ignoredAnnotations, key) {
+			annotations[key] = value
+		}
+	}
+	if len(annotations) == 0 {
+		annotations = nil
+	}
+
+	return metav1.ObjectMeta{
+		Name:        name,
+		Labels:      meta.Labels,
+		Annotations: annotations,
+	}
+}
+
+// manifest returns the YAML manifest of the objects.
+func manifest(objects ...runtime.Object) (string, error) {
+	documents := []string{}
+	for _, object := range objects {
+		content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(object)
+		if err != nil {
+			return "", err
+		}
+		delete(content, "status")
+		removeNulls(content)
+
+		buf := &bytes.Buffer{}
+		encoder := yaml.NewEncoder(buf)
+		encoder.SetIndent(2)
+		if err := encoder.Encode(content); err != nil {
+			return "", err
+		}
+		documents = append(documents, buf.String())
+	}
+
+	return strings.Join(documents, "---\n"), nil
+}
+
+// removeNulls removes the null values, such as unset timestamps, from the content of an object.
+func removeNulls(content map[string]any) {
+	for key, value := range content {
+		switch v := value.(type) {
+		case nil:
+			delete(content, key)
+		case map[string]any:
+			removeNulls(v)
+		case []any:
+			for _, item := range v {
+				if m, ok := item.(map[string]any); ok {
+					removeNulls(m)
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,214 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubeimport
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+
+	"github.com/radius-project/radius/pkg/to"
+)
+
+func testDeployment() *appsv1.Deployment {
+	return &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "web",
+			Namespace: "default",
+			Labels:    map[string]string{"app": "web"},
+			Annotations: map[string]string{
+				"deployment.kubernetes.io/revision": "3",
+				"team":                              "frontend",
+			},
+		},
+		Spec: appsv1.DeploymentSpec{
+			Replicas: to.Ptr(int32(2)),
+			Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "web"}},
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"app": "web"}},
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{
+						{
+							Name:            "server",
+							Image:           "nginx:1.25",
+							ImagePullPolicy: corev1.PullAlways,
+							Args:            []string{"--port", "8080"},
+							Env: []corev1.EnvVar{
+								{Name: "MODE", Value: "production"},
+								{Name: "PASSWORD", ValueFrom: &corev1.EnvVarSource{SecretKeyRef: &corev1.SecretKeySelector{
+									LocalObjectReference: corev1.LocalObjectReference{Name: "web"},
+									Key:                  "password",
+								}}},
+							},
+							Ports: []corev1.ContainerPort{
+								{Name: "http", ContainerPort: 8080},
+								{Name: "metrics", ContainerPort: 9090},
+							},
+						},
+					},
+				},
+			},
+		},
+		Status: appsv1.DeploymentStatus{ReadyReplicas: 2},
+	}
+}
+
+func testService() *corev1.Service {
+	return &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{Name: "web-svc", Namespace: "default"},
+		Spec: corev1.ServiceSpec{
+			Type:      corev1.ServiceTypeLoadBalancer,
+			ClusterIP: "10.0.0.10",
+			Selector:  map[string]string{"app": "web"},
+			Ports: []corev1.ServicePort{
+				{Name: "http", Port: 80, TargetPort: intstr.FromString("http")},
+				{Name: "admin", Port: 8443, TargetPort: intstr.FromInt32(8443)},
+			},
+		},
+	}
+}
+
+func Test_FindService(t *testing.T) {
+	deployment := testDeployment()
+
+	t.Run("matching selector", func(t *testing.T) {
+		services := []corev1.Service{
+			{ObjectMeta: metav1.ObjectMeta{Name: "other"}, Spec: corev1.ServiceSpec{Selector: map[string]string{"app": "other"}}},
+			*testService(),
+		}
+		require.Equal(t, "web-svc", FindService(deployment, services).Name)
+	})
+
+	t.Run("prefers same name", func(t *testing.T) {
+		services := []corev1.Service{
+			*testService(),
+			{ObjectMeta: metav1.ObjectMeta{Name: "web"}, Spec: corev1.ServiceSpec{Selector: map[string]string{"app": "web"}}},
+		}
+		require.Equal(t, "web", FindService(deployment, services).Name)
+	})
+
+	t.Run("no match", func(t *testing.T) {
+		services := []corev1.Service{
+			{ObjectMeta: metav1.ObjectMeta{Name: "headless"}},
+			{ObjectMeta: metav1.ObjectMeta{Name: "other"}, Spec: corev1.ServiceSpec{Selector: map[string]string{"app": "other"}}},
+		}
+		require.Nil(t, FindService(deployment, services))
+	})
+}
+
+func Test_Convert(t *testing.T) {
+	t.Run("deployment and service", func(t *testing.T) {
+		deployment := testDeployment()
+		container, err := Convert(deployment, testService())
+		require.NoError(t, err)
+
+		require.Equal(t, "web", container.Name)
+		require.Equal(t, "nginx:1.25", container.Image)
+		require.Equal(t, "Always", container.ImagePullPolicy)
+		require.Equal(t, []string{"--port", "8080"}, container.Args)
+		require.Equal(t, map[string]string{"MODE": "production"}, container.Env)
+		require.Equal(t, map[string]Port{
+			"http":    {ContainerPort: 8080, Port: 80},
+			"metrics": {ContainerPort: 9090},
+		}, container.Ports)
+		require.Equal(t, []string{
+			`The service "web-svc" is renamed to "web".`,
+			`The service "web-svc" of type LoadBalancer is converted to a ClusterIP service.`,
+		}, container.Warnings)
+
+		expected := `apiVersion: apps/v1
+kind: Deployment
+metadata:
+  annotations:
+    team: frontend
+  labels:
+    app: web
+  name: web
+spec:
+  replicas: 2
+  selector:
+    matchLabels:
+      app: web
+  strategy: {}
+  template:
+    metadata:
+      labels:
+        app: web
+    spec:
+      containers:
+        - env:
+            - name: PASSWORD
+              valueFrom:
+                secretKeyRef:
+                  key: password
+                  name: web
+          name: web
+          resources: {}
+---
+apiVersion: v1
+kind: Service
+metadata:
+  name: web
+spec:
+  ports:
+    - name: admin
+      port: 8443
+      targetPort: 8443
+`
+		require.Equal(t, expected, container.Base)
+
+		// The input is not modified.
+		require.Equal(t, "server", deployment.Spec.Template.Spec.Containers[0].Name)
+	})
+
+	t.Run("deployment without service", func(t *testing.T) {
+		container, err := Convert(testDeployment(), nil)
+		require.NoError(t, err)
+
+		require.Equal(t, map[string]Port{
+			"http":    {ContainerPort: 8080},
+			"metrics": {ContainerPort: 9090},
+		}, container.Ports)
+		require.Empty(t, container.Warnings)
+		require.NotContains(t, container.Base, "kind: Service")
+	})
+
+	t.Run("multiple containers", func(t *testing.T) {
+		deployment := testDeployment()
+		deployment.Spec.Template.Spec.Containers = append(deployment.Spec.Template.Spec.Containers,
+			corev1.Container{Name: "web", Image: "web:latest"})
+
+		container, err := Convert(deployment, nil)
+		require.NoError(t, err)
+
+		require.Equal(t, "web:latest", container.Image)
+		require.Equal(t, []string{`The deployment "web" has 2 containers. The container "web" is converted and the other containers are kept in the base manifest.`}, container.Warnings)
+		require.Contains(t, container.Base, "image: nginx:1.25")
+	})
+
+	t.Run("no containers", func(t *testing.T) {
+		deployment := testDeployment()
+		deployment.Spec.Template.Spec.Containers = nil
+
+		_, err := Convert(deployment, nil)
+		require.Error(t, err)
+	})
+}
@@ -19,6 +19,7 @@ package output
 const (
 	FormatJson    = "json"
 	FormatTable   = "table"
+	FormatYaml    = "yaml"
 	DefaultFormat = FormatTable
 )
 
@@ -27,5 +28,6 @@ func SupportedFormats() []string {
 	return []string{
 		FormatJson,
 		FormatTable,
+		FormatYaml,
 	}
 }
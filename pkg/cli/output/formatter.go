@@ -53,6 +53,8 @@ func NewFormatter(format string) (Formatter, error) {
 		return &JSONFormatter{}, nil
 	case FormatTable:
 		return &TableFormatter{}, nil
+	case FormatYaml:
+		return &YAMLFormatter{}, nil
 	default:
 		return nil, fmt.Errorf("unsupported format %s", format)
 	}
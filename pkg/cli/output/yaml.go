@@ -0,0 +1,63 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package output
+
+import (
+	"encoding/json"
+	"io"
+
+	"gopkg.in/yaml.v3"
+)
+
+type YAMLFormatter struct {
+}
+
+// Format marshals the object into YAML and writes it to the writer. The object is marshaled as JSON first so that the
+// YAML output uses the same field names and field order as the JSON output.
+func (f *YAMLFormatter) Format(obj any, writer io.Writer, options FormatterOptions) error {
+	b, err := json.Marshal(obj)
+	if err != nil {
+		return err
+	}
+
+	// JSON is valid YAML, and decoding it into a node preserves the order of the fields.
+	node := yaml.Node{}
+	err = yaml.Unmarshal(b, &node)
+	if err != nil {
+		return err
+	}
+	resetStyle(&node)
+
+	encoder := yaml.NewEncoder(writer)
+	encoder.SetIndent(2)
+	err = encoder.Encode(&node)
+	if err != nil {
+		return err
+	}
+
+	return encoder.Close()
+}
+
+// resetStyle clears the JSON flow style of the node so that it is encoded in the block style.
+func resetStyle(node *yaml.Node) {
+	node.Style = 0
+	for _, child := range node.Content {
+		resetStyle(child)
+	}
+}
+
+var _ Formatter = (*YAMLFormatter)(nil)
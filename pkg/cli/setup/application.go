@@ -20,6 +20,9 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"slices"
+
+	"golang.org/x/exp/maps"
 
 	"github.com/radius-project/radius/pkg/version"
 )
@@ -66,6 +69,12 @@ resource demo 'Applications.Core/containers@2023-10-01-preview' = {
 // ScaffoldApplication creates a working sample application in the provided directory
 // along with configuration for the application name.
 func ScaffoldApplication(directory string, name string) error {
+	return ScaffoldApplicationFromTemplate(directory, name, GetBuiltinTemplate(DefaultTemplate))
+}
+
+// ScaffoldApplicationFromTemplate creates the files of the template in the provided directory
+// along with configuration for the application name.
+func ScaffoldApplicationFromTemplate(directory string, name string, template *Template) error {
 	// Create .rad in the working directory
 	err := os.Mkdir(filepath.Join(directory, ".rad"), 0755)
 	if os.IsExist(err) {
@@ -74,20 +83,27 @@ func ScaffoldApplication(directory string, name string) error {
 		return err
 	}
 
-	// We NEVER overwrite app.bicep or the bicepconfig.json if it exists. We assume the user might have changed it, and don't
-	// want them to lose their content.
+	// We NEVER overwrite the files of the template (like app.bicep) or the bicepconfig.json if it exists. We assume the user
+	// might have changed it, and don't want them to lose their content.
 	//
 	// On the other hand, we ALWAYS overwrite rad.yaml if it exists. We assume that the reason why
 	// the user is running `rad init` is to populate it.
-	appBicepFilepath := filepath.Join(directory, "app.bicep")
-	_, err = os.Stat(appBicepFilepath)
-	if os.IsNotExist(err) {
-		err = os.WriteFile(appBicepFilepath, []byte(appBicepTemplate), 0644)
-		if err != nil {
+	for _, file := range sortedFiles(template) {
+		filePath := filepath.Join(directory, filepath.FromSlash(file))
+		_, err = os.Stat(filePath)
+		if os.IsNotExist(err) {
+			err = os.MkdirAll(filepath.Dir(filePath), 0755)
+			if err != nil {
+				return err
+			}
+
+			err = os.WriteFile(filePath, []byte(template.Files[file]), 0644)
+			if err != nil {
+				return err
+			}
+		} else if err != nil {
 			return err
 		}
-	} else if err != nil {
-		return err
 	}
 
 	bicepConfigFilepath := filepath.Join(directory, "bicepconfig.json")
@@ -110,6 +126,12 @@ func ScaffoldApplication(directory string, name string) error {
 	return nil
 }
 
+func sortedFiles(template *Template) []string {
+	files := maps.Keys(template.Files)
+	slices.Sort(files)
+	return files
+}
+
 func getVersionedBicepConfig() string {
 	tag := version.Channel()
 	if version.IsEdgeChannel() {
@@ -0,0 +1,200 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package setup
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"path"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+	"oras.land/oras-go/v2"
+	"oras.land/oras-go/v2/content"
+)
+
+const (
+	// DefaultTemplate is the name of the template scaffolded by `rad init` when no template is specified.
+	DefaultTemplate = "demo"
+
+	// TemplateArtifactType is the artifact type of the application templates stored in an OCI registry. Each layer of the
+	// artifact is a file of the template, named by its title annotation. Templates can be pushed with the oras CLI, for
+	// example:
+	//
+	//	oras push myregistry.azurecr.io/templates/web-api:latest --artifact-type application/vnd.radius.template app.bicep
+	TemplateArtifactType = "application/vnd.radius.template"
+
+	// webDatabaseBicepTemplate is the template of the built-in templates with a container exposed by a gateway and
+	// connected to a database provisioned by a recipe.
+	webDatabaseBicepTemplate = `extension radius
+
+@description('The Radius Application ID. Injected automatically by the rad CLI.')
+param application string
+
+@description('The Radius Environment ID. Injected automatically by the rad CLI.')
+param environment string
+
+resource frontend 'Applications.Core/containers@2023-10-01-preview' = {
+  name: 'frontend'
+  properties: {
+    application: application
+    container: {
+      image: 'ghcr.io/radius-project/samples/demo:latest'
+      ports: {
+        web: {
+          containerPort: 3000
+        }
+      }
+    }
+    connections: {
+      %[1]s: {
+        source: db.id
+      }
+    }
+  }
+}
+
+resource gateway 'Applications.Core/gateways@2023-10-01-preview' = {
+  name: 'gateway'
+  properties: {
+    application: application
+    routes: [
+      {
+        path: '/'
+        destination: 'http://frontend:3000'
+      }
+    ]
+  }
+}
+
+// The database is provisioned by the default recipe of the environment.
+resource db '%[2]s@2023-10-01-preview' = {
+  name: 'db'
+  properties: {
+    application: application
+    environment: environment
+  }
+}
+` // Trailing newline intentional.
+)
+
+// Template is a starter application scaffolded by `rad init`.
+type Template struct {
+	// Name is the name of the template.
+	Name string
+
+	// Description is a short description of the template.
+	Description string
+
+	// Files is the content of the files of the template keyed by their path relative to the application directory.
+	Files map[string]string
+}
+
+// BuiltinTemplates is the list of templates that are included in the rad CLI.
+var BuiltinTemplates = []Template{
+	{
+		Name:        DefaultTemplate,
+		Description: "A container running the Radius demo application",
+		Files:       map[string]string{"app.bicep": appBicepTemplate},
+	},
+	{
+		Name:        "web-redis",
+		Description: "A container exposed by a gateway, with a Redis cache provisioned by a recipe",
+		Files:       map[string]string{"app.bicep": fmt.Sprintf(webDatabaseBicepTemplate, "redis", "Applications.Datastores/redisCaches")},
+	},
+	{
+		Name:        "web-mongo",
+		Description: "A container exposed by a gateway, with a MongoDB database provisioned by a recipe",
+		Files:       map[string]string{"app.bicep": fmt.Sprintf(webDatabaseBicepTemplate, "mongodb", "Applications.Datastores/mongoDatabases")},
+	},
+	{
+		Name:        "web-sql",
+		Description: "A container exposed by a gateway, with a SQL database provisioned by a recipe",
+		Files:       map[string]string{"app.bicep": fmt.Sprintf(webDatabaseBicepTemplate, "sql", "Applications.Datastores/sqlDatabases")},
+	},
+}
+
+// GetBuiltinTemplate returns the built-in template with the given name, or nil if there is none.
+func GetBuiltinTemplate(name string) *Template {
+	i := slices.IndexFunc(BuiltinTemplates, func(t Template) bool { return t.Name == name })
+	if i < 0 {
+		return nil
+	}
+
+	return &BuiltinTemplates[i]
+}
+
+// TemplateReference returns the reference of the named template in the registry. The registry is a host optionally
+// followed by a repository prefix, for example "myregistry.azurecr.io/templates". The tag defaults to "latest".
+func TemplateReference(registry string, name string) string {
+	reference := path.Join(strings.TrimSuffix(registry, "/"), name)
+	if !strings.Contains(name, ":") && !strings.Contains(name, "@") {
+		reference += ":latest"
+	}
+
+	return reference
+}
+
+// ReadTemplate reads the files of the template with the given reference from the target, typically a remote repository.
+// The name of the returned template is not set.
+func ReadTemplate(ctx context.Context, target oras.ReadOnlyTarget, reference string) (*Template, error) {
+	descriptor, err := target.Resolve(ctx, reference)
+	if err != nil {
+		return nil, err
+	}
+
+	b, err := content.FetchAll(ctx, target, descriptor)
+	if err != nil {
+		return nil, err
+	}
+
+	manifest := ocispec.Manifest{}
+	if err := json.Unmarshal(b, &manifest); err != nil {
+		return nil, err
+	}
+
+	if manifest.ArtifactType != "" && manifest.ArtifactType != TemplateArtifactType {
+		return nil, fmt.Errorf("the artifact type %q is not a template, expected %q", manifest.ArtifactType, TemplateArtifactType)
+	}
+
+	template := &Template{Files: map[string]string{}}
+	for _, layer := range manifest.Layers {
+		name := layer.Annotations[ocispec.AnnotationTitle]
+		if name == "" {
+			continue
+		}
+
+		if !filepath.IsLocal(name) {
+			return nil, fmt.Errorf("the template file %q is not a relative path", name)
+		}
+
+		b, err := content.FetchAll(ctx, target, layer)
+		if err != nil {
+			return nil, err
+		}
+		template.Files[filepath.ToSlash(filepath.Clean(name))] = string(b)
+	}
+
+	if len(template.Files) == 0 {
+		return nil, fmt.Errorf("the template %q has no files", reference)
+	}
+
+	return template, nil
+}
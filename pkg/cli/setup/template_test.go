@@ -0,0 +1,134 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package setup
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+	"github.com/stretchr/testify/require"
+	"oras.land/oras-go/v2"
+	"oras.land/oras-go/v2/content"
+	"oras.land/oras-go/v2/content/memory"
+)
+
+// pushTemplate pushes a template artifact with the files to the store and tags it as "latest".
+func pushTemplate(t *testing.T, store *memory.Store, artifactType string, files map[string]string) {
+	ctx := context.Background()
+
+	layers := []ocispec.Descriptor{}
+	for name, data := range files {
+		desc := content.NewDescriptorFromBytes("application/octet-stream", []byte(data))
+		desc.Annotations = map[string]string{ocispec.AnnotationTitle: name}
+		require.NoError(t, store.Push(ctx, desc, bytes.NewReader([]byte(data))))
+		layers = append(layers, desc)
+	}
+
+	manifest, err := oras.PackManifest(ctx, store, oras.PackManifestVersion1_1, artifactType, oras.PackManifestOptions{Layers: layers})
+	require.NoError(t, err)
+	require.NoError(t, store.Tag(ctx, manifest, "latest"))
+}
+
+func Test_GetBuiltinTemplate(t *testing.T) {
+	template := GetBuiltinTemplate(DefaultTemplate)
+	require.NotNil(t, template)
+	require.Equal(t, appBicepTemplate, template.Files["app.bicep"])
+
+	template = GetBuiltinTemplate("web-redis")
+	require.NotNil(t, template)
+	require.Contains(t, template.Files["app.bicep"], "'Applications.Datastores/redisCaches@2023-10-01-preview'")
+	require.Contains(t, template.Files["app.bicep"], "'Applications.Core/gateways@2023-10-01-preview'")
+
+	require.Nil(t, GetBuiltinTemplate("unknown"))
+}
+
+func Test_TemplateReference(t *testing.T) {
+	require.Equal(t, "myregistry.azurecr.io/templates/web-api:latest", TemplateReference("myregistry.azurecr.io/templates/", "web-api"))
+	require.Equal(t, "myregistry.azurecr.io/web-api:v1", TemplateReference("myregistry.azurecr.io", "web-api:v1"))
+}
+
+func Test_ReadTemplate(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		store := memory.New()
+		pushTemplate(t, store, TemplateArtifactType, map[string]string{
+			"app.bicep":           "extension radius",
+			"infra/modules.bicep": "param name string",
+		})
+
+		template, err := ReadTemplate(context.Background(), store, "latest")
+		require.NoError(t, err)
+		require.Equal(t, map[string]string{
+			"app.bicep":           "extension radius",
+			"infra/modules.bicep": "param name string",
+		}, template.Files)
+	})
+
+	t.Run("not a template", func(t *testing.T) {
+		store := memory.New()
+		pushTemplate(t, store, "application/vnd.example", map[string]string{"app.bicep": "extension radius"})
+
+		_, err := ReadTemplate(context.Background(), store, "latest")
+		require.Error(t, err)
+	})
+
+	t.Run("file outside of the application directory", func(t *testing.T) {
+		store := memory.New()
+		pushTemplate(t, store, TemplateArtifactType, map[string]string{"../app.bicep": "extension radius"})
+
+		_, err := ReadTemplate(context.Background(), store, "latest")
+		require.Error(t, err)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		_, err := ReadTemplate(context.Background(), memory.New(), "latest")
+		require.Error(t, err)
+	})
+}
+
+func Test_ScaffoldApplicationFromTemplate(t *testing.T) {
+	directory := t.TempDir()
+
+	// Pre-create a file of the template
+	err := os.WriteFile(filepath.Join(directory, "app.bicep"), []byte("something else"), 0644)
+	require.NoError(t, err)
+
+	template := &Template{
+		Name: "web-api",
+		Files: map[string]string{
+			"app.bicep":           "extension radius",
+			"infra/modules.bicep": "param name string",
+		},
+	}
+
+	err = ScaffoldApplicationFromTemplate(directory, "cool-application", template)
+	require.NoError(t, err)
+
+	require.FileExists(t, filepath.Join(directory, ".rad", "rad.yaml"))
+	require.FileExists(t, filepath.Join(directory, "bicepconfig.json"))
+
+	b, err := os.ReadFile(filepath.Join(directory, "app.bicep"))
+	require.NoError(t, err)
+	require.Equal(t, "something else", string(b))
+
+	b, err = os.ReadFile(filepath.Join(directory, "infra", "modules.bicep"))
+	require.NoError(t, err)
+	require.Equal(t, "param name string", string(b))
+}
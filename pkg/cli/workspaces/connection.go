@@ -22,6 +22,7 @@ import (
 	"strings"
 
 	"github.com/mitchellh/mapstructure"
+	"github.com/radius-project/radius/pkg/cli/httptrace"
 	"github.com/radius-project/radius/pkg/cli/kubernetes"
 	"github.com/radius-project/radius/pkg/sdk"
 )
@@ -100,7 +101,12 @@ func (ws Workspace) Connect() (sdk.Connection, error) {
 		return nil, err
 	}
 
-	return connectionConfig.Connect()
+	connection, err := connectionConfig.Connect()
+	if err != nil {
+		return nil, err
+	}
+
+	return httptrace.WrapConnection(connection), nil
 }
 
 // ConnectionConfigEquals() checks if the given ConnectionConfig is of type Kubernetes and if the Kubernetes
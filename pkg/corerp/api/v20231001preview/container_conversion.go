@@ -19,17 +19,28 @@ package v20231001preview
 import (
 	"encoding/json"
 	"fmt"
+	"sort"
 
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
 	"github.com/radius-project/radius/pkg/corerp/datamodel"
 	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
 	"github.com/radius-project/radius/pkg/to"
+	"golang.org/x/exp/maps"
+)
+
+const (
+	minPort = 1
+	maxPort = 65535
 )
 
 // ConvertTo converts from the versioned Container resource to version-agnostic datamodel.
 func (src *ContainerResource) ConvertTo() (v1.DataModelInterface, error) {
 	// Note: SystemData conversion isn't required since this property comes ARM and datastore.
 
+	if err := src.validate(); err != nil {
+		return nil, err
+	}
+
 	connections := make(map[string]datamodel.ConnectionProperties)
 	for key, val := range src.Properties.Connections {
 		if val != nil {
@@ -55,6 +66,7 @@ func (src *ContainerResource) ConvertTo() (v1.DataModelInterface, error) {
 					Kind:  kind,
 					Roles: roles,
 				},
+				PrivateEndpoint: toConnectionPrivateEndpointDataModel(val.PrivateEndpoint),
 			}
 		}
 	}
@@ -102,11 +114,6 @@ func (src *ContainerResource) ConvertTo() (v1.DataModelInterface, error) {
 		}
 	}
 
-	convertedEnvironmentVariables, err := toEnvironmentVariableDataModel(src.Properties.Container.Env)
-	if err != nil {
-		return nil, err
-	}
-
 	converted := &datamodel.ContainerResource{
 		BaseResource: v1.BaseResource{
 			TrackedResource: v1.TrackedResource{
@@ -129,7 +136,7 @@ func (src *ContainerResource) ConvertTo() (v1.DataModelInterface, error) {
 			Container: datamodel.Container{
 				Image:           to.String(src.Properties.Container.Image),
 				ImagePullPolicy: toImagePullPolicyDataModel(src.Properties.Container.ImagePullPolicy),
-				Env:             convertedEnvironmentVariables,
+				Env:             toEnvironmentVariableDataModel(src.Properties.Container.Env),
 				LivenessProbe:   livenessProbe,
 				Ports:           ports,
 				ReadinessProbe:  readinessProbe,
@@ -156,24 +163,101 @@ func (src *ContainerResource) ConvertTo() (v1.DataModelInterface, error) {
 	return converted, nil
 }
 
-// toEnvironmentVariableDataModel: Converts from versioned datamodel to base datamodel
-func toEnvironmentVariableDataModel(e map[string]*EnvironmentVariable) (map[string]datamodel.EnvironmentVariable, error) {
-	environmentVariableMap := map[string]datamodel.EnvironmentVariable{}
+// validate checks the container properties that cannot be described by the OpenAPI spec. It returns a *v1.ErrValidation
+// locating each invalid property with its JSON pointer in the request payload.
+func (src *ContainerResource) validate() error {
+	verr := &v1.ErrValidation{}
+	if src.Properties == nil {
+		verr.Add(v1.JSONPointer("properties"), "properties is required")
+		return verr.Err()
+	}
 
-	for key, val := range e {
+	c := src.Properties.Container
+	if c == nil {
+		verr.Add(v1.JSONPointer("properties", "container"), "container is required")
+		return verr.Err()
+	}
+
+	if to.String(c.Image) == "" {
+		verr.Add(v1.JSONPointer("properties", "container", "image"), "image is required")
+	}
+
+	// Sort the keys so the errors are reported in the same order on each request.
+	names := maps.Keys(c.Env)
+	sort.Strings(names)
+	for _, name := range names {
+		pointer := v1.JSONPointer("properties", "container", "env", name)
+		val := c.Env[name]
+		switch {
+		case val == nil:
+			verr.Add(pointer, "environment variable must not be null")
+		case val.Value != nil && val.ValueFrom != nil:
+			// An environment variable can have either value(Value) or secret value(ValueFrom), but not both
+			verr.Add(pointer, "value and valueFrom are mutually exclusive")
+		case val.Value == nil && val.ValueFrom == nil:
+			verr.Add(pointer, "either value or valueFrom is required")
+		case val.ValueFrom != nil && val.ValueFrom.SecretRef == nil:
+			verr.Add(v1.JSONPointer("properties", "container", "env", name, "valueFrom", "secretRef"), "secretRef is required")
+		}
+	}
+
+	names = maps.Keys(c.Ports)
+	sort.Strings(names)
+	for _, name := range names {
+		val := c.Ports[name]
 		if val == nil {
-			return nil, v1.NewClientErrInvalidRequest(fmt.Sprintf("Environment variable %s is nil", key))
+			verr.Add(v1.JSONPointer("properties", "container", "ports", name), "port must not be null")
+			continue
 		}
-		// An environment variable can have either value(Value) or secret value(ValueFrom), but not both
-		if val.Value != nil && val.ValueFrom != nil {
-			return nil, v1.NewClientErrInvalidRequest(fmt.Sprintf("Environment variable %s has both value and secret value", key))
+
+		validatePort(verr, v1.JSONPointer("properties", "container", "ports", name, "containerPort"), val.ContainerPort, true)
+		validatePort(verr, v1.JSONPointer("properties", "container", "ports", name, "port"), val.Port, false)
+	}
+
+	validateHealthProbe(verr, v1.JSONPointer("properties", "container", "livenessProbe"), c.LivenessProbe)
+	validateHealthProbe(verr, v1.JSONPointer("properties", "container", "readinessProbe"), c.ReadinessProbe)
+
+	return verr.Err()
+}
+
+// validatePort checks that the port at the given JSON pointer is a valid port number.
+func validatePort(verr *v1.ErrValidation, pointer string, port *int32, required bool) {
+	if port == nil {
+		if required {
+			verr.Add(pointer, "port is required")
 		}
+		return
+	}
+
+	if *port < minPort || *port > maxPort {
+		verr.Add(pointer, fmt.Sprintf("port must be between %d and %d, got %d", minPort, maxPort, *port))
+	}
+}
 
-		// An environment variable must have either value(Value) or secret value(ValueFrom)
-		if val.Value == nil && val.ValueFrom == nil {
-			return nil, v1.NewClientErrInvalidRequest(fmt.Sprintf("Environment variable %s has neither value nor secret value", key))
+// validateHealthProbe checks that the health probe at the given JSON pointer is of exactly one of the supported kinds
+// and that the properties of its kind are valid.
+func validateHealthProbe(verr *v1.ErrValidation, pointer string, probe HealthProbePropertiesClassification) {
+	switch p := probe.(type) {
+	case nil:
+		return
+	case *ExecHealthProbeProperties:
+		if to.String(p.Command) == "" {
+			verr.Add(pointer+v1.JSONPointer("command"), "command is required for a probe of kind 'exec'")
 		}
+	case *HTTPGetHealthProbeProperties:
+		validatePort(verr, pointer+v1.JSONPointer("containerPort"), p.ContainerPort, true)
+	case *TCPHealthProbeProperties:
+		validatePort(verr, pointer+v1.JSONPointer("containerPort"), p.ContainerPort, true)
+	default:
+		verr.Add(pointer+v1.JSONPointer("kind"), fmt.Sprintf("a probe must be of exactly one kind of 'exec', 'httpGet' or 'tcp', got %q", to.String(p.GetHealthProbeProperties().Kind)))
+	}
+}
+
+// toEnvironmentVariableDataModel: Converts from versioned datamodel to base datamodel
+func toEnvironmentVariableDataModel(e map[string]*EnvironmentVariable) map[string]datamodel.EnvironmentVariable {
+	environmentVariableMap := map[string]datamodel.EnvironmentVariable{}
 
+	for key, val := range e {
 		if val.Value != nil {
 			environmentVariableMap[key] = datamodel.EnvironmentVariable{
 				Value: val.Value,
@@ -191,7 +275,7 @@ func toEnvironmentVariableDataModel(e map[string]*EnvironmentVariable) (map[stri
 		}
 
 	}
-	return environmentVariableMap, nil
+	return environmentVariableMap
 }
 
 // fromEnvironmentVariableDataModel: Converts from base datamodel to versioned datamodel
@@ -248,6 +332,7 @@ func (dst *ContainerResource) ConvertFrom(src v1.DataModelInterface) error {
 				Kind:  kind,
 				Roles: roles,
 			},
+			PrivateEndpoint: fromConnectionPrivateEndpointDataModel(val.PrivateEndpoint),
 		}
 	}
 
@@ -456,6 +541,29 @@ func fromKindDataModel(kind datamodel.IAMKind) *IAMKind {
 	return &k
 }
 
+func toConnectionPrivateEndpointDataModel(pe *ConnectionPrivateEndpoint) *datamodel.ConnectionPrivateEndpoint {
+	if pe == nil {
+		return nil
+	}
+	return &datamodel.ConnectionPrivateEndpoint{
+		Subnet:         to.String(pe.Subnet),
+		PrivateDNSZone: to.String(pe.PrivateDNSZone),
+	}
+}
+
+func fromConnectionPrivateEndpointDataModel(pe *datamodel.ConnectionPrivateEndpoint) *ConnectionPrivateEndpoint {
+	if pe == nil {
+		return nil
+	}
+	r := &ConnectionPrivateEndpoint{
+		Subnet: to.Ptr(pe.Subnet),
+	}
+	if pe.PrivateDNSZone != "" {
+		r.PrivateDNSZone = to.Ptr(pe.PrivateDNSZone)
+	}
+	return r
+}
+
 func toPortProtocolDataModel(protocol *PortProtocol) datamodel.Protocol {
 	if protocol == nil {
 		return datamodel.ProtocolTCP
@@ -583,7 +691,9 @@ func toRuntimePropertiesDataModel(runtime *RuntimesProperties) *datamodel.Runtim
 	r := &datamodel.RuntimeProperties{}
 	if runtime.Kubernetes != nil {
 		r.Kubernetes = &datamodel.KubernetesRuntime{
-			Base: to.String(runtime.Kubernetes.Base),
+			Base:            to.String(runtime.Kubernetes.Base),
+			HeadlessService: to.Bool(runtime.Kubernetes.HeadlessService),
+			Service:         toKubernetesServiceDataModel(runtime.Kubernetes.Service),
 		}
 		if runtime.Kubernetes.Pod != nil {
 			// Serializes PodSpec patch object to JSON-encoded. Internally, Radius does JSON strategic merge patch
@@ -606,7 +716,11 @@ func fromRuntimePropertiesDataModel(runtime *datamodel.RuntimeProperties) *Runti
 	r := &RuntimesProperties{}
 	if runtime.Kubernetes != nil {
 		r.Kubernetes = &KubernetesRuntimeProperties{
-			Base: to.Ptr(runtime.Kubernetes.Base),
+			Base:    to.Ptr(runtime.Kubernetes.Base),
+			Service: fromKubernetesServiceDataModel(runtime.Kubernetes.Service),
+		}
+		if runtime.Kubernetes.HeadlessService {
+			r.Kubernetes.HeadlessService = to.Ptr(true)
 		}
 		if runtime.Kubernetes.Pod != "" {
 			podPatch := map[string]any{}
@@ -619,6 +733,50 @@ func fromRuntimePropertiesDataModel(runtime *datamodel.RuntimeProperties) *Runti
 	return r
 }
 
+func toKubernetesServiceDataModel(service *KubernetesServiceProperties) *datamodel.KubernetesService {
+	if service == nil {
+		return nil
+	}
+
+	s := &datamodel.KubernetesService{
+		SessionAffinityTimeoutSeconds: service.SessionAffinityTimeoutSeconds,
+		TopologyAwareRouting:          to.Bool(service.TopologyAwareRouting),
+	}
+	if service.Type != nil {
+		s.Type = string(*service.Type)
+	}
+	if service.SessionAffinity != nil {
+		s.SessionAffinity = string(*service.SessionAffinity)
+	}
+	if service.ExternalTrafficPolicy != nil {
+		s.ExternalTrafficPolicy = string(*service.ExternalTrafficPolicy)
+	}
+	return s
+}
+
+func fromKubernetesServiceDataModel(service *datamodel.KubernetesService) *KubernetesServiceProperties {
+	if service == nil {
+		return nil
+	}
+
+	s := &KubernetesServiceProperties{
+		SessionAffinityTimeoutSeconds: service.SessionAffinityTimeoutSeconds,
+	}
+	if service.Type != "" {
+		s.Type = to.Ptr(KubernetesServiceType(service.Type))
+	}
+	if service.SessionAffinity != "" {
+		s.SessionAffinity = to.Ptr(KubernetesSessionAffinity(service.SessionAffinity))
+	}
+	if service.ExternalTrafficPolicy != "" {
+		s.ExternalTrafficPolicy = to.Ptr(KubernetesExternalTrafficPolicy(service.ExternalTrafficPolicy))
+	}
+	if service.TopologyAwareRouting {
+		s.TopologyAwareRouting = to.Ptr(true)
+	}
+	return s
+}
+
 func toResourceReferencesDataModel(r []*ResourceReference) []datamodel.ResourceReference {
 	result := []datamodel.ResourceReference{}
 	for _, rr := range r {
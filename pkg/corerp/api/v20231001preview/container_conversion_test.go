@@ -63,7 +63,11 @@ func TestContainerConvertVersionedToDataModel(t *testing.T) {
 		},
 		{
 			filename: "containerresource-nil-env-variables.json",
-			err:      v1.NewClientErrInvalidRequest("Environment variable DB_USER has neither value nor secret value"),
+			err: &v1.ErrValidation{
+				Details: []v1.ErrorDetails{
+					{Code: v1.CodeInvalidProperties, Target: "/properties/container/env/DB_USER", Message: "either value or valueFrom is required"},
+				},
+			},
 			emptyExt: false,
 		},
 	}
@@ -110,6 +114,10 @@ func TestContainerConvertVersionedToDataModel(t *testing.T) {
 							},
 						},
 					}, ct.Properties.Container.Env)
+					require.Equal(t, &datamodel.ConnectionPrivateEndpoint{
+						Subnet:         "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/radius-test-rg/providers/Microsoft.Network/virtualNetworks/vnet/subnets/default",
+						PrivateDNSZone: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/radius-test-rg/providers/Microsoft.Network/privateDnsZones/privatelink.database.windows.net",
+					}, ct.Properties.Connections["inventory"].PrivateEndpoint)
 				}
 
 				val, ok := ct.Properties.Connections["inventory"]
@@ -142,6 +150,14 @@ func TestContainerConvertVersionedToDataModel(t *testing.T) {
 					require.NotEmpty(t, ct.Properties.Runtimes.Kubernetes.Base)
 					require.Equal(t, *r.Properties.Runtimes.Kubernetes.Base, ct.Properties.Runtimes.Kubernetes.Base)
 					require.Equal(t, "{\"containers\":[{\"name\":\"sidecar\"}],\"hostNetwork\":true}", ct.Properties.Runtimes.Kubernetes.Pod)
+					require.True(t, ct.Properties.Runtimes.Kubernetes.HeadlessService)
+					require.Equal(t, &datamodel.KubernetesService{
+						Type:                          "LoadBalancer",
+						SessionAffinity:               "ClientIP",
+						SessionAffinityTimeoutSeconds: to.Ptr(int32(3600)),
+						ExternalTrafficPolicy:         "Local",
+						TopologyAwareRouting:          true,
+					}, ct.Properties.Runtimes.Kubernetes.Service)
 				}
 
 			}
@@ -211,6 +227,10 @@ func TestContainerConvertDataModelToVersioned(t *testing.T) {
 							},
 						},
 					}, r.Properties.Container.Env)
+					require.Equal(t, &ConnectionPrivateEndpoint{
+						Subnet:         to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/radius-test-rg/providers/Microsoft.Network/virtualNetworks/vnet/subnets/default"),
+						PrivateDNSZone: to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/radius-test-rg/providers/Microsoft.Network/privateDnsZones/privatelink.database.windows.net"),
+					}, versioned.Properties.Connections["inventory"].PrivateEndpoint)
 				}
 
 				val, ok := r.Properties.Connections["inventory"]
@@ -238,6 +258,14 @@ func TestContainerConvertDataModelToVersioned(t *testing.T) {
 						},
 						"hostNetwork": true,
 					}, versioned.Properties.Runtimes.Kubernetes.Pod)
+					require.Equal(t, to.Ptr(true), versioned.Properties.Runtimes.Kubernetes.HeadlessService)
+					require.Equal(t, &KubernetesServiceProperties{
+						Type:                          to.Ptr(KubernetesServiceTypeLoadBalancer),
+						SessionAffinity:               to.Ptr(KubernetesSessionAffinityClientIP),
+						SessionAffinityTimeoutSeconds: to.Ptr(int32(3600)),
+						ExternalTrafficPolicy:         to.Ptr(KubernetesExternalTrafficPolicyLocal),
+						TopologyAwareRouting:          to.Ptr(true),
+					}, versioned.Properties.Runtimes.Kubernetes.Service)
 				}
 			}
 		})
@@ -280,6 +308,90 @@ func TestContainerConvertVersionedToDataModelEmptyProtocol(t *testing.T) {
 
 }
 
+func TestContainerConvertVersionedToDataModel_Validation(t *testing.T) {
+	validationTests := []struct {
+		desc    string
+		mutate  func(r *ContainerResource)
+		details []v1.ErrorDetails
+	}{
+		{
+			desc: "missing container",
+			mutate: func(r *ContainerResource) {
+				r.Properties.Container = nil
+			},
+			details: []v1.ErrorDetails{
+				{Code: v1.CodeInvalidProperties, Target: "/properties/container", Message: "container is required"},
+			},
+		},
+		{
+			desc: "missing image",
+			mutate: func(r *ContainerResource) {
+				r.Properties.Container.Image = to.Ptr("")
+			},
+			details: []v1.ErrorDetails{
+				{Code: v1.CodeInvalidProperties, Target: "/properties/container/image", Message: "image is required"},
+			},
+		},
+		{
+			desc: "environment variable with value and valueFrom",
+			mutate: func(r *ContainerResource) {
+				r.Properties.Container.Env["DB_USER"].ValueFrom = r.Properties.Container.Env["DB_PASSWORD"].ValueFrom
+			},
+			details: []v1.ErrorDetails{
+				{Code: v1.CodeInvalidProperties, Target: "/properties/container/env/DB_USER", Message: "value and valueFrom are mutually exclusive"},
+			},
+		},
+		{
+			desc: "ports out of range",
+			mutate: func(r *ContainerResource) {
+				r.Properties.Container.Ports = map[string]*ContainerPortProperties{
+					"web":   {ContainerPort: to.Ptr[int32](0)},
+					"admin": {ContainerPort: to.Ptr[int32](8080), Port: to.Ptr[int32](70000)},
+					"a/b":   nil,
+				}
+			},
+			details: []v1.ErrorDetails{
+				{Code: v1.CodeInvalidProperties, Target: "/properties/container/ports/a~1b", Message: "port must not be null"},
+				{Code: v1.CodeInvalidProperties, Target: "/properties/container/ports/admin/port", Message: "port must be between 1 and 65535, got 70000"},
+				{Code: v1.CodeInvalidProperties, Target: "/properties/container/ports/web/containerPort", Message: "port must be between 1 and 65535, got 0"},
+			},
+		},
+		{
+			desc: "probes of unknown kind and with invalid port",
+			mutate: func(r *ContainerResource) {
+				r.Properties.Container.LivenessProbe = &HealthProbeProperties{Kind: to.Ptr("grpc")}
+				r.Properties.Container.ReadinessProbe = &HTTPGetHealthProbeProperties{Kind: to.Ptr("httpGet"), Path: to.Ptr("/healthz"), ContainerPort: to.Ptr[int32](-1)}
+			},
+			details: []v1.ErrorDetails{
+				{Code: v1.CodeInvalidProperties, Target: "/properties/container/livenessProbe/kind", Message: "a probe must be of exactly one kind of 'exec', 'httpGet' or 'tcp', got \"grpc\""},
+				{Code: v1.CodeInvalidProperties, Target: "/properties/container/readinessProbe/containerPort", Message: "port must be between 1 and 65535, got -1"},
+			},
+		},
+		{
+			desc: "exec probe without command",
+			mutate: func(r *ContainerResource) {
+				r.Properties.Container.LivenessProbe = &ExecHealthProbeProperties{Kind: to.Ptr("exec")}
+			},
+			details: []v1.ErrorDetails{
+				{Code: v1.CodeInvalidProperties, Target: "/properties/container/livenessProbe/command", Message: "command is required for a probe of kind 'exec'"},
+			},
+		},
+	}
+
+	for _, tc := range validationTests {
+		t.Run(tc.desc, func(t *testing.T) {
+			rawPayload := testutil.ReadFixture("containerresource.json")
+			r := &ContainerResource{}
+			err := json.Unmarshal(rawPayload, r)
+			require.NoError(t, err)
+			tc.mutate(r)
+
+			_, err = r.ConvertTo()
+			require.Equal(t, &v1.ErrValidation{Details: tc.details}, err)
+		})
+	}
+}
+
 func TestContainerConvertFromValidation(t *testing.T) {
 	validationTests := []struct {
 		src v1.DataModelInterface
@@ -367,6 +367,14 @@ func fromEnvExtensionClassificationDataModel(e datamodel.Extension) ExtensionCla
 			Annotations: *to.StringMapPtr(ann),
 			Labels:      *to.StringMapPtr(lbl),
 		}
+	case datamodel.MutualTLS:
+		if e.MutualTLS != nil {
+			return &MutualTLSExtension{
+				Kind: to.Ptr(string(e.Kind)),
+				Mesh: to.Ptr(ServiceMesh(e.MutualTLS.Mesh)),
+				Mode: to.Ptr(MutualTLSMode(e.MutualTLS.Mode)),
+			}
+		}
 	}
 
 	return nil
@@ -383,6 +391,24 @@ func toEnvExtensionDataModel(e ExtensionClassification) datamodel.Extension {
 				Labels:      to.StringMap(c.Labels),
 			},
 		}
+	case *MutualTLSExtension:
+		var mesh string
+		if c.Mesh != nil {
+			mesh = string(*c.Mesh)
+		}
+
+		mode := datamodel.MutualTLSModeStrict
+		if c.Mode != nil {
+			mode = string(*c.Mode)
+		}
+
+		return datamodel.Extension{
+			Kind: datamodel.MutualTLS,
+			MutualTLS: &datamodel.MutualTLSExtension{
+				Mesh: mesh,
+				Mode: mode,
+			},
+		}
 	}
 
 	return datamodel.Extension{}
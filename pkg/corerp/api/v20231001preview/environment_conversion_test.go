@@ -330,6 +330,43 @@ func TestConvertVersionedToDataModel(t *testing.T) {
 			},
 			err: nil,
 		},
+		{
+			filename: "environmentresource-with-mutualtls.json",
+			expected: &datamodel.Environment{
+				BaseResource: v1.BaseResource{
+					TrackedResource: v1.TrackedResource{
+						ID:   "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/radius-test-rg/providers/Applications.Core/environments/env0",
+						Name: "env0",
+						Type: "Applications.Core/environments",
+						Tags: map[string]string{},
+					},
+					InternalMetadata: v1.InternalMetadata{
+						CreatedAPIVersion:      "2023-10-01-preview",
+						UpdatedAPIVersion:      "2023-10-01-preview",
+						AsyncProvisioningState: v1.ProvisioningStateAccepted,
+					},
+				},
+				Properties: datamodel.EnvironmentProperties{
+					Compute: rpv1.EnvironmentCompute{
+						Kind: "kubernetes",
+						KubernetesCompute: rpv1.KubernetesComputeProperties{
+							ResourceID: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/testGroup/providers/Microsoft.ContainerService/managedClusters/radiusTestCluster",
+							Namespace:  "default",
+						},
+					},
+					Extensions: []datamodel.Extension{
+						{
+							Kind: datamodel.MutualTLS,
+							MutualTLS: &datamodel.MutualTLSExtension{
+								Mesh: datamodel.ServiceMeshIstio,
+								Mode: datamodel.MutualTLSModeStrict,
+							},
+						},
+					},
+				},
+			},
+			err: nil,
+		},
 		{
 			filename: "environmentresource-invalid-missing-namespace.json",
 			err:      &v1.ErrModelConversion{PropertyName: "$.properties.compute.namespace", ValidValue: "63 characters or less"},
@@ -526,6 +563,23 @@ func TestConvertDataModelWithIdentityToVersioned(t *testing.T) {
 	require.Equal(t, map[string]*string{}, versioned.Properties.RecipeConfig.Env)
 }
 
+func TestFromEnvExtensionClassificationDataModel_MutualTLS(t *testing.T) {
+	versioned := fromEnvExtensionClassificationDataModel(datamodel.Extension{
+		Kind: datamodel.MutualTLS,
+		MutualTLS: &datamodel.MutualTLSExtension{
+			Mesh: datamodel.ServiceMeshIstio,
+			Mode: datamodel.MutualTLSModePermissive,
+		},
+	})
+
+	expected := &MutualTLSExtension{
+		Kind: to.Ptr("mutualTls"),
+		Mesh: to.Ptr(ServiceMeshIstio),
+		Mode: to.Ptr(MutualTLSModePermissive),
+	}
+	require.Equal(t, expected, versioned)
+}
+
 func TestConvertFromValidation(t *testing.T) {
 	validationTests := []struct {
 		src v1.DataModelInterface
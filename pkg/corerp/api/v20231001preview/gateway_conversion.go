@@ -35,10 +35,17 @@ func (src *GatewayResource) ConvertTo() (v1.DataModelInterface, error) {
 			tls.SSLPassthrough = false
 		}
 
-		if src.Properties.TLS.CertificateFrom != nil {
+		if src.Properties.TLS.CertificateFrom != nil || len(src.Properties.TLS.Hosts) > 0 {
 			tls.CertificateFrom = to.String(src.Properties.TLS.CertificateFrom)
 			tls.MinimumProtocolVersion = toTLSMinVersionDataModel(src.Properties.TLS.MinimumProtocolVersion)
 		}
+
+		for _, h := range src.Properties.TLS.Hosts {
+			tls.Hosts = append(tls.Hosts, datamodel.GatewayTLSHost{
+				Hostname:        to.String(h.Hostname),
+				CertificateFrom: to.String(h.CertificateFrom),
+			})
+		}
 	}
 
 	// Note: SystemData conversion isn't required since this property comes ARM and datastore.
@@ -50,6 +57,51 @@ func (src *GatewayResource) ConvertTo() (v1.DataModelInterface, error) {
 				Path:             to.String(r.Path),
 				ReplacePrefix:    to.String(r.ReplacePrefix),
 				EnableWebsockets: to.Bool(r.EnableWebsockets),
+				Methods:          stringSlice(r.Methods),
+				ReplaceHostname:  to.String(r.ReplaceHostname),
+			}
+			if r.Kind != nil {
+				s.Kind = datamodel.GatewayRouteKind(*r.Kind)
+			}
+			for _, h := range r.Headers {
+				s.Headers = append(s.Headers, datamodel.GatewayRouteHeaderMatch{
+					Name:  to.String(h.Name),
+					Value: to.String(h.Value),
+				})
+			}
+			if r.Redirect != nil {
+				s.Redirect = &datamodel.GatewayRouteRedirect{
+					Scheme:     to.String(r.Redirect.Scheme),
+					Hostname:   to.String(r.Redirect.Hostname),
+					Port:       to.Int32(r.Redirect.Port),
+					Path:       to.String(r.Redirect.Path),
+					StatusCode: to.Int32(r.Redirect.StatusCode),
+				}
+			}
+			if r.RateLimit != nil {
+				s.RateLimit = &datamodel.GatewayRouteRateLimit{
+					Requests: to.Int32(r.RateLimit.Requests),
+					Unit:     to.String(r.RateLimit.Unit),
+					Burst:    to.Int32(r.RateLimit.Burst),
+				}
+			}
+			if r.Timeouts != nil {
+				s.Timeouts = &datamodel.GatewayRouteTimeouts{
+					Response: to.String(r.Timeouts.Response),
+					Idle:     to.String(r.Timeouts.Idle),
+				}
+			}
+			if r.RetryPolicy != nil {
+				s.RetryPolicy = &datamodel.GatewayRouteRetryPolicy{
+					Attempts:      to.Int32(r.RetryPolicy.Attempts),
+					PerTryTimeout: to.String(r.RetryPolicy.PerTryTimeout),
+				}
+			}
+			for _, d := range r.Destinations {
+				s.Destinations = append(s.Destinations, datamodel.GatewayRouteDestination{
+					Destination: to.String(d.Destination),
+					Weight:      to.Int32(d.Weight),
+				})
 			}
 			routes = append(routes, s)
 		}
@@ -60,6 +112,7 @@ func (src *GatewayResource) ConvertTo() (v1.DataModelInterface, error) {
 		hostname = &datamodel.GatewayPropertiesHostname{
 			FullyQualifiedHostname: to.String(src.Properties.Hostname.FullyQualifiedHostname),
 			Prefix:                 to.String(src.Properties.Hostname.Prefix),
+			DNSZone:                to.String(src.Properties.Hostname.DNSZone),
 		}
 	}
 
@@ -105,6 +158,13 @@ func (dst *GatewayResource) ConvertFrom(src v1.DataModelInterface) error {
 			MinimumProtocolVersion: fromTLSMinVersionDataModel(g.Properties.TLS.MinimumProtocolVersion),
 			SSLPassthrough:         to.Ptr(g.Properties.TLS.SSLPassthrough),
 		}
+
+		for _, h := range g.Properties.TLS.Hosts {
+			tls.Hosts = append(tls.Hosts, &GatewayTLSHost{
+				Hostname:        to.Ptr(h.Hostname),
+				CertificateFrom: to.Ptr(h.CertificateFrom),
+			})
+		}
 	}
 
 	routes := []*GatewayRoute{}
@@ -115,6 +175,51 @@ func (dst *GatewayResource) ConvertFrom(src v1.DataModelInterface) error {
 				Path:             to.Ptr(r.Path),
 				ReplacePrefix:    to.Ptr(r.ReplacePrefix),
 				EnableWebsockets: to.Ptr(r.EnableWebsockets),
+				Methods:          to.SliceOfPtrs(r.Methods...),
+				ReplaceHostname:  to.Ptr(r.ReplaceHostname),
+			}
+			if r.Kind != "" {
+				s.Kind = to.Ptr(GatewayRouteKind(r.Kind))
+			}
+			for _, h := range r.Headers {
+				s.Headers = append(s.Headers, &GatewayRouteHeaderMatch{
+					Name:  to.Ptr(h.Name),
+					Value: to.Ptr(h.Value),
+				})
+			}
+			if r.Redirect != nil {
+				s.Redirect = &GatewayRouteRedirect{
+					Scheme:     to.Ptr(r.Redirect.Scheme),
+					Hostname:   to.Ptr(r.Redirect.Hostname),
+					Port:       to.Ptr(r.Redirect.Port),
+					Path:       to.Ptr(r.Redirect.Path),
+					StatusCode: to.Ptr(r.Redirect.StatusCode),
+				}
+			}
+			if r.RateLimit != nil {
+				s.RateLimit = &GatewayRouteRateLimit{
+					Requests: to.Ptr(r.RateLimit.Requests),
+					Unit:     to.Ptr(r.RateLimit.Unit),
+					Burst:    to.Ptr(r.RateLimit.Burst),
+				}
+			}
+			if r.Timeouts != nil {
+				s.Timeouts = &GatewayRouteTimeouts{
+					Response: to.Ptr(r.Timeouts.Response),
+					Idle:     to.Ptr(r.Timeouts.Idle),
+				}
+			}
+			if r.RetryPolicy != nil {
+				s.RetryPolicy = &GatewayRouteRetryPolicy{
+					Attempts:      to.Ptr(r.RetryPolicy.Attempts),
+					PerTryTimeout: to.Ptr(r.RetryPolicy.PerTryTimeout),
+				}
+			}
+			for _, d := range r.Destinations {
+				s.Destinations = append(s.Destinations, &GatewayRouteDestination{
+					Destination: to.Ptr(d.Destination),
+					Weight:      to.Ptr(d.Weight),
+				})
 			}
 			routes = append(routes, s)
 		}
@@ -125,6 +230,7 @@ func (dst *GatewayResource) ConvertFrom(src v1.DataModelInterface) error {
 		hostname = &GatewayHostname{
 			FullyQualifiedHostname: to.Ptr(g.Properties.Hostname.FullyQualifiedHostname),
 			Prefix:                 to.Ptr(g.Properties.Hostname.Prefix),
+			DNSZone:                to.Ptr(g.Properties.Hostname.DNSZone),
 		}
 	}
 
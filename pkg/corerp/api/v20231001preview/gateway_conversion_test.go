@@ -23,6 +23,7 @@ import (
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
 	"github.com/radius-project/radius/pkg/corerp/datamodel"
 	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+	"github.com/radius-project/radius/pkg/to"
 	"github.com/radius-project/radius/test/testutil"
 	"github.com/radius-project/radius/test/testutil/resourcetypeutil"
 
@@ -48,6 +49,7 @@ func TestGatewayConvertVersionedToDataModel(t *testing.T) {
 	require.Equal(t, "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/testGroup/providers/Applications.Core/applications/app0", gw.Properties.Application)
 	require.Equal(t, "myapp.mydomain.com", gw.Properties.Hostname.FullyQualifiedHostname)
 	require.Equal(t, "myprefix", gw.Properties.Hostname.Prefix)
+	require.Equal(t, "mydomain.com", gw.Properties.Hostname.DNSZone)
 	require.Equal(t, "mydestination", gw.Properties.Routes[0].Destination)
 	require.Equal(t, "mypath", gw.Properties.Routes[0].Path)
 	require.Equal(t, "myreplaceprefix", gw.Properties.Routes[0].ReplacePrefix)
@@ -76,6 +78,7 @@ func TestGatewayConvertDataModelToVersioned(t *testing.T) {
 	require.Equal(t, "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/testGroup/providers/Applications.Core/applications/app0", *versioned.Properties.Application)
 	require.Equal(t, "myapp.mydomain.com", *versioned.Properties.Hostname.FullyQualifiedHostname)
 	require.Equal(t, "myprefix", *versioned.Properties.Hostname.Prefix)
+	require.Equal(t, "mydomain.com", *versioned.Properties.Hostname.DNSZone)
 	require.Equal(t, "myreplaceprefix", *versioned.Properties.Routes[0].ReplacePrefix)
 	require.False(t, *versioned.Properties.Routes[0].EnableWebsockets)
 	require.Equal(t, "mypath", *versioned.Properties.Routes[0].Path)
@@ -195,6 +198,174 @@ func TestGatewayEnableWebsocketsConvertDataModelToVersioned(t *testing.T) {
 	require.Equal(t, true, *versioned.Properties.TLS.SSLPassthrough)
 }
 
+func TestGatewayWeightedDestinationsConvertVersionedToDataModel(t *testing.T) {
+	// arrange
+	rawPayload := testutil.ReadFixture("gatewayresourcedatamodel-with-weighteddestinations.json")
+	r := &GatewayResource{}
+	err := json.Unmarshal(rawPayload, r)
+	require.NoError(t, err)
+
+	// act
+	dm, err := r.ConvertTo()
+
+	// assert
+	require.NoError(t, err)
+	gw := dm.(*datamodel.Gateway)
+	require.Equal(t, "", gw.Properties.Routes[0].Destination)
+	require.Equal(t, "mypath", gw.Properties.Routes[0].Path)
+	expected := []datamodel.GatewayRouteDestination{
+		{Destination: "http://myservice", Weight: 90},
+		{Destination: "http://myservice-canary", Weight: 10},
+	}
+	require.Equal(t, expected, gw.Properties.Routes[0].Destinations)
+}
+
+func TestGatewayWeightedDestinationsConvertDataModelToVersioned(t *testing.T) {
+	// arrange
+	rawPayload := testutil.ReadFixture("gatewayresourcedatamodel-with-weighteddestinations.json")
+	r := &datamodel.Gateway{}
+	err := json.Unmarshal(rawPayload, r)
+	require.NoError(t, err)
+
+	// act
+	versioned := &GatewayResource{}
+	err = versioned.ConvertFrom(r)
+
+	// assert
+	require.NoError(t, err)
+	require.Equal(t, "mypath", *versioned.Properties.Routes[0].Path)
+	expected := []*GatewayRouteDestination{
+		{Destination: to.Ptr("http://myservice"), Weight: to.Ptr[int32](90)},
+		{Destination: to.Ptr("http://myservice-canary"), Weight: to.Ptr[int32](10)},
+	}
+	require.Equal(t, expected, versioned.Properties.Routes[0].Destinations)
+}
+
+func TestGatewayMatchesConvertVersionedToDataModel(t *testing.T) {
+	// arrange
+	rawPayload := testutil.ReadFixture("gatewayresourcedatamodel-with-matches.json")
+	r := &GatewayResource{}
+	err := json.Unmarshal(rawPayload, r)
+	require.NoError(t, err)
+
+	// act
+	dm, err := r.ConvertTo()
+
+	// assert
+	require.NoError(t, err)
+	gw := dm.(*datamodel.Gateway)
+	require.Equal(t, []datamodel.GatewayRouteHeaderMatch{{Name: "x-canary", Value: "true"}}, gw.Properties.Routes[0].Headers)
+	require.Equal(t, []string{"GET", "HEAD"}, gw.Properties.Routes[0].Methods)
+	require.Equal(t, "myservice.internal", gw.Properties.Routes[0].ReplaceHostname)
+	require.Nil(t, gw.Properties.Routes[0].Redirect)
+	expected := &datamodel.GatewayRouteRedirect{
+		Scheme:     "https",
+		Hostname:   "myapp.mydomain.com",
+		Port:       443,
+		Path:       "/new",
+		StatusCode: 301,
+	}
+	require.Equal(t, expected, gw.Properties.Routes[1].Redirect)
+}
+
+func TestGatewayMatchesConvertDataModelToVersioned(t *testing.T) {
+	// arrange
+	rawPayload := testutil.ReadFixture("gatewayresourcedatamodel-with-matches.json")
+	r := &datamodel.Gateway{}
+	err := json.Unmarshal(rawPayload, r)
+	require.NoError(t, err)
+
+	// act
+	versioned := &GatewayResource{}
+	err = versioned.ConvertFrom(r)
+
+	// assert
+	require.NoError(t, err)
+	require.Equal(t, []*GatewayRouteHeaderMatch{{Name: to.Ptr("x-canary"), Value: to.Ptr("true")}}, versioned.Properties.Routes[0].Headers)
+	require.Equal(t, to.SliceOfPtrs("GET", "HEAD"), versioned.Properties.Routes[0].Methods)
+	require.Equal(t, "myservice.internal", *versioned.Properties.Routes[0].ReplaceHostname)
+	require.Nil(t, versioned.Properties.Routes[0].Redirect)
+	expected := &GatewayRouteRedirect{
+		Scheme:     to.Ptr("https"),
+		Hostname:   to.Ptr("myapp.mydomain.com"),
+		Port:       to.Ptr[int32](443),
+		Path:       to.Ptr("/new"),
+		StatusCode: to.Ptr[int32](301),
+	}
+	require.Equal(t, expected, versioned.Properties.Routes[1].Redirect)
+}
+
+func TestGatewayPoliciesConvertVersionedToDataModel(t *testing.T) {
+	// arrange
+	rawPayload := testutil.ReadFixture("gatewayresourcedatamodel-with-policies.json")
+	r := &GatewayResource{}
+	err := json.Unmarshal(rawPayload, r)
+	require.NoError(t, err)
+
+	// act
+	dm, err := r.ConvertTo()
+
+	// assert
+	require.NoError(t, err)
+	gw := dm.(*datamodel.Gateway)
+	require.Equal(t, &datamodel.GatewayRouteRateLimit{Requests: 100, Unit: "minute", Burst: 10}, gw.Properties.Routes[0].RateLimit)
+	require.Equal(t, &datamodel.GatewayRouteTimeouts{Response: "30s", Idle: "5m"}, gw.Properties.Routes[0].Timeouts)
+	require.Equal(t, &datamodel.GatewayRouteRetryPolicy{Attempts: 3, PerTryTimeout: "5s"}, gw.Properties.Routes[0].RetryPolicy)
+}
+
+func TestGatewayPoliciesConvertDataModelToVersioned(t *testing.T) {
+	// arrange
+	rawPayload := testutil.ReadFixture("gatewayresourcedatamodel-with-policies.json")
+	r := &datamodel.Gateway{}
+	err := json.Unmarshal(rawPayload, r)
+	require.NoError(t, err)
+
+	// act
+	versioned := &GatewayResource{}
+	err = versioned.ConvertFrom(r)
+
+	// assert
+	require.NoError(t, err)
+	expectedRateLimit := &GatewayRouteRateLimit{Requests: to.Ptr[int32](100), Unit: to.Ptr("minute"), Burst: to.Ptr[int32](10)}
+	require.Equal(t, expectedRateLimit, versioned.Properties.Routes[0].RateLimit)
+	require.Equal(t, &GatewayRouteTimeouts{Response: to.Ptr("30s"), Idle: to.Ptr("5m")}, versioned.Properties.Routes[0].Timeouts)
+	require.Equal(t, &GatewayRouteRetryPolicy{Attempts: to.Ptr[int32](3), PerTryTimeout: to.Ptr("5s")}, versioned.Properties.Routes[0].RetryPolicy)
+}
+
+func TestGatewayRouteKindsConvertVersionedToDataModel(t *testing.T) {
+	// arrange
+	rawPayload := testutil.ReadFixture("gatewayresourcedatamodel-with-routekinds.json")
+	r := &GatewayResource{}
+	err := json.Unmarshal(rawPayload, r)
+	require.NoError(t, err)
+
+	// act
+	dm, err := r.ConvertTo()
+
+	// assert
+	require.NoError(t, err)
+	gw := dm.(*datamodel.Gateway)
+	require.Equal(t, datamodel.GatewayRouteKindWebsocket, gw.Properties.Routes[0].Kind)
+	require.Equal(t, datamodel.GatewayRouteKindTCP, gw.Properties.Routes[1].Kind)
+}
+
+func TestGatewayRouteKindsConvertDataModelToVersioned(t *testing.T) {
+	// arrange
+	rawPayload := testutil.ReadFixture("gatewayresourcedatamodel-with-routekinds.json")
+	r := &datamodel.Gateway{}
+	err := json.Unmarshal(rawPayload, r)
+	require.NoError(t, err)
+
+	// act
+	versioned := &GatewayResource{}
+	err = versioned.ConvertFrom(r)
+
+	// assert
+	require.NoError(t, err)
+	require.Equal(t, to.Ptr(GatewayRouteKindWebsocket), versioned.Properties.Routes[0].Kind)
+	require.Equal(t, to.Ptr(GatewayRouteKindTCP), versioned.Properties.Routes[1].Kind)
+}
+
 func TestGatewayTLSTerminationConvertVersionedToDataModel(t *testing.T) {
 	// arrange
 	rawPayload := testutil.ReadFixture("gatewayresource-with-tlstermination.json")
@@ -223,6 +394,7 @@ func TestGatewayTLSTerminationConvertVersionedToDataModel(t *testing.T) {
 	require.Equal(t, "2023-10-01-preview", gw.InternalMetadata.UpdatedAPIVersion)
 	require.Equal(t, "secretname", gw.Properties.TLS.CertificateFrom)
 	require.Equal(t, datamodel.TLSMinVersion13, gw.Properties.TLS.MinimumProtocolVersion)
+	require.Equal(t, []datamodel.GatewayTLSHost{{Hostname: "api.mydomain.com", CertificateFrom: "apisecretname"}}, gw.Properties.TLS.Hosts)
 }
 
 func TestGatewayTLSTerminationConvertDataModelToVersioned(t *testing.T) {
@@ -251,6 +423,7 @@ func TestGatewayTLSTerminationConvertDataModelToVersioned(t *testing.T) {
 	require.Equal(t, resourcetypeutil.MustPopulateResourceStatus(&ResourceStatus{}), versioned.Properties.Status)
 	require.Equal(t, "secretname", *versioned.Properties.TLS.CertificateFrom)
 	require.Equal(t, TLSMinVersionTls13, *versioned.Properties.TLS.MinimumProtocolVersion)
+	require.Equal(t, []*GatewayTLSHost{{Hostname: to.Ptr("api.mydomain.com"), CertificateFrom: to.Ptr("apisecretname")}}, versioned.Properties.TLS.Hosts)
 }
 
 func TestGatewayTLSTerminationConvertVersionedToDataModel_NoMinProtocolVersion(t *testing.T) {
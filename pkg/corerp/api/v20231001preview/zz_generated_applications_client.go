@@ -251,6 +251,62 @@ func (client *ApplicationsClient) getGraphHandleResponse(resp *http.Response) (A
 	return result, nil
 }
 
+// GetStatus - Gets the status of the resources of the application.
+// If the operation fails it returns an *azcore.ResponseError type.
+//
+// Generated from API version 2023-10-01-preview
+//   - applicationName - The application name
+//   - body - The content of the action request
+//   - options - ApplicationsClientGetStatusOptions contains the optional parameters for the ApplicationsClient.GetStatus method.
+func (client *ApplicationsClient) GetStatus(ctx context.Context, applicationName string, body map[string]any, options *ApplicationsClientGetStatusOptions) (ApplicationsClientGetStatusResponse, error) {
+	var err error
+	req, err := client.getStatusCreateRequest(ctx, applicationName, body, options)
+	if err != nil {
+		return ApplicationsClientGetStatusResponse{}, err
+	}
+	httpResp, err := client.internal.Pipeline().Do(req)
+	if err != nil {
+		return ApplicationsClientGetStatusResponse{}, err
+	}
+	if !runtime.HasStatusCode(httpResp, http.StatusOK) {
+		err = runtime.NewResponseError(httpResp)
+		return ApplicationsClientGetStatusResponse{}, err
+	}
+	resp, err := client.getStatusHandleResponse(httpResp)
+	return resp, err
+}
+
+// getStatusCreateRequest creates the GetStatus request.
+func (client *ApplicationsClient) getStatusCreateRequest(ctx context.Context, applicationName string, body map[string]any, options *ApplicationsClientGetStatusOptions) (*policy.Request, error) {
+	urlPath := "/{rootScope}/providers/Applications.Core/applications/{applicationName}/getStatus"
+	urlPath = strings.ReplaceAll(urlPath, "{rootScope}", client.rootScope)
+	if applicationName == "" {
+		return nil, errors.New("parameter applicationName cannot be empty")
+	}
+	urlPath = strings.ReplaceAll(urlPath, "{applicationName}", url.PathEscape(applicationName))
+	req, err := runtime.NewRequest(ctx, http.MethodPost, runtime.JoinPaths(client.internal.Endpoint(), urlPath))
+	if err != nil {
+		return nil, err
+	}
+	reqQP := req.Raw().URL.Query()
+	reqQP.Set("api-version", "2023-10-01-preview")
+	req.Raw().URL.RawQuery = reqQP.Encode()
+	req.Raw().Header["Accept"] = []string{"application/json"}
+	if err := runtime.MarshalAsJSON(req, body); err != nil {
+	return nil, err
+}
+	return req, nil
+}
+
+// getStatusHandleResponse handles the GetStatus response.
+func (client *ApplicationsClient) getStatusHandleResponse(resp *http.Response) (ApplicationsClientGetStatusResponse, error) {
+	result := ApplicationsClientGetStatusResponse{}
+	if err := runtime.UnmarshalAsJSON(resp, &result.ApplicationStatusResponse); err != nil {
+		return ApplicationsClientGetStatusResponse{}, err
+	}
+	return result, nil
+}
+
 // NewListByScopePager - List ApplicationResource resources by Scope
 //
 // Generated from API version 2023-10-01-preview
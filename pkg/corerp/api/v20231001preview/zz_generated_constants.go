@@ -142,6 +142,48 @@ func PossibleDirectionValues() []Direction {
 	}
 }
 
+// GatewayRouteKind - The kind of traffic served by a gateway route.
+type GatewayRouteKind string
+
+const (
+	// GatewayRouteKindHTTP - HTTP requests, routed on their path, headers and methods.
+	GatewayRouteKindHTTP GatewayRouteKind = "http"
+	// GatewayRouteKindTCP - Raw TCP connections, forwarded to the service once TLS is handled by the gateway.
+	GatewayRouteKindTCP GatewayRouteKind = "tcp"
+	// GatewayRouteKindWebsocket - HTTP requests upgraded to WebSocket connections.
+	GatewayRouteKindWebsocket GatewayRouteKind = "websocket"
+)
+
+// PossibleGatewayRouteKindValues returns the possible values for the GatewayRouteKind const type.
+func PossibleGatewayRouteKindValues() []GatewayRouteKind {
+	return []GatewayRouteKind{	
+		GatewayRouteKindHTTP,
+		GatewayRouteKindTCP,
+		GatewayRouteKindWebsocket,
+	}
+}
+
+// HealthState - The health state of a resource.
+type HealthState string
+
+const (
+	// HealthStateHealthy - The resource is provisioned and its workloads are ready.
+	HealthStateHealthy HealthState = "Healthy"
+	// HealthStateUnhealthy - The resource failed to provision or its workloads are not ready.
+	HealthStateUnhealthy HealthState = "Unhealthy"
+	// HealthStateUnknown - The health of the resource cannot be determined, for example because it is still being provisioned.
+	HealthStateUnknown HealthState = "Unknown"
+)
+
+// PossibleHealthStateValues returns the possible values for the HealthState const type.
+func PossibleHealthStateValues() []HealthState {
+	return []HealthState{	
+		HealthStateHealthy,
+		HealthStateUnhealthy,
+		HealthStateUnknown,
+	}
+}
+
 // IAMKind - The kind of IAM provider to configure
 type IAMKind string
 
@@ -196,6 +238,60 @@ func PossibleImagePullPolicyValues() []ImagePullPolicy {
 	}
 }
 
+// KubernetesExternalTrafficPolicy - The external traffic policy of a Kubernetes Service
+type KubernetesExternalTrafficPolicy string
+
+const (
+	// KubernetesExternalTrafficPolicyCluster - External traffic is routed to replicas on all nodes
+	KubernetesExternalTrafficPolicyCluster KubernetesExternalTrafficPolicy = "Cluster"
+	// KubernetesExternalTrafficPolicyLocal - External traffic is routed to replicas on the receiving node, preserving the client source IP
+	KubernetesExternalTrafficPolicyLocal KubernetesExternalTrafficPolicy = "Local"
+)
+
+// PossibleKubernetesExternalTrafficPolicyValues returns the possible values for the KubernetesExternalTrafficPolicy const type.
+func PossibleKubernetesExternalTrafficPolicyValues() []KubernetesExternalTrafficPolicy {
+	return []KubernetesExternalTrafficPolicy{	
+		KubernetesExternalTrafficPolicyCluster,
+		KubernetesExternalTrafficPolicyLocal,
+	}
+}
+
+// KubernetesServiceType - The type of a Kubernetes Service
+type KubernetesServiceType string
+
+const (
+	// KubernetesServiceTypeClusterIP - The Service is reachable from within the cluster
+	KubernetesServiceTypeClusterIP KubernetesServiceType = "ClusterIP"
+	// KubernetesServiceTypeLoadBalancer - The Service is exposed through a cloud load balancer
+	KubernetesServiceTypeLoadBalancer KubernetesServiceType = "LoadBalancer"
+)
+
+// PossibleKubernetesServiceTypeValues returns the possible values for the KubernetesServiceType const type.
+func PossibleKubernetesServiceTypeValues() []KubernetesServiceType {
+	return []KubernetesServiceType{	
+		KubernetesServiceTypeClusterIP,
+		KubernetesServiceTypeLoadBalancer,
+	}
+}
+
+// KubernetesSessionAffinity - The session affinity of a Kubernetes Service
+type KubernetesSessionAffinity string
+
+const (
+	// KubernetesSessionAffinityClientIP - Connections of a client are routed to the same replica
+	KubernetesSessionAffinityClientIP KubernetesSessionAffinity = "ClientIP"
+	// KubernetesSessionAffinityNone - Connections are balanced between replicas
+	KubernetesSessionAffinityNone KubernetesSessionAffinity = "None"
+)
+
+// PossibleKubernetesSessionAffinityValues returns the possible values for the KubernetesSessionAffinity const type.
+func PossibleKubernetesSessionAffinityValues() []KubernetesSessionAffinity {
+	return []KubernetesSessionAffinity{	
+		KubernetesSessionAffinityClientIP,
+		KubernetesSessionAffinityNone,
+	}
+}
+
 // ManagedStore - The managed store for the ephemeral volume
 type ManagedStore string
 
@@ -214,6 +310,24 @@ func PossibleManagedStoreValues() []ManagedStore {
 	}
 }
 
+// MutualTLSMode - The mutual TLS mode of a mutual TLS extension
+type MutualTLSMode string
+
+const (
+	// MutualTLSModePermissive - Both mutual TLS and plaintext traffic are accepted by the containers
+	MutualTLSModePermissive MutualTLSMode = "permissive"
+	// MutualTLSModeStrict - Only mutual TLS traffic is accepted by the containers
+	MutualTLSModeStrict MutualTLSMode = "strict"
+)
+
+// PossibleMutualTLSModeValues returns the possible values for the MutualTLSMode const type.
+func PossibleMutualTLSModeValues() []MutualTLSMode {
+	return []MutualTLSMode{	
+		MutualTLSModePermissive,
+		MutualTLSModeStrict,
+	}
+}
+
 // Origin - The intended executor of the operation; as in Resource Based Access Control (RBAC) and audit logs UX. Default
 // value is "user,system"
 type Origin string
@@ -373,6 +487,21 @@ func PossibleSecretValueEncodingValues() []SecretValueEncoding {
 	}
 }
 
+// ServiceMesh - The service mesh of a mutual TLS extension
+type ServiceMesh string
+
+const (
+	// ServiceMeshIstio - Istio service mesh
+	ServiceMeshIstio ServiceMesh = "istio"
+)
+
+// PossibleServiceMeshValues returns the possible values for the ServiceMesh const type.
+func PossibleServiceMeshValues() []ServiceMesh {
+	return []ServiceMesh{	
+		ServiceMeshIstio,
+	}
+}
+
 // TLSMinVersion - Tls Minimum versions for Gateway resource.
 type TLSMinVersion string
 
@@ -397,12 +526,15 @@ type Versions string
 const (
 	// VersionsV20231001Preview - 2023-10-01-preview
 	VersionsV20231001Preview Versions = "2023-10-01-preview"
+	// VersionsV20250801 - 2025-08-01
+	VersionsV20250801 Versions = "2025-08-01"
 )
 
 // PossibleVersionsValues returns the possible values for the Versions const type.
 func PossibleVersionsValues() []Versions {
 	return []Versions{	
 		VersionsV20231001Preview,
+		VersionsV20250801,
 	}
 }
 
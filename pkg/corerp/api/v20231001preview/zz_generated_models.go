@@ -124,6 +124,33 @@ type ApplicationResourceUpdateProperties struct {
 	Extensions []ExtensionClassification
 }
 
+// ApplicationStatusResource - Describes the status of a resource of an application.
+type ApplicationStatusResource struct {
+	// REQUIRED; The endpoints and computed values of this resource, such as its URL, host and port.
+	ComputedValues map[string]*string
+
+	// REQUIRED; The health state of this resource.
+	HealthState *HealthState
+
+	// REQUIRED; The resource ID.
+	ID *string
+
+	// REQUIRED; The resource name.
+	Name *string
+
+	// REQUIRED; provisioningState of this resource.
+	ProvisioningState *string
+
+	// REQUIRED; The resource type.
+	Type *string
+}
+
+// ApplicationStatusResponse - Describes the status of the resources of an application.
+type ApplicationStatusResponse struct {
+	// REQUIRED; The resources of the application.
+	Resources []*ApplicationStatusResource
+}
+
 // AuthConfig - Authentication information used to access private Terraform module sources. Supported module sources: Git.
 type AuthConfig struct {
 	// Authentication information used to access private Terraform modules from Git repository sources.
@@ -250,6 +277,24 @@ type CertificateObjectProperties struct {
 	Version *string
 }
 
+// ConnectionPrivateEndpoint - Private endpoint properties of a connection
+type ConnectionPrivateEndpoint struct {
+	// REQUIRED; The resource id of the subnet in which the private endpoint is created
+	Subnet *string
+
+	// The resource id of the private DNS zone in which the private endpoint registers its hostname
+	PrivateDNSZone *string
+}
+
+// ConnectionPrivateEndpointUpdate - Private endpoint properties of a connection
+type ConnectionPrivateEndpointUpdate struct {
+	// The resource id of the private DNS zone in which the private endpoint registers its hostname
+	PrivateDNSZone *string
+
+	// The resource id of the subnet in which the private endpoint is created
+	Subnet *string
+}
+
 // ConnectionProperties - Connection Properties
 type ConnectionProperties struct {
 	// REQUIRED; The source of the connection
@@ -260,6 +305,9 @@ type ConnectionProperties struct {
 
 	// iam properties
 	Iam *IamProperties
+
+	// Private endpoint through which the container reaches the source of the connection
+	PrivateEndpoint *ConnectionPrivateEndpoint
 }
 
 // ConnectionPropertiesUpdate - Connection Properties
@@ -270,6 +318,9 @@ type ConnectionPropertiesUpdate struct {
 	// iam properties
 	Iam *IamPropertiesUpdate
 
+	// Private endpoint through which the container reaches the source of the connection
+	PrivateEndpoint *ConnectionPrivateEndpointUpdate
+
 	// The source of the connection
 	Source *string
 }
@@ -842,6 +893,10 @@ func (e *Extension) GetExtension() *Extension { return e }
 
 // GatewayHostname - Declare hostname information for the Gateway. Leaving the hostname empty auto-assigns one: mygateway.myapp.PUBLICHOSTNAMEORIP.nip.io.
 type GatewayHostname struct {
+	// The DNS zone hosting 'fullyQualifiedHostname', as a domain name or the resource id of a DNS zone. Ex - 'mydomain.com'. When
+// set, the gateway is annotated for external-dns to manage its DNS record in the zone.
+	DNSZone *string
+
 	// Specify a fully-qualified domain name: myapp.mydomain.com. Mutually exclusive with 'prefix' and will take priority if both
 // are defined.
 	FullyQualifiedHostname *string
@@ -949,15 +1004,110 @@ type GatewayRoute struct {
 	// The URL or id of the service to route to. Ex - 'http://myservice'.
 	Destination *string
 
+	// The services to split the traffic of the route between, according to their weights. Mutually exclusive with 'destination'.
+	Destinations []*GatewayRouteDestination
+
 	// Enables websocket support for the route. Defaults to false.
 	EnableWebsockets *bool
 
+	// The headers to match the incoming request headers on. All of them must match.
+	Headers []*GatewayRouteHeaderMatch
+
+	// The kind of traffic served by the route. Defaults to http. A tcp route must be the only route of a gateway with TLS configured.
+	Kind *GatewayRouteKind
+
+	// The HTTP methods to match the incoming request method on. Ex - ['GET', 'HEAD']. Defaults to matching all methods.
+	Methods []*string
+
 	// The path to match the incoming request path on. Ex - /myservice.
 	Path *string
 
+	// Limit the rate of the requests sent to the service by each replica of the gateway.
+	RateLimit *GatewayRouteRateLimit
+
+	// Redirect the matching requests instead of sending them to a service. Mutually exclusive with 'destination' and 'destinations'.
+	Redirect *GatewayRouteRedirect
+
+	// Optionally update the Host header when sending the request to the service. Ex - 'myservice.internal'.
+	ReplaceHostname *string
+
 	// Optionally update the prefix when sending the request to the service. Ex - replacePrefix: '/' and path: '/myservice' will
 // transform '/myservice/myroute' to '/myroute'
 	ReplacePrefix *string
+
+	// Retry the requests which failed to be sent to the service.
+	RetryPolicy *GatewayRouteRetryPolicy
+
+	// The timeouts of the requests sent to the service.
+	Timeouts *GatewayRouteTimeouts
+}
+
+// GatewayRouteDestination - A service to route a share of the traffic of a route to.
+type GatewayRouteDestination struct {
+	// REQUIRED; The URL or id of the service to route to. Ex - 'http://myservice'.
+	Destination *string
+
+	// The relative weight of the traffic routed to the service. Ex - weights of 90 and 10 send 90% of the traffic to the first
+// service. Defaults to splitting the traffic evenly.
+	Weight *int32
+}
+
+// GatewayRouteHeaderMatch - A header to match the incoming request headers on.
+type GatewayRouteHeaderMatch struct {
+	// REQUIRED; The name of the header. Ex - 'x-canary'.
+	Name *string
+
+	// The value the header must be equal to. Defaults to matching any request with the header.
+	Value *string
+}
+
+// GatewayRouteRateLimit - The rate limit of a route.
+type GatewayRouteRateLimit struct {
+	// REQUIRED; The number of requests allowed per unit of time.
+	Requests *int32
+
+	// REQUIRED; The unit of time of the rate limit: 'second', 'minute' or 'hour'.
+	Unit *string
+
+	// The number of requests allowed above the rate limit in a burst. Defaults to 0.
+	Burst *int32
+}
+
+// GatewayRouteRedirect - The redirect sent for the requests matching a route. The parts of the request which are not specified
+// are kept.
+type GatewayRouteRedirect struct {
+	// The hostname of the redirect. Ex - 'myapp.mydomain.com'.
+	Hostname *string
+
+	// The path of the redirect, replacing the whole path of the request. Ex - '/newpath'.
+	Path *string
+
+	// The port of the redirect.
+	Port *int32
+
+	// The scheme of the redirect. Ex - 'https'.
+	Scheme *string
+
+	// The HTTP status code of the redirect, 301 or 302. Defaults to 302.
+	StatusCode *int32
+}
+
+// GatewayRouteRetryPolicy - The retry policy of a route.
+type GatewayRouteRetryPolicy struct {
+	// REQUIRED; The maximum number of retries of a request.
+	Attempts *int32
+
+	// The timeout of each retry. Ex - '5s'. Defaults to the response timeout of the route.
+	PerTryTimeout *string
+}
+
+// GatewayRouteTimeouts - The timeouts of a route.
+type GatewayRouteTimeouts struct {
+	// The time to wait before closing the connection to the service when no data is sent. Ex - '5m'.
+	Idle *string
+
+	// The time to wait for the service to respond to a request. Ex - '30s'. Defaults to 15s.
+	Response *string
 }
 
 // GatewayTLS - TLS configuration definition for Gateway resource.
@@ -965,6 +1115,10 @@ type GatewayTLS struct {
 	// The resource id for the secret containing the TLS certificate and key for the gateway.
 	CertificateFrom *string
 
+	// Additional hosts served by the gateway, each with its own TLS certificate. The certificate of a request is selected using
+// Server Name Indication (SNI).
+	Hosts []*GatewayTLSHost
+
 	// TLS minimum protocol version (defaults to 1.2).
 	MinimumProtocolVersion *TLSMinVersion
 
@@ -972,6 +1126,15 @@ type GatewayTLS struct {
 	SSLPassthrough *bool
 }
 
+// GatewayTLSHost - A host served by the gateway with its own TLS certificate.
+type GatewayTLSHost struct {
+	// REQUIRED; The resource id for the secret store containing the TLS certificate and key for the host.
+	CertificateFrom *string
+
+	// REQUIRED; The fully-qualified domain name of the host. Ex - myapp.mydomain.com.
+	Hostname *string
+}
+
 // GitAuthConfig - Authentication information used to access private Terraform modules from Git repository sources.
 type GitAuthConfig struct {
 	// Personal Access Token (PAT) configuration used to authenticate to Git platforms.
@@ -1180,8 +1343,33 @@ type KubernetesRuntimeProperties struct {
 // Secrets, and ConfigMaps.
 	Base *string
 
+	// Generates a headless Service for the container so that DNS and SRV records are published for each of its replicas.
+	HeadlessService *bool
+
 	// A strategic merge patch that will be applied to the PodSpec object when this container is being deployed.
 	Pod map[string]any
+
+	// The configuration of the Service generated for the ports of the container.
+	Service *KubernetesServiceProperties
+}
+
+// KubernetesServiceProperties - The configuration of the Kubernetes Service generated for the ports of a container
+type KubernetesServiceProperties struct {
+	// Routes external traffic to replicas on the receiving node only when set to Local. Requires the LoadBalancer type. Defaults
+// to Cluster.
+	ExternalTrafficPolicy *KubernetesExternalTrafficPolicy
+
+	// Routes the connections of a client to the same replica when set to ClientIP. Defaults to None.
+	SessionAffinity *KubernetesSessionAffinity
+
+	// The number of seconds a client sticks to the same replica when the session affinity is ClientIP. Defaults to 10800.
+	SessionAffinityTimeoutSeconds *int32
+
+	// Prefers the replicas in the zone of the client when routing traffic to the Service.
+	TopologyAwareRouting *bool
+
+	// The type of the Service. Defaults to ClusterIP.
+	Type *KubernetesServiceType
 }
 
 // ManualScalingExtension - ManualScaling Extension
@@ -1200,6 +1388,26 @@ func (m *ManualScalingExtension) GetExtension() *Extension {
 	}
 }
 
+// MutualTLSExtension - Mutual TLS extension of an environment. Requires the service mesh to be installed on the Kubernetes
+// cluster.
+type MutualTLSExtension struct {
+	// REQUIRED; Discriminator property for Extension.
+	Kind *string
+
+	// REQUIRED; The service mesh encrypting and authenticating the traffic between the containers of the environment.
+	Mesh *ServiceMesh
+
+	// The mutual TLS mode. Defaults to strict.
+	Mode *MutualTLSMode
+}
+
+// GetExtension implements the ExtensionClassification interface for type MutualTLSExtension.
+func (m *MutualTLSExtension) GetExtension() *Extension {
+	return &Extension{
+		Kind: m.Kind,
+	}
+}
+
 // Operation - Details of a REST API operation, returned from the Resource Provider Operations API
 type Operation struct {
 	// Localized display information for this particular operation.
@@ -337,6 +337,80 @@ func (a *ApplicationResourceUpdateProperties) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+// MarshalJSON implements the json.Marshaller interface for type ApplicationStatusResource.
+func (a ApplicationStatusResource) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	populate(objectMap, "computedValues", a.ComputedValues)
+	populate(objectMap, "healthState", a.HealthState)
+	populate(objectMap, "id", a.ID)
+	populate(objectMap, "name", a.Name)
+	populate(objectMap, "provisioningState", a.ProvisioningState)
+	populate(objectMap, "type", a.Type)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type ApplicationStatusResource.
+func (a *ApplicationStatusResource) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", a, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "computedValues":
+				err = unpopulate(val, "ComputedValues", &a.ComputedValues)
+			delete(rawMsg, key)
+		case "healthState":
+				err = unpopulate(val, "HealthState", &a.HealthState)
+			delete(rawMsg, key)
+		case "id":
+				err = unpopulate(val, "ID", &a.ID)
+			delete(rawMsg, key)
+		case "name":
+				err = unpopulate(val, "Name", &a.Name)
+			delete(rawMsg, key)
+		case "provisioningState":
+				err = unpopulate(val, "ProvisioningState", &a.ProvisioningState)
+			delete(rawMsg, key)
+		case "type":
+				err = unpopulate(val, "Type", &a.Type)
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", a, err)
+		}
+	}
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaller interface for type ApplicationStatusResponse.
+func (a ApplicationStatusResponse) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	populate(objectMap, "resources", a.Resources)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type ApplicationStatusResponse.
+func (a *ApplicationStatusResponse) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", a, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "resources":
+				err = unpopulate(val, "Resources", &a.Resources)
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", a, err)
+		}
+	}
+	return nil
+}
+
 // MarshalJSON implements the json.Marshaller interface for type AuthConfig.
 func (a AuthConfig) MarshalJSON() ([]byte, error) {
 	objectMap := make(map[string]any)
@@ -575,11 +649,74 @@ func (c *CertificateObjectProperties) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+// MarshalJSON implements the json.Marshaller interface for type ConnectionPrivateEndpoint.
+func (c ConnectionPrivateEndpoint) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	populate(objectMap, "privateDnsZone", c.PrivateDNSZone)
+	populate(objectMap, "subnet", c.Subnet)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type ConnectionPrivateEndpoint.
+func (c *ConnectionPrivateEndpoint) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", c, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "privateDnsZone":
+				err = unpopulate(val, "PrivateDNSZone", &c.PrivateDNSZone)
+			delete(rawMsg, key)
+		case "subnet":
+				err = unpopulate(val, "Subnet", &c.Subnet)
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", c, err)
+		}
+	}
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaller interface for type ConnectionPrivateEndpointUpdate.
+func (c ConnectionPrivateEndpointUpdate) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	populate(objectMap, "privateDnsZone", c.PrivateDNSZone)
+	populate(objectMap, "subnet", c.Subnet)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type ConnectionPrivateEndpointUpdate.
+func (c *ConnectionPrivateEndpointUpdate) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", c, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "privateDnsZone":
+				err = unpopulate(val, "PrivateDNSZone", &c.PrivateDNSZone)
+			delete(rawMsg, key)
+		case "subnet":
+				err = unpopulate(val, "Subnet", &c.Subnet)
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", c, err)
+		}
+	}
+	return nil
+}
+
 // MarshalJSON implements the json.Marshaller interface for type ConnectionProperties.
 func (c ConnectionProperties) MarshalJSON() ([]byte, error) {
 	objectMap := make(map[string]any)
 	populate(objectMap, "disableDefaultEnvVars", c.DisableDefaultEnvVars)
 	populate(objectMap, "iam", c.Iam)
+	populate(objectMap, "privateEndpoint", c.PrivateEndpoint)
 	populate(objectMap, "source", c.Source)
 	return json.Marshal(objectMap)
 }
@@ -599,6 +736,9 @@ func (c *ConnectionProperties) UnmarshalJSON(data []byte) error {
 		case "iam":
 				err = unpopulate(val, "Iam", &c.Iam)
 			delete(rawMsg, key)
+		case "privateEndpoint":
+				err = unpopulate(val, "PrivateEndpoint", &c.PrivateEndpoint)
+			delete(rawMsg, key)
 		case "source":
 				err = unpopulate(val, "Source", &c.Source)
 			delete(rawMsg, key)
@@ -615,6 +755,7 @@ func (c ConnectionPropertiesUpdate) MarshalJSON() ([]byte, error) {
 	objectMap := make(map[string]any)
 	populate(objectMap, "disableDefaultEnvVars", c.DisableDefaultEnvVars)
 	populate(objectMap, "iam", c.Iam)
+	populate(objectMap, "privateEndpoint", c.PrivateEndpoint)
 	populate(objectMap, "source", c.Source)
 	return json.Marshal(objectMap)
 }
@@ -634,6 +775,9 @@ func (c *ConnectionPropertiesUpdate) UnmarshalJSON(data []byte) error {
 		case "iam":
 				err = unpopulate(val, "Iam", &c.Iam)
 			delete(rawMsg, key)
+		case "privateEndpoint":
+				err = unpopulate(val, "PrivateEndpoint", &c.PrivateEndpoint)
+			delete(rawMsg, key)
 		case "source":
 				err = unpopulate(val, "Source", &c.Source)
 			delete(rawMsg, key)
@@ -1993,6 +2137,7 @@ func (e *Extension) UnmarshalJSON(data []byte) error {
 // MarshalJSON implements the json.Marshaller interface for type GatewayHostname.
 func (g GatewayHostname) MarshalJSON() ([]byte, error) {
 	objectMap := make(map[string]any)
+	populate(objectMap, "dnsZone", g.DNSZone)
 	populate(objectMap, "fullyQualifiedHostname", g.FullyQualifiedHostname)
 	populate(objectMap, "prefix", g.Prefix)
 	return json.Marshal(objectMap)
@@ -2007,6 +2152,9 @@ func (g *GatewayHostname) UnmarshalJSON(data []byte) error {
 	for key, val := range rawMsg {
 		var err error
 		switch key {
+		case "dnsZone":
+				err = unpopulate(val, "DNSZone", &g.DNSZone)
+			delete(rawMsg, key)
 		case "fullyQualifiedHostname":
 				err = unpopulate(val, "FullyQualifiedHostname", &g.FullyQualifiedHostname)
 			delete(rawMsg, key)
@@ -2244,9 +2392,18 @@ func (g *GatewayResourceUpdateProperties) UnmarshalJSON(data []byte) error {
 func (g GatewayRoute) MarshalJSON() ([]byte, error) {
 	objectMap := make(map[string]any)
 	populate(objectMap, "destination", g.Destination)
+	populate(objectMap, "destinations", g.Destinations)
 	populate(objectMap, "enableWebsockets", g.EnableWebsockets)
+	populate(objectMap, "headers", g.Headers)
+	populate(objectMap, "kind", g.Kind)
+	populate(objectMap, "methods", g.Methods)
 	populate(objectMap, "path", g.Path)
+	populate(objectMap, "rateLimit", g.RateLimit)
+	populate(objectMap, "redirect", g.Redirect)
+	populate(objectMap, "replaceHostname", g.ReplaceHostname)
 	populate(objectMap, "replacePrefix", g.ReplacePrefix)
+	populate(objectMap, "retryPolicy", g.RetryPolicy)
+	populate(objectMap, "timeouts", g.Timeouts)
 	return json.Marshal(objectMap)
 }
 
@@ -2262,15 +2419,244 @@ func (g *GatewayRoute) UnmarshalJSON(data []byte) error {
 		case "destination":
 				err = unpopulate(val, "Destination", &g.Destination)
 			delete(rawMsg, key)
+		case "destinations":
+				err = unpopulate(val, "Destinations", &g.Destinations)
+			delete(rawMsg, key)
 		case "enableWebsockets":
 				err = unpopulate(val, "EnableWebsockets", &g.EnableWebsockets)
 			delete(rawMsg, key)
+		case "headers":
+				err = unpopulate(val, "Headers", &g.Headers)
+			delete(rawMsg, key)
+		case "kind":
+				err = unpopulate(val, "Kind", &g.Kind)
+			delete(rawMsg, key)
+		case "methods":
+				err = unpopulate(val, "Methods", &g.Methods)
+			delete(rawMsg, key)
 		case "path":
 				err = unpopulate(val, "Path", &g.Path)
 			delete(rawMsg, key)
+		case "rateLimit":
+				err = unpopulate(val, "RateLimit", &g.RateLimit)
+			delete(rawMsg, key)
+		case "redirect":
+				err = unpopulate(val, "Redirect", &g.Redirect)
+			delete(rawMsg, key)
+		case "replaceHostname":
+				err = unpopulate(val, "ReplaceHostname", &g.ReplaceHostname)
+			delete(rawMsg, key)
 		case "replacePrefix":
 				err = unpopulate(val, "ReplacePrefix", &g.ReplacePrefix)
 			delete(rawMsg, key)
+		case "retryPolicy":
+				err = unpopulate(val, "RetryPolicy", &g.RetryPolicy)
+			delete(rawMsg, key)
+		case "timeouts":
+				err = unpopulate(val, "Timeouts", &g.Timeouts)
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", g, err)
+		}
+	}
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaller interface for type GatewayRouteDestination.
+func (g GatewayRouteDestination) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	populate(objectMap, "destination", g.Destination)
+	populate(objectMap, "weight", g.Weight)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type GatewayRouteDestination.
+func (g *GatewayRouteDestination) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", g, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "destination":
+				err = unpopulate(val, "Destination", &g.Destination)
+			delete(rawMsg, key)
+		case "weight":
+				err = unpopulate(val, "Weight", &g.Weight)
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", g, err)
+		}
+	}
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaller interface for type GatewayRouteHeaderMatch.
+func (g GatewayRouteHeaderMatch) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	populate(objectMap, "name", g.Name)
+	populate(objectMap, "value", g.Value)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type GatewayRouteHeaderMatch.
+func (g *GatewayRouteHeaderMatch) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", g, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "name":
+				err = unpopulate(val, "Name", &g.Name)
+			delete(rawMsg, key)
+		case "value":
+				err = unpopulate(val, "Value", &g.Value)
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", g, err)
+		}
+	}
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaller interface for type GatewayRouteRateLimit.
+func (g GatewayRouteRateLimit) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	populate(objectMap, "burst", g.Burst)
+	populate(objectMap, "requests", g.Requests)
+	populate(objectMap, "unit", g.Unit)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type GatewayRouteRateLimit.
+func (g *GatewayRouteRateLimit) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", g, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "burst":
+				err = unpopulate(val, "Burst", &g.Burst)
+			delete(rawMsg, key)
+		case "requests":
+				err = unpopulate(val, "Requests", &g.Requests)
+			delete(rawMsg, key)
+		case "unit":
+				err = unpopulate(val, "Unit", &g.Unit)
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", g, err)
+		}
+	}
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaller interface for type GatewayRouteRedirect.
+func (g GatewayRouteRedirect) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	populate(objectMap, "hostname", g.Hostname)
+	populate(objectMap, "path", g.Path)
+	populate(objectMap, "port", g.Port)
+	populate(objectMap, "scheme", g.Scheme)
+	populate(objectMap, "statusCode", g.StatusCode)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type GatewayRouteRedirect.
+func (g *GatewayRouteRedirect) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", g, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "hostname":
+				err = unpopulate(val, "Hostname", &g.Hostname)
+			delete(rawMsg, key)
+		case "path":
+				err = unpopulate(val, "Path", &g.Path)
+			delete(rawMsg, key)
+		case "port":
+				err = unpopulate(val, "Port", &g.Port)
+			delete(rawMsg, key)
+		case "scheme":
+				err = unpopulate(val, "Scheme", &g.Scheme)
+			delete(rawMsg, key)
+		case "statusCode":
+				err = unpopulate(val, "StatusCode", &g.StatusCode)
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", g, err)
+		}
+	}
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaller interface for type GatewayRouteRetryPolicy.
+func (g GatewayRouteRetryPolicy) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	populate(objectMap, "attempts", g.Attempts)
+	populate(objectMap, "perTryTimeout", g.PerTryTimeout)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type GatewayRouteRetryPolicy.
+func (g *GatewayRouteRetryPolicy) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", g, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "attempts":
+				err = unpopulate(val, "Attempts", &g.Attempts)
+			delete(rawMsg, key)
+		case "perTryTimeout":
+				err = unpopulate(val, "PerTryTimeout", &g.PerTryTimeout)
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", g, err)
+		}
+	}
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaller interface for type GatewayRouteTimeouts.
+func (g GatewayRouteTimeouts) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	populate(objectMap, "idle", g.Idle)
+	populate(objectMap, "response", g.Response)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type GatewayRouteTimeouts.
+func (g *GatewayRouteTimeouts) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", g, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "idle":
+				err = unpopulate(val, "Idle", &g.Idle)
+			delete(rawMsg, key)
+		case "response":
+				err = unpopulate(val, "Response", &g.Response)
+			delete(rawMsg, key)
 		}
 		if err != nil {
 			return fmt.Errorf("unmarshalling type %T: %v", g, err)
@@ -2283,6 +2669,7 @@ func (g *GatewayRoute) UnmarshalJSON(data []byte) error {
 func (g GatewayTLS) MarshalJSON() ([]byte, error) {
 	objectMap := make(map[string]any)
 	populate(objectMap, "certificateFrom", g.CertificateFrom)
+	populate(objectMap, "hosts", g.Hosts)
 	populate(objectMap, "minimumProtocolVersion", g.MinimumProtocolVersion)
 	populate(objectMap, "sslPassthrough", g.SSLPassthrough)
 	return json.Marshal(objectMap)
@@ -2300,6 +2687,9 @@ func (g *GatewayTLS) UnmarshalJSON(data []byte) error {
 		case "certificateFrom":
 				err = unpopulate(val, "CertificateFrom", &g.CertificateFrom)
 			delete(rawMsg, key)
+		case "hosts":
+				err = unpopulate(val, "Hosts", &g.Hosts)
+			delete(rawMsg, key)
 		case "minimumProtocolVersion":
 				err = unpopulate(val, "MinimumProtocolVersion", &g.MinimumProtocolVersion)
 			delete(rawMsg, key)
@@ -2314,6 +2704,37 @@ func (g *GatewayTLS) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+// MarshalJSON implements the json.Marshaller interface for type GatewayTLSHost.
+func (g GatewayTLSHost) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	populate(objectMap, "certificateFrom", g.CertificateFrom)
+	populate(objectMap, "hostname", g.Hostname)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type GatewayTLSHost.
+func (g *GatewayTLSHost) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", g, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "certificateFrom":
+				err = unpopulate(val, "CertificateFrom", &g.CertificateFrom)
+			delete(rawMsg, key)
+		case "hostname":
+				err = unpopulate(val, "Hostname", &g.Hostname)
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", g, err)
+		}
+	}
+	return nil
+}
+
 // MarshalJSON implements the json.Marshaller interface for type GitAuthConfig.
 func (g GitAuthConfig) MarshalJSON() ([]byte, error) {
 	objectMap := make(map[string]any)
@@ -2754,7 +3175,9 @@ func (k *KubernetesNamespaceExtension) UnmarshalJSON(data []byte) error {
 func (k KubernetesRuntimeProperties) MarshalJSON() ([]byte, error) {
 	objectMap := make(map[string]any)
 	populate(objectMap, "base", k.Base)
+	populate(objectMap, "headlessService", k.HeadlessService)
 	populate(objectMap, "pod", k.Pod)
+	populate(objectMap, "service", k.Service)
 	return json.Marshal(objectMap)
 }
 
@@ -2770,9 +3193,58 @@ func (k *KubernetesRuntimeProperties) UnmarshalJSON(data []byte) error {
 		case "base":
 				err = unpopulate(val, "Base", &k.Base)
 			delete(rawMsg, key)
+		case "headlessService":
+				err = unpopulate(val, "HeadlessService", &k.HeadlessService)
+			delete(rawMsg, key)
 		case "pod":
 				err = unpopulate(val, "Pod", &k.Pod)
 			delete(rawMsg, key)
+		case "service":
+				err = unpopulate(val, "Service", &k.Service)
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", k, err)
+		}
+	}
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaller interface for type KubernetesServiceProperties.
+func (k KubernetesServiceProperties) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	populate(objectMap, "externalTrafficPolicy", k.ExternalTrafficPolicy)
+	populate(objectMap, "sessionAffinity", k.SessionAffinity)
+	populate(objectMap, "sessionAffinityTimeoutSeconds", k.SessionAffinityTimeoutSeconds)
+	populate(objectMap, "topologyAwareRouting", k.TopologyAwareRouting)
+	populate(objectMap, "type", k.Type)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type KubernetesServiceProperties.
+func (k *KubernetesServiceProperties) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", k, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "externalTrafficPolicy":
+				err = unpopulate(val, "ExternalTrafficPolicy", &k.ExternalTrafficPolicy)
+			delete(rawMsg, key)
+		case "sessionAffinity":
+				err = unpopulate(val, "SessionAffinity", &k.SessionAffinity)
+			delete(rawMsg, key)
+		case "sessionAffinityTimeoutSeconds":
+				err = unpopulate(val, "SessionAffinityTimeoutSeconds", &k.SessionAffinityTimeoutSeconds)
+			delete(rawMsg, key)
+		case "topologyAwareRouting":
+				err = unpopulate(val, "TopologyAwareRouting", &k.TopologyAwareRouting)
+			delete(rawMsg, key)
+		case "type":
+				err = unpopulate(val, "Type", &k.Type)
+			delete(rawMsg, key)
 		}
 		if err != nil {
 			return fmt.Errorf("unmarshalling type %T: %v", k, err)
@@ -2812,6 +3284,41 @@ func (m *ManualScalingExtension) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+// MarshalJSON implements the json.Marshaller interface for type MutualTLSExtension.
+func (m MutualTLSExtension) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	objectMap["kind"] = "mutualTls"
+	populate(objectMap, "mesh", m.Mesh)
+	populate(objectMap, "mode", m.Mode)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type MutualTLSExtension.
+func (m *MutualTLSExtension) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", m, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "kind":
+				err = unpopulate(val, "Kind", &m.Kind)
+			delete(rawMsg, key)
+		case "mesh":
+				err = unpopulate(val, "Mesh", &m.Mesh)
+			delete(rawMsg, key)
+		case "mode":
+				err = unpopulate(val, "Mode", &m.Mode)
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", m, err)
+		}
+	}
+	return nil
+}
+
 // MarshalJSON implements the json.Marshaller interface for type Operation.
 func (o Operation) MarshalJSON() ([]byte, error) {
 	objectMap := make(map[string]any)
@@ -27,6 +27,11 @@ type ApplicationsClientGetOptions struct {
 	// placeholder for future optional parameters
 }
 
+// ApplicationsClientGetStatusOptions contains the optional parameters for the ApplicationsClient.GetStatus method.
+type ApplicationsClientGetStatusOptions struct {
+	// placeholder for future optional parameters
+}
+
 // ApplicationsClientListByScopeOptions contains the optional parameters for the ApplicationsClient.NewListByScopePager method.
 type ApplicationsClientListByScopeOptions struct {
 	// placeholder for future optional parameters
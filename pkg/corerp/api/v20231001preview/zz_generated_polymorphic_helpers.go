@@ -69,6 +69,8 @@ func unmarshalExtensionClassification(rawMsg json.RawMessage) (ExtensionClassifi
 		b = &KubernetesNamespaceExtension{}
 	case "manualScaling":
 		b = &ManualScalingExtension{}
+	case "mutualTls":
+		b = &MutualTLSExtension{}
 	default:
 		b = &Extension{}
 	}
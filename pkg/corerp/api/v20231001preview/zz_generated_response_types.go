@@ -30,6 +30,12 @@ type ApplicationsClientGetResponse struct {
 	ApplicationResource
 }
 
+// ApplicationsClientGetStatusResponse contains the response from method ApplicationsClient.GetStatus.
+type ApplicationsClientGetStatusResponse struct {
+	// Describes the status of the resources of an application.
+	ApplicationStatusResponse
+}
+
 // ApplicationsClientListByScopeResponse contains the response from method ApplicationsClient.NewListByScopePager.
 type ApplicationsClientListByScopeResponse struct {
 	// The response of a ApplicationResource list operation.
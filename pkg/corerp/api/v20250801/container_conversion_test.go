@@ -0,0 +1,477 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v20250801
+
+import (
+	"encoding/json"
+	"testing"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/corerp/datamodel"
+	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/test/testutil"
+	"github.com/radius-project/radius/test/testutil/resourcetypeutil"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestContainerConvertVersionedToDataModel(t *testing.T) {
+	conversionTests := []struct {
+		filename string
+		err      error
+		emptyExt bool
+	}{
+		{
+			filename: "containerresource.json",
+			err:      nil,
+			emptyExt: false,
+		},
+		{
+			filename: "containerresource-runtimes.json",
+			err:      nil,
+			emptyExt: false,
+		},
+		{
+			filename: "containerresourceemptyext.json",
+			err:      nil,
+			emptyExt: true,
+		},
+		{
+			filename: "containerresourceemptyext2.json",
+			err:      nil,
+			emptyExt: true,
+		},
+		{
+			filename: "containerresource-manual.json",
+			err:      nil,
+			emptyExt: true,
+		},
+		{
+			filename: "containerresource-nil-env-variables.json",
+			err: &v1.ErrValidation{
+				Details: []v1.ErrorDetails{
+					{Code: v1.CodeInvalidProperties, Target: "/properties/container/env/DB_USER", Message: "either value or valueFrom is required"},
+				},
+			},
+			emptyExt: false,
+		},
+	}
+
+	for _, tt := range conversionTests {
+		t.Run(tt.filename, func(t *testing.T) {
+			// arrange
+			rawPayload := testutil.ReadFixture(tt.filename)
+			r := &ContainerResource{}
+			err := json.Unmarshal(rawPayload, r)
+			require.NoError(t, err)
+
+			// act
+			dm, err := r.ConvertTo()
+
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+			} else {
+				// assert
+				require.NoError(t, err)
+				ct := dm.(*datamodel.ContainerResource)
+				require.Equal(t, "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/radius-test-rg/providers/Applications.Core/containers/container0", ct.ID)
+				require.Equal(t, "container0", ct.Name)
+				require.Equal(t, "Applications.Core/containers", ct.Type)
+				require.Equal(t, "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/testGroup/providers/Applications.Core/applications/app0", ct.Properties.Application)
+
+				if tt.filename == "containerresource-manual.json" {
+					require.Equal(t, datamodel.ContainerResourceProvisioningManual, ct.Properties.ResourceProvisioning)
+					require.Equal(t, []datamodel.ResourceReference{{ID: "/planes/test/local/providers/Test.Namespace/testResources/test-resource"}}, ct.Properties.Resources)
+					return
+				}
+
+				if tt.filename == "containerresource.json" {
+					require.Equal(t, map[string]datamodel.EnvironmentVariable{
+						"DB_USER": {
+							Value: to.Ptr("DB_USER"),
+						},
+						"DB_PASSWORD": {
+							ValueFrom: &datamodel.EnvironmentVariableReference{
+								SecretRef: &datamodel.EnvironmentVariableSecretReference{
+									Source: "secret.id",
+									Key:    "DB_PASSWORD",
+								},
+							},
+						},
+					}, ct.Properties.Container.Env)
+					require.Equal(t, &datamodel.ConnectionPrivateEndpoint{
+						Subnet:         "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/radius-test-rg/providers/Microsoft.Network/virtualNetworks/vnet/subnets/default",
+						PrivateDNSZone: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/radius-test-rg/providers/Microsoft.Network/privateDnsZones/privatelink.database.windows.net",
+					}, ct.Properties.Connections["inventory"].PrivateEndpoint)
+				}
+
+				val, ok := ct.Properties.Connections["inventory"]
+				require.True(t, ok)
+				require.Equal(t, "inventory_route_id", val.Source)
+				require.Equal(t, true, *val.DisableDefaultEnvVars)
+				require.Equal(t, "azure", string(val.IAM.Kind))
+				require.Equal(t, "read", val.IAM.Roles[0])
+				require.Equal(t, "ghcr.io/radius-project/webapptutorial-todoapp", ct.Properties.Container.Image)
+				tcpProbe := ct.Properties.Container.LivenessProbe
+				require.Equal(t, datamodel.TCPHealthProbe, tcpProbe.Kind)
+				require.Equal(t, to.Ptr[float32](5), tcpProbe.TCP.InitialDelaySeconds)
+				require.Equal(t, int32(8080), tcpProbe.TCP.ContainerPort)
+				require.Equal(t, []rpv1.OutputResource(nil), ct.Properties.Status.OutputResources)
+				require.Equal(t, "2025-08-01", ct.InternalMetadata.UpdatedAPIVersion)
+				require.Equal(t, 3, len(ct.Properties.Extensions))
+
+				require.Equal(t, []string{"/bin/sh"}, ct.Properties.Container.Command)
+				require.Equal(t, []string{"-c", "while true; do echo hello; sleep 10;done"}, ct.Properties.Container.Args)
+				require.Equal(t, "Always", ct.Properties.RestartPolicy)
+				require.Equal(t, "/app", ct.Properties.Container.WorkingDir)
+				if tt.emptyExt {
+					require.Equal(t, getTestContainerEmptyKubernetesMetadataExt(), ct.Properties.Extensions)
+				} else {
+					require.Equal(t, getTestContainerExtensions(), ct.Properties.Extensions)
+				}
+
+				if r.Properties.Runtimes != nil {
+					require.NotNil(t, ct.Properties.Runtimes.Kubernetes)
+					require.NotEmpty(t, ct.Properties.Runtimes.Kubernetes.Base)
+					require.Equal(t, *r.Properties.Runtimes.Kubernetes.Base, ct.Properties.Runtimes.Kubernetes.Base)
+					require.Equal(t, "{\"containers\":[{\"name\":\"sidecar\"}],\"hostNetwork\":true}", ct.Properties.Runtimes.Kubernetes.Pod)
+					require.True(t, ct.Properties.Runtimes.Kubernetes.HeadlessService)
+					require.Equal(t, &datamodel.KubernetesService{
+						Type:                          "LoadBalancer",
+						SessionAffinity:               "ClientIP",
+						SessionAffinityTimeoutSeconds: to.Ptr(int32(3600)),
+						ExternalTrafficPolicy:         "Local",
+						TopologyAwareRouting:          true,
+					}, ct.Properties.Runtimes.Kubernetes.Service)
+				}
+
+			}
+		})
+	}
+}
+
+func TestContainerConvertDataModelToVersioned(t *testing.T) {
+	conversionTests := []struct {
+		filename string
+		err      error
+	}{
+		{
+			filename: "containerresourcedatamodel.json",
+			err:      nil,
+		},
+		{
+			filename: "containerresourcedatamodel-runtime.json",
+			err:      nil,
+		},
+		{
+			filename: "containerresourcedatamodelemptyext.json",
+			err:      nil,
+		},
+		{
+			filename: "containerresourcedatamodel-manual.json",
+		},
+	}
+
+	for _, tt := range conversionTests {
+		t.Run(tt.filename, func(t *testing.T) {
+			rawPayload := testutil.ReadFixture(tt.filename)
+			r := &datamodel.ContainerResource{}
+			err := json.Unmarshal(rawPayload, r)
+			require.NoError(t, err)
+
+			// act
+			versioned := &ContainerResource{}
+			err = versioned.ConvertFrom(r)
+
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/radius-test-rg/providers/Applications.Core/containers/container0", *versioned.ID)
+				require.Equal(t, "container0", r.Name)
+				require.Equal(t, "Applications.Core/containers", r.Type)
+				require.Equal(t, "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/testGroup/providers/Applications.Core/applications/app0", *versioned.Properties.Application)
+
+				if tt.filename == "containerresourcedatamodel-manual.json" {
+					require.Equal(t, ContainerResourceProvisioning("manual"), *versioned.Properties.ResourceProvisioning)
+					require.Equal(t, []*ResourceReference{{ID: to.Ptr("/planes/test/local/providers/Test.Namespace/testResources/test-resource")}}, versioned.Properties.Resources)
+					return
+				}
+
+				if tt.filename == "containerresourcedatamodel.json" {
+					require.Equal(t, map[string]datamodel.EnvironmentVariable{
+						"DB_USER": {
+							Value: to.Ptr("DB_USER"),
+						},
+						"DB_PASSWORD": {
+							ValueFrom: &datamodel.EnvironmentVariableReference{
+								SecretRef: &datamodel.EnvironmentVariableSecretReference{
+									Source: "secret.id",
+									Key:    "DB_PASSWORD",
+								},
+							},
+						},
+					}, r.Properties.Container.Env)
+					require.Equal(t, &ConnectionPrivateEndpoint{
+						Subnet:         to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/radius-test-rg/providers/Microsoft.Network/virtualNetworks/vnet/subnets/default"),
+						PrivateDNSZone: to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/radius-test-rg/providers/Microsoft.Network/privateDnsZones/privatelink.database.windows.net"),
+					}, versioned.Properties.Connections["inventory"].PrivateEndpoint)
+				}
+
+				val, ok := r.Properties.Connections["inventory"]
+				require.True(t, ok)
+				require.Equal(t, "inventory_route_id", val.Source)
+				require.Equal(t, "azure", string(val.IAM.Kind))
+				require.Equal(t, "read", val.IAM.Roles[0])
+				require.Equal(t, "ghcr.io/radius-project/webapptutorial-todoapp", *versioned.Properties.Container.Image)
+				require.Equal(t, resourcetypeutil.MustPopulateResourceStatus(&ResourceStatus{}), versioned.Properties.Status)
+				require.Equal(t, "kubernetesMetadata", *versioned.Properties.Extensions[2].GetExtension().Kind)
+				require.Equal(t, 3, len(versioned.Properties.Extensions))
+				require.Equal(t, to.SliceOfPtrs([]string{"/bin/sh"}...), versioned.Properties.Container.Command)
+				require.Equal(t, to.SliceOfPtrs([]string{"-c", "while true; do echo hello; sleep 10;done"}...), versioned.Properties.Container.Args)
+				require.Equal(t, to.Ptr("/app"), versioned.Properties.Container.WorkingDir)
+
+				if r.Properties.Runtimes != nil {
+					require.NotNil(t, versioned.Properties.Runtimes)
+					require.NotEmpty(t, *versioned.Properties.Runtimes.Kubernetes.Base)
+					require.Equal(t, r.Properties.Runtimes.Kubernetes.Base, *versioned.Properties.Runtimes.Kubernetes.Base)
+					require.Equal(t, map[string]any{
+						"containers": []any{
+							map[string]any{
+								"name": "sidecar",
+							},
+						},
+						"hostNetwork": true,
+					}, versioned.Properties.Runtimes.Kubernetes.Pod)
+					require.Equal(t, to.Ptr(true), versioned.Properties.Runtimes.Kubernetes.HeadlessService)
+					require.Equal(t, &KubernetesServiceProperties{
+						Type:                          to.Ptr(KubernetesServiceTypeLoadBalancer),
+						SessionAffinity:               to.Ptr(KubernetesSessionAffinityClientIP),
+						SessionAffinityTimeoutSeconds: to.Ptr(int32(3600)),
+						ExternalTrafficPolicy:         to.Ptr(KubernetesExternalTrafficPolicyLocal),
+						TopologyAwareRouting:          to.Ptr(true),
+					}, versioned.Properties.Runtimes.Kubernetes.Service)
+				}
+			}
+		})
+	}
+
+}
+
+func TestContainerConvertVersionedToDataModelEmptyProtocol(t *testing.T) {
+	// arrange
+	rawPayload := testutil.ReadFixture("containerresourcenegativetest.json")
+	r := &ContainerResource{}
+	err := json.Unmarshal(rawPayload, r)
+	require.NoError(t, err)
+
+	// act
+	dm, err := r.ConvertTo()
+
+	// assert
+	require.NoError(t, err)
+	ct := dm.(*datamodel.ContainerResource)
+	require.Equal(t, "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/radius-test-rg/providers/Applications.Core/containers/container0", ct.ID)
+	require.Equal(t, "container0", ct.Name)
+	require.Equal(t, "Applications.Core/containers", ct.Type)
+	require.Equal(t, "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/testGroup/providers/Applications.Core/applications/app0", ct.Properties.Application)
+	val, ok := ct.Properties.Connections["inventory"]
+	require.True(t, ok)
+	require.Equal(t, "inventory_route_id", val.Source)
+	require.Equal(t, "azure", string(val.IAM.Kind))
+	require.Equal(t, false, *val.DisableDefaultEnvVars)
+	require.Equal(t, "read", val.IAM.Roles[0])
+	require.Equal(t, "ghcr.io/radius-project/webapptutorial-todoapp", ct.Properties.Container.Image)
+	require.Equal(t, []rpv1.OutputResource(nil), ct.Properties.Status.OutputResources)
+	require.Equal(t, "2025-08-01", ct.InternalMetadata.UpdatedAPIVersion)
+
+	var commands []string
+	var args []string
+	require.Equal(t, commands, ct.Properties.Container.Command)
+	require.Equal(t, args, ct.Properties.Container.Args)
+	require.Equal(t, "", ct.Properties.Container.WorkingDir)
+
+}
+
+func TestContainerConvertVersionedToDataModel_Validation(t *testing.T) {
+	validationTests := []struct {
+		desc    string
+		mutate  func(r *ContainerResource)
+		details []v1.ErrorDetails
+	}{
+		{
+			desc: "missing container",
+			mutate: func(r *ContainerResource) {
+				r.Properties.Container = nil
+			},
+			details: []v1.ErrorDetails{
+				{Code: v1.CodeInvalidProperties, Target: "/properties/container", Message: "container is required"},
+			},
+		},
+		{
+			desc: "missing image",
+			mutate: func(r *ContainerResource) {
+				r.Properties.Container.Image = to.Ptr("")
+			},
+			details: []v1.ErrorDetails{
+				{Code: v1.CodeInvalidProperties, Target: "/properties/container/image", Message: "image is required"},
+			},
+		},
+		{
+			desc: "environment variable with value and valueFrom",
+			mutate: func(r *ContainerResource) {
+				r.Properties.Container.Env["DB_USER"].ValueFrom = r.Properties.Container.Env["DB_PASSWORD"].ValueFrom
+			},
+			details: []v1.ErrorDetails{
+				{Code: v1.CodeInvalidProperties, Target: "/properties/container/env/DB_USER", Message: "value and valueFrom are mutually exclusive"},
+			},
+		},
+		{
+			desc: "ports out of range",
+			mutate: func(r *ContainerResource) {
+				r.Properties.Container.Ports = map[string]*ContainerPortProperties{
+					"web":   {ContainerPort: to.Ptr[int32](0)},
+					"admin": {ContainerPort: to.Ptr[int32](8080), Port: to.Ptr[int32](70000)},
+					"a/b":   nil,
+				}
+			},
+			details: []v1.ErrorDetails{
+				{Code: v1.CodeInvalidProperties, Target: "/properties/container/ports/a~1b", Message: "port must not be null"},
+				{Code: v1.CodeInvalidProperties, Target: "/properties/container/ports/admin/port", Message: "port must be between 1 and 65535, got 70000"},
+				{Code: v1.CodeInvalidProperties, Target: "/properties/container/ports/web/containerPort", Message: "port must be between 1 and 65535, got 0"},
+			},
+		},
+		{
+			desc: "probes of unknown kind and with invalid port",
+			mutate: func(r *ContainerResource) {
+				r.Properties.Container.LivenessProbe = &HealthProbeProperties{Kind: to.Ptr("grpc")}
+				r.Properties.Container.ReadinessProbe = &HTTPGetHealthProbeProperties{Kind: to.Ptr("httpGet"), Path: to.Ptr("/healthz"), ContainerPort: to.Ptr[int32](-1)}
+			},
+			details: []v1.ErrorDetails{
+				{Code: v1.CodeInvalidProperties, Target: "/properties/container/livenessProbe/kind", Message: "a probe must be of exactly one kind of 'exec', 'httpGet' or 'tcp', got \"grpc\""},
+				{Code: v1.CodeInvalidProperties, Target: "/properties/container/readinessProbe/containerPort", Message: "port must be between 1 and 65535, got -1"},
+			},
+		},
+		{
+			desc: "exec probe without command",
+			mutate: func(r *ContainerResource) {
+				r.Properties.Container.LivenessProbe = &ExecHealthProbeProperties{Kind: to.Ptr("exec")}
+			},
+			details: []v1.ErrorDetails{
+				{Code: v1.CodeInvalidProperties, Target: "/properties/container/livenessProbe/command", Message: "command is required for a probe of kind 'exec'"},
+			},
+		},
+	}
+
+	for _, tc := range validationTests {
+		t.Run(tc.desc, func(t *testing.T) {
+			rawPayload := testutil.ReadFixture("containerresource.json")
+			r := &ContainerResource{}
+			err := json.Unmarshal(rawPayload, r)
+			require.NoError(t, err)
+			tc.mutate(r)
+
+			_, err = r.ConvertTo()
+			require.Equal(t, &v1.ErrValidation{Details: tc.details}, err)
+		})
+	}
+}
+
+func TestContainerConvertFromValidation(t *testing.T) {
+	validationTests := []struct {
+		src v1.DataModelInterface
+		err error
+	}{
+		{&resourcetypeutil.FakeResource{}, v1.ErrInvalidModelConversion},
+		{nil, v1.ErrInvalidModelConversion},
+	}
+
+	for _, tc := range validationTests {
+		versioned := &ContainerResource{}
+		err := versioned.ConvertFrom(tc.src)
+		require.ErrorAs(t, tc.err, &err)
+	}
+}
+
+func getTestContainerExtensions() []datamodel.Extension {
+	var replicavalue int32 = 2
+	ptrreplicaval := &replicavalue
+	extensions := []datamodel.Extension{
+		{
+			Kind: datamodel.ManualScaling,
+			ManualScaling: &datamodel.ManualScalingExtension{
+				Replicas: ptrreplicaval,
+			},
+		},
+		{
+			Kind: datamodel.DaprSidecar,
+			DaprSidecar: &datamodel.DaprSidecarExtension{
+				AppID:    "app-id",
+				AppPort:  80,
+				Config:   "config",
+				Protocol: "http",
+			},
+		},
+		{
+			Kind: datamodel.KubernetesMetadata,
+			KubernetesMetadata: &datamodel.KubeMetadataExtension{
+				Annotations: map[string]string{
+					"prometheus.io/scrape": "true",
+					"prometheus.io/port":   "80",
+				},
+				Labels: map[string]string{
+					"foo/bar/team":    "credit",
+					"foo/bar/contact": "radiususer",
+				},
+			},
+		},
+	}
+
+	return extensions
+}
+
+func getTestContainerEmptyKubernetesMetadataExt() []datamodel.Extension {
+	var replicavalue int32 = 2
+	ptrreplicaval := &replicavalue
+	extensions := []datamodel.Extension{
+		{
+			Kind: datamodel.ManualScaling,
+			ManualScaling: &datamodel.ManualScalingExtension{
+				Replicas: ptrreplicaval,
+			},
+		},
+		{
+			Kind: datamodel.DaprSidecar,
+			DaprSidecar: &datamodel.DaprSidecarExtension{
+				AppID:    "app-id",
+				AppPort:  80,
+				Config:   "config",
+				Protocol: "http",
+			},
+		},
+		{
+			Kind: datamodel.KubernetesMetadata,
+			KubernetesMetadata: &datamodel.KubeMetadataExtension{
+				Annotations: map[string]string{},
+				Labels:      map[string]string{},
+			},
+		},
+	}
+
+	return extensions
+}
@@ -0,0 +1,285 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v20250801
+
+import (
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/corerp/datamodel"
+	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+	"github.com/radius-project/radius/pkg/to"
+)
+
+// ConvertTo converts from the versioned Gateway resource to version-agnostic datamodel.
+func (src *GatewayResource) ConvertTo() (v1.DataModelInterface, error) {
+	tls := &datamodel.GatewayPropertiesTLS{}
+	if src.Properties.TLS == nil {
+		tls = nil
+	} else {
+		if src.Properties.TLS.SSLPassthrough != nil {
+			tls.SSLPassthrough = to.Bool(src.Properties.TLS.SSLPassthrough)
+		} else {
+			tls.SSLPassthrough = false
+		}
+
+		if src.Properties.TLS.CertificateFrom != nil || len(src.Properties.TLS.Hosts) > 0 {
+			tls.CertificateFrom = to.String(src.Properties.TLS.CertificateFrom)
+			tls.MinimumProtocolVersion = toTLSMinVersionDataModel(src.Properties.TLS.MinimumProtocolVersion)
+		}
+
+		for _, h := range src.Properties.TLS.Hosts {
+			tls.Hosts = append(tls.Hosts, datamodel.GatewayTLSHost{
+				Hostname:        to.String(h.Hostname),
+				CertificateFrom: to.String(h.CertificateFrom),
+			})
+		}
+	}
+
+	// Note: SystemData conversion isn't required since this property comes ARM and datastore.
+	routes := []datamodel.GatewayRoute{}
+	if src.Properties.Routes != nil {
+		for _, r := range src.Properties.Routes {
+			s := datamodel.GatewayRoute{
+				Destination:      to.String(r.Destination),
+				Path:             to.String(r.Path),
+				ReplacePrefix:    to.String(r.ReplacePrefix),
+				EnableWebsockets: to.Bool(r.EnableWebsockets),
+				Methods:          stringSlice(r.Methods),
+				ReplaceHostname:  to.String(r.ReplaceHostname),
+			}
+			if r.Kind != nil {
+				s.Kind = datamodel.GatewayRouteKind(*r.Kind)
+			}
+			for _, h := range r.Headers {
+				s.Headers = append(s.Headers, datamodel.GatewayRouteHeaderMatch{
+					Name:  to.String(h.Name),
+					Value: to.String(h.Value),
+				})
+			}
+			if r.Redirect != nil {
+				s.Redirect = &datamodel.GatewayRouteRedirect{
+					Scheme:     to.String(r.Redirect.Scheme),
+					Hostname:   to.String(r.Redirect.Hostname),
+					Port:       to.Int32(r.Redirect.Port),
+					Path:       to.String(r.Redirect.Path),
+					StatusCode: to.Int32(r.Redirect.StatusCode),
+				}
+			}
+			if r.RateLimit != nil {
+				s.RateLimit = &datamodel.GatewayRouteRateLimit{
+					Requests: to.Int32(r.RateLimit.Requests),
+					Unit:     to.String(r.RateLimit.Unit),
+					Burst:    to.Int32(r.RateLimit.Burst),
+				}
+			}
+			if r.Timeouts != nil {
+				s.Timeouts = &datamodel.GatewayRouteTimeouts{
+					Response: to.String(r.Timeouts.Response),
+					Idle:     to.String(r.Timeouts.Idle),
+				}
+			}
+			if r.RetryPolicy != nil {
+				s.RetryPolicy = &datamodel.GatewayRouteRetryPolicy{
+					Attempts:      to.Int32(r.RetryPolicy.Attempts),
+					PerTryTimeout: to.String(r.RetryPolicy.PerTryTimeout),
+				}
+			}
+			for _, d := range r.Destinations {
+				s.Destinations = append(s.Destinations, datamodel.GatewayRouteDestination{
+					Destination: to.String(d.Destination),
+					Weight:      to.Int32(d.Weight),
+				})
+			}
+			routes = append(routes, s)
+		}
+	}
+
+	var hostname *datamodel.GatewayPropertiesHostname
+	if src.Properties.Hostname != nil {
+		hostname = &datamodel.GatewayPropertiesHostname{
+			FullyQualifiedHostname: to.String(src.Properties.Hostname.FullyQualifiedHostname),
+			Prefix:                 to.String(src.Properties.Hostname.Prefix),
+			DNSZone:                to.String(src.Properties.Hostname.DNSZone),
+		}
+	}
+
+	converted := &datamodel.Gateway{
+		BaseResource: v1.BaseResource{
+			TrackedResource: v1.TrackedResource{
+				ID:       to.String(src.ID),
+				Name:     to.String(src.Name),
+				Type:     to.String(src.Type),
+				Location: to.String(src.Location),
+				Tags:     to.StringMap(src.Tags),
+			},
+			InternalMetadata: v1.InternalMetadata{
+				UpdatedAPIVersion:      Version,
+				AsyncProvisioningState: toProvisioningStateDataModel(src.Properties.ProvisioningState),
+			},
+		},
+		Properties: datamodel.GatewayProperties{
+			BasicResourceProperties: rpv1.BasicResourceProperties{
+				Application: to.String(src.Properties.Application),
+			},
+			Hostname: hostname,
+			TLS:      tls,
+			Routes:   routes,
+			URL:      to.String(src.Properties.URL),
+		},
+	}
+
+	return converted, nil
+}
+
+// ConvertFrom converts from version-agnostic datamodel to the versioned Gateway resource.
+func (dst *GatewayResource) ConvertFrom(src v1.DataModelInterface) error {
+	g, ok := src.(*datamodel.Gateway)
+	if !ok {
+		return v1.ErrInvalidModelConversion
+	}
+
+	var tls *GatewayTLS
+	if g.Properties.TLS != nil {
+		tls = &GatewayTLS{
+			CertificateFrom:        to.Ptr(g.Properties.TLS.CertificateFrom),
+			MinimumProtocolVersion: fromTLSMinVersionDataModel(g.Properties.TLS.MinimumProtocolVersion),
+			SSLPassthrough:         to.Ptr(g.Properties.TLS.SSLPassthrough),
+		}
+
+		for _, h := range g.Properties.TLS.Hosts {
+			tls.Hosts = append(tls.Hosts, &GatewayTLSHost{
+				Hostname:        to.Ptr(h.Hostname),
+				CertificateFrom: to.Ptr(h.CertificateFrom),
+			})
+		}
+	}
+
+	routes := []*GatewayRoute{}
+	if g.Properties.Routes != nil {
+		for _, r := range g.Properties.Routes {
+			s := &GatewayRoute{
+				Destination:      to.Ptr(r.Destination),
+				Path:             to.Ptr(r.Path),
+				ReplacePrefix:    to.Ptr(r.ReplacePrefix),
+				EnableWebsockets: to.Ptr(r.EnableWebsockets),
+				Methods:          to.SliceOfPtrs(r.Methods...),
+				ReplaceHostname:  to.Ptr(r.ReplaceHostname),
+			}
+			if r.Kind != "" {
+				s.Kind = to.Ptr(GatewayRouteKind(r.Kind))
+			}
+			for _, h := range r.Headers {
+				s.Headers = append(s.Headers, &GatewayRouteHeaderMatch{
+					Name:  to.Ptr(h.Name),
+					Value: to.Ptr(h.Value),
+				})
+			}
+			if r.Redirect != nil {
+				s.Redirect = &GatewayRouteRedirect{
+					Scheme:     to.Ptr(r.Redirect.Scheme),
+					Hostname:   to.Ptr(r.Redirect.Hostname),
+					Port:       to.Ptr(r.Redirect.Port),
+					Path:       to.Ptr(r.Redirect.Path),
+					StatusCode: to.Ptr(r.Redirect.StatusCode),
+				}
+			}
+			if r.RateLimit != nil {
+				s.RateLimit = &GatewayRouteRateLimit{
+					Requests: to.Ptr(r.RateLimit.Requests),
+					Unit:     to.Ptr(r.RateLimit.Unit),
+					Burst:    to.Ptr(r.RateLimit.Burst),
+				}
+			}
+			if r.Timeouts != nil {
+				s.Timeouts = &GatewayRouteTimeouts{
+					Response: to.Ptr(r.Timeouts.Response),
+					Idle:     to.Ptr(r.Timeouts.Idle),
+				}
+			}
+			if r.RetryPolicy != nil {
+				s.RetryPolicy = &GatewayRouteRetryPolicy{
+					Attempts:      to.Ptr(r.RetryPolicy.Attempts),
+					PerTryTimeout: to.Ptr(r.RetryPolicy.PerTryTimeout),
+				}
+			}
+			for _, d := range r.Destinations {
+				s.Destinations = append(s.Destinations, &GatewayRouteDestination{
+					Destination: to.Ptr(d.Destination),
+					Weight:      to.Ptr(d.Weight),
+				})
+			}
+			routes = append(routes, s)
+		}
+	}
+
+	var hostname *GatewayHostname
+	if g.Properties.Hostname != nil {
+		hostname = &GatewayHostname{
+			FullyQualifiedHostname: to.Ptr(g.Properties.Hostname.FullyQualifiedHostname),
+			Prefix:                 to.Ptr(g.Properties.Hostname.Prefix),
+			DNSZone:                to.Ptr(g.Properties.Hostname.DNSZone),
+		}
+	}
+
+	dst.ID = to.Ptr(g.ID)
+	dst.Name = to.Ptr(g.Name)
+	dst.Type = to.Ptr(g.Type)
+	dst.SystemData = fromSystemDataModel(g.SystemData)
+	dst.Location = to.Ptr(g.Location)
+	dst.Tags = *to.StringMapPtr(g.Tags)
+	dst.Properties = &GatewayProperties{
+		Status: &ResourceStatus{
+			OutputResources: toOutputResourcesDataModel(g.Properties.Status.OutputResources),
+		},
+		ProvisioningState: fromProvisioningStateDataModel(g.InternalMetadata.AsyncProvisioningState),
+		Application:       to.Ptr(g.Properties.Application),
+		Hostname:          hostname,
+		Routes:            routes,
+		TLS:               tls,
+		URL:               to.Ptr(g.Properties.URL),
+	}
+
+	return nil
+}
+
+func toTLSMinVersionDataModel(tlsMinVersion *TLSMinVersion) datamodel.MinimumTLSProtocolVersion {
+	if tlsMinVersion == nil {
+		return datamodel.DefaultTLSMinVersion
+	}
+
+	switch *tlsMinVersion {
+	case TLSMinVersionTls12:
+		return datamodel.TLSMinVersion12
+	case TLSMinVersionTls13:
+		return datamodel.TLSMinVersion13
+	default:
+		return datamodel.DefaultTLSMinVersion
+	}
+}
+
+func fromTLSMinVersionDataModel(tlsMinVersion datamodel.MinimumTLSProtocolVersion) *TLSMinVersion {
+	var t TLSMinVersion
+	switch tlsMinVersion {
+	case datamodel.TLSMinVersion12:
+		t = TLSMinVersionTls12
+	case datamodel.TLSMinVersion13:
+		t = TLSMinVersionTls13
+	default:
+		t = TLSMinVersionTls12
+	}
+
+	return &t
+}
@@ -417,6 +417,11 @@ func (dp *deploymentProcessor) getEnvOptions(ctx context.Context, env *corerp_dm
 		envOpts.KubernetesMetadata = envExt.KubernetesMetadata
 	}
 
+	// Get Environment MutualTLS Info
+	if envExt := corerp_dm.FindExtension(env.Properties.Extensions, corerp_dm.MutualTLS); envExt != nil && envExt.MutualTLS != nil {
+		envOpts.MutualTLS = envExt.MutualTLS
+	}
+
 	if publicEndpointOverride != "" {
 		// Check if publicEndpointOverride contains a scheme,
 		// and if so, throw an error to the user
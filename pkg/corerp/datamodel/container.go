@@ -99,6 +99,30 @@ type KubernetesRuntime struct {
 
 	// Pod represents the Kubernetes PodSpec strategic merge patch to be applied to the rendered PodSpec. This is stored as a JSON-encoded string.
 	Pod string `json:"pod,omitempty"`
+
+	// HeadlessService represents whether a headless Service is generated for the container.
+	HeadlessService bool `json:"headlessService,omitempty"`
+
+	// Service represents the configuration of the Service generated for the ports of the container.
+	Service *KubernetesService `json:"service,omitempty"`
+}
+
+// KubernetesService represents the configuration of the Kubernetes Service generated for the ports of a container.
+type KubernetesService struct {
+	// Type is the type of the Service, either ClusterIP or LoadBalancer. Defaults to ClusterIP.
+	Type string `json:"type,omitempty"`
+
+	// SessionAffinity is the session affinity of the Service, either None or ClientIP.
+	SessionAffinity string `json:"sessionAffinity,omitempty"`
+
+	// SessionAffinityTimeoutSeconds is the number of seconds a client sticks to the same replica with the ClientIP session affinity.
+	SessionAffinityTimeoutSeconds *int32 `json:"sessionAffinityTimeoutSeconds,omitempty"`
+
+	// ExternalTrafficPolicy is the external traffic policy of the Service, either Cluster or Local.
+	ExternalTrafficPolicy string `json:"externalTrafficPolicy,omitempty"`
+
+	// TopologyAwareRouting represents whether traffic prefers the replicas in the zone of the client.
+	TopologyAwareRouting bool `json:"topologyAwareRouting,omitempty"`
 }
 
 // RuntimeProperties represents the runtime configuration for the platform-specific functionalities.
@@ -109,9 +133,19 @@ type RuntimeProperties struct {
 
 // ConnectionProperties represents the properties of Connection.
 type ConnectionProperties struct {
-	Source                string        `json:"source,omitempty"`
-	DisableDefaultEnvVars *bool         `json:"disableDefaultEnvVars,omitempty"`
-	IAM                   IAMProperties `json:"iam,omitempty"`
+	Source                string                     `json:"source,omitempty"`
+	DisableDefaultEnvVars *bool                      `json:"disableDefaultEnvVars,omitempty"`
+	IAM                   IAMProperties              `json:"iam,omitempty"`
+	PrivateEndpoint       *ConnectionPrivateEndpoint `json:"privateEndpoint,omitempty"`
+}
+
+// ConnectionPrivateEndpoint represents the private endpoint through which the container reaches the source of a connection.
+type ConnectionPrivateEndpoint struct {
+	// Subnet is the resource id of the subnet in which the private endpoint is created.
+	Subnet string `json:"subnet,omitempty"`
+
+	// PrivateDNSZone is the resource id of the private DNS zone in which the private endpoint registers its hostname.
+	PrivateDNSZone string `json:"privateDnsZone,omitempty"`
 }
 
 // Container - Definition of a container.
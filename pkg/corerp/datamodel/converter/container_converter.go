@@ -21,6 +21,7 @@ import (
 
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
 	v20231001preview "github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
+	v20250801 "github.com/radius-project/radius/pkg/corerp/api/v20250801"
 	"github.com/radius-project/radius/pkg/corerp/datamodel"
 )
 
@@ -32,6 +33,11 @@ func ContainerDataModelToVersioned(model *datamodel.ContainerResource, version s
 		err := versioned.ConvertFrom(model)
 		return versioned, err
 
+	case v20250801.Version:
+		versioned := &v20250801.ContainerResource{}
+		err := versioned.ConvertFrom(model)
+		return versioned, err
+
 	default:
 		return nil, v1.ErrUnsupportedAPIVersion
 	}
@@ -48,6 +54,14 @@ func ContainerDataModelFromVersioned(content []byte, version string) (*datamodel
 		dm, err := am.ConvertTo()
 		return dm.(*datamodel.ContainerResource), err
 
+	case v20250801.Version:
+		am := &v20250801.ContainerResource{}
+		if err := json.Unmarshal(content, am); err != nil {
+			return nil, err
+		}
+		dm, err := am.ConvertTo()
+		return dm.(*datamodel.ContainerResource), err
+
 	default:
 		return nil, v1.ErrUnsupportedAPIVersion
 	}
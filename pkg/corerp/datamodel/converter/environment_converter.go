@@ -21,6 +21,7 @@ import (
 
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
 	v20231001preview "github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
+	v20250801 "github.com/radius-project/radius/pkg/corerp/api/v20250801"
 	"github.com/radius-project/radius/pkg/corerp/datamodel"
 )
 
@@ -34,6 +35,13 @@ func EnvironmentDataModelToVersioned(model *datamodel.Environment, version strin
 		}
 		return versioned, nil
 
+	case v20250801.Version:
+		versioned := &v20250801.EnvironmentResource{}
+		if err := versioned.ConvertFrom(model); err != nil {
+			return nil, err
+		}
+		return versioned, nil
+
 	default:
 		return nil, v1.ErrUnsupportedAPIVersion
 	}
@@ -53,6 +61,17 @@ func EnvironmentDataModelFromVersioned(content []byte, version string) (*datamod
 		}
 		return dm.(*datamodel.Environment), nil
 
+	case v20250801.Version:
+		am := &v20250801.EnvironmentResource{}
+		if err := json.Unmarshal(content, am); err != nil {
+			return nil, err
+		}
+		dm, err := am.ConvertTo()
+		if err != nil {
+			return nil, err
+		}
+		return dm.(*datamodel.Environment), nil
+
 	default:
 		return nil, v1.ErrUnsupportedAPIVersion
 	}
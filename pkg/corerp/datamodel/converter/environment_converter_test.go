@@ -23,6 +23,7 @@ import (
 
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
 	v20231001preview "github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
+	v20250801 "github.com/radius-project/radius/pkg/corerp/api/v20250801"
 	"github.com/radius-project/radius/pkg/corerp/datamodel"
 	"github.com/stretchr/testify/require"
 )
@@ -51,6 +52,12 @@ func TestEnvironmentDataModelToVersioned(t *testing.T) {
 			&v20231001preview.EnvironmentResource{},
 			nil,
 		},
+		{
+			"../../api/v20250801/testdata/environmentresourcedatamodel.json",
+			"2025-08-01",
+			&v20250801.EnvironmentResource{},
+			nil,
+		},
 		// TODO: add new conversion tests.
 		{
 			"",
@@ -87,6 +94,11 @@ func TestEnvironmentDataModelFromVersioned(t *testing.T) {
 			"2023-10-01-preview",
 			nil,
 		},
+		{
+			"../../api/v20250801/testdata/environmentresource.json",
+			"2025-08-01",
+			nil,
+		},
 		// TODO: add new conversion tests.
 		{
 			"",
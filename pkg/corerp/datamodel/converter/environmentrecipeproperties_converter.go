@@ -21,6 +21,7 @@ import (
 
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
 	v20231001preview "github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
+	v20250801 "github.com/radius-project/radius/pkg/corerp/api/v20250801"
 	"github.com/radius-project/radius/pkg/corerp/datamodel"
 )
 
@@ -34,6 +35,13 @@ func EnvironmentRecipePropertiesDataModelToVersioned(model *datamodel.Environmen
 		}
 		return versioned, nil
 
+	case v20250801.Version:
+		versioned := &v20250801.RecipeGetMetadataResponse{}
+		if err := versioned.ConvertFrom(model); err != nil {
+			return nil, err
+		}
+		return versioned, nil
+
 	default:
 		return nil, v1.ErrUnsupportedAPIVersion
 	}
@@ -53,6 +61,17 @@ func RecipeDataModelFromVersioned(content []byte, version string) (*datamodel.Re
 		}
 		return dm.(*datamodel.Recipe), nil
 
+	case v20250801.Version:
+		am := &v20250801.RecipeGetMetadata{}
+		if err := json.Unmarshal(content, am); err != nil {
+			return nil, err
+		}
+		dm, err := am.ConvertTo()
+		if err != nil {
+			return nil, err
+		}
+		return dm.(*datamodel.Recipe), nil
+
 	default:
 		return nil, v1.ErrUnsupportedAPIVersion
 	}
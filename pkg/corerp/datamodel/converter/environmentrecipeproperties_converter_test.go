@@ -22,6 +22,7 @@ import (
 
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
 	v20231001preview "github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
+	v20250801 "github.com/radius-project/radius/pkg/corerp/api/v20250801"
 	"github.com/radius-project/radius/pkg/corerp/datamodel"
 	"github.com/stretchr/testify/require"
 )
@@ -42,6 +43,12 @@ func TestEnvironmentRecipePropertiesDataModelToVersioned(t *testing.T) {
 			&v20231001preview.RecipeGetMetadataResponse{},
 			nil,
 		},
+		{
+			"../../api/v20250801/testdata/environmentrecipepropertiesdatamodel.json",
+			"2025-08-01",
+			&v20250801.RecipeGetMetadataResponse{},
+			nil,
+		},
 		// TODO: add new conversion tests.
 		{
 			"",
@@ -78,6 +85,11 @@ func TestRecipeDatamodelFromVersioned(t *testing.T) {
 			"2023-10-01-preview",
 			nil,
 		},
+		{
+			"../../api/v20250801/testdata/reciperesource.json",
+			"2025-08-01",
+			nil,
+		},
 		// TODO: add new conversion tests.
 		{
 			"",
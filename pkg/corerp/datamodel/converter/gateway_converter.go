@@ -21,6 +21,7 @@ import (
 
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
 	v20231001preview "github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
+	v20250801 "github.com/radius-project/radius/pkg/corerp/api/v20250801"
 	"github.com/radius-project/radius/pkg/corerp/datamodel"
 )
 
@@ -32,6 +33,11 @@ func GatewayDataModelToVersioned(model *datamodel.Gateway, version string) (v1.V
 		err := versioned.ConvertFrom(model)
 		return versioned, err
 
+	case v20250801.Version:
+		versioned := &v20250801.GatewayResource{}
+		err := versioned.ConvertFrom(model)
+		return versioned, err
+
 	default:
 		return nil, v1.ErrUnsupportedAPIVersion
 	}
@@ -48,6 +54,14 @@ func GatewayDataModelFromVersioned(content []byte, version string) (*datamodel.G
 		dm, err := am.ConvertTo()
 		return dm.(*datamodel.Gateway), err
 
+	case v20250801.Version:
+		am := &v20250801.GatewayResource{}
+		if err := json.Unmarshal(content, am); err != nil {
+			return nil, err
+		}
+		dm, err := am.ConvertTo()
+		return dm.(*datamodel.Gateway), err
+
 	default:
 		return nil, v1.ErrUnsupportedAPIVersion
 	}
@@ -22,6 +22,7 @@ import (
 
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
 	"github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/corerp/api/v20250801"
 	"github.com/radius-project/radius/pkg/corerp/datamodel"
 	"github.com/stretchr/testify/require"
 )
@@ -42,6 +43,12 @@ func TestGatewayDataModelToVersioned(t *testing.T) {
 			&v20231001preview.GatewayResource{},
 			nil,
 		},
+		{
+			"../../api/v20250801/testdata/gatewayresourcedatamodel.json",
+			"2025-08-01",
+			&v20250801.GatewayResource{},
+			nil,
+		},
 		{
 			"",
 			"unsupported",
@@ -77,6 +84,11 @@ func TestGatewayDataModelFromVersioned(t *testing.T) {
 			"2023-10-01-preview",
 			nil,
 		},
+		{
+			"../../api/v20250801/testdata/gatewayresource.json",
+			"2025-08-01",
+			nil,
+		},
 		{
 			"",
 			"unsupported",
@@ -0,0 +1,112 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package converter
+
+import (
+	"encoding/json"
+	"testing"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	v20231001preview "github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
+	v20250801 "github.com/radius-project/radius/pkg/corerp/api/v20250801"
+	"github.com/stretchr/testify/require"
+)
+
+// NOTE: these tests validate that a resource created with one api version can be read and written with
+// another one without losing any property.
+
+var roundTripVersions = []struct {
+	from string
+	to   string
+}{
+	{v20231001preview.Version, v20250801.Version},
+	{v20250801.Version, v20231001preview.Version},
+}
+
+func TestContainerDataModel_RoundTripAcrossVersions(t *testing.T) {
+	for _, tc := range roundTripVersions {
+		t.Run(tc.from+" to "+tc.to, func(t *testing.T) {
+			original, err := ContainerDataModelFromVersioned(loadTestData("../../api/v20231001preview/testdata/containerresource.json"), tc.from)
+			require.NoError(t, err)
+
+			versioned, err := ContainerDataModelToVersioned(original, tc.to)
+			require.NoError(t, err)
+
+			b, err := json.Marshal(versioned)
+			require.NoError(t, err)
+
+			converted, err := ContainerDataModelFromVersioned(b, tc.to)
+			require.NoError(t, err)
+
+			require.Equal(t, tc.to, converted.InternalMetadata.UpdatedAPIVersion)
+			resetAPIVersions(&original.InternalMetadata, &converted.InternalMetadata)
+			require.Equal(t, original, converted)
+		})
+	}
+}
+
+func TestGatewayDataModel_RoundTripAcrossVersions(t *testing.T) {
+	for _, tc := range roundTripVersions {
+		t.Run(tc.from+" to "+tc.to, func(t *testing.T) {
+			original, err := GatewayDataModelFromVersioned(loadTestData("../../api/v20231001preview/testdata/gatewayresource.json"), tc.from)
+			require.NoError(t, err)
+
+			versioned, err := GatewayDataModelToVersioned(original, tc.to)
+			require.NoError(t, err)
+
+			b, err := json.Marshal(versioned)
+			require.NoError(t, err)
+
+			converted, err := GatewayDataModelFromVersioned(b, tc.to)
+			require.NoError(t, err)
+
+			require.Equal(t, tc.to, converted.InternalMetadata.UpdatedAPIVersion)
+			resetAPIVersions(&original.InternalMetadata, &converted.InternalMetadata)
+			require.Equal(t, original, converted)
+		})
+	}
+}
+
+func TestEnvironmentDataModel_RoundTripAcrossVersions(t *testing.T) {
+	for _, tc := range roundTripVersions {
+		t.Run(tc.from+" to "+tc.to, func(t *testing.T) {
+			original, err := EnvironmentDataModelFromVersioned(loadTestData("../../api/v20231001preview/testdata/environmentresource.json"), tc.from)
+			require.NoError(t, err)
+
+			versioned, err := EnvironmentDataModelToVersioned(original, tc.to)
+			require.NoError(t, err)
+
+			b, err := json.Marshal(versioned)
+			require.NoError(t, err)
+
+			converted, err := EnvironmentDataModelFromVersioned(b, tc.to)
+			require.NoError(t, err)
+
+			require.Equal(t, tc.to, converted.InternalMetadata.UpdatedAPIVersion)
+			resetAPIVersions(&original.InternalMetadata, &converted.InternalMetadata)
+			require.Equal(t, original, converted)
+		})
+	}
+}
+
+// resetAPIVersions clears the api versions recorded by the conversions, which are expected to differ across versions.
+func resetAPIVersions(metadata ...*v1.InternalMetadata) {
+	for _, m := range metadata {
+		m.CreatedAPIVersion = ""
+		m.UpdatedAPIVersion = ""
+	}
+}
@@ -24,6 +24,18 @@ const (
 	DaprSidecar                  ExtensionKind = "daprSidecar"
 	KubernetesMetadata           ExtensionKind = "kubernetesMetadata"
 	KubernetesNamespaceExtension ExtensionKind = "kubernetesNamespace"
+	MutualTLS                    ExtensionKind = "mutualTls"
+)
+
+const (
+	// ServiceMeshIstio is the Istio service mesh.
+	ServiceMeshIstio = "istio"
+
+	// MutualTLSModeStrict accepts only mutual TLS traffic.
+	MutualTLSModeStrict = "strict"
+
+	// MutualTLSModePermissive accepts both mutual TLS and plaintext traffic.
+	MutualTLSModePermissive = "permissive"
 )
 
 // Extension of a resource.
@@ -33,6 +45,7 @@ type Extension struct {
 	DaprSidecar         *DaprSidecarExtension   `json:"daprSidecar,omitempty"`
 	KubernetesMetadata  *KubeMetadataExtension  `json:"kubernetesMetadata,omitempty"`
 	KubernetesNamespace *KubeNamespaceExtension `json:"kubernetesNamespace,omitempty"`
+	MutualTLS           *MutualTLSExtension     `json:"mutualTls,omitempty"`
 }
 
 // KubeMetadataExtension represents the extension of kubernetes resource.
@@ -46,6 +59,12 @@ type KubeNamespaceExtension struct {
 	Namespace string `json:"namespace,omitempty"`
 }
 
+// MutualTLSExtension represents the extension enabling mutual TLS between the containers of an environment.
+type MutualTLSExtension struct {
+	Mesh string `json:"mesh,omitempty"`
+	Mode string `json:"mode,omitempty"`
+}
+
 // FindExtension searches a slice of Extensions for one with a matching ExtensionKind.
 func FindExtension(exts []Extension, kind ExtensionKind) *Extension {
 	for _, ext := range exts {
@@ -72,16 +72,78 @@ type GatewayProperties struct {
 
 // GatewayRoute represents the route attached to Gateway.
 type GatewayRoute struct {
-	Destination      string `json:"destination,omitempty"`
-	Path             string `json:"path,omitempty"`
-	ReplacePrefix    string `json:"replacePrefix,omitempty"`
-	EnableWebsockets bool   `json:"enableWebsockets,omitempty"`
+	Destination      string                    `json:"destination,omitempty"`
+	Destinations     []GatewayRouteDestination `json:"destinations,omitempty"`
+	Path             string                    `json:"path,omitempty"`
+	ReplacePrefix    string                    `json:"replacePrefix,omitempty"`
+	EnableWebsockets bool                      `json:"enableWebsockets,omitempty"`
+	Headers          []GatewayRouteHeaderMatch `json:"headers,omitempty"`
+	Methods          []string                  `json:"methods,omitempty"`
+	ReplaceHostname  string                    `json:"replaceHostname,omitempty"`
+	Redirect         *GatewayRouteRedirect     `json:"redirect,omitempty"`
+	RateLimit        *GatewayRouteRateLimit    `json:"rateLimit,omitempty"`
+	Timeouts         *GatewayRouteTimeouts     `json:"timeouts,omitempty"`
+	RetryPolicy      *GatewayRouteRetryPolicy  `json:"retryPolicy,omitempty"`
+	Kind             GatewayRouteKind          `json:"kind,omitempty"`
+}
+
+// GatewayRouteKind represents the kind of traffic served by a gateway route.
+type GatewayRouteKind string
+
+const (
+	// GatewayRouteKindHTTP routes HTTP requests. It is the default kind.
+	GatewayRouteKindHTTP GatewayRouteKind = "http"
+	// GatewayRouteKindWebsocket routes HTTP requests upgraded to WebSocket connections.
+	GatewayRouteKindWebsocket GatewayRouteKind = "websocket"
+	// GatewayRouteKindTCP routes raw TCP connections.
+	GatewayRouteKindTCP GatewayRouteKind = "tcp"
+)
+
+// GatewayRouteDestination - Declare a service to route a share of the traffic of a route to.
+type GatewayRouteDestination struct {
+	Destination string `json:"destination,omitempty"`
+	Weight      int32  `json:"weight,omitempty"`
+}
+
+// GatewayRouteHeaderMatch - Declare a header to match the incoming request headers on.
+type GatewayRouteHeaderMatch struct {
+	Name  string `json:"name,omitempty"`
+	Value string `json:"value,omitempty"`
+}
+
+// GatewayRouteRedirect - Declare the redirect sent for the requests matching a route.
+type GatewayRouteRedirect struct {
+	Scheme     string `json:"scheme,omitempty"`
+	Hostname   string `json:"hostname,omitempty"`
+	Port       int32  `json:"port,omitempty"`
+	Path       string `json:"path,omitempty"`
+	StatusCode int32  `json:"statusCode,omitempty"`
+}
+
+// GatewayRouteRateLimit - Declare the rate limit of a route.
+type GatewayRouteRateLimit struct {
+	Requests int32  `json:"requests,omitempty"`
+	Unit     string `json:"unit,omitempty"`
+	Burst    int32  `json:"burst,omitempty"`
+}
+
+// GatewayRouteTimeouts - Declare the timeouts of a route.
+type GatewayRouteTimeouts struct {
+	Response string `json:"response,omitempty"`
+	Idle     string `json:"idle,omitempty"`
+}
+
+// GatewayRouteRetryPolicy - Declare the retry policy of a route.
+type GatewayRouteRetryPolicy struct {
+	Attempts      int32  `json:"attempts,omitempty"`
+	PerTryTimeout string `json:"perTryTimeout,omitempty"`
 }
 
 // GatewayPropertiesHostname - Declare hostname information for the Gateway.
 type GatewayPropertiesHostname struct {
 	FullyQualifiedHostname string `json:"fullyQualifiedHostname,omitempty"`
 	Prefix                 string `json:"prefix,omitempty"`
+	DNSZone                string `json:"dnsZone,omitempty"`
 }
 
 // GatewayPropertiesTLS - Declare TLS information for the Gateway.
@@ -89,6 +151,13 @@ type GatewayPropertiesTLS struct {
 	SSLPassthrough         bool                      `json:"sslPassthrough,omitempty"`
 	MinimumProtocolVersion MinimumTLSProtocolVersion `json:"minimumProtocolVersion,omitempty"`
 	CertificateFrom        string                    `json:"certificateFrom,omitempty"`
+	Hosts                  []GatewayTLSHost          `json:"hosts,omitempty"`
+}
+
+// GatewayTLSHost - Declare an additional host of the Gateway with its own TLS certificate.
+type GatewayTLSHost struct {
+	Hostname        string `json:"hostname,omitempty"`
+	CertificateFrom string `json:"certificateFrom,omitempty"`
 }
 
 // IsValid checks if the given MinimumTLSProtocolVersion is valid.
@@ -0,0 +1,144 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package applications
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	appsv1 "k8s.io/api/apps/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	runtimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/cli/clients_new/generated"
+	corerpv20231001preview "github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	resources_kubernetes "github.com/radius-project/radius/pkg/ucp/resources/kubernetes"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+)
+
+var (
+	// computedValueProperties is the list of properties returned as the endpoints and computed values of a resource.
+	computedValueProperties = []string{"url", "host", "port", "server", "database", "namespace"}
+)
+
+// computeStatus computes the status of the resources of an application. The health of the Kubernetes Deployments
+// created for the resources is checked when a Kubernetes client is provided.
+func computeStatus(ctx context.Context, kubeClient runtimeclient.Client, applicationResources []generated.GenericResource) *corerpv20231001preview.ApplicationStatusResponse {
+	status := &corerpv20231001preview.ApplicationStatusResponse{
+		Resources: []*corerpv20231001preview.ApplicationStatusResource{},
+	}
+
+	for _, resource := range applicationResources {
+		provisioningState := string(v1.ProvisioningStateSucceeded)
+		if state, ok := resource.Properties["provisioningState"].(string); ok {
+			provisioningState = state
+		}
+
+		status.Resources = append(status.Resources, &corerpv20231001preview.ApplicationStatusResource{
+			ID:                resource.ID,
+			Name:              resource.Name,
+			Type:              resource.Type,
+			ProvisioningState: to.Ptr(provisioningState),
+			HealthState:       to.Ptr(computeHealthState(ctx, kubeClient, resource, v1.ProvisioningState(provisioningState))),
+			ComputedValues:    computedValuesFromAPIData(resource),
+		})
+	}
+
+	// Produce a stable output
+	sort.Slice(status.Resources, func(i, j int) bool {
+		return to.String(status.Resources[i].ID) < to.String(status.Resources[j].ID)
+	})
+
+	return status
+}
+
+// computeHealthState computes the health of a resource from its provisioning state and the readiness of the Kubernetes
+// Deployments in its output resources.
+func computeHealthState(ctx context.Context, kubeClient runtimeclient.Client, resource generated.GenericResource, provisioningState v1.ProvisioningState) corerpv20231001preview.HealthState {
+	switch provisioningState {
+	case v1.ProvisioningStateSucceeded:
+	case v1.ProvisioningStateFailed, v1.ProvisioningStateCanceled:
+		return corerpv20231001preview.HealthStateUnhealthy
+	default:
+		return corerpv20231001preview.HealthStateUnknown
+	}
+
+	if kubeClient == nil {
+		return corerpv20231001preview.HealthStateHealthy
+	}
+
+	logger := ucplog.FromContextOrDiscard(ctx)
+	for _, outputResource := range outputResourcesFromAPIData(resource) {
+		id, err := resources.ParseResource(to.String(outputResource.ID))
+		if err != nil || !strings.EqualFold(id.Type(), resources_kubernetes.ResourceTypeDeployment) {
+			continue
+		}
+
+		_, _, namespace, name := resources_kubernetes.ToParts(id)
+		deployment := &appsv1.Deployment{}
+		err = kubeClient.Get(ctx, runtimeclient.ObjectKey{Namespace: namespace, Name: name}, deployment)
+		if apierrors.IsNotFound(err) {
+			return corerpv20231001preview.HealthStateUnhealthy
+		} else if err != nil {
+			logger.Info(fmt.Sprintf("Failed to get the deployment %s/%s: %s", namespace, name, err.Error()))
+			return corerpv20231001preview.HealthStateUnknown
+		}
+
+		if !isDeploymentReady(deployment) {
+			return corerpv20231001preview.HealthStateUnhealthy
+		}
+	}
+
+	return corerpv20231001preview.HealthStateHealthy
+}
+
+// isDeploymentReady returns true if all the desired replicas of the deployment are ready.
+func isDeploymentReady(deployment *appsv1.Deployment) bool {
+	replicas := int32(1)
+	if deployment.Spec.Replicas != nil {
+		replicas = *deployment.Spec.Replicas
+	}
+
+	return deployment.Status.ReadyReplicas >= replicas
+}
+
+// computedValuesFromAPIData returns the endpoints and computed values of a resource, such as its URL, host and port.
+func computedValuesFromAPIData(resource generated.GenericResource) map[string]*string {
+	values := map[string]*string{}
+	for _, property := range computedValueProperties {
+		value, ok := resource.Properties[property]
+		if !ok || value == nil {
+			continue
+		}
+
+		switch v := value.(type) {
+		case string:
+			if v != "" {
+				values[property] = to.Ptr(v)
+			}
+		case float64, int, int32, int64, bool:
+			values[property] = to.Ptr(fmt.Sprint(v))
+		}
+	}
+
+	return values
+}
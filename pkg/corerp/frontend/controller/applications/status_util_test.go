@@ -0,0 +1,145 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package applications
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/radius-project/radius/pkg/cli/clients_new/generated"
+	corerpv20231001preview "github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/to"
+)
+
+func Test_computeStatus(t *testing.T) {
+	containerID := "/planes/radius/local/resourcegroups/default/providers/Applications.Core/containers/frontend"
+	gatewayID := "/planes/radius/local/resourcegroups/default/providers/Applications.Core/gateways/gateway"
+	redisID := "/planes/radius/local/resourcegroups/default/providers/Applications.Datastores/redisCaches/redis"
+
+	container := func(provisioningState string) generated.GenericResource {
+		return generated.GenericResource{
+			ID:   to.Ptr(containerID),
+			Name: to.Ptr("frontend"),
+			Type: to.Ptr("Applications.Core/containers"),
+			Properties: map[string]any{
+				"provisioningState": provisioningState,
+				"status": map[string]any{
+					"outputResources": []any{
+						map[string]any{"id": "/planes/kubernetes/local/namespaces/default-app/providers/apps/Deployment/frontend"},
+						map[string]any{"id": "/planes/kubernetes/local/namespaces/default-app/providers/core/Service/frontend"},
+					},
+				},
+			},
+		}
+	}
+
+	gateway := generated.GenericResource{
+		ID:   to.Ptr(gatewayID),
+		Name: to.Ptr("gateway"),
+		Type: to.Ptr("Applications.Core/gateways"),
+		Properties: map[string]any{
+			"provisioningState": "Succeeded",
+			"url":               "http://localhost:8080",
+		},
+	}
+
+	redis := generated.GenericResource{
+		ID:   to.Ptr(redisID),
+		Name: to.Ptr("redis"),
+		Type: to.Ptr("Applications.Datastores/redisCaches"),
+		Properties: map[string]any{
+			"provisioningState": "Updating",
+			"host":              "redis.default-app.svc.cluster.local",
+			"port":              float64(6379),
+			"username":          "",
+		},
+	}
+
+	deployment := func(readyReplicas int32) *appsv1.Deployment {
+		return &appsv1.Deployment{
+			ObjectMeta: metav1.ObjectMeta{Name: "frontend", Namespace: "default-app"},
+			Spec:       appsv1.DeploymentSpec{Replicas: to.Ptr(int32(2))},
+			Status:     appsv1.DeploymentStatus{ReadyReplicas: readyReplicas},
+		}
+	}
+
+	t.Run("deployments are ready", func(t *testing.T) {
+		client := fake.NewClientBuilder().WithObjects(deployment(2)).Build()
+		status := computeStatus(context.Background(), client, []generated.GenericResource{redis, gateway, container("Succeeded")})
+
+		expected := &corerpv20231001preview.ApplicationStatusResponse{
+			Resources: []*corerpv20231001preview.ApplicationStatusResource{
+				{
+					ID:                to.Ptr(containerID),
+					Name:              to.Ptr("frontend"),
+					Type:              to.Ptr("Applications.Core/containers"),
+					ProvisioningState: to.Ptr("Succeeded"),
+					HealthState:       to.Ptr(corerpv20231001preview.HealthStateHealthy),
+					ComputedValues:    map[string]*string{},
+				},
+				{
+					ID:                to.Ptr(gatewayID),
+					Name:              to.Ptr("gateway"),
+					Type:              to.Ptr("Applications.Core/gateways"),
+					ProvisioningState: to.Ptr("Succeeded"),
+					HealthState:       to.Ptr(corerpv20231001preview.HealthStateHealthy),
+					ComputedValues:    map[string]*string{"url": to.Ptr("http://localhost:8080")},
+				},
+				{
+					ID:                to.Ptr(redisID),
+					Name:              to.Ptr("redis"),
+					Type:              to.Ptr("Applications.Datastores/redisCaches"),
+					ProvisioningState: to.Ptr("Updating"),
+					HealthState:       to.Ptr(corerpv20231001preview.HealthStateUnknown),
+					ComputedValues: map[string]*string{
+						"host": to.Ptr("redis.default-app.svc.cluster.local"),
+						"port": to.Ptr("6379"),
+					},
+				},
+			},
+		}
+		require.Equal(t, expected, status)
+	})
+
+	t.Run("deployment is not ready", func(t *testing.T) {
+		client := fake.NewClientBuilder().WithObjects(deployment(1)).Build()
+		status := computeStatus(context.Background(), client, []generated.GenericResource{container("Succeeded")})
+		require.Equal(t, corerpv20231001preview.HealthStateUnhealthy, *status.Resources[0].HealthState)
+	})
+
+	t.Run("deployment does not exist", func(t *testing.T) {
+		client := fake.NewClientBuilder().Build()
+		status := computeStatus(context.Background(), client, []generated.GenericResource{container("Succeeded")})
+		require.Equal(t, corerpv20231001preview.HealthStateUnhealthy, *status.Resources[0].HealthState)
+	})
+
+	t.Run("provisioning failed", func(t *testing.T) {
+		client := fake.NewClientBuilder().WithObjects(deployment(2)).Build()
+		status := computeStatus(context.Background(), client, []generated.GenericResource{container("Failed")})
+		require.Equal(t, corerpv20231001preview.HealthStateUnhealthy, *status.Resources[0].HealthState)
+	})
+
+	t.Run("without kubernetes client", func(t *testing.T) {
+		status := computeStatus(context.Background(), nil, []generated.GenericResource{container("Succeeded")})
+		require.Equal(t, corerpv20231001preview.HealthStateHealthy, *status.Resources[0].HealthState)
+	})
+}
@@ -0,0 +1,191 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package handlers
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/resources/armresources"
+	"github.com/radius-project/radius/pkg/azure/armauth"
+	"github.com/radius-project/radius/pkg/azure/clientv2"
+	"github.com/radius-project/radius/pkg/logging"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	resources_azure "github.com/radius-project/radius/pkg/ucp/resources/azure"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+)
+
+const (
+	PrivateEndpointNameKey    = "privateendpointname"
+	PrivateEndpointSubnetKey  = "privateendpointsubnet"
+	PrivateEndpointGroupIDKey = "privateendpointgroupid"
+
+	// PrivateEndpointTargetKey is used to pass the fully qualified identifier of the resource the private endpoint connects to.
+	PrivateEndpointTargetKey = "privateendpointtarget"
+
+	// PrivateEndpointDNSZoneKey is used to pass the fully qualified identifier of the private DNS zone in which the
+	// private endpoint registers its hostname. It is optional.
+	PrivateEndpointDNSZoneKey = "privateendpointdnszone"
+
+	networkAPIVersion = "2023-09-01"
+)
+
+// NewAzurePrivateEndpointHandler creates a new ResourceHandler for Azure private endpoints.
+func NewAzurePrivateEndpointHandler(arm *armauth.ArmConfig) ResourceHandler {
+	return &azurePrivateEndpointHandler{arm: arm}
+}
+
+type azurePrivateEndpointHandler struct {
+	arm *armauth.ArmConfig
+}
+
+// Put creates or updates a private endpoint connecting the given subnet to the target resource, in the resource
+// group of the subnet. When a private DNS zone is given, the private endpoint registers its hostname in the zone.
+// It returns an error if the virtual network cannot be found or if the creation or update fails.
+func (handler *azurePrivateEndpointHandler) Put(ctx context.Context, options *PutOptions) (map[string]string, error) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	properties, ok := options.Resource.CreateResource.Data.(map[string]string)
+	if !ok {
+		return properties, fmt.Errorf("invalid required properties for resource")
+	}
+
+	name, err := GetMapValue[string](properties, PrivateEndpointNameKey)
+	if err != nil {
+		return nil, err
+	}
+
+	target, err := GetMapValue[string](properties, PrivateEndpointTargetKey)
+	if err != nil {
+		return nil, err
+	}
+
+	groupID, err := GetMapValue[string](properties, PrivateEndpointGroupIDKey)
+	if err != nil {
+		return nil, err
+	}
+
+	subnet, err := GetMapValue[string](properties, PrivateEndpointSubnetKey)
+	if err != nil {
+		return nil, err
+	}
+
+	subnetID, err := resources.ParseResource(subnet)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := clientv2.NewGenericResourceClient(subnetID.FindScope(resources_azure.ScopeSubscriptions), &handler.arm.ClientOptions, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	// The private endpoint has to be created in the region of the virtual network.
+	vnetID := subnetID.Truncate()
+	vnet, err := client.GetByID(ctx, vnetID.String(), networkAPIVersion, &armresources.ClientGetByIDOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get virtual network %q: %w", vnetID.String(), err)
+	}
+
+	id := resources.MakeRelativeID(
+		subnetID.ScopeSegments(),
+		[]resources.TypeSegment{{Type: resources_azure.ResourceTypeNetworkPrivateEndpoint, Name: name}},
+		nil)
+
+	poller, err := client.BeginCreateOrUpdateByID(ctx, id, networkAPIVersion, armresources.GenericResource{
+		Location: vnet.Location,
+		Properties: map[string]any{
+			"subnet": map[string]any{
+				"id": subnet,
+			},
+			"privateLinkServiceConnections": []any{
+				map[string]any{
+					"name": name,
+					"properties": map[string]any{
+						"privateLinkServiceId": target,
+						"groupIds":             []string{groupID},
+					},
+				},
+			},
+		},
+	}, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create private endpoint %q: %w", id, err)
+	}
+
+	resp, err := poller.PollUntilDone(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create private endpoint %q: %w", id, err)
+	}
+
+	if dnsZone := properties[PrivateEndpointDNSZoneKey]; dnsZone != "" {
+		zoneID, err := resources.ParseResource(dnsZone)
+		if err != nil {
+			return nil, err
+		}
+
+		groupPoller, err := client.BeginCreateOrUpdateByID(ctx, id+"/privateDnsZoneGroups/default", networkAPIVersion, armresources.GenericResource{
+			Properties: map[string]any{
+				"privateDnsZoneConfigs": []any{
+					map[string]any{
+						"name": zoneID.Name(),
+						"properties": map[string]any{
+							"privateDnsZoneId": dnsZone,
+						},
+					},
+				},
+			},
+		}, nil)
+		if err != nil {
+			return nil, fmt.Errorf("failed to register private endpoint %q in private DNS zone %q: %w", id, dnsZone, err)
+		}
+
+		if _, err := groupPoller.PollUntilDone(ctx, nil); err != nil {
+			return nil, fmt.Errorf("failed to register private endpoint %q in private DNS zone %q: %w", id, dnsZone, err)
+		}
+	}
+
+	logger.Info(fmt.Sprintf("Created private endpoint %s to access %s", id, target), logging.LogFieldLocalID, options.Resource.LocalID)
+
+	peID, err := resources.ParseResource(to.String(resp.ID))
+	if err != nil {
+		return nil, err
+	}
+
+	options.Resource.ID = peID
+	return properties, nil
+}
+
+// Delete deletes the private endpoint. The private DNS zone group of the private endpoint is deleted along with it.
+func (handler *azurePrivateEndpointHandler) Delete(ctx context.Context, options *DeleteOptions) error {
+	client, err := clientv2.NewGenericResourceClient(options.Resource.ID.FindScope(resources_azure.ScopeSubscriptions), &handler.arm.ClientOptions, nil)
+	if err != nil {
+		return err
+	}
+
+	poller, err := client.BeginDeleteByID(ctx, options.Resource.ID.String(), networkAPIVersion, nil)
+	if err != nil {
+		return fmt.Errorf("failed to delete private endpoint %q: %w", options.Resource.ID.String(), err)
+	}
+
+	if _, err := poller.PollUntilDone(ctx, nil); err != nil {
+		return fmt.Errorf("failed to delete private endpoint %q: %w", options.Resource.ID.String(), err)
+	}
+
+	return nil
+}
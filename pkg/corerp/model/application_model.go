@@ -28,6 +28,7 @@ import (
 	"github.com/radius-project/radius/pkg/corerp/renderers/gateway"
 	"github.com/radius-project/radius/pkg/corerp/renderers/kubernetesmetadata"
 	"github.com/radius-project/radius/pkg/corerp/renderers/manualscale"
+	"github.com/radius-project/radius/pkg/corerp/renderers/mutualtls"
 	"github.com/radius-project/radius/pkg/corerp/renderers/volume"
 	"github.com/radius-project/radius/pkg/resourcemodel"
 	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
@@ -88,9 +89,11 @@ func NewApplicationModel(arm *armauth.ArmConfig, k8sClient client.Client, k8sCli
 			ResourceType: container.ResourceType,
 			Renderer: &kubernetesmetadata.Renderer{
 				Inner: &manualscale.Renderer{
-					Inner: &daprextension.Renderer{
-						Inner: &container.Renderer{
-							RoleAssignmentMap: roleAssignmentMap,
+					Inner: &mutualtls.Renderer{
+						Inner: &daprextension.Renderer{
+							Inner: &container.Renderer{
+								RoleAssignmentMap: roleAssignmentMap,
+							},
 						},
 					},
 				},
@@ -154,6 +157,13 @@ func NewApplicationModel(arm *armauth.ArmConfig, k8sClient client.Client, k8sCli
 			},
 			ResourceHandler: handlers.NewAzureRoleAssignmentHandler(arm),
 		},
+		{
+			ResourceType: resourcemodel.ResourceType{
+				Type:     resources_azure.ResourceTypeNetworkPrivateEndpoint,
+				Provider: resourcemodel.ProviderAzure,
+			},
+			ResourceHandler: handlers.NewAzurePrivateEndpointHandler(arm),
+		},
 	}
 	err := checkForDuplicateRegistrations(radiusResourceModel, outputResourceModel)
 	if err != nil {
@@ -0,0 +1,150 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package container
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/corerp/datamodel"
+	"github.com/radius-project/radius/pkg/corerp/handlers"
+	"github.com/radius-project/radius/pkg/corerp/renderers"
+	"github.com/radius-project/radius/pkg/resourcemodel"
+	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	resources_azure "github.com/radius-project/radius/pkg/ucp/resources/azure"
+	resources_radius "github.com/radius-project/radius/pkg/ucp/resources/radius"
+)
+
+// privateLinkResource describes how a private endpoint connects to a kind of Azure resource.
+type privateLinkResource struct {
+	// GroupID is the sub-resource of the target resource the private endpoint connects to.
+	GroupID string
+
+	// DNSZone is the private DNS zone in which the private endpoint of the target resource is resolved.
+	DNSZone string
+}
+
+// privateLinkResources maps the lower-cased Azure resource types that support private endpoints to their private link settings.
+var privateLinkResources = map[string]privateLinkResource{
+	"microsoft.sql/servers":                     {GroupID: "sqlServer", DNSZone: "privatelink.database.windows.net"},
+	"microsoft.cache/redis":                     {GroupID: "redisCache", DNSZone: "privatelink.redis.cache.windows.net"},
+	"microsoft.keyvault/vaults":                 {GroupID: "vault", DNSZone: "privatelink.vaultcore.azure.net"},
+	"microsoft.storage/storageaccounts":         {GroupID: "blob", DNSZone: "privatelink.blob.core.windows.net"},
+	"microsoft.servicebus/namespaces":           {GroupID: "namespace", DNSZone: "privatelink.servicebus.windows.net"},
+	"microsoft.documentdb/databaseaccounts":     {GroupID: "Sql", DNSZone: "privatelink.documents.azure.com"},
+	"microsoft.dbforpostgresql/flexibleservers": {GroupID: "postgresqlServer", DNSZone: "privatelink.postgres.database.azure.com"},
+}
+
+// getPrivateEndpointTarget returns the Azure resource reached through the private endpoint of a connection, along with
+// its private link settings. The source of the connection is either the Azure resource itself, or a Radius resource
+// with an Azure output resource supporting private endpoints.
+func getPrivateEndpointTarget(connection datamodel.ConnectionProperties, dependencies map[string]renderers.RendererDependency) (resources.ID, privateLinkResource, error) {
+	id, err := resources.ParseResource(connection.Source)
+	if err != nil {
+		return resources.ID{}, privateLinkResource{}, v1.NewClientErrInvalidRequest(fmt.Sprintf("the source of a connection with a private endpoint must be a resource ID, got %q", connection.Source))
+	}
+
+	if !resources_radius.IsRadiusResource(id) {
+		plr, ok := privateLinkResources[strings.ToLower(id.Type())]
+		if !resources_azure.IsAzureResource(id) || !ok {
+			return resources.ID{}, privateLinkResource{}, v1.NewClientErrInvalidRequest(fmt.Sprintf("private endpoints are not supported for connections to resources of type %q", id.Type()))
+		}
+		return id, plr, nil
+	}
+
+	dependency, ok := dependencies[connection.Source]
+	if !ok {
+		return resources.ID{}, privateLinkResource{}, v1.NewClientErrInvalidRequest(fmt.Sprintf("connection source %q was not found in the dependencies collection", connection.Source))
+	}
+
+	// Sort the LocalIDs so the same output resource is picked on each render.
+	localIDs := []string{}
+	for localID := range dependency.OutputResources {
+		localIDs = append(localIDs, localID)
+	}
+	sort.Strings(localIDs)
+
+	for _, localID := range localIDs {
+		target := dependency.OutputResources[localID]
+		if !resources_azure.IsAzureResource(target) {
+			continue
+		}
+		if plr, ok := privateLinkResources[strings.ToLower(target.Type())]; ok {
+			return target, plr, nil
+		}
+	}
+
+	return resources.ID{}, privateLinkResource{}, v1.NewClientErrInvalidRequest(fmt.Sprintf("connection source %q has no Azure resource supporting private endpoints", connection.Source))
+}
+
+// makePrivateEndpointForConnection creates the private endpoint through which the container reaches the source of a connection.
+func (r Renderer) makePrivateEndpointForConnection(resource *datamodel.ContainerResource, connection datamodel.ConnectionProperties, dependencies map[string]renderers.RendererDependency) (rpv1.OutputResource, error) {
+	target, plr, err := getPrivateEndpointTarget(connection, dependencies)
+	if err != nil {
+		return rpv1.OutputResource{}, err
+	}
+
+	subnet, err := resources.ParseResource(connection.PrivateEndpoint.Subnet)
+	if err != nil || !strings.EqualFold(subnet.Type(), resources_azure.ResourceTypeNetworkVirtualNetworkSubnet) {
+		return rpv1.OutputResource{}, v1.NewClientErrInvalidRequest(fmt.Sprintf("invalid private endpoint subnet %q: must be the resource ID of a subnet", connection.PrivateEndpoint.Subnet))
+	}
+
+	data := map[string]string{
+		handlers.PrivateEndpointNameKey:    fmt.Sprintf("%s-%s", resource.Name, target.Name()),
+		handlers.PrivateEndpointTargetKey:  target.String(),
+		handlers.PrivateEndpointSubnetKey:  subnet.String(),
+		handlers.PrivateEndpointGroupIDKey: plr.GroupID,
+	}
+
+	if connection.PrivateEndpoint.PrivateDNSZone != "" {
+		zone, err := resources.ParseResource(connection.PrivateEndpoint.PrivateDNSZone)
+		if err != nil || !strings.EqualFold(zone.Type(), resources_azure.ResourceTypeNetworkPrivateDNSZone) {
+			return rpv1.OutputResource{}, v1.NewClientErrInvalidRequest(fmt.Sprintf("invalid private DNS zone %q: must be the resource ID of a private DNS zone", connection.PrivateEndpoint.PrivateDNSZone))
+		}
+
+		// The private hostname given to the container only resolves in the private link zone of the target resource.
+		if !strings.EqualFold(zone.Name(), plr.DNSZone) {
+			return rpv1.OutputResource{}, v1.NewClientErrInvalidRequest(fmt.Sprintf("private DNS zone %q must be named %q to resolve private endpoints of resources of type %q", zone.Name(), plr.DNSZone, target.Type()))
+		}
+		data[handlers.PrivateEndpointDNSZoneKey] = zone.String()
+	}
+
+	return rpv1.OutputResource{
+		LocalID: rpv1.NewLocalID(rpv1.LocalIDPrivateEndpointPrefix, target.String()),
+		CreateResource: &rpv1.Resource{
+			Data: data,
+			ResourceType: resourcemodel.ResourceType{
+				Type:     resources_azure.ResourceTypeNetworkPrivateEndpoint,
+				Provider: resourcemodel.ProviderAzure,
+			},
+		},
+	}, nil
+}
+
+// getPrivateHostname returns the hostname through which the container reaches the source of a connection over its
+// private endpoint.
+func getPrivateHostname(connection datamodel.ConnectionProperties, dependencies map[string]renderers.RendererDependency) (string, error) {
+	target, plr, err := getPrivateEndpointTarget(connection, dependencies)
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("%s.%s", target.Name(), plr.DNSZone), nil
+}
@@ -57,6 +57,16 @@ const (
 
 	AzureKeyVaultSecretsUserRole = "Key Vault Secrets User"
 	AzureKeyVaultCryptoUserRole  = "Key Vault Crypto User"
+
+	// HeadlessHostnameKey is the computed value holding the hostname of the headless service of the container.
+	HeadlessHostnameKey = "headlessHostname"
+	// SRVRecordKeyPrefix prefixes the computed values holding the SRV record name of each port of the container.
+	SRVRecordKeyPrefix = "srv_"
+
+	// topologyModeAnnotation enables topology aware routing for a service.
+	topologyModeAnnotation = "service.kubernetes.io/topology-mode"
+	// maxSessionAffinityTimeoutSeconds is the maximum session affinity timeout accepted by Kubernetes.
+	maxSessionAffinityTimeoutSeconds = 86400
 )
 
 // GetSupportedKinds returns a list of supported volume kinds.
@@ -229,6 +239,20 @@ func (r Renderer) Render(ctx context.Context, dm v1.DataModelInterface, options
 		outputResources = append(outputResources, roles...)
 	}
 
+	// Connections might request private connectivity to the cloud resource they target.
+	for _, connection := range properties.Connections {
+		if connection.PrivateEndpoint == nil {
+			continue
+		}
+
+		privateEndpoint, err := r.makePrivateEndpointForConnection(resource, connection, dependencies)
+		if err != nil {
+			return renderers.RendererOutput{}, err
+		}
+
+		outputResources = append(outputResources, privateEndpoint)
+	}
+
 	// If the container has a base manifest, deserialize base manifest and validation should be done by frontend controller.
 	baseManifest, err := fetchBaseManifest(resource)
 	if err != nil {
@@ -274,6 +298,15 @@ func (r Renderer) Render(ctx context.Context, dm v1.DataModelInterface, options
 		outputResources = append(outputResources, serviceResource)
 	}
 
+	// If the container opts in, generate a headless service so DNS and SRV records are published for each replica.
+	if properties.Runtimes != nil && properties.Runtimes.Kubernetes != nil && properties.Runtimes.Kubernetes.HeadlessService {
+		headlessResource, err := r.makeHeadlessService(appId.Name(), resource, servicePorts, options, computedValues)
+		if err != nil {
+			return renderers.RendererOutput{}, err
+		}
+		outputResources = append(outputResources, headlessResource)
+	}
+
 	// Populate the remaining resources from the base manifest.
 	outputResources = populateAllBaseResources(ctx, baseManifest, outputResources, options)
 
@@ -307,9 +340,111 @@ SKIPINSERT:
 	base.Spec.Selector = kubernetes.MakeSelectorLabels(appId.Name(), resource.Name)
 	base.Spec.Type = corev1.ServiceTypeClusterIP
 
+	runtimes := resource.Properties.Runtimes
+	if runtimes != nil && runtimes.Kubernetes != nil && runtimes.Kubernetes.Service != nil {
+		if err := applyServiceOptions(base, runtimes.Kubernetes.Service); err != nil {
+			return rpv1.OutputResource{}, err
+		}
+	}
+
 	return rpv1.NewKubernetesOutputResource(rpv1.LocalIDService, base, base.ObjectMeta), nil
 }
 
+// applyServiceOptions applies the type, session affinity, external traffic policy and topology aware routing
+// configured for the service of the container.
+func applyServiceOptions(service *corev1.Service, options *datamodel.KubernetesService) error {
+	switch corev1.ServiceType(options.Type) {
+	case "", corev1.ServiceTypeClusterIP:
+	case corev1.ServiceTypeLoadBalancer:
+		service.Spec.Type = corev1.ServiceTypeLoadBalancer
+	default:
+		return v1.NewClientErrInvalidRequest(fmt.Sprintf("invalid service type %q: must be ClusterIP or LoadBalancer", options.Type))
+	}
+
+	switch corev1.ServiceAffinity(options.SessionAffinity) {
+	case "", corev1.ServiceAffinityNone:
+		if options.SessionAffinityTimeoutSeconds != nil {
+			return v1.NewClientErrInvalidRequest("sessionAffinityTimeoutSeconds requires the ClientIP session affinity")
+		}
+	case corev1.ServiceAffinityClientIP:
+		service.Spec.SessionAffinity = corev1.ServiceAffinityClientIP
+		if timeout := options.SessionAffinityTimeoutSeconds; timeout != nil {
+			if *timeout <= 0 || *timeout > maxSessionAffinityTimeoutSeconds {
+				return v1.NewClientErrInvalidRequest(fmt.Sprintf("invalid sessionAffinityTimeoutSeconds %d: must be between 1 and %d", *timeout, maxSessionAffinityTimeoutSeconds))
+			}
+			service.Spec.SessionAffinityConfig = &corev1.SessionAffinityConfig{
+				ClientIP: &corev1.ClientIPConfig{TimeoutSeconds: to.Ptr(*timeout)},
+			}
+		}
+	default:
+		return v1.NewClientErrInvalidRequest(fmt.Sprintf("invalid session affinity %q: must be None or ClientIP", options.SessionAffinity))
+	}
+
+	if options.ExternalTrafficPolicy != "" {
+		// Kubernetes only accepts an external traffic policy on services reachable from outside of the cluster.
+		if service.Spec.Type != corev1.ServiceTypeLoadBalancer {
+			return v1.NewClientErrInvalidRequest("externalTrafficPolicy requires the LoadBalancer service type")
+		}
+
+		switch corev1.ServiceExternalTrafficPolicy(options.ExternalTrafficPolicy) {
+		case corev1.ServiceExternalTrafficPolicyCluster, corev1.ServiceExternalTrafficPolicyLocal:
+			service.Spec.ExternalTrafficPolicy = corev1.ServiceExternalTrafficPolicy(options.ExternalTrafficPolicy)
+		default:
+			return v1.NewClientErrInvalidRequest(fmt.Sprintf("invalid external traffic policy %q: must be Cluster or Local", options.ExternalTrafficPolicy))
+		}
+	}
+
+	if options.TopologyAwareRouting {
+		if service.ObjectMeta.Annotations == nil {
+			service.ObjectMeta.Annotations = map[string]string{}
+		}
+		service.ObjectMeta.Annotations[topologyModeAnnotation] = "Auto"
+	}
+
+	return nil
+}
+
+// makeHeadlessService creates a headless service selecting the pods of the container, and adds the hostname of the
+// service and the SRV record name of each port to the computed values.
+func (r Renderer) makeHeadlessService(
+	applicationName string,
+	resource *datamodel.ContainerResource,
+	servicePorts []corev1.ServicePort,
+	options renderers.RenderOptions,
+	computedValues map[string]rpv1.ComputedValueReference) (rpv1.OutputResource, error) {
+	name := kubernetes.NormalizeResourceName(resource.Name) + "-headless"
+	if !kubernetes.IsValidObjectName(name) {
+		return rpv1.OutputResource{}, v1.NewClientErrInvalidRequest(fmt.Sprintf("container name %q is too long to generate a headless service", resource.Name))
+	}
+
+	service := &corev1.Service{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "Service",
+			APIVersion: "v1",
+		},
+		ObjectMeta: getObjectMeta(metav1.ObjectMeta{}, applicationName, resource.Name, resource.ResourceTypeName(), options),
+		Spec: corev1.ServiceSpec{
+			ClusterIP: corev1.ClusterIPNone,
+			Selector:  kubernetes.MakeSelectorLabels(applicationName, resource.Name),
+			Ports:     servicePorts,
+		},
+	}
+	service.Name = name
+
+	// The cluster domain is omitted so that the names resolve through the search path of the pods.
+	hostname := fmt.Sprintf("%s.%s.svc", service.Name, service.Namespace)
+	computedValues[HeadlessHostnameKey] = rpv1.ComputedValueReference{
+		Value: hostname,
+	}
+	for _, port := range servicePorts {
+		computedValues[SRVRecordKeyPrefix+port.Name] = rpv1.ComputedValueReference{
+			Value: fmt.Sprintf("_%s._%s.%s", port.Name, strings.ToLower(string(port.Protocol)), hostname),
+		}
+	}
+
+	return rpv1.NewKubernetesOutputResource(rpv1.LocalIDHeadlessService, service, service.ObjectMeta), nil
+}
+
 func (r Renderer) makeDeployment(
 	manifest kubeutil.ObjectManifest,
 	applicationName string,
@@ -733,6 +868,17 @@ func getEnvVarsAndSecretData(resource *datamodel.ContainerResource, dependencies
 				continue
 			}
 
+			// handles case where the container reaches the source over a private endpoint.
+			if con.PrivateEndpoint != nil {
+				hostname, err := getPrivateHostname(con, dependencies)
+				if err != nil {
+					return map[string]corev1.EnvVar{}, map[string][]byte{}, err
+				}
+
+				hostnameKey := fmt.Sprintf("%s_%s_%s", "CONNECTION", strings.ToUpper(name), "HOSTNAME")
+				env[hostnameKey] = corev1.EnvVar{Name: hostnameKey, Value: hostname}
+			}
+
 			// handles case where container has source field structured as a resourceID.
 			for key, value := range properties.ComputedValues {
 				name := fmt.Sprintf("%s_%s_%s", "CONNECTION", strings.ToUpper(name), strings.ToUpper(key))
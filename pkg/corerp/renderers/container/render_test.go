@@ -19,6 +19,7 @@ package container
 import (
 	"encoding/json"
 	"fmt"
+	"strings"
 	"testing"
 
 	apiv1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
@@ -1082,6 +1083,189 @@ func Test_Render_AzureConnectionEmptyRoleAllowed(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func Test_Render_ConnectionWithPrivateEndpoint(t *testing.T) {
+	sqlID := makeAzureResourceID(t, "Microsoft.Sql/servers", "test-sql").String()
+	subnetID := "/subscriptions/test-subscription/resourceGroups/test-resourcegroup/providers/Microsoft.Network/virtualNetworks/test-vnet/subnets/default"
+	zoneID := makeAzureResourceID(t, "Microsoft.Network/privateDnsZones", "privatelink.database.windows.net").String()
+	properties := datamodel.ContainerProperties{
+		BasicResourceProperties: rpv1.BasicResourceProperties{
+			Application: applicationResourceID,
+		},
+		Connections: map[string]datamodel.ConnectionProperties{
+			"sql": {
+				Source: sqlID,
+				PrivateEndpoint: &datamodel.ConnectionPrivateEndpoint{
+					Subnet:         subnetID,
+					PrivateDNSZone: zoneID,
+				},
+			},
+		},
+		Container: datamodel.Container{
+			Image: "testimage:latest",
+		},
+	}
+	resource := makeResource(properties)
+
+	ctx := testcontext.New(t)
+	renderer := Renderer{}
+	output, err := renderer.Render(ctx, resource, renderers.RenderOptions{Dependencies: map[string]renderers.RendererDependency{}, Environment: renderers.EnvironmentOptions{Namespace: "default"}})
+	require.NoError(t, err)
+
+	resourceMap := outputResourcesToResourceTypeMap(output.Resources)
+	expected := []rpv1.OutputResource{
+		{
+			LocalID: rpv1.NewLocalID(rpv1.LocalIDPrivateEndpointPrefix, sqlID),
+			CreateResource: &rpv1.Resource{
+				ResourceType: resourcemodel.ResourceType{
+					Type:     resources_azure.ResourceTypeNetworkPrivateEndpoint,
+					Provider: resourcemodel.ProviderAzure,
+				},
+				Data: map[string]string{
+					handlers.PrivateEndpointNameKey:    "test-container-test-sql",
+					handlers.PrivateEndpointTargetKey:  sqlID,
+					handlers.PrivateEndpointSubnetKey:  subnetID,
+					handlers.PrivateEndpointGroupIDKey: "sqlServer",
+					handlers.PrivateEndpointDNSZoneKey: zoneID,
+				},
+			},
+		},
+	}
+	require.Equal(t, expected, resourceMap[resources_azure.ResourceTypeNetworkPrivateEndpoint])
+
+	deployment, _ := kubernetes.FindDeployment(output.Resources)
+	require.NotNil(t, deployment)
+	require.Equal(t, []corev1.EnvVar{
+		{Name: "CONNECTION_SQL_HOSTNAME", Value: "test-sql.privatelink.database.windows.net"},
+	}, deployment.Spec.Template.Spec.Containers[0].Env)
+}
+
+func Test_Render_ConnectionWithPrivateEndpoint_RadiusResource(t *testing.T) {
+	redisID := makeAzureResourceID(t, "Microsoft.Cache/redis", "test-redis")
+	sourceID := makeRadiusResourceID(t, "Applications.Datastores/redisCaches", "redis")
+	subnetID := "/subscriptions/test-subscription/resourceGroups/test-resourcegroup/providers/Microsoft.Network/virtualNetworks/test-vnet/subnets/default"
+	properties := datamodel.ContainerProperties{
+		BasicResourceProperties: rpv1.BasicResourceProperties{
+			Application: applicationResourceID,
+		},
+		Connections: map[string]datamodel.ConnectionProperties{
+			"redis": {
+				Source: sourceID.String(),
+				PrivateEndpoint: &datamodel.ConnectionPrivateEndpoint{
+					Subnet: subnetID,
+				},
+			},
+		},
+		Container: datamodel.Container{
+			Image: "testimage:latest",
+		},
+	}
+	resource := makeResource(properties)
+	dependencies := map[string]renderers.RendererDependency{
+		sourceID.String(): {
+			ResourceID: sourceID,
+			ComputedValues: map[string]any{
+				"port": 6380,
+			},
+			OutputResources: map[string]resources.ID{
+				"RecipeResource0": redisID,
+			},
+		},
+	}
+
+	ctx := testcontext.New(t)
+	renderer := Renderer{}
+	output, err := renderer.Render(ctx, resource, renderers.RenderOptions{Dependencies: dependencies, Environment: renderers.EnvironmentOptions{Namespace: "default"}})
+	require.NoError(t, err)
+
+	resourceMap := outputResourcesToResourceTypeMap(output.Resources)
+	privateEndpoints := resourceMap[resources_azure.ResourceTypeNetworkPrivateEndpoint]
+	require.Len(t, privateEndpoints, 1)
+	require.Equal(t, map[string]string{
+		handlers.PrivateEndpointNameKey:    "test-container-test-redis",
+		handlers.PrivateEndpointTargetKey:  redisID.String(),
+		handlers.PrivateEndpointSubnetKey:  subnetID,
+		handlers.PrivateEndpointGroupIDKey: "redisCache",
+	}, privateEndpoints[0].CreateResource.Data)
+
+	deployment, _ := kubernetes.FindDeployment(output.Resources)
+	require.NotNil(t, deployment)
+	require.Equal(t, []corev1.EnvVar{
+		{Name: "CONNECTION_REDIS_HOSTNAME", Value: "test-redis.privatelink.redis.cache.windows.net"},
+		{
+			Name: "CONNECTION_REDIS_PORT",
+			ValueFrom: &corev1.EnvVarSource{
+				SecretKeyRef: &corev1.SecretKeySelector{
+					LocalObjectReference: corev1.LocalObjectReference{
+						Name: secretName,
+					},
+					Key: "CONNECTION_REDIS_PORT",
+				},
+			},
+		},
+	}, deployment.Spec.Template.Spec.Containers[0].Env)
+}
+
+func Test_Render_ConnectionWithPrivateEndpoint_Fails(t *testing.T) {
+	sqlID := makeAzureResourceID(t, "Microsoft.Sql/servers", "test-sql").String()
+	subnetID := "/subscriptions/test-subscription/resourceGroups/test-resourcegroup/providers/Microsoft.Network/virtualNetworks/test-vnet/subnets/default"
+
+	tests := []struct {
+		name            string
+		source          string
+		privateEndpoint datamodel.ConnectionPrivateEndpoint
+		message         string
+	}{
+		{
+			name:            "unsupported resource type",
+			source:          makeAzureResourceID(t, "SomeProvider/ResourceType", "test-azure-resource").String(),
+			privateEndpoint: datamodel.ConnectionPrivateEndpoint{Subnet: subnetID},
+			message:         "private endpoints are not supported for connections to resources of type \"SomeProvider/ResourceType\"",
+		},
+		{
+			name:            "invalid subnet",
+			source:          sqlID,
+			privateEndpoint: datamodel.ConnectionPrivateEndpoint{Subnet: sqlID},
+			message:         fmt.Sprintf("invalid private endpoint subnet %q: must be the resource ID of a subnet", sqlID),
+		},
+		{
+			name:   "mismatched private DNS zone",
+			source: sqlID,
+			privateEndpoint: datamodel.ConnectionPrivateEndpoint{
+				Subnet:         subnetID,
+				PrivateDNSZone: makeAzureResourceID(t, "Microsoft.Network/privateDnsZones", "privatelink.redis.cache.windows.net").String(),
+			},
+			message: "private DNS zone \"privatelink.redis.cache.windows.net\" must be named \"privatelink.database.windows.net\" to resolve private endpoints of resources of type \"Microsoft.Sql/servers\"",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			properties := datamodel.ContainerProperties{
+				BasicResourceProperties: rpv1.BasicResourceProperties{
+					Application: applicationResourceID,
+				},
+				Connections: map[string]datamodel.ConnectionProperties{
+					"conn": {
+						Source:          tc.source,
+						PrivateEndpoint: &tc.privateEndpoint,
+					},
+				},
+				Container: datamodel.Container{
+					Image: "testimage:latest",
+				},
+			}
+			resource := makeResource(properties)
+
+			ctx := testcontext.New(t)
+			renderer := Renderer{}
+			_, err := renderer.Render(ctx, resource, renderers.RenderOptions{Dependencies: map[string]renderers.RendererDependency{}})
+			require.Error(t, err)
+			require.Equal(t, apiv1.CodeInvalid, err.(*apiv1.ErrClientRP).Code)
+			require.Equal(t, tc.message, err.(*apiv1.ErrClientRP).Message)
+		})
+	}
+}
+
 func Test_Render_EphemeralVolumes(t *testing.T) {
 	const tempVolName = "TempVolume"
 	const tempVolMountPath = "/tmpfs"
@@ -1753,6 +1937,197 @@ func Test_DNS_Service_Generation(t *testing.T) {
 	})
 }
 
+func Test_Service_Options(t *testing.T) {
+	properties := datamodel.ContainerProperties{
+		BasicResourceProperties: rpv1.BasicResourceProperties{
+			Application: applicationResourceID,
+		},
+		Container: datamodel.Container{
+			Image: "someimage:latest",
+			Ports: map[string]datamodel.ContainerPort{
+				"web": {
+					ContainerPort: 3000,
+					Port:          80,
+				},
+			},
+		},
+		Runtimes: &datamodel.RuntimeProperties{
+			Kubernetes: &datamodel.KubernetesRuntime{
+				Service: &datamodel.KubernetesService{
+					Type:                          "LoadBalancer",
+					SessionAffinity:               "ClientIP",
+					SessionAffinityTimeoutSeconds: to.Ptr(int32(3600)),
+					ExternalTrafficPolicy:         "Local",
+					TopologyAwareRouting:          true,
+				},
+			},
+		},
+	}
+
+	resource := makeResource(properties)
+	ctx := testcontext.New(t)
+	renderer := Renderer{}
+	output, err := renderer.Render(ctx, resource, renderOptionsEnvAndAppKubeMetadata())
+	require.NoError(t, err)
+
+	service, _ := kubernetes.FindService(output.Resources)
+	require.NotNil(t, service)
+	require.Equal(t, corev1.ServiceTypeLoadBalancer, service.Spec.Type)
+	require.Equal(t, corev1.ServiceAffinityClientIP, service.Spec.SessionAffinity)
+	require.Equal(t, &corev1.SessionAffinityConfig{
+		ClientIP: &corev1.ClientIPConfig{TimeoutSeconds: to.Ptr(int32(3600))},
+	}, service.Spec.SessionAffinityConfig)
+	require.Equal(t, corev1.ServiceExternalTrafficPolicyLocal, service.Spec.ExternalTrafficPolicy)
+	require.Equal(t, "Auto", service.Annotations["service.kubernetes.io/topology-mode"])
+}
+
+func Test_Service_Options_Fails(t *testing.T) {
+	tests := []struct {
+		name    string
+		service datamodel.KubernetesService
+		message string
+	}{
+		{
+			name:    "invalid type",
+			service: datamodel.KubernetesService{Type: "NodePort"},
+			message: "invalid service type \"NodePort\": must be ClusterIP or LoadBalancer",
+		},
+		{
+			name:    "timeout without ClientIP session affinity",
+			service: datamodel.KubernetesService{SessionAffinityTimeoutSeconds: to.Ptr(int32(60))},
+			message: "sessionAffinityTimeoutSeconds requires the ClientIP session affinity",
+		},
+		{
+			name:    "timeout out of range",
+			service: datamodel.KubernetesService{SessionAffinity: "ClientIP", SessionAffinityTimeoutSeconds: to.Ptr(int32(86401))},
+			message: "invalid sessionAffinityTimeoutSeconds 86401: must be between 1 and 86400",
+		},
+		{
+			name:    "external traffic policy on ClusterIP service",
+			service: datamodel.KubernetesService{ExternalTrafficPolicy: "Local"},
+			message: "externalTrafficPolicy requires the LoadBalancer service type",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			properties := datamodel.ContainerProperties{
+				BasicResourceProperties: rpv1.BasicResourceProperties{
+					Application: applicationResourceID,
+				},
+				Container: datamodel.Container{
+					Image: "someimage:latest",
+					Ports: map[string]datamodel.ContainerPort{
+						"web": {
+							ContainerPort: 3000,
+						},
+					},
+				},
+				Runtimes: &datamodel.RuntimeProperties{
+					Kubernetes: &datamodel.KubernetesRuntime{
+						Service: &tc.service,
+					},
+				},
+			}
+
+			resource := makeResource(properties)
+			ctx := testcontext.New(t)
+			renderer := Renderer{}
+			_, err := renderer.Render(ctx, resource, renderOptionsEnvAndAppKubeMetadata())
+			require.Error(t, err)
+			require.Equal(t, apiv1.CodeInvalid, err.(*apiv1.ErrClientRP).Code)
+			require.Equal(t, tc.message, err.(*apiv1.ErrClientRP).Message)
+		})
+	}
+}
+
+func Test_Headless_Service_Generation(t *testing.T) {
+	properties := datamodel.ContainerProperties{
+		BasicResourceProperties: rpv1.BasicResourceProperties{
+			Application: applicationResourceID,
+		},
+		Container: datamodel.Container{
+			Image: "someimage:latest",
+			Ports: map[string]datamodel.ContainerPort{
+				"web": {
+					ContainerPort: 3000,
+					Port:          80,
+				},
+			},
+		},
+		Runtimes: &datamodel.RuntimeProperties{
+			Kubernetes: &datamodel.KubernetesRuntime{
+				HeadlessService: true,
+			},
+		},
+	}
+
+	t.Run("verify headless service generation", func(t *testing.T) {
+		resource := makeResource(properties)
+		ctx := testcontext.New(t)
+		renderer := Renderer{}
+		output, err := renderer.Render(ctx, resource, renderers.RenderOptions{Environment: renderers.EnvironmentOptions{Namespace: "default"}})
+		require.NoError(t, err)
+
+		var headless rpv1.OutputResource
+		for _, r := range output.Resources {
+			if r.LocalID == rpv1.LocalIDHeadlessService {
+				headless = r
+			}
+		}
+		require.NotNil(t, headless.CreateResource)
+
+		service, ok := headless.CreateResource.Data.(*corev1.Service)
+		require.True(t, ok)
+		require.Equal(t, "test-container-headless", service.Name)
+		require.Equal(t, "default", service.Namespace)
+		require.Equal(t, corev1.ClusterIPNone, service.Spec.ClusterIP)
+		require.Equal(t, kubernetes.MakeSelectorLabels(applicationName, resource.Name), service.Spec.Selector)
+		require.Equal(t, []corev1.ServicePort{
+			{
+				Name:       "web",
+				Port:       80,
+				TargetPort: intstr.FromInt(3000),
+				Protocol:   corev1.ProtocolTCP,
+			},
+		}, service.Spec.Ports)
+
+		require.Equal(t, map[string]rpv1.ComputedValueReference{
+			HeadlessHostnameKey: {
+				Value: "test-container-headless.default.svc",
+			},
+			SRVRecordKeyPrefix + "web": {
+				Value: "_web._tcp.test-container-headless.default.svc",
+			},
+		}, output.ComputedValues)
+	})
+
+	t.Run("verify no headless service by default", func(t *testing.T) {
+		resource := makeResource(properties)
+		resource.Properties.Runtimes = nil
+		ctx := testcontext.New(t)
+		renderer := Renderer{}
+		output, err := renderer.Render(ctx, resource, renderers.RenderOptions{Environment: renderers.EnvironmentOptions{Namespace: "default"}})
+		require.NoError(t, err)
+
+		for _, r := range output.Resources {
+			require.NotEqual(t, rpv1.LocalIDHeadlessService, r.LocalID)
+		}
+		require.Empty(t, output.ComputedValues)
+	})
+
+	t.Run("verify container name too long", func(t *testing.T) {
+		resource := makeResource(properties)
+		resource.Name = strings.Repeat("a", 60)
+		ctx := testcontext.New(t)
+		renderer := Renderer{}
+		_, err := renderer.Render(ctx, resource, renderers.RenderOptions{Environment: renderers.EnvironmentOptions{Namespace: "default"}})
+		require.Error(t, err)
+		require.Equal(t, apiv1.CodeInvalid, err.(*apiv1.ErrClientRP).Code)
+		require.Equal(t, fmt.Sprintf("container name %q is too long to generate a headless service", resource.Name), err.(*apiv1.ErrClientRP).Message)
+	})
+}
+
 func Test_Render_ImagePullPolicySpecified(t *testing.T) {
 	properties := datamodel.ContainerProperties{
 		BasicResourceProperties: rpv1.BasicResourceProperties{
@@ -21,9 +21,14 @@ import (
 	"errors"
 	"fmt"
 	"net"
+	"net/http"
 	"net/url"
+	"reflect"
+	"regexp"
+	"slices"
 	"strconv"
 	"strings"
+	"time"
 
 	contourv1 "github.com/projectcontour/contour/apis/projectcontour/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -33,6 +38,7 @@ import (
 	"github.com/radius-project/radius/pkg/corerp/renderers"
 	"github.com/radius-project/radius/pkg/kubernetes"
 	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+	"github.com/radius-project/radius/pkg/to"
 	"github.com/radius-project/radius/pkg/ucp/resources"
 	resources_kubernetes "github.com/radius-project/radius/pkg/ucp/resources/kubernetes"
 )
@@ -40,11 +46,20 @@ import (
 const secretStoreNotFound = "secretStore resource %s not found"
 const invalidSecretStoreResource = "certificateFrom must reference a secretStore resource"
 
+// externalDNSHostnameAnnotation asks external-dns to manage the DNS record of a hostname.
+const externalDNSHostnameAnnotation = "external-dns.alpha.kubernetes.io/hostname"
+
+// dnsZoneResourceType is the type of the Azure DNS zones, which are named after their domain.
+const dnsZoneResourceType = "Microsoft.Network/dnsZones"
+
+// rateLimitUnits are the units of time supported by the rate limits of routes.
+var rateLimitUnits = []string{"second", "minute", "hour"}
+
 type Renderer struct {
 }
 
-// GetDependencyIDs parses the gateway data model to get the secretStore resource ID
-// from the certificateFrom property, and returns them as two slices of resource IDs.
+// GetDependencyIDs parses the gateway data model to get the secretStore resource IDs
+// from the certificateFrom properties, and returns them as two slices of resource IDs.
 func (r Renderer) GetDependencyIDs(ctx context.Context, dm v1.DataModelInterface) (radiusResourceIDs []resources.ID, azureResourceIDs []resources.ID, err error) {
 	gateway, ok := dm.(*datamodel.Gateway)
 	if !ok {
@@ -62,6 +77,22 @@ func (r Renderer) GetDependencyIDs(ctx context.Context, dm v1.DataModelInterface
 		radiusResourceIDs = append(radiusResourceIDs, resourceID)
 	}
 
+	// Get secretStore resource IDs from the certificateFrom property of the additional hosts
+	if gtwyProperties.TLS != nil {
+		for _, host := range gtwyProperties.TLS.Hosts {
+			if host.CertificateFrom == "" {
+				continue
+			}
+
+			resourceID, err := resources.ParseResource(host.CertificateFrom)
+			if err != nil {
+				return nil, nil, v1.NewClientErrInvalidRequest(err.Error())
+			}
+
+			radiusResourceIDs = append(radiusResourceIDs, resourceID)
+		}
+	}
+
 	return radiusResourceIDs, azureResourceIDs, nil
 }
 
@@ -87,7 +118,7 @@ func (r Renderer) Render(ctx context.Context, dm v1.DataModelInterface, options
 	} else if err != nil {
 		return renderers.RendererOutput{}, fmt.Errorf("getting hostname failed with error: %s", err)
 	} else {
-		isHttps := gateway.Properties.TLS != nil && (gateway.Properties.TLS.SSLPassthrough || gateway.Properties.TLS.CertificateFrom != "")
+		isHttps := gateway.Properties.TLS != nil && (gateway.Properties.TLS.SSLPassthrough || gateway.Properties.TLS.CertificateFrom != "" || len(gateway.Properties.TLS.Hosts) > 0)
 		publicEndpoint = getPublicEndpoint(hostname, options.Environment.Gateway.Port, isHttps)
 	}
 
@@ -110,6 +141,12 @@ func (r Renderer) Render(ctx context.Context, dm v1.DataModelInterface, options
 	}
 	outputResources = append(outputResources, httpProxyObjects...)
 
+	hostHTTPProxyObjects, err := MakeHostHTTPProxies(ctx, options, gateway, gatewayObject, applicationName)
+	if err != nil {
+		return renderers.RendererOutput{}, err
+	}
+	outputResources = append(outputResources, hostHTTPProxyObjects...)
+
 	return renderers.RendererOutput{
 		Resources:      outputResources,
 		ComputedValues: computedValues,
@@ -120,6 +157,7 @@ func (r Renderer) Render(ctx context.Context, dm v1.DataModelInterface, options
 // to act as the Gateway.
 func MakeRootHTTPProxy(ctx context.Context, options renderers.RenderOptions, gateway *datamodel.Gateway, resourceName string, applicationName string, hostname string) (rpv1.OutputResource, error) {
 	includes := []contourv1.Include{}
+	var redirects []contourv1.Route
 	dependencies := options.Dependencies
 
 	if len(gateway.Properties.Routes) < 1 {
@@ -134,67 +172,66 @@ func MakeRootHTTPProxy(ctx context.Context, options renderers.RenderOptions, gat
 		sslPassthrough = gateway.Properties.TLS.SSLPassthrough
 
 		if gateway.Properties.TLS.CertificateFrom != "" {
-			secretStoreResourceId := gateway.Properties.TLS.CertificateFrom
-			secretStoreResource, ok := dependencies[secretStoreResourceId]
-			if !ok {
-				return rpv1.OutputResource{}, v1.NewClientErrInvalidRequest(fmt.Sprintf(secretStoreNotFound, secretStoreResourceId))
+			secretName, err := getTLSSecretName(dependencies, gateway.Properties.TLS.CertificateFrom)
+			if err != nil {
+				return rpv1.OutputResource{}, err
 			}
 
-			referencedResource := dependencies[secretStoreResourceId].Resource
-			if !strings.EqualFold(referencedResource.ResourceTypeName(), datamodel.SecretStoreResourceType) {
-				return rpv1.OutputResource{}, v1.NewClientErrInvalidRequest(invalidSecretStoreResource)
+			contourTLSConfig = &contourv1.TLS{
+				SecretName:             secretName,
+				MinimumProtocolVersion: string(gateway.Properties.TLS.MinimumProtocolVersion),
 			}
+		}
+	}
 
-			// Validate the secretStore resource: it must be of type certificate and have tls.crt and tls.key
-			secretStore, ok := referencedResource.(*datamodel.SecretStore)
-			if !ok {
-				return rpv1.OutputResource{}, v1.NewClientErrInvalidRequest(invalidSecretStoreResource)
-			}
+	// Additional hosts terminate TLS at the gateway, which is not possible with SSL Passthrough
+	if sslPassthrough && len(gateway.Properties.TLS.Hosts) > 0 {
+		return rpv1.OutputResource{}, v1.NewClientErrInvalidRequest("cannot support `hosts` with sslPassthrough set to true")
+	}
 
-			if secretStore.Properties.Type != datamodel.SecretTypeCert {
-				return rpv1.OutputResource{}, v1.NewClientErrInvalidRequest(invalidSecretStoreResource + " with type certificate")
-			}
+	// If SSL Passthrough is enabled, then we can only have one route
+	if sslPassthrough && len(gateway.Properties.Routes) > 1 {
+		return rpv1.OutputResource{}, v1.NewClientErrInvalidRequest("cannot support multiple routes with sslPassthrough set to true")
+	}
 
-			if secretStore.Properties.Data["tls.crt"] == nil {
-				return rpv1.OutputResource{}, v1.NewClientErrInvalidRequest(invalidSecretStoreResource + " with tls.crt")
-			}
+	var tcpRoute *datamodel.GatewayRoute //tcpRoute will hold the one tcp route, if the gateway terminates TLS for it
+	var route datamodel.GatewayRoute     //route will hold the one sslPassthrough route, if sslPassthrough is true
+	for _, route = range gateway.Properties.Routes {
+		if err := validateRoute(&route, sslPassthrough); err != nil {
+			return rpv1.OutputResource{}, err
+		}
 
-			if secretStore.Properties.Data["tls.key"] == nil {
-				return rpv1.OutputResource{}, v1.NewClientErrInvalidRequest(invalidSecretStoreResource + " with tls.key")
+		// Contour only proxies TCP connections on TLS virtual hosts, where the connection takes the whole host
+		if route.Kind == datamodel.GatewayRouteKindTCP {
+			if len(gateway.Properties.Routes) > 1 {
+				return rpv1.OutputResource{}, v1.NewClientErrInvalidRequest("cannot support multiple routes with a tcp route")
 			}
 
-			// Get the name and namespace of the Kubernetes secret resource from the secretStore OutputResources
-			if secretStoreResource.OutputResources == nil {
-				return rpv1.OutputResource{}, v1.NewClientErrInvalidRequest(fmt.Sprintf(secretStoreNotFound, secretStoreResourceId))
+			if contourTLSConfig == nil && !sslPassthrough {
+				return rpv1.OutputResource{}, v1.NewClientErrInvalidRequest("must set `certificateFrom` or `sslPassthrough` in `tls` to support tcp routes")
 			}
 
-			secretResourceID, ok := secretStoreResource.OutputResources[rpv1.LocalIDSecret]
-			if !ok {
-				return rpv1.OutputResource{}, v1.NewClientErrInvalidRequest(fmt.Sprintf(secretStoreNotFound, secretStoreResourceId))
+			if !sslPassthrough {
+				tcpRoute = &route
+				continue
 			}
+		}
 
-			secretName := secretResourceID.Name()
-			secretNamespace := secretResourceID.FindScope(resources_kubernetes.ScopeNamespaces)
-			if secretNamespace == "" {
-				return rpv1.OutputResource{}, v1.NewClientErrInvalidRequest(fmt.Sprintf(secretStoreNotFound, secretStoreResourceId))
-			}
+		prefix := route.Path
 
-			contourTLSConfig = &contourv1.TLS{
-				SecretName:             fmt.Sprintf("%s/%s", secretNamespace, secretName),
-				MinimumProtocolVersion: string(gateway.Properties.TLS.MinimumProtocolVersion),
-			}
+		if sslPassthrough {
+			prefix = "/"
 		}
-	}
 
-	// If SSL Passthrough is enabled, then we can only have one route
-	if sslPassthrough && len(gateway.Properties.Routes) > 1 {
-		return rpv1.OutputResource{}, v1.NewClientErrInvalidRequest("cannot support multiple routes with sslPassthrough set to true")
-	}
+		conditions := getRouteConditions(&route, prefix)
 
-	var route datamodel.GatewayRoute //route will hold the one sslPassthrough route, if sslPassthrough is true
-	for _, route = range gateway.Properties.Routes {
-		if sslPassthrough && (route.Path != "" || route.ReplacePrefix != "") {
-			return rpv1.OutputResource{}, v1.NewClientErrInvalidRequest("cannot support `path` or `replacePrefix` in routes with sslPassthrough set to true")
+		// Redirects are answered by the gateway, so they are served by the root HTTPProxy instead of being included
+		if route.Redirect != nil {
+			redirects = append(redirects, contourv1.Route{
+				Conditions:            conditions,
+				RequestRedirectPolicy: getRedirectPolicy(route.Redirect),
+			})
+			continue
 		}
 
 		routeName, err := getRouteName(&route)
@@ -203,19 +240,10 @@ func MakeRootHTTPProxy(ctx context.Context, options renderers.RenderOptions, gat
 		}
 
 		routeResourceName := kubernetes.NormalizeResourceName(routeName)
-		prefix := route.Path
-
-		if sslPassthrough {
-			prefix = "/"
-		}
 
 		includes = append(includes, contourv1.Include{
-			Name: routeResourceName,
-			Conditions: []contourv1.MatchCondition{
-				{
-					Prefix: prefix,
-				},
-			},
+			Name:       routeResourceName,
+			Conditions: conditions,
 		})
 	}
 
@@ -257,6 +285,21 @@ func MakeRootHTTPProxy(ctx context.Context, options renderers.RenderOptions, gat
 				},
 			},
 		}
+	} else if tcpRoute != nil {
+		// The gateway terminates TLS and forwards the decrypted TCP connection to the services
+		services, err := getRouteServices(tcpRoute, dependencies)
+		if err != nil {
+			return rpv1.OutputResource{}, err
+		}
+
+		tcpProxy = &contourv1.TCPProxy{
+			Services: services,
+		}
+	}
+
+	annotations, err := getDNSAnnotations(gateway, renderers.GetAnnotations(options))
+	if err != nil {
+		return rpv1.OutputResource{}, err
 	}
 
 	// The root HTTPProxy object acts as the Gateway
@@ -269,21 +312,99 @@ func MakeRootHTTPProxy(ctx context.Context, options renderers.RenderOptions, gat
 			Name:        kubernetes.NormalizeResourceName(resourceName),
 			Namespace:   options.Environment.Namespace,
 			Labels:      renderers.GetLabels(options, applicationName, resourceName, gateway.ResourceTypeName()),
-			Annotations: renderers.GetAnnotations(options),
+			Annotations: annotations,
 		},
 		Spec: contourv1.HTTPProxySpec{
 			VirtualHost: virtualHost,
 			Includes:    includes,
+			Routes:      redirects,
 		},
 	}
 
-	if sslPassthrough {
+	if tcpProxy != nil {
 		rootHTTPProxy.Spec.TCPProxy = tcpProxy
 	}
 
 	return rpv1.NewKubernetesOutputResource(rpv1.LocalIDGateway, rootHTTPProxy, rootHTTPProxy.ObjectMeta), nil
 }
 
+// MakeHostHTTPProxies creates a root Contour HTTPProxy resource for each additional host of the gateway. Each of them
+// serves the same routes as the gateway with the TLS certificate of its host.
+func MakeHostHTTPProxies(ctx context.Context, options renderers.RenderOptions, gateway *datamodel.Gateway, gatewayOutPutResource rpv1.OutputResource, applicationName string) ([]rpv1.OutputResource, error) {
+	if gateway.Properties.TLS == nil || len(gateway.Properties.TLS.Hosts) == 0 {
+		return nil, nil
+	}
+
+	rootHTTPProxy, ok := gatewayOutPutResource.CreateResource.Data.(*contourv1.HTTPProxy)
+	if !ok {
+		return nil, errors.New("gateway output resource must be a Contour HTTPProxy")
+	}
+
+	outputResources := []rpv1.OutputResource{}
+	hostnames := map[string]bool{}
+	for _, host := range gateway.Properties.TLS.Hosts {
+		if host.Hostname == "" {
+			return nil, v1.NewClientErrInvalidRequest("must specify `hostname` for each of the `hosts`")
+		}
+
+		hostname := strings.ToLower(host.Hostname)
+		if hostnames[hostname] || hostname == rootHTTPProxy.Spec.VirtualHost.Fqdn {
+			return nil, v1.NewClientErrInvalidRequest(fmt.Sprintf("hostname %s is declared more than once", host.Hostname))
+		}
+		hostnames[hostname] = true
+
+		if host.CertificateFrom == "" {
+			return nil, v1.NewClientErrInvalidRequest(fmt.Sprintf("must specify `certificateFrom` for host %s", host.Hostname))
+		}
+
+		secretName, err := getTLSSecretName(options.Dependencies, host.CertificateFrom)
+		if err != nil {
+			return nil, err
+		}
+
+		// Kubernetes object names cannot contain dots
+		resourceName := fmt.Sprintf("%s-%s", strings.ToLower(gateway.Name), strings.ReplaceAll(hostname, ".", "-"))
+		if !kubernetes.IsValidObjectName(resourceName) {
+			return nil, v1.NewClientErrInvalidRequest(fmt.Sprintf("hostname %s is not valid or too long to name the HTTPProxy of the host", host.Hostname))
+		}
+
+		hostHTTPProxy := &contourv1.HTTPProxy{
+			TypeMeta: metav1.TypeMeta{
+				Kind:       "HTTPProxy",
+				APIVersion: contourv1.SchemeGroupVersion.String(),
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        kubernetes.NormalizeResourceName(resourceName),
+				Namespace:   options.Environment.Namespace,
+				Labels:      renderers.GetLabels(options, applicationName, gateway.Name, gateway.ResourceTypeName()),
+				Annotations: renderers.GetAnnotations(options),
+			},
+			Spec: contourv1.HTTPProxySpec{
+				VirtualHost: &contourv1.VirtualHost{
+					Fqdn: hostname,
+					TLS: &contourv1.TLS{
+						SecretName:             secretName,
+						MinimumProtocolVersion: string(gateway.Properties.TLS.MinimumProtocolVersion),
+					},
+				},
+				Includes: rootHTTPProxy.Spec.Includes,
+				Routes:   rootHTTPProxy.Spec.Routes,
+				TCPProxy: rootHTTPProxy.Spec.TCPProxy,
+			},
+		}
+
+		// Create unique localID for dependency graph
+		localID := fmt.Sprintf("%s-%s", rpv1.LocalIDGateway, hostname)
+		outputResource := rpv1.NewKubernetesOutputResource(localID, hostHTTPProxy, hostHTTPProxy.ObjectMeta)
+
+		// The routes must be created before the root http proxy of the host, like for the gateway
+		outputResource.CreateResource.Dependencies = append([]string{}, gatewayOutPutResource.CreateResource.Dependencies...)
+		outputResources = append(outputResources, outputResource)
+	}
+
+	return outputResources, nil
+}
+
 // MakeRoutesHTTPProxies creates HTTPProxy objects for each route in the gateway and returns them as OutputResources. It returns
 // an error if it fails to get the route name.
 func MakeRoutesHTTPProxies(ctx context.Context, options renderers.RenderOptions, resource datamodel.Gateway, gateway *datamodel.GatewayProperties, gatewayName string, gatewayOutPutResource rpv1.OutputResource, applicationName string) ([]rpv1.OutputResource, error) {
@@ -291,20 +412,19 @@ func MakeRoutesHTTPProxies(ctx context.Context, options renderers.RenderOptions,
 	objects := make(map[string]*contourv1.HTTPProxy)
 
 	for _, route := range gateway.Routes {
-		port := renderers.DefaultPort
+		// Redirects are served by the root HTTPProxy
+		if route.Redirect != nil {
+			continue
+		}
 
-		if isURL(route.Destination) {
-			_, _, urlPort, err := parseURL(route.Destination)
-			if err != nil {
-				return []rpv1.OutputResource{}, err
-			}
-			port = urlPort
-		} else {
-			routeProperties := dependencies[route.Destination]
-			routePort, ok := routeProperties.ComputedValues["port"].(float64)
-			if ok {
-				port = int32(routePort)
-			}
+		// So are tcp routes, unless the TLS connection is passed through
+		if route.Kind == datamodel.GatewayRouteKindTCP && (gateway.TLS == nil || !gateway.TLS.SSLPassthrough) {
+			continue
+		}
+
+		services, err := getRouteServices(&route, dependencies)
+		if err != nil {
+			return []rpv1.OutputResource{}, err
 		}
 
 		routeName, err := getRouteName(&route)
@@ -314,7 +434,6 @@ func MakeRoutesHTTPProxies(ctx context.Context, options renderers.RenderOptions,
 
 		// Create unique localID for dependency graph
 		localID := fmt.Sprintf("%s-%s", rpv1.LocalIDHttpProxy, routeName)
-		routeResourceName := kubernetes.NormalizeResourceName(routeName)
 
 		var pathRewritePolicy *contourv1.PathRewritePolicy
 		if route.ReplacePrefix != "" {
@@ -328,13 +447,36 @@ func MakeRoutesHTTPProxies(ctx context.Context, options renderers.RenderOptions,
 			}
 		}
 
+		var requestHeadersPolicy *contourv1.HeadersPolicy
+		if route.ReplaceHostname != "" {
+			requestHeadersPolicy = &contourv1.HeadersPolicy{
+				Set: []contourv1.HeaderValue{
+					{
+						Name:  "Host",
+						Value: route.ReplaceHostname,
+					},
+				},
+			}
+		}
+
+		rateLimitPolicy, timeoutPolicy, retryPolicy := getRoutePolicies(&route)
+
 		// If this route already exists, append to it
 		if object, exists := objects[localID]; exists {
+			// The routes to the same destination share the same HTTPProxy, and so the same Host header and policies
+			existing := object.Spec.Routes[0]
+			if !reflect.DeepEqual(existing.RequestHeadersPolicy, requestHeadersPolicy) ||
+				!reflect.DeepEqual(existing.RateLimitPolicy, rateLimitPolicy) ||
+				!reflect.DeepEqual(existing.TimeoutPolicy, timeoutPolicy) ||
+				!reflect.DeepEqual(existing.RetryPolicy, retryPolicy) {
+				return []rpv1.OutputResource{}, v1.NewClientErrInvalidRequest(fmt.Sprintf("routes to %s must use the same `replaceHostname`, `rateLimit`, `timeouts` and `retryPolicy`", routeName))
+			}
+
 			if pathRewritePolicy != nil {
 			outer:
 				for i := range object.Spec.Routes {
 					for _, service := range object.Spec.Routes[i].Services {
-						if service.Name == routeResourceName {
+						if service.Name == services[0].Name {
 							if object.Spec.Routes[i].PathRewritePolicy == nil {
 								object.Spec.Routes[i].PathRewritePolicy = pathRewritePolicy
 							} else {
@@ -354,7 +496,7 @@ func MakeRoutesHTTPProxies(ctx context.Context, options renderers.RenderOptions,
 				APIVersion: contourv1.SchemeGroupVersion.String(),
 			},
 			ObjectMeta: metav1.ObjectMeta{
-				Name:        routeResourceName,
+				Name:        kubernetes.NormalizeResourceName(routeName),
 				Namespace:   options.Environment.Namespace,
 				Labels:      renderers.GetLabels(options, applicationName, routeName, resource.ResourceTypeName()),
 				Annotations: renderers.GetAnnotations(options),
@@ -362,14 +504,13 @@ func MakeRoutesHTTPProxies(ctx context.Context, options renderers.RenderOptions,
 			Spec: contourv1.HTTPProxySpec{
 				Routes: []contourv1.Route{
 					{
-						Services: []contourv1.Service{
-							{
-								Name: routeResourceName,
-								Port: int(port),
-							},
-						},
-						PathRewritePolicy: pathRewritePolicy,
-						EnableWebsockets:  route.EnableWebsockets,
+						Services:             services,
+						PathRewritePolicy:    pathRewritePolicy,
+						RequestHeadersPolicy: requestHeadersPolicy,
+						RateLimitPolicy:      rateLimitPolicy,
+						TimeoutPolicy:        timeoutPolicy,
+						RetryPolicy:          retryPolicy,
+						EnableWebsockets:     route.EnableWebsockets || route.Kind == datamodel.GatewayRouteKindWebsocket,
 					},
 				},
 			},
@@ -389,8 +530,367 @@ func MakeRoutesHTTPProxies(ctx context.Context, options renderers.RenderOptions,
 	return outputResources, nil
 }
 
+// getDNSAnnotations adds the external-dns annotations to the given annotations when the hostname of the gateway
+// declares the DNS zone hosting it, so that external-dns manages its DNS record.
+func getDNSAnnotations(gateway *datamodel.Gateway, annotations map[string]string) (map[string]string, error) {
+	hostname := gateway.Properties.Hostname
+	if hostname == nil || hostname.DNSZone == "" {
+		return annotations, nil
+	}
+
+	if hostname.FullyQualifiedHostname == "" {
+		return nil, v1.NewClientErrInvalidRequest("must specify `fullyQualifiedHostname` to use `dnsZone`")
+	}
+
+	zone := hostname.DNSZone
+	if strings.HasPrefix(zone, "/") {
+		id, err := resources.ParseResource(zone)
+		if err != nil || !strings.EqualFold(id.Type(), dnsZoneResourceType) {
+			return nil, v1.NewClientErrInvalidRequest(fmt.Sprintf("dnsZone %s must be a domain name or the resource id of an Azure DNS zone", zone))
+		}
+		zone = id.Name()
+	}
+
+	zone = strings.TrimSuffix(strings.ToLower(zone), ".")
+	fqdn := strings.TrimSuffix(strings.ToLower(hostname.FullyQualifiedHostname), ".")
+	if fqdn != zone && !strings.HasSuffix(fqdn, "."+zone) {
+		return nil, v1.NewClientErrInvalidRequest(fmt.Sprintf("hostname %s is not in DNS zone %s", hostname.FullyQualifiedHostname, zone))
+	}
+
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	annotations[externalDNSHostnameAnnotation] = fqdn
+	annotations[kubernetes.AnnotationDNSZone] = zone
+
+	return annotations, nil
+}
+
+// getTLSSecretName validates the secretStore resource referenced by certificateFrom and returns the
+// namespaced name of the Kubernetes secret holding its certificate, in the format <namespace>/<name>.
+func getTLSSecretName(dependencies map[string]renderers.RendererDependency, secretStoreID string) (string, error) {
+	secretStoreResource, ok := dependencies[secretStoreID]
+	if !ok {
+		return "", v1.NewClientErrInvalidRequest(fmt.Sprintf(secretStoreNotFound, secretStoreID))
+	}
+
+	referencedResource := dependencies[secretStoreID].Resource
+	if !strings.EqualFold(referencedResource.ResourceTypeName(), datamodel.SecretStoreResourceType) {
+		return "", v1.NewClientErrInvalidRequest(invalidSecretStoreResource)
+	}
+
+	// Validate the secretStore resource: it must be of type certificate and have tls.crt and tls.key
+	secretStore, ok := referencedResource.(*datamodel.SecretStore)
+	if !ok {
+		return "", v1.NewClientErrInvalidRequest(invalidSecretStoreResource)
+	}
+
+	if secretStore.Properties.Type != datamodel.SecretTypeCert {
+		return "", v1.NewClientErrInvalidRequest(invalidSecretStoreResource + " with type certificate")
+	}
+
+	if secretStore.Properties.Data["tls.crt"] == nil {
+		return "", v1.NewClientErrInvalidRequest(invalidSecretStoreResource + " with tls.crt")
+	}
+
+	if secretStore.Properties.Data["tls.key"] == nil {
+		return "", v1.NewClientErrInvalidRequest(invalidSecretStoreResource + " with tls.key")
+	}
+
+	// Get the name and namespace of the Kubernetes secret resource from the secretStore OutputResources
+	if secretStoreResource.OutputResources == nil {
+		return "", v1.NewClientErrInvalidRequest(fmt.Sprintf(secretStoreNotFound, secretStoreID))
+	}
+
+	secretResourceID, ok := secretStoreResource.OutputResources[rpv1.LocalIDSecret]
+	if !ok {
+		return "", v1.NewClientErrInvalidRequest(fmt.Sprintf(secretStoreNotFound, secretStoreID))
+	}
+
+	secretName := secretResourceID.Name()
+	secretNamespace := secretResourceID.FindScope(resources_kubernetes.ScopeNamespaces)
+	if secretNamespace == "" {
+		return "", v1.NewClientErrInvalidRequest(fmt.Sprintf(secretStoreNotFound, secretStoreID))
+	}
+
+	return fmt.Sprintf("%s/%s", secretNamespace, secretName), nil
+}
+
+// validateRoute validates the match conditions, destinations and filters of the route.
+func validateRoute(route *datamodel.GatewayRoute, sslPassthrough bool) error {
+	switch route.Kind {
+	case "", datamodel.GatewayRouteKindHTTP, datamodel.GatewayRouteKindWebsocket:
+	case datamodel.GatewayRouteKindTCP:
+		if route.Path != "" || route.ReplacePrefix != "" || len(route.Headers) > 0 || len(route.Methods) > 0 || route.ReplaceHostname != "" || route.Redirect != nil {
+			return v1.NewClientErrInvalidRequest("cannot support `path`, `replacePrefix`, `headers`, `methods`, `replaceHostname` or `redirect` in tcp routes")
+		}
+
+		if route.RateLimit != nil || route.Timeouts != nil || route.RetryPolicy != nil || route.EnableWebsockets {
+			return v1.NewClientErrInvalidRequest("cannot support `rateLimit`, `timeouts`, `retryPolicy` or `enableWebsockets` in tcp routes")
+		}
+	default:
+		return v1.NewClientErrInvalidRequest(fmt.Sprintf("kind %q of the route must be one of http, websocket, tcp", route.Kind))
+	}
+
+	if sslPassthrough && (route.Path != "" || route.ReplacePrefix != "") {
+		return v1.NewClientErrInvalidRequest("cannot support `path` or `replacePrefix` in routes with sslPassthrough set to true")
+	}
+
+	if sslPassthrough && (len(route.Headers) > 0 || len(route.Methods) > 0 || route.ReplaceHostname != "" || route.Redirect != nil) {
+		return v1.NewClientErrInvalidRequest("cannot support `headers`, `methods`, `replaceHostname` or `redirect` in routes with sslPassthrough set to true")
+	}
+
+	if len(route.Destinations) > 0 {
+		if sslPassthrough {
+			return v1.NewClientErrInvalidRequest("cannot support `destinations` in routes with sslPassthrough set to true")
+		}
+
+		if route.Destination != "" {
+			return v1.NewClientErrInvalidRequest("cannot support both `destination` and `destinations` in a route")
+		}
+
+		for _, destination := range route.Destinations {
+			if destination.Weight < 0 {
+				return v1.NewClientErrInvalidRequest(fmt.Sprintf("weight of destination %s must not be negative", destination.Destination))
+			}
+		}
+	}
+
+	for _, header := range route.Headers {
+		if header.Name == "" {
+			return v1.NewClientErrInvalidRequest("must specify `name` for each of the `headers` of a route")
+		}
+	}
+
+	for _, method := range route.Methods {
+		if method == "" {
+			return v1.NewClientErrInvalidRequest("cannot support empty `methods` in a route")
+		}
+	}
+
+	if sslPassthrough && (route.RateLimit != nil || route.Timeouts != nil || route.RetryPolicy != nil) {
+		return v1.NewClientErrInvalidRequest("cannot support `rateLimit`, `timeouts` or `retryPolicy` in routes with sslPassthrough set to true")
+	}
+
+	if route.RateLimit != nil {
+		if route.RateLimit.Requests <= 0 {
+			return v1.NewClientErrInvalidRequest("`requests` of the rate limit must be greater than 0")
+		}
+
+		if !slices.Contains(rateLimitUnits, route.RateLimit.Unit) {
+			return v1.NewClientErrInvalidRequest(fmt.Sprintf("unit %q of the rate limit must be one of %s", route.RateLimit.Unit, strings.Join(rateLimitUnits, ", ")))
+		}
+
+		if route.RateLimit.Burst < 0 {
+			return v1.NewClientErrInvalidRequest("`burst` of the rate limit must not be negative")
+		}
+	}
+
+	if route.Timeouts != nil {
+		if err := validateDuration("response", route.Timeouts.Response); err != nil {
+			return err
+		}
+
+		if err := validateDuration("idle", route.Timeouts.Idle); err != nil {
+			return err
+		}
+	}
+
+	if route.RetryPolicy != nil {
+		if route.RetryPolicy.Attempts <= 0 {
+			return v1.NewClientErrInvalidRequest("`attempts` of the retry policy must be greater than 0")
+		}
+
+		if err := validateDuration("perTryTimeout", route.RetryPolicy.PerTryTimeout); err != nil {
+			return err
+		}
+	}
+
+	if route.Redirect != nil {
+		if route.Destination != "" || len(route.Destinations) > 0 || route.ReplacePrefix != "" || route.ReplaceHostname != "" {
+			return v1.NewClientErrInvalidRequest("cannot support `destination`, `destinations`, `replacePrefix` or `replaceHostname` in routes with a `redirect`")
+		}
+
+		if route.RateLimit != nil || route.Timeouts != nil || route.RetryPolicy != nil {
+			return v1.NewClientErrInvalidRequest("cannot support `rateLimit`, `timeouts` or `retryPolicy` in routes with a `redirect`")
+		}
+
+		if route.Redirect.StatusCode != 0 && route.Redirect.StatusCode != http.StatusMovedPermanently && route.Redirect.StatusCode != http.StatusFound {
+			return v1.NewClientErrInvalidRequest(fmt.Sprintf("status code %d of the redirect must be 301 or 302", route.Redirect.StatusCode))
+		}
+	}
+
+	return nil
+}
+
+func validateDuration(name string, duration string) error {
+	if duration == "" {
+		return nil
+	}
+
+	if _, err := time.ParseDuration(duration); err != nil {
+		return v1.NewClientErrInvalidRequest(fmt.Sprintf("`%s` must be a duration, ex - '30s': %s", name, err.Error()))
+	}
+
+	return nil
+}
+
+// getRoutePolicies returns the Contour rate limit, timeout and retry policies of the route, or nil for the policies
+// which are not specified.
+func getRoutePolicies(route *datamodel.GatewayRoute) (*contourv1.RateLimitPolicy, *contourv1.TimeoutPolicy, *contourv1.RetryPolicy) {
+	var rateLimitPolicy *contourv1.RateLimitPolicy
+	if route.RateLimit != nil {
+		rateLimitPolicy = &contourv1.RateLimitPolicy{
+			Local: &contourv1.LocalRateLimitPolicy{
+				Requests: uint32(route.RateLimit.Requests),
+				Unit:     route.RateLimit.Unit,
+				Burst:    uint32(route.RateLimit.Burst),
+			},
+		}
+	}
+
+	var timeoutPolicy *contourv1.TimeoutPolicy
+	if route.Timeouts != nil {
+		timeoutPolicy = &contourv1.TimeoutPolicy{
+			Response: route.Timeouts.Response,
+			Idle:     route.Timeouts.Idle,
+		}
+	}
+
+	var retryPolicy *contourv1.RetryPolicy
+	if route.RetryPolicy != nil {
+		retryPolicy = &contourv1.RetryPolicy{
+			NumRetries:    int64(route.RetryPolicy.Attempts),
+			PerTryTimeout: route.RetryPolicy.PerTryTimeout,
+		}
+	}
+
+	return rateLimitPolicy, timeoutPolicy, retryPolicy
+}
+
+// getRouteConditions returns the conditions a request must match to be served by the route. The method of the request is
+// matched using the :method pseudo-header.
+func getRouteConditions(route *datamodel.GatewayRoute, prefix string) []contourv1.MatchCondition {
+	conditions := []contourv1.MatchCondition{
+		{
+			Prefix: prefix,
+		},
+	}
+
+	for _, header := range route.Headers {
+		condition := &contourv1.HeaderMatchCondition{
+			Name:  header.Name,
+			Exact: header.Value,
+		}
+		if header.Value == "" {
+			condition.Present = true
+		}
+
+		conditions = append(conditions, contourv1.MatchCondition{Header: condition})
+	}
+
+	if len(route.Methods) == 1 {
+		conditions = append(conditions, contourv1.MatchCondition{
+			Header: &contourv1.HeaderMatchCondition{
+				Name:  ":method",
+				Exact: strings.ToUpper(route.Methods[0]),
+			},
+		})
+	} else if len(route.Methods) > 1 {
+		methods := []string{}
+		for _, method := range route.Methods {
+			methods = append(methods, regexp.QuoteMeta(strings.ToUpper(method)))
+		}
+
+		conditions = append(conditions, contourv1.MatchCondition{
+			Header: &contourv1.HeaderMatchCondition{
+				Name:  ":method",
+				Regex: strings.Join(methods, "|"),
+			},
+		})
+	}
+
+	return conditions
+}
+
+// getRedirectPolicy returns the Contour redirect policy of the redirect. The parts of the redirect which are not
+// specified are left unset so that they are kept from the request.
+func getRedirectPolicy(redirect *datamodel.GatewayRouteRedirect) *contourv1.HTTPRequestRedirectPolicy {
+	policy := &contourv1.HTTPRequestRedirectPolicy{}
+	if redirect.Scheme != "" {
+		policy.Scheme = to.Ptr(redirect.Scheme)
+	}
+	if redirect.Hostname != "" {
+		policy.Hostname = to.Ptr(redirect.Hostname)
+	}
+	if redirect.Port != 0 {
+		policy.Port = to.Ptr(redirect.Port)
+	}
+	if redirect.Path != "" {
+		policy.Path = to.Ptr(redirect.Path)
+	}
+	if redirect.StatusCode != 0 {
+		policy.StatusCode = to.Ptr(int(redirect.StatusCode))
+	}
+
+	return policy
+}
+
+// getRouteName returns the name of the route. The name of a route splitting its traffic is built from the hostnames and
+// weights of its destinations so that routes with the same split share the same HTTPProxy.
 func getRouteName(route *datamodel.GatewayRoute) (string, error) {
-	u, err := url.Parse(route.Destination)
+	if len(route.Destinations) == 0 {
+		return getDestinationHostname(route.Destination)
+	}
+
+	parts := []string{}
+	for _, destination := range route.Destinations {
+		hostname, err := getDestinationHostname(destination.Destination)
+		if err != nil {
+			return "", err
+		}
+
+		parts = append(parts, hostname)
+		if destination.Weight != 0 {
+			parts = append(parts, strconv.Itoa(int(destination.Weight)))
+		}
+	}
+
+	return strings.Join(parts, "-"), nil
+}
+
+// getRouteServices returns the Contour services the route sends its traffic to, weighted for routes splitting their
+// traffic between multiple destinations.
+func getRouteServices(route *datamodel.GatewayRoute, dependencies map[string]renderers.RendererDependency) ([]contourv1.Service, error) {
+	destinations := route.Destinations
+	if len(destinations) == 0 {
+		destinations = []datamodel.GatewayRouteDestination{{Destination: route.Destination}}
+	}
+
+	services := []contourv1.Service{}
+	for _, destination := range destinations {
+		hostname, err := getDestinationHostname(destination.Destination)
+		if err != nil {
+			return nil, err
+		}
+
+		port, err := getDestinationPort(destination.Destination, dependencies)
+		if err != nil {
+			return nil, err
+		}
+
+		services = append(services, contourv1.Service{
+			Name:   kubernetes.NormalizeResourceName(hostname),
+			Port:   int(port),
+			Weight: int64(destination.Weight),
+		})
+	}
+
+	return services, nil
+}
+
+func getDestinationHostname(destination string) (string, error) {
+	u, err := url.Parse(destination)
 	if err != nil {
 		return "", v1.NewClientErrInvalidRequest(err.Error())
 	}
@@ -398,6 +898,26 @@ func getRouteName(route *datamodel.GatewayRoute) (string, error) {
 	return u.Hostname(), nil
 }
 
+func getDestinationPort(destination string, dependencies map[string]renderers.RendererDependency) (int32, error) {
+	if isURL(destination) {
+		_, _, port, err := parseURL(destination)
+		if err != nil {
+			return 0, err
+		}
+
+		return port, nil
+	}
+
+	port := renderers.DefaultPort
+	routeProperties := dependencies[destination]
+	routePort, ok := routeProperties.ComputedValues["port"].(float64)
+	if ok {
+		port = int32(routePort)
+	}
+
+	return port, nil
+}
+
 // getHostname returns the hostname of the public endpoint of the Gateway.
 // This sometimes involves transforming the external IP of the cluster into
 // a hostname that's unique to this Gateway and Application.
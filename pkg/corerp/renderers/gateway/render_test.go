@@ -120,6 +120,37 @@ func Test_GetDependencyIDs_Success(t *testing.T) {
 	require.ElementsMatch(t, expectedAzureResourceIDs, resourceIDs)
 }
 
+func Test_GetDependencyIDs_WithHosts(t *testing.T) {
+	secretStoreID := makeSecretStoreResourceID("testsecret")
+	apiSecretStoreID := makeSecretStoreResourceID("apisecret")
+	properties := datamodel.GatewayProperties{
+		TLS: &datamodel.GatewayPropertiesTLS{
+			CertificateFrom: secretStoreID,
+			Hosts: []datamodel.GatewayTLSHost{
+				{
+					Hostname:        "api.example.com",
+					CertificateFrom: apiSecretStoreID,
+				},
+			},
+		},
+		Routes: []datamodel.GatewayRoute{
+			{
+				Destination: "http://A",
+			},
+		},
+	}
+	resource := makeResource(properties)
+
+	ctx := testcontext.New(t)
+	renderer := Renderer{}
+	radiusResourceIDs, resourceIDs, err := renderer.GetDependencyIDs(ctx, resource)
+	require.NoError(t, err)
+	require.Len(t, resourceIDs, 0)
+
+	expectedRadiusResourceIDs := []resources.ID{resources.MustParse(secretStoreID), resources.MustParse(apiSecretStoreID)}
+	require.ElementsMatch(t, expectedRadiusResourceIDs, radiusResourceIDs)
+}
+
 func Test_Render_WithIPAndNoHostname(t *testing.T) {
 	r := &Renderer{}
 
@@ -919,6 +950,725 @@ func Test_Render_Multiple_Routes(t *testing.T) {
 	validateContourHTTPRoute(t, output.Resources, "B", expectedHTTPRouteSpecB, "")
 }
 
+func Test_Render_Route_WithWeightedDestinations(t *testing.T) {
+	r := &Renderer{}
+
+	routePath := "/"
+	properties := datamodel.GatewayProperties{
+		BasicResourceProperties: rpv1.BasicResourceProperties{
+			Application: "/subscriptions/test-sub-id/resourceGroups/test-rg/providers/Applications.Core/applications/test-application",
+		},
+		Routes: []datamodel.GatewayRoute{
+			{
+				Destinations: []datamodel.GatewayRouteDestination{
+					{
+						Destination: "http://A",
+						Weight:      90,
+					},
+					{
+						Destination: "http://B:8080",
+						Weight:      10,
+					},
+				},
+				Path: routePath,
+			},
+		},
+	}
+	resource := makeResource(properties)
+	dependencies := map[string]renderers.RendererDependency{}
+	environmentOptions := getEnvironmentOptions("", testExternalIP, "", false, false)
+	expectedHostname := fmt.Sprintf("%s.%s.%s.nip.io", resourceName, applicationName, testExternalIP)
+
+	output, err := r.Render(context.Background(), resource, renderers.RenderOptions{Dependencies: dependencies, Environment: environmentOptions})
+	require.NoError(t, err)
+	require.Len(t, output.Resources, 2)
+
+	expectedRouteName := "A-90-B-10"
+	expectedGatewaySpec := &contourv1.HTTPProxySpec{
+		VirtualHost: &contourv1.VirtualHost{
+			Fqdn: expectedHostname,
+		},
+		Includes: []contourv1.Include{
+			{
+				Name: kubernetes.NormalizeResourceName(expectedRouteName),
+				Conditions: []contourv1.MatchCondition{
+					{
+						Prefix: routePath,
+					},
+				},
+			},
+		},
+	}
+
+	expectedHTTPRouteSpec := contourv1.HTTPProxySpec{
+		Routes: []contourv1.Route{
+			{
+				Services: []contourv1.Service{
+					{
+						Name:   kubernetes.NormalizeResourceName("A"),
+						Port:   80,
+						Weight: 90,
+					},
+					{
+						Name:   kubernetes.NormalizeResourceName("B"),
+						Port:   8080,
+						Weight: 10,
+					},
+				},
+			},
+		},
+	}
+
+	validateContourHTTPProxy(t, output.Resources, expectedGatewaySpec, "")
+	validateContourHTTPRoute(t, output.Resources, expectedRouteName, expectedHTTPRouteSpec, "")
+}
+
+func Test_Render_Route_WithWeightedDestinations_Fails(t *testing.T) {
+	tests := []struct {
+		name     string
+		tls      *datamodel.GatewayPropertiesTLS
+		route    datamodel.GatewayRoute
+		errorMsg string
+	}{
+		{
+			name: "destination and destinations",
+			route: datamodel.GatewayRoute{
+				Destination:  "http://A",
+				Destinations: []datamodel.GatewayRouteDestination{{Destination: "http://B"}},
+			},
+			errorMsg: "cannot support both `destination` and `destinations` in a route",
+		},
+		{
+			name: "negative weight",
+			route: datamodel.GatewayRoute{
+				Destinations: []datamodel.GatewayRouteDestination{{Destination: "http://A", Weight: -1}},
+			},
+			errorMsg: "weight of destination http://A must not be negative",
+		},
+		{
+			name: "sslPassthrough",
+			tls: &datamodel.GatewayPropertiesTLS{
+				SSLPassthrough: true,
+			},
+			route: datamodel.GatewayRoute{
+				Destinations: []datamodel.GatewayRouteDestination{{Destination: "http://A"}, {Destination: "http://B"}},
+			},
+			errorMsg: "cannot support `destinations` in routes with sslPassthrough set to true",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			r := &Renderer{}
+			properties := datamodel.GatewayProperties{
+				BasicResourceProperties: rpv1.BasicResourceProperties{
+					Application: "/subscriptions/test-sub-id/resourceGroups/test-rg/providers/Applications.Core/applications/test-application",
+				},
+				Routes: []datamodel.GatewayRoute{tc.route},
+				TLS:    tc.tls,
+			}
+			resource := makeResource(properties)
+			environmentOptions := getEnvironmentOptions("", testExternalIP, "", false, false)
+
+			output, err := r.Render(context.Background(), resource, renderers.RenderOptions{Dependencies: map[string]renderers.RendererDependency{}, Environment: environmentOptions})
+			require.Error(t, err)
+			require.Equal(t, v1.CodeInvalid, err.(*v1.ErrClientRP).Code)
+			require.Equal(t, tc.errorMsg, err.(*v1.ErrClientRP).Message)
+			require.Len(t, output.Resources, 0)
+		})
+	}
+}
+
+func Test_Render_Route_WithMatchesAndFilters(t *testing.T) {
+	r := &Renderer{}
+
+	properties := datamodel.GatewayProperties{
+		BasicResourceProperties: rpv1.BasicResourceProperties{
+			Application: "/subscriptions/test-sub-id/resourceGroups/test-rg/providers/Applications.Core/applications/test-application",
+		},
+		Routes: []datamodel.GatewayRoute{
+			{
+				Destination: "http://A",
+				Path:        "/api",
+				Headers: []datamodel.GatewayRouteHeaderMatch{
+					{
+						Name:  "x-canary",
+						Value: "true",
+					},
+					{
+						Name: "authorization",
+					},
+				},
+				Methods:         []string{"get", "HEAD"},
+				ReplaceHostname: "a.internal",
+			},
+			{
+				Path:    "/old",
+				Methods: []string{"GET"},
+				Redirect: &datamodel.GatewayRouteRedirect{
+					Scheme:     "https",
+					Path:       "/new",
+					StatusCode: 301,
+				},
+			},
+		},
+	}
+	resource := makeResource(properties)
+	environmentOptions := getEnvironmentOptions("", testExternalIP, "", false, false)
+	expectedHostname := fmt.Sprintf("%s.%s.%s.nip.io", resourceName, applicationName, testExternalIP)
+
+	output, err := r.Render(context.Background(), resource, renderers.RenderOptions{Dependencies: map[string]renderers.RendererDependency{}, Environment: environmentOptions})
+	require.NoError(t, err)
+	require.Len(t, output.Resources, 2)
+
+	expectedGatewaySpec := &contourv1.HTTPProxySpec{
+		VirtualHost: &contourv1.VirtualHost{
+			Fqdn: expectedHostname,
+		},
+		Includes: []contourv1.Include{
+			{
+				Name: kubernetes.NormalizeResourceName("A"),
+				Conditions: []contourv1.MatchCondition{
+					{
+						Prefix: "/api",
+					},
+					{
+						Header: &contourv1.HeaderMatchCondition{
+							Name:  "x-canary",
+							Exact: "true",
+						},
+					},
+					{
+						Header: &contourv1.HeaderMatchCondition{
+							Name:    "authorization",
+							Present: true,
+						},
+					},
+					{
+						Header: &contourv1.HeaderMatchCondition{
+							Name:  ":method",
+							Regex: "GET|HEAD",
+						},
+					},
+				},
+			},
+		},
+		Routes: []contourv1.Route{
+			{
+				Conditions: []contourv1.MatchCondition{
+					{
+						Prefix: "/old",
+					},
+					{
+						Header: &contourv1.HeaderMatchCondition{
+							Name:  ":method",
+							Exact: "GET",
+						},
+					},
+				},
+				RequestRedirectPolicy: &contourv1.HTTPRequestRedirectPolicy{
+					Scheme:     to.Ptr("https"),
+					Path:       to.Ptr("/new"),
+					StatusCode: to.Ptr(301),
+				},
+			},
+		},
+	}
+
+	expectedHTTPRouteSpec := createExpectedHTTPRouteSpec("A", 80, nil, false)
+	expectedHTTPRouteSpec.Routes[0].RequestHeadersPolicy = &contourv1.HeadersPolicy{
+		Set: []contourv1.HeaderValue{
+			{
+				Name:  "Host",
+				Value: "a.internal",
+			},
+		},
+	}
+
+	validateContourHTTPProxy(t, output.Resources, expectedGatewaySpec, "")
+	validateContourHTTPRoute(t, output.Resources, "A", expectedHTTPRouteSpec, "")
+}
+
+func Test_Render_Route_WithMatchesAndFilters_Fails(t *testing.T) {
+	tests := []struct {
+		name     string
+		tls      *datamodel.GatewayPropertiesTLS
+		routes   []datamodel.GatewayRoute
+		errorMsg string
+	}{
+		{
+			name: "header without name",
+			routes: []datamodel.GatewayRoute{
+				{Destination: "http://A", Headers: []datamodel.GatewayRouteHeaderMatch{{Value: "true"}}},
+			},
+			errorMsg: "must specify `name` for each of the `headers` of a route",
+		},
+		{
+			name: "redirect with destination",
+			routes: []datamodel.GatewayRoute{
+				{Destination: "http://A", Redirect: &datamodel.GatewayRouteRedirect{Scheme: "https"}},
+			},
+			errorMsg: "cannot support `destination`, `destinations`, `replacePrefix` or `replaceHostname` in routes with a `redirect`",
+		},
+		{
+			name: "redirect with invalid status code",
+			routes: []datamodel.GatewayRoute{
+				{Redirect: &datamodel.GatewayRouteRedirect{StatusCode: 307}},
+			},
+			errorMsg: "status code 307 of the redirect must be 301 or 302",
+		},
+		{
+			name: "sslPassthrough with headers",
+			tls: &datamodel.GatewayPropertiesTLS{
+				SSLPassthrough: true,
+			},
+			routes: []datamodel.GatewayRoute{
+				{Destination: "http://A", Headers: []datamodel.GatewayRouteHeaderMatch{{Name: "x-canary"}}},
+			},
+			errorMsg: "cannot support `headers`, `methods`, `replaceHostname` or `redirect` in routes with sslPassthrough set to true",
+		},
+		{
+			name: "different replaceHostname for the same destination",
+			routes: []datamodel.GatewayRoute{
+				{Destination: "http://A", Path: "/a", ReplaceHostname: "a.internal"},
+				{Destination: "http://A", Path: "/b"},
+			},
+			errorMsg: "routes to A must use the same `replaceHostname`, `rateLimit`, `timeouts` and `retryPolicy`",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			r := &Renderer{}
+			properties := datamodel.GatewayProperties{
+				BasicResourceProperties: rpv1.BasicResourceProperties{
+					Application: "/subscriptions/test-sub-id/resourceGroups/test-rg/providers/Applications.Core/applications/test-application",
+				},
+				Routes: tc.routes,
+				TLS:    tc.tls,
+			}
+			resource := makeResource(properties)
+			environmentOptions := getEnvironmentOptions("", testExternalIP, "", false, false)
+
+			output, err := r.Render(context.Background(), resource, renderers.RenderOptions{Dependencies: map[string]renderers.RendererDependency{}, Environment: environmentOptions})
+			require.Error(t, err)
+			require.Equal(t, v1.CodeInvalid, err.(*v1.ErrClientRP).Code)
+			require.Equal(t, tc.errorMsg, err.(*v1.ErrClientRP).Message)
+			require.Len(t, output.Resources, 0)
+		})
+	}
+}
+
+func Test_Render_Route_WithPolicies(t *testing.T) {
+	r := &Renderer{}
+
+	properties, expectedIncludes := makeTestGateway(datamodel.GatewayProperties{
+		BasicResourceProperties: rpv1.BasicResourceProperties{
+			Application: "/subscriptions/test-sub-id/resourceGroups/test-rg/providers/Applications.Core/applications/test-application",
+		},
+	})
+	properties.Routes[0].RateLimit = &datamodel.GatewayRouteRateLimit{
+		Requests: 100,
+		Unit:     "minute",
+		Burst:    10,
+	}
+	properties.Routes[0].Timeouts = &datamodel.GatewayRouteTimeouts{
+		Response: "30s",
+		Idle:     "5m",
+	}
+	properties.Routes[0].RetryPolicy = &datamodel.GatewayRouteRetryPolicy{
+		Attempts:      3,
+		PerTryTimeout: "5s",
+	}
+	resource := makeResource(properties)
+	environmentOptions := getEnvironmentOptions("", testExternalIP, "", false, false)
+	expectedHostname := fmt.Sprintf("%s.%s.%s.nip.io", resourceName, applicationName, testExternalIP)
+
+	output, err := r.Render(context.Background(), resource, renderers.RenderOptions{Dependencies: map[string]renderers.RendererDependency{}, Environment: environmentOptions})
+	require.NoError(t, err)
+	require.Len(t, output.Resources, 2)
+
+	expectedGatewaySpec := &contourv1.HTTPProxySpec{
+		VirtualHost: &contourv1.VirtualHost{
+			Fqdn: expectedHostname,
+		},
+		Includes: expectedIncludes,
+	}
+
+	expectedHTTPRouteSpec := createExpectedHTTPRouteSpec("A", 80, nil, false)
+	expectedHTTPRouteSpec.Routes[0].RateLimitPolicy = &contourv1.RateLimitPolicy{
+		Local: &contourv1.LocalRateLimitPolicy{
+			Requests: 100,
+			Unit:     "minute",
+			Burst:    10,
+		},
+	}
+	expectedHTTPRouteSpec.Routes[0].TimeoutPolicy = &contourv1.TimeoutPolicy{
+		Response: "30s",
+		Idle:     "5m",
+	}
+	expectedHTTPRouteSpec.Routes[0].RetryPolicy = &contourv1.RetryPolicy{
+		NumRetries:    3,
+		PerTryTimeout: "5s",
+	}
+
+	validateContourHTTPProxy(t, output.Resources, expectedGatewaySpec, "")
+	validateContourHTTPRoute(t, output.Resources, "A", expectedHTTPRouteSpec, "")
+}
+
+func Test_Render_Route_WithPolicies_Fails(t *testing.T) {
+	tests := []struct {
+		name     string
+		route    datamodel.GatewayRoute
+		errorMsg string
+	}{
+		{
+			name: "rate limit without requests",
+			route: datamodel.GatewayRoute{
+				Destination: "http://A",
+				RateLimit:   &datamodel.GatewayRouteRateLimit{Unit: "second"},
+			},
+			errorMsg: "`requests` of the rate limit must be greater than 0",
+		},
+		{
+			name: "rate limit with invalid unit",
+			route: datamodel.GatewayRoute{
+				Destination: "http://A",
+				RateLimit:   &datamodel.GatewayRouteRateLimit{Requests: 10, Unit: "day"},
+			},
+			errorMsg: "unit \"day\" of the rate limit must be one of second, minute, hour",
+		},
+		{
+			name: "invalid timeout",
+			route: datamodel.GatewayRoute{
+				Destination: "http://A",
+				Timeouts:    &datamodel.GatewayRouteTimeouts{Response: "thirty"},
+			},
+			errorMsg: "`response` must be a duration, ex - '30s': time: invalid duration \"thirty\"",
+		},
+		{
+			name: "retry policy without attempts",
+			route: datamodel.GatewayRoute{
+				Destination: "http://A",
+				RetryPolicy: &datamodel.GatewayRouteRetryPolicy{PerTryTimeout: "5s"},
+			},
+			errorMsg: "`attempts` of the retry policy must be greater than 0",
+		},
+		{
+			name: "redirect with policies",
+			route: datamodel.GatewayRoute{
+				Redirect:  &datamodel.GatewayRouteRedirect{Scheme: "https"},
+				RateLimit: &datamodel.GatewayRouteRateLimit{Requests: 10, Unit: "second"},
+			},
+			errorMsg: "cannot support `rateLimit`, `timeouts` or `retryPolicy` in routes with a `redirect`",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			r := &Renderer{}
+			properties := datamodel.GatewayProperties{
+				BasicResourceProperties: rpv1.BasicResourceProperties{
+					Application: "/subscriptions/test-sub-id/resourceGroups/test-rg/providers/Applications.Core/applications/test-application",
+				},
+				Routes: []datamodel.GatewayRoute{tc.route},
+			}
+			resource := makeResource(properties)
+			environmentOptions := getEnvironmentOptions("", testExternalIP, "", false, false)
+
+			output, err := r.Render(context.Background(), resource, renderers.RenderOptions{Dependencies: map[string]renderers.RendererDependency{}, Environment: environmentOptions})
+			require.Error(t, err)
+			require.Equal(t, v1.CodeInvalid, err.(*v1.ErrClientRP).Code)
+			require.Equal(t, tc.errorMsg, err.(*v1.ErrClientRP).Message)
+			require.Len(t, output.Resources, 0)
+		})
+	}
+}
+
+func Test_Render_Route_WithWebsocketKind(t *testing.T) {
+	r := &Renderer{}
+
+	properties, expectedIncludes := makeTestGateway(datamodel.GatewayProperties{
+		BasicResourceProperties: rpv1.BasicResourceProperties{
+			Application: "/subscriptions/test-sub-id/resourceGroups/test-rg/providers/Applications.Core/applications/test-application",
+		},
+	})
+	properties.Routes[0].Kind = datamodel.GatewayRouteKindWebsocket
+	resource := makeResource(properties)
+	environmentOptions := getEnvironmentOptions("", testExternalIP, "", false, false)
+	expectedHostname := fmt.Sprintf("%s.%s.%s.nip.io", resourceName, applicationName, testExternalIP)
+
+	output, err := r.Render(context.Background(), resource, renderers.RenderOptions{Dependencies: map[string]renderers.RendererDependency{}, Environment: environmentOptions})
+	require.NoError(t, err)
+	require.Len(t, output.Resources, 2)
+
+	expectedGatewaySpec := &contourv1.HTTPProxySpec{
+		VirtualHost: &contourv1.VirtualHost{
+			Fqdn: expectedHostname,
+		},
+		Includes: expectedIncludes,
+	}
+
+	validateContourHTTPProxy(t, output.Resources, expectedGatewaySpec, "")
+	validateContourHTTPRoute(t, output.Resources, "A", createExpectedHTTPRouteSpec("A", 80, nil, true), "")
+}
+
+func Test_Render_Route_WithTCPKind(t *testing.T) {
+	r := &Renderer{}
+
+	secretStoreResourceId := makeSecretStoreResourceID("myapp-tls-secret")
+	properties := datamodel.GatewayProperties{
+		BasicResourceProperties: rpv1.BasicResourceProperties{
+			Application: "/subscriptions/test-sub-id/resourceGroups/test-rg/providers/Applications.Core/applications/test-application",
+		},
+		TLS: &datamodel.GatewayPropertiesTLS{
+			MinimumProtocolVersion: "1.2",
+			CertificateFrom:        secretStoreResourceId,
+		},
+		Routes: []datamodel.GatewayRoute{
+			{
+				Kind: datamodel.GatewayRouteKindTCP,
+				Destinations: []datamodel.GatewayRouteDestination{
+					{
+						Destination: "tcp://A:5432",
+						Weight:      90,
+					},
+					{
+						Destination: "tcp://B:5432",
+						Weight:      10,
+					},
+				},
+			},
+		},
+	}
+	resource := makeResource(properties)
+	environmentOptions := getEnvironmentOptions("", testExternalIP, "", false, false)
+	expectedHostname := fmt.Sprintf("%s.%s.%s.nip.io", resourceName, applicationName, testExternalIP)
+
+	dependencies := map[string]renderers.RendererDependency{
+		secretStoreResourceId: makeCertificateDependency(secretStoreResourceId, "myapp-tls-secret", environmentOptions.Namespace),
+	}
+
+	output, err := r.Render(context.Background(), resource, renderers.RenderOptions{Dependencies: dependencies, Environment: environmentOptions})
+	require.NoError(t, err)
+	require.Len(t, output.Resources, 1)
+
+	expectedGatewaySpec := &contourv1.HTTPProxySpec{
+		VirtualHost: &contourv1.VirtualHost{
+			Fqdn: expectedHostname,
+			TLS: &contourv1.TLS{
+				MinimumProtocolVersion: "1.2",
+				SecretName:             environmentOptions.Namespace + "/myapp-tls-secret",
+			},
+		},
+		Includes: []contourv1.Include{},
+		TCPProxy: &contourv1.TCPProxy{
+			Services: []contourv1.Service{
+				{
+					Name:   "a",
+					Port:   5432,
+					Weight: 90,
+				},
+				{
+					Name:   "b",
+					Port:   5432,
+					Weight: 10,
+				},
+			},
+		},
+	}
+
+	validateContourHTTPProxy(t, output.Resources, expectedGatewaySpec, "")
+}
+
+func Test_Render_Route_WithTCPKind_Fails(t *testing.T) {
+	secretStoreResourceId := makeSecretStoreResourceID("myapp-tls-secret")
+	tls := &datamodel.GatewayPropertiesTLS{
+		CertificateFrom: secretStoreResourceId,
+	}
+
+	tests := []struct {
+		name     string
+		tls      *datamodel.GatewayPropertiesTLS
+		routes   []datamodel.GatewayRoute
+		errorMsg string
+	}{
+		{
+			name: "tcp route without tls",
+			routes: []datamodel.GatewayRoute{
+				{Kind: datamodel.GatewayRouteKindTCP, Destination: "tcp://A:5432"},
+			},
+			errorMsg: "must set `certificateFrom` or `sslPassthrough` in `tls` to support tcp routes",
+		},
+		{
+			name: "tcp route with other routes",
+			tls:  tls,
+			routes: []datamodel.GatewayRoute{
+				{Kind: datamodel.GatewayRouteKindTCP, Destination: "tcp://A:5432"},
+				{Destination: "http://B", Path: "/"},
+			},
+			errorMsg: "cannot support multiple routes with a tcp route",
+		},
+		{
+			name: "tcp route with path",
+			tls:  tls,
+			routes: []datamodel.GatewayRoute{
+				{Kind: datamodel.GatewayRouteKindTCP, Destination: "tcp://A:5432", Path: "/"},
+			},
+			errorMsg: "cannot support `path`, `replacePrefix`, `headers`, `methods`, `replaceHostname` or `redirect` in tcp routes",
+		},
+		{
+			name: "tcp route with websockets",
+			tls:  tls,
+			routes: []datamodel.GatewayRoute{
+				{Kind: datamodel.GatewayRouteKindTCP, Destination: "tcp://A:5432", EnableWebsockets: true},
+			},
+			errorMsg: "cannot support `rateLimit`, `timeouts`, `retryPolicy` or `enableWebsockets` in tcp routes",
+		},
+		{
+			name: "unknown kind",
+			routes: []datamodel.GatewayRoute{
+				{Kind: "udp", Destination: "udp://A:53"},
+			},
+			errorMsg: "kind \"udp\" of the route must be one of http, websocket, tcp",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			r := &Renderer{}
+			properties := datamodel.GatewayProperties{
+				BasicResourceProperties: rpv1.BasicResourceProperties{
+					Application: "/subscriptions/test-sub-id/resourceGroups/test-rg/providers/Applications.Core/applications/test-application",
+				},
+				TLS:    tc.tls,
+				Routes: tc.routes,
+			}
+			resource := makeResource(properties)
+			environmentOptions := getEnvironmentOptions("", testExternalIP, "", false, false)
+			dependencies := map[string]renderers.RendererDependency{
+				secretStoreResourceId: makeCertificateDependency(secretStoreResourceId, "myapp-tls-secret", environmentOptions.Namespace),
+			}
+
+			output, err := r.Render(context.Background(), resource, renderers.RenderOptions{Dependencies: dependencies, Environment: environmentOptions})
+			require.Error(t, err)
+			require.Equal(t, v1.CodeInvalid, err.(*v1.ErrClientRP).Code)
+			require.Equal(t, tc.errorMsg, err.(*v1.ErrClientRP).Message)
+			require.Len(t, output.Resources, 0)
+		})
+	}
+}
+
+func Test_Render_WithDNSZone(t *testing.T) {
+	tests := []struct {
+		name         string
+		dnsZone      string
+		expectedZone string
+	}{
+		{
+			name:         "domain name",
+			dnsZone:      "MyDomain.com.",
+			expectedZone: "mydomain.com",
+		},
+		{
+			name:         "azure dns zone",
+			dnsZone:      "/subscriptions/test-sub-id/resourceGroups/test-rg/providers/Microsoft.Network/dnsZones/mydomain.com",
+			expectedZone: "mydomain.com",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			r := &Renderer{}
+
+			properties, expectedIncludes := makeTestGateway(datamodel.GatewayProperties{
+				BasicResourceProperties: rpv1.BasicResourceProperties{
+					Application: "/subscriptions/test-sub-id/resourceGroups/test-rg/providers/Applications.Core/applications/test-application",
+				},
+				Hostname: &datamodel.GatewayPropertiesHostname{
+					FullyQualifiedHostname: "myapp.mydomain.com",
+					DNSZone:                tc.dnsZone,
+				},
+			})
+			resource := makeResource(properties)
+			environmentOptions := getEnvironmentOptions("", testExternalIP, "", false, false)
+
+			output, err := r.Render(context.Background(), resource, renderers.RenderOptions{Dependencies: map[string]renderers.RendererDependency{}, Environment: environmentOptions})
+			require.NoError(t, err)
+			require.Len(t, output.Resources, 2)
+			require.Equal(t, "http://myapp.mydomain.com", output.ComputedValues["url"].Value)
+
+			expectedGatewaySpec := &contourv1.HTTPProxySpec{
+				VirtualHost: &contourv1.VirtualHost{
+					Fqdn: "myapp.mydomain.com",
+				},
+				Includes: expectedIncludes,
+			}
+			validateContourHTTPProxy(t, output.Resources, expectedGatewaySpec, "")
+
+			httpProxy, _ := kubernetes.FindContourHTTPProxy(output.Resources)
+			expectedAnnotations := map[string]string{
+				"external-dns.alpha.kubernetes.io/hostname": "myapp.mydomain.com",
+				kubernetes.AnnotationDNSZone:                tc.expectedZone,
+			}
+			require.Equal(t, expectedAnnotations, httpProxy.Annotations)
+		})
+	}
+}
+
+func Test_Render_WithDNSZone_Fails(t *testing.T) {
+	tests := []struct {
+		name     string
+		hostname *datamodel.GatewayPropertiesHostname
+		errorMsg string
+	}{
+		{
+			name: "dns zone without fully qualified hostname",
+			hostname: &datamodel.GatewayPropertiesHostname{
+				Prefix:  "myprefix",
+				DNSZone: "mydomain.com",
+			},
+			errorMsg: "must specify `fullyQualifiedHostname` to use `dnsZone`",
+		},
+		{
+			name: "hostname outside of the dns zone",
+			hostname: &datamodel.GatewayPropertiesHostname{
+				FullyQualifiedHostname: "myapp.otherdomain.com",
+				DNSZone:                "mydomain.com",
+			},
+			errorMsg: "hostname myapp.otherdomain.com is not in DNS zone mydomain.com",
+		},
+		{
+			name: "dns zone resource id of another type",
+			hostname: &datamodel.GatewayPropertiesHostname{
+				FullyQualifiedHostname: "myapp.mydomain.com",
+				DNSZone:                "/subscriptions/test-sub-id/resourceGroups/test-rg/providers/Microsoft.Network/privateDnsZones/mydomain.com",
+			},
+			errorMsg: "dnsZone /subscriptions/test-sub-id/resourceGroups/test-rg/providers/Microsoft.Network/privateDnsZones/mydomain.com must be a domain name or the resource id of an Azure DNS zone",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			r := &Renderer{}
+			properties, _ := makeTestGateway(datamodel.GatewayProperties{
+				BasicResourceProperties: rpv1.BasicResourceProperties{
+					Application: "/subscriptions/test-sub-id/resourceGroups/test-rg/providers/Applications.Core/applications/test-application",
+				},
+				Hostname: tc.hostname,
+			})
+			resource := makeResource(properties)
+			environmentOptions := getEnvironmentOptions("", testExternalIP, "", false, false)
+
+			output, err := r.Render(context.Background(), resource, renderers.RenderOptions{Dependencies: map[string]renderers.RendererDependency{}, Environment: environmentOptions})
+			require.Error(t, err)
+			require.Equal(t, v1.CodeInvalid, err.(*v1.ErrClientRP).Code)
+			require.Equal(t, tc.errorMsg, err.(*v1.ErrClientRP).Message)
+			require.Len(t, output.Resources, 0)
+		})
+	}
+}
+
 func Test_Render_Route_WithPrefixRewrite(t *testing.T) {
 	r := &Renderer{}
 
@@ -1455,6 +2205,156 @@ func Test_Render_With_TLSTermination(t *testing.T) {
 	validateContourHTTPProxy(t, output.Resources, expectedGatewaySpec, "")
 }
 
+func Test_Render_With_TLSTermination_Hosts(t *testing.T) {
+	r := &Renderer{}
+
+	secretStoreResourceId := makeSecretStoreResourceID("myapp-tls-secret")
+	apiSecretStoreResourceId := makeSecretStoreResourceID("api-tls-secret")
+	properties, expectedIncludes := makeTestGateway(datamodel.GatewayProperties{
+		BasicResourceProperties: rpv1.BasicResourceProperties{
+			Application: "/subscriptions/test-sub-id/resourceGroups/test-rg/providers/Applications.Core/applications/test-application",
+		},
+		TLS: &datamodel.GatewayPropertiesTLS{
+			MinimumProtocolVersion: "1.2",
+			CertificateFrom:        secretStoreResourceId,
+			Hosts: []datamodel.GatewayTLSHost{
+				{
+					Hostname:        "api.example.com",
+					CertificateFrom: apiSecretStoreResourceId,
+				},
+			},
+		},
+	})
+	resource := makeResource(properties)
+
+	environmentOptions := getEnvironmentOptions("", testExternalIP, "", false, false)
+
+	dependencies := map[string]renderers.RendererDependency{
+		secretStoreResourceId:    makeCertificateDependency(secretStoreResourceId, "myapp-tls-secret", environmentOptions.Namespace),
+		apiSecretStoreResourceId: makeCertificateDependency(apiSecretStoreResourceId, "api-tls-secret", environmentOptions.Namespace),
+	}
+
+	output, err := r.Render(context.Background(), resource, renderers.RenderOptions{Dependencies: dependencies, Environment: environmentOptions})
+	require.NoError(t, err)
+	require.Len(t, output.Resources, 3)
+
+	expectedHostname := fmt.Sprintf("%s.%s.%s.nip.io", resourceName, applicationName, testExternalIP)
+	require.Equal(t, "https://"+expectedHostname, output.ComputedValues["url"].Value)
+
+	expectedGatewaySpec := &contourv1.HTTPProxySpec{
+		VirtualHost: &contourv1.VirtualHost{
+			Fqdn: expectedHostname,
+			TLS: &contourv1.TLS{
+				MinimumProtocolVersion: "1.2",
+				SecretName:             environmentOptions.Namespace + "/myapp-tls-secret",
+			},
+		},
+		Includes: expectedIncludes,
+	}
+	validateContourHTTPProxy(t, output.Resources, expectedGatewaySpec, "")
+
+	expectedLocalID := rpv1.LocalIDGateway + "-api.example.com"
+	var hostOutputResource rpv1.OutputResource
+	for _, r := range output.Resources {
+		if r.LocalID == expectedLocalID {
+			hostOutputResource = r
+		}
+	}
+	require.NotNil(t, hostOutputResource.CreateResource)
+	require.Equal(t, []string{rpv1.LocalIDHttpProxy + "-A"}, hostOutputResource.CreateResource.Dependencies)
+
+	hostHTTPProxy, ok := hostOutputResource.CreateResource.Data.(*contourv1.HTTPProxy)
+	require.True(t, ok)
+	require.Equal(t, kubernetes.NormalizeResourceName(resourceName+"-api-example-com"), hostHTTPProxy.Name)
+	require.Equal(t, applicationName, hostHTTPProxy.Namespace)
+	require.Equal(t, kubernetes.MakeDescriptiveLabels(applicationName, resourceName, ResourceType), hostHTTPProxy.Labels)
+
+	expectedHostSpec := contourv1.HTTPProxySpec{
+		VirtualHost: &contourv1.VirtualHost{
+			Fqdn: "api.example.com",
+			TLS: &contourv1.TLS{
+				MinimumProtocolVersion: "1.2",
+				SecretName:             environmentOptions.Namespace + "/api-tls-secret",
+			},
+		},
+		Includes: expectedIncludes,
+	}
+	require.Equal(t, expectedHostSpec, hostHTTPProxy.Spec)
+}
+
+func Test_Render_With_TLSTermination_Hosts_Fails(t *testing.T) {
+	secretStoreResourceId := makeSecretStoreResourceID("myapp-tls-secret")
+	environmentOptions := getEnvironmentOptions("", testExternalIP, "", false, false)
+	dependencies := map[string]renderers.RendererDependency{
+		secretStoreResourceId: makeCertificateDependency(secretStoreResourceId, "myapp-tls-secret", environmentOptions.Namespace),
+	}
+
+	tests := []struct {
+		name     string
+		tls      *datamodel.GatewayPropertiesTLS
+		errorMsg string
+	}{
+		{
+			name: "sslPassthrough",
+			tls: &datamodel.GatewayPropertiesTLS{
+				SSLPassthrough: true,
+				Hosts:          []datamodel.GatewayTLSHost{{Hostname: "api.example.com", CertificateFrom: secretStoreResourceId}},
+			},
+			errorMsg: "cannot support `hosts` with sslPassthrough set to true",
+		},
+		{
+			name: "missing hostname",
+			tls: &datamodel.GatewayPropertiesTLS{
+				Hosts: []datamodel.GatewayTLSHost{{CertificateFrom: secretStoreResourceId}},
+			},
+			errorMsg: "must specify `hostname` for each of the `hosts`",
+		},
+		{
+			name: "duplicate hostname",
+			tls: &datamodel.GatewayPropertiesTLS{
+				Hosts: []datamodel.GatewayTLSHost{
+					{Hostname: "api.example.com", CertificateFrom: secretStoreResourceId},
+					{Hostname: "API.example.com", CertificateFrom: secretStoreResourceId},
+				},
+			},
+			errorMsg: "hostname API.example.com is declared more than once",
+		},
+		{
+			name: "missing certificateFrom",
+			tls: &datamodel.GatewayPropertiesTLS{
+				Hosts: []datamodel.GatewayTLSHost{{Hostname: "api.example.com"}},
+			},
+			errorMsg: "must specify `certificateFrom` for host api.example.com",
+		},
+		{
+			name: "secretStore not found",
+			tls: &datamodel.GatewayPropertiesTLS{
+				Hosts: []datamodel.GatewayTLSHost{{Hostname: "api.example.com", CertificateFrom: makeSecretStoreResourceID("missing")}},
+			},
+			errorMsg: fmt.Sprintf(secretStoreNotFound, makeSecretStoreResourceID("missing")),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			r := &Renderer{}
+			properties, _ := makeTestGateway(datamodel.GatewayProperties{
+				BasicResourceProperties: rpv1.BasicResourceProperties{
+					Application: "/subscriptions/test-sub-id/resourceGroups/test-rg/providers/Applications.Core/applications/test-application",
+				},
+				TLS: tc.tls,
+			})
+			resource := makeResource(properties)
+
+			output, err := r.Render(context.Background(), resource, renderers.RenderOptions{Dependencies: dependencies, Environment: environmentOptions})
+			require.Error(t, err)
+			require.Equal(t, v1.CodeInvalid, err.(*v1.ErrClientRP).Code)
+			require.Equal(t, tc.errorMsg, err.(*v1.ErrClientRP).Message)
+			require.Len(t, output.Resources, 0)
+		})
+	}
+}
+
 func Test_ParseURL(t *testing.T) {
 	const valid_url = "http://examplehost:80"
 	const invalid_url = "http://abc:def"
@@ -1600,6 +2500,33 @@ func makeSecretStoreResourceID(secretStoreName string) string {
 	return "/planes/radius/local/resourcegroups/test-resourcegroup/providers/Applications.Core/secretStores/" + secretStoreName
 }
 
+func makeCertificateDependency(secretStoreResourceId string, secretName string, namespace string) renderers.RendererDependency {
+	return renderers.RendererDependency{
+		ResourceID: resources.MustParse(secretStoreResourceId),
+		Resource: &datamodel.SecretStore{
+			Properties: &datamodel.SecretStoreProperties{
+				Type: datamodel.SecretTypeCert,
+				Data: map[string]*datamodel.SecretStoreDataValue{
+					"tls.crt": {
+						Value: to.Ptr("test-crt"),
+					},
+					"tls.key": {
+						Value: to.Ptr("test-key"),
+					},
+				},
+			},
+		},
+		OutputResources: map[string]resources.ID{
+			rpv1.LocalIDSecret: resources_kubernetes.IDFromParts(
+				resources_kubernetes.PlaneNameTODO,
+				"",
+				"Secret",
+				namespace,
+				secretName),
+		},
+	}
+}
+
 func makeResource(properties datamodel.GatewayProperties) *datamodel.Gateway {
 	return &datamodel.Gateway{
 		BaseResource: v1.BaseResource{
@@ -0,0 +1,139 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mutualtls
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/corerp/datamodel"
+	"github.com/radius-project/radius/pkg/corerp/renderers"
+	"github.com/radius-project/radius/pkg/kubernetes"
+	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+const (
+	// IstioInjectionLabel is the pod label asking Istio to inject its sidecar proxy.
+	IstioInjectionLabel = "sidecar.istio.io/inject"
+
+	peerAuthenticationAPIVersion = "security.istio.io/v1beta1"
+	peerAuthenticationKind       = "PeerAuthentication"
+)
+
+// Renderer is the renderers.Renderer implementation for the environment mutualTls extension.
+type Renderer struct {
+	Inner renderers.Renderer
+}
+
+// GetDependencyIDs gets the IDs of the dependencies of the given resource.
+func (r *Renderer) GetDependencyIDs(ctx context.Context, resource v1.DataModelInterface) ([]resources.ID, []resources.ID, error) {
+	// Let the inner renderer do its work
+	return r.Inner.GetDependencyIDs(ctx, resource)
+}
+
+// Render renders the container using the Inner renderer and, when the environment enables mutual TLS, adds the
+// container's pods to the service mesh and outputs a policy that only accepts mutual TLS traffic for them.
+func (r *Renderer) Render(ctx context.Context, dm v1.DataModelInterface, options renderers.RenderOptions) (renderers.RendererOutput, error) {
+	// Let the inner renderer do its work
+	output, err := r.Inner.Render(ctx, dm, options)
+	if err != nil {
+		return renderers.RendererOutput{}, err
+	}
+
+	resource, ok := dm.(*datamodel.ContainerResource)
+	if !ok {
+		return renderers.RendererOutput{}, v1.ErrInvalidModelConversion
+	}
+
+	extension := options.Environment.MutualTLS
+	if extension == nil {
+		return output, nil
+	}
+
+	if extension.Mesh != datamodel.ServiceMeshIstio {
+		return renderers.RendererOutput{}, v1.NewClientErrInvalidRequest(fmt.Sprintf("service mesh %q is not supported for mutual TLS", extension.Mesh))
+	}
+
+	mode := extension.Mode
+	if mode == "" {
+		mode = datamodel.MutualTLSModeStrict
+	}
+	if mode != datamodel.MutualTLSModeStrict && mode != datamodel.MutualTLSModePermissive {
+		return renderers.RendererOutput{}, v1.NewClientErrInvalidRequest(fmt.Sprintf("mutual TLS mode %q is not supported", extension.Mode))
+	}
+
+	// There is no deployment for a 'manual' model container.
+	deployment, _ := kubernetes.FindDeployment(output.Resources)
+	if deployment == nil {
+		return output, nil
+	}
+
+	if deployment.Spec.Template.Labels == nil {
+		deployment.Spec.Template.Labels = map[string]string{}
+	}
+	deployment.Spec.Template.Labels[IstioInjectionLabel] = "true"
+
+	if deployment.Spec.Selector == nil || len(deployment.Spec.Selector.MatchLabels) == 0 {
+		return output, nil
+	}
+
+	matchLabels := map[string]any{}
+	for k, v := range deployment.Spec.Selector.MatchLabels {
+		matchLabels[k] = v
+	}
+
+	peerAuthentication := &unstructured.Unstructured{
+		Object: map[string]any{
+			"apiVersion": peerAuthenticationAPIVersion,
+			"kind":       peerAuthenticationKind,
+			"metadata": map[string]any{
+				"name":      kubernetes.NormalizeResourceName(resource.Name),
+				"namespace": deployment.Namespace,
+				"labels":    toAnyMap(deployment.Labels),
+			},
+			"spec": map[string]any{
+				"selector": map[string]any{
+					"matchLabels": matchLabels,
+				},
+				"mtls": map[string]any{
+					"mode": strings.ToUpper(mode),
+				},
+			},
+		},
+	}
+
+	outputResource := rpv1.NewKubernetesOutputResource(rpv1.LocalIDPeerAuthentication, peerAuthentication, metav1.ObjectMeta{
+		Name:      peerAuthentication.GetName(),
+		Namespace: peerAuthentication.GetNamespace(),
+	})
+	output.Resources = append(output.Resources, outputResource)
+
+	return output, nil
+}
+
+func toAnyMap(m map[string]string) map[string]any {
+	result := map[string]any{}
+	for k, v := range m {
+		result[k] = v
+	}
+	return result
+}
@@ -0,0 +1,204 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mutualtls
+
+import (
+	"context"
+	"testing"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/corerp/datamodel"
+	"github.com/radius-project/radius/pkg/corerp/renderers"
+	"github.com/radius-project/radius/pkg/kubernetes"
+	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+var _ renderers.Renderer = (*noop)(nil)
+
+type noop struct {
+}
+
+func (r *noop) GetDependencyIDs(ctx context.Context, resource v1.DataModelInterface) ([]resources.ID, []resources.ID, error) {
+	return nil, nil, nil
+}
+
+func (r *noop) Render(ctx context.Context, dm v1.DataModelInterface, options renderers.RenderOptions) (renderers.RendererOutput, error) {
+	// Return a deployment so the mutualtls extension can modify it
+	deployment := appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-container",
+			Namespace: "test-namespace",
+			Labels: map[string]string{
+				kubernetes.LabelName: "test-container",
+			},
+		},
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "Deployment",
+			APIVersion: "apps/v1",
+		},
+		Spec: appsv1.DeploymentSpec{
+			Selector: &metav1.LabelSelector{
+				MatchLabels: map[string]string{
+					kubernetes.LabelRadiusResource: "test-container",
+				},
+			},
+		},
+	}
+	resources := []rpv1.OutputResource{rpv1.NewKubernetesOutputResource(rpv1.LocalIDDeployment, &deployment, deployment.ObjectMeta)}
+	return renderers.RendererOutput{Resources: resources}, nil
+}
+
+func Test_Render_NoExtension(t *testing.T) {
+	renderer := &Renderer{Inner: &noop{}}
+
+	output, err := renderer.Render(context.Background(), makeResource(), renderers.RenderOptions{})
+	require.NoError(t, err)
+	require.Len(t, output.Resources, 1)
+
+	deployment, _ := kubernetes.FindDeployment(output.Resources)
+	require.NotNil(t, deployment)
+	require.NotContains(t, deployment.Spec.Template.Labels, IstioInjectionLabel)
+}
+
+func Test_Render_Success(t *testing.T) {
+	tests := []struct {
+		name         string
+		mode         string
+		expectedMode string
+	}{
+		{
+			name:         "default mode",
+			mode:         "",
+			expectedMode: "STRICT",
+		},
+		{
+			name:         "strict mode",
+			mode:         datamodel.MutualTLSModeStrict,
+			expectedMode: "STRICT",
+		},
+		{
+			name:         "permissive mode",
+			mode:         datamodel.MutualTLSModePermissive,
+			expectedMode: "PERMISSIVE",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			renderer := &Renderer{Inner: &noop{}}
+			options := makeOptions(datamodel.ServiceMeshIstio, tc.mode)
+
+			output, err := renderer.Render(context.Background(), makeResource(), options)
+			require.NoError(t, err)
+			require.Len(t, output.Resources, 2)
+
+			deployment, _ := kubernetes.FindDeployment(output.Resources)
+			require.NotNil(t, deployment)
+			require.Equal(t, "true", deployment.Spec.Template.Labels[IstioInjectionLabel])
+
+			peerAuthentication := output.Resources[1]
+			require.Equal(t, rpv1.LocalIDPeerAuthentication, peerAuthentication.LocalID)
+
+			un, ok := peerAuthentication.CreateResource.Data.(*unstructured.Unstructured)
+			require.True(t, ok)
+			require.Equal(t, "security.istio.io/v1beta1", un.GetAPIVersion())
+			require.Equal(t, "PeerAuthentication", un.GetKind())
+			require.Equal(t, "test-container", un.GetName())
+			require.Equal(t, "test-namespace", un.GetNamespace())
+			require.Equal(t, map[string]string{kubernetes.LabelName: "test-container"}, un.GetLabels())
+
+			matchLabels, found, err := unstructured.NestedStringMap(un.Object, "spec", "selector", "matchLabels")
+			require.NoError(t, err)
+			require.True(t, found)
+			require.Equal(t, deployment.Spec.Selector.MatchLabels, matchLabels)
+
+			mode, found, err := unstructured.NestedString(un.Object, "spec", "mtls", "mode")
+			require.NoError(t, err)
+			require.True(t, found)
+			require.Equal(t, tc.expectedMode, mode)
+		})
+	}
+}
+
+func Test_Render_Fails(t *testing.T) {
+	tests := []struct {
+		name    string
+		mesh    string
+		mode    string
+		message string
+	}{
+		{
+			name:    "unsupported mesh",
+			mesh:    "linkerd",
+			message: "service mesh \"linkerd\" is not supported for mutual TLS",
+		},
+		{
+			name:    "unsupported mode",
+			mesh:    datamodel.ServiceMeshIstio,
+			mode:    "disable",
+			message: "mutual TLS mode \"disable\" is not supported",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			renderer := &Renderer{Inner: &noop{}}
+
+			_, err := renderer.Render(context.Background(), makeResource(), makeOptions(tc.mesh, tc.mode))
+			require.Error(t, err)
+			require.Equal(t, v1.CodeInvalid, err.(*v1.ErrClientRP).Code)
+			require.Equal(t, tc.message, err.(*v1.ErrClientRP).Message)
+		})
+	}
+}
+
+func makeOptions(mesh string, mode string) renderers.RenderOptions {
+	return renderers.RenderOptions{
+		Environment: renderers.EnvironmentOptions{
+			MutualTLS: &datamodel.MutualTLSExtension{
+				Mesh: mesh,
+				Mode: mode,
+			},
+		},
+	}
+}
+
+func makeResource() *datamodel.ContainerResource {
+	resource := datamodel.ContainerResource{
+		BaseResource: v1.BaseResource{
+			TrackedResource: v1.TrackedResource{
+				ID:   "/subscriptions/test-sub-id/resourceGroups/test-group/providers/Applications.Core/containers/test-container",
+				Name: "test-container",
+				Type: "Applications.Core/containers",
+			},
+		},
+		Properties: datamodel.ContainerProperties{
+			BasicResourceProperties: rpv1.BasicResourceProperties{
+				Application: "/subscriptions/test-sub-id/resourceGroups/test-group/providers/Applications.Core/applications/test-app",
+			},
+			Container: datamodel.Container{
+				Image: "someimage:latest",
+			},
+		},
+	}
+	return &resource
+}
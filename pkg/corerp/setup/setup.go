@@ -88,6 +88,11 @@ func SetupNamespace(recipeControllerConfig *controllerconfig.RecipeControllerCon
 					return app_ctrl.NewGetGraph(opt, *recipeControllerConfig.UCPConnection)
 				},
 			},
+			"getStatus": {
+				APIController: func(opt apictrl.Options) (apictrl.Controller, error) {
+					return app_ctrl.NewGetStatus(opt, *recipeControllerConfig.UCPConnection)
+				},
+			},
 		},
 	})
 
@@ -67,6 +67,10 @@ var handlerTests = []rpctest.HandlerTestSpec{
 		OperationType: v1.OperationType{Type: app_ctrl.ResourceTypeName, Method: v1.OperationDelete},
 		Path:          "/resourcegroups/testrg/providers/applications.core/applications/app0",
 		Method:        http.MethodDelete,
+	}, {
+		OperationType: v1.OperationType{Type: app_ctrl.ResourceTypeName, Method: "ACTIONGETSTATUS"},
+		Path:          "/resourcegroups/testrg/providers/applications.core/applications/app0/getstatus",
+		Method:        http.MethodPost,
 	}, {
 		OperationType: v1.OperationType{Type: ctr_ctrl.ResourceTypeName, Method: v1.OperationPlaneScopeList},
 		Path:          "/providers/applications.core/containers",
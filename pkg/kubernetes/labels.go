@@ -44,6 +44,9 @@ const (
 
 	// AnnotationIdentityType is the annotation for supported identity.
 	AnnotationIdentityType = "radapp.io/identity-type"
+
+	// AnnotationDNSZone is the annotation for the DNS zone of a gateway, to scope external-dns to it with --annotation-filter.
+	AnnotationDNSZone = "radapp.io/dns-zone"
 )
 
 // NOTE: the difference between descriptive labels and selector labels
@@ -42,6 +42,9 @@ const (
 
 	// AsyncOperationDuration is the metric name for async operation duration.
 	AsnycOperationDuration = "asyncoperation.duration"
+
+	// PurgedOperationStatusCount is the metric name for the count of purged async operation statuses.
+	PurgedOperationStatusCount = "asyncoperation.purged.operationstatus"
 )
 
 type asyncOperationMetrics struct {
@@ -77,6 +80,11 @@ func (a *asyncOperationMetrics) Init() error {
 		return err
 	}
 
+	a.counters[PurgedOperationStatusCount], err = meter.Int64Counter(PurgedOperationStatusCount)
+	if err != nil {
+		return err
+	}
+
 	a.valueRecorders[AsnycOperationDuration], err = meter.Float64Histogram(AsnycOperationDuration)
 	if err != nil {
 		return err
@@ -123,6 +131,15 @@ func (a *asyncOperationMetrics) RecordAsyncOperationDuration(ctx context.Context
 	}
 }
 
+// RecordPurgedOperationStatuses adds the number of expired async operation statuses of the provider namespace which
+// were purged.
+func (a *asyncOperationMetrics) RecordPurgedOperationStatuses(ctx context.Context, providerNamespace string, count int) {
+	if a.counters[PurgedOperationStatusCount] != nil {
+		a.counters[PurgedOperationStatusCount].Add(ctx, int64(count),
+			metric.WithAttributes(providerAttrKey.String(normalizeAttrValue(providerNamespace))))
+	}
+}
+
 func newAsyncOperationCommonAttributes(req *ctrl.Request, res *ctrl.Result) []attribute.KeyValue {
 	attrs := make([]attribute.KeyValue, 0)
 
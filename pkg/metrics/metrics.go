@@ -22,6 +22,9 @@ var (
 
 	// DefaultRecipeEngineMetrics holds recipe engine metrics definitions.
 	DefaultRecipeEngineMetrics = newRecipeEngineMetrics()
+
+	// DefaultUCPRequestMetrics holds UCP request metrics definitions.
+	DefaultUCPRequestMetrics = newUCPRequestMetrics()
 )
 
 // InitMetrics initializes metrics for Radius.
@@ -34,5 +37,9 @@ func InitMetrics() error {
 		return err
 	}
 
+	if err := DefaultUCPRequestMetrics.Init(); err != nil {
+		return err
+	}
+
 	return nil
 }
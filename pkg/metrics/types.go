@@ -42,6 +42,15 @@ const (
 	// recipeTemplatePathAttrKey is the attribute name for the recipe template path.
 	recipeTemplatePathAttrKey = attribute.Key("recipe_template_path")
 
+	// planeAttrKey is the attribute name for the plane.
+	planeAttrKey = attribute.Key("plane")
+
+	// providerAttrKey is the attribute name for the resource provider namespace.
+	providerAttrKey = attribute.Key("provider")
+
+	// statusCodeAttrKey is the attribute name for the HTTP status code.
+	statusCodeAttrKey = attribute.Key("status_code")
+
 	// TerraformVersionAttrKey is the attribute key for the Terraform version.
 	TerraformVersionAttrKey = attribute.Key("terraform_version")
 
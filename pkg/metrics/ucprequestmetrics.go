@@ -0,0 +1,114 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"time"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+const (
+	// ucpRequestCount is the metric name for the number of requests handled by UCP.
+	ucpRequestCount = "ucp.request"
+
+	// ucpRequestErrorCount is the metric name for the number of requests handled by UCP that failed with a server error.
+	ucpRequestErrorCount = "ucp.request.error"
+
+	// ucpRequestDuration is the metric name for the duration of requests handled by UCP.
+	ucpRequestDuration = "ucp.request.duration"
+)
+
+type ucpRequestMetrics struct {
+	counters       map[string]metric.Int64Counter
+	valueRecorders map[string]metric.Float64Histogram
+}
+
+func newUCPRequestMetrics() *ucpRequestMetrics {
+	return &ucpRequestMetrics{
+		counters:       make(map[string]metric.Int64Counter),
+		valueRecorders: make(map[string]metric.Float64Histogram),
+	}
+}
+
+// Init initializes the UCP request metrics.
+func (m *ucpRequestMetrics) Init() error {
+	meter := otel.GetMeterProvider().Meter("ucp-request-metrics")
+
+	var err error
+	m.counters[ucpRequestCount], err = meter.Int64Counter(ucpRequestCount)
+	if err != nil {
+		return err
+	}
+
+	m.counters[ucpRequestErrorCount], err = meter.Int64Counter(ucpRequestErrorCount)
+	if err != nil {
+		return err
+	}
+
+	m.valueRecorders[ucpRequestDuration], err = meter.Float64Histogram(ucpRequestDuration)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// RecordRequest records the count and duration of a request handled by UCP with the given attributes. Requests
+// that completed with a server error (5xx) status code are also recorded as errors.
+func (m *ucpRequestMetrics) RecordRequest(ctx context.Context, startTime time.Time, statusCode int, attrs []attribute.KeyValue) {
+	attrs = append(attrs, statusCodeAttrKey.Int(statusCode))
+
+	if m.counters[ucpRequestCount] != nil {
+		m.counters[ucpRequestCount].Add(ctx, 1, metric.WithAttributes(attrs...))
+	}
+
+	if statusCode >= http.StatusInternalServerError && m.counters[ucpRequestErrorCount] != nil {
+		m.counters[ucpRequestErrorCount].Add(ctx, 1, metric.WithAttributes(attrs...))
+	}
+
+	if m.valueRecorders[ucpRequestDuration] != nil {
+		elapsedTime := float64(time.Since(startTime)) / float64(time.Millisecond)
+		m.valueRecorders[ucpRequestDuration].Record(ctx, elapsedTime, metric.WithAttributes(attrs...))
+	}
+}
+
+// NewUCPRequestAttributes generates the plane, provider and operation attributes for a request handled by UCP.
+func NewUCPRequestAttributes(id resources.ID, operationType v1.OperationType) []attribute.KeyValue {
+	attrs := make([]attribute.KeyValue, 0)
+
+	if plane := id.PlaneScope(); plane != "" {
+		attrs = append(attrs, planeAttrKey.String(strings.ToLower(plane)))
+	}
+
+	if provider := id.ProviderNamespace(); provider != "" {
+		attrs = append(attrs, providerAttrKey.String(strings.ToLower(provider)))
+	}
+
+	if operationType.Type != "" {
+		attrs = append(attrs, operationTypeAttrKey.String(strings.ToLower(operationType.String())))
+	}
+
+	return attrs
+}
@@ -0,0 +1,49 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package middleware
+
+import (
+	"net/http"
+	"time"
+
+	chimiddleware "github.com/go-chi/chi/v5/middleware"
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/metrics"
+)
+
+// UCPRequestMetrics is the middleware to record the count, duration and errors of requests handled by UCP, by plane,
+// resource provider and operation. It must run after the ARM request context has been added to the request.
+func UCPRequestMetrics(next http.Handler) http.Handler {
+	fn := func(w http.ResponseWriter, r *http.Request) {
+		startTime := time.Now()
+		ww := chimiddleware.NewWrapResponseWriter(w, r.ProtoMajor)
+
+		next.ServeHTTP(ww, r)
+
+		// The operation type is set on the ARM request context by the handler of the matched route.
+		rpcCtx := v1.ARMRequestContextFromContext(r.Context())
+
+		statusCode := ww.Status()
+		if statusCode == 0 {
+			statusCode = http.StatusOK
+		}
+
+		attrs := metrics.NewUCPRequestAttributes(rpcCtx.ResourceID, rpcCtx.OperationType)
+		metrics.DefaultUCPRequestMetrics.RecordRequest(r.Context(), startTime, statusCode, attrs)
+	}
+	return http.HandlerFunc(fn)
+}
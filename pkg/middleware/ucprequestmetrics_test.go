@@ -0,0 +1,129 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package middleware
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/armrpc/rpctest"
+	"github.com/radius-project/radius/pkg/metrics"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+)
+
+func TestUCPRequestMetrics(t *testing.T) {
+	tests := []struct {
+		name       string
+		statusCode int
+		errorCount int64
+	}{
+		{name: "success", statusCode: http.StatusCreated, errorCount: 0},
+		{name: "client error", statusCode: http.StatusNotFound, errorCount: 0},
+		{name: "server error", statusCode: http.StatusInternalServerError, errorCount: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reader := sdkmetric.NewManualReader()
+			setMeterProvider(t, sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader)))
+
+			called := false
+			handler := UCPRequestMetrics(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				called = true
+				v1.ARMRequestContextFromContext(r.Context()).OperationType = v1.OperationType{Type: "UCPRADIUSPROXY", Method: v1.OperationProxy}
+				w.WriteHeader(tt.statusCode)
+			}))
+
+			req, err := http.NewRequest(http.MethodPut, "/planes/radius/local/resourceGroups/test-rg/providers/Applications.Core/applications/test-app?api-version=2023-10-01-preview", nil)
+			require.NoError(t, err)
+			req = req.WithContext(rpctest.NewARMRequestContext(req))
+
+			w := httptest.NewRecorder()
+			handler.ServeHTTP(w, req)
+
+			require.True(t, called)
+			require.Equal(t, tt.statusCode, w.Code)
+
+			rm := metricdata.ResourceMetrics{}
+			require.NoError(t, reader.Collect(context.Background(), &rm))
+
+			expectedAttrs := attribute.NewSet(
+				attribute.String("plane", "/planes/radius/local"),
+				attribute.String("provider", "applications.core"),
+				attribute.String("operation_type", "ucpradiusproxy|proxy"),
+				attribute.Int("status_code", tt.statusCode),
+			)
+
+			requests, ok := findMetric(rm, "ucp.request").Data.(metricdata.Sum[int64])
+			require.True(t, ok)
+			require.Len(t, requests.DataPoints, 1)
+			require.Equal(t, int64(1), requests.DataPoints[0].Value)
+			require.True(t, expectedAttrs.Equals(&requests.DataPoints[0].Attributes), requests.DataPoints[0].Attributes.Encoded(attribute.DefaultEncoder()))
+
+			duration, ok := findMetric(rm, "ucp.request.duration").Data.(metricdata.Histogram[float64])
+			require.True(t, ok)
+			require.Len(t, duration.DataPoints, 1)
+			require.Equal(t, uint64(1), duration.DataPoints[0].Count)
+			require.True(t, expectedAttrs.Equals(&duration.DataPoints[0].Attributes))
+
+			// Only server errors are recorded as errors.
+			errorMetric := findMetric(rm, "ucp.request.error")
+			if tt.errorCount == 0 {
+				require.Nil(t, errorMetric.Data)
+			} else {
+				errorCount, ok := errorMetric.Data.(metricdata.Sum[int64])
+				require.True(t, ok)
+				require.Len(t, errorCount.DataPoints, 1)
+				require.Equal(t, tt.errorCount, errorCount.DataPoints[0].Value)
+				require.True(t, expectedAttrs.Equals(&errorCount.DataPoints[0].Attributes))
+			}
+		})
+	}
+}
+
+// setMeterProvider sets the global meter provider and reinitializes the UCP request metrics for the duration of
+// the test.
+func setMeterProvider(t *testing.T, provider *sdkmetric.MeterProvider) {
+	previous := otel.GetMeterProvider()
+	otel.SetMeterProvider(provider)
+	require.NoError(t, metrics.DefaultUCPRequestMetrics.Init())
+
+	t.Cleanup(func() {
+		otel.SetMeterProvider(previous)
+		require.NoError(t, metrics.DefaultUCPRequestMetrics.Init())
+	})
+}
+
+// findMetric returns the metric with the given name, or an empty metric if it was not recorded.
+func findMetric(rm metricdata.ResourceMetrics, name string) metricdata.Metrics {
+	for _, sm := range rm.ScopeMetrics {
+		for _, m := range sm.Metrics {
+			if m.Name == name {
+				return m
+			}
+		}
+	}
+
+	return metricdata.Metrics{}
+}
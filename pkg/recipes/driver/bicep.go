@@ -229,7 +229,13 @@ func (d *bicepDriver) Delete(ctx context.Context, opts DeleteOptions) error {
 				if err != nil {
 					if attempt <= d.options.DeleteRetryCount {
 						logger.V(ucplog.LevelInfo).Error(err, "attempt failed", "delay", d.options.DeleteRetryDelaySeconds)
-						time.Sleep(time.Duration(d.options.DeleteRetryDelaySeconds) * time.Second)
+
+						// Stop retrying if the operation was canceled or another deletion failed.
+						select {
+						case <-groupCtx.Done():
+							return recipes.NewRecipeError(recipes.RecipeDeletionFailed, groupCtx.Err().Error(), "", recipes.GetErrorDetails(err))
+						case <-time.After(time.Duration(d.options.DeleteRetryDelaySeconds) * time.Second):
+						}
 						continue
 					}
 
@@ -17,6 +17,8 @@ limitations under the License.
 package driver
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"strings"
 	"testing"
@@ -459,6 +461,40 @@ func Test_Bicep_Delete_Error(t *testing.T) {
 	require.Equal(t, err, &recipeError)
 }
 
+func Test_Bicep_Delete_Canceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(testcontext.New(t))
+	driver, client := setupDeleteInputs(t)
+	driver.options.DeleteRetryCount = 5
+	driver.options.DeleteRetryDelaySeconds = 60
+	outputResources := []rpv1.OutputResource{
+		{
+			ID: resources_kubernetes.IDFromParts(
+				resources_kubernetes.PlaneNameTODO,
+				"core",
+				"Deployment",
+				"recipe-app",
+				"redis"),
+			RadiusManaged: to.Ptr(true),
+		},
+	}
+	client.EXPECT().
+		Delete(gomock.Any(), "/planes/kubernetes/local/namespaces/recipe-app/providers/core/Deployment/redis").
+		DoAndReturn(func(ctx context.Context, id string) error {
+			cancel()
+			return errors.New("resource is still in use")
+		}).
+		Times(1)
+
+	err := driver.Delete(ctx, DeleteOptions{
+		OutputResources: outputResources,
+	})
+	require.Error(t, err)
+	recipeError, ok := err.(*recipes.RecipeError)
+	require.True(t, ok)
+	require.Equal(t, recipes.RecipeDeletionFailed, recipeError.ErrorDetails.Code)
+	require.Equal(t, context.Canceled.Error(), recipeError.ErrorDetails.Message)
+}
+
 func Test_Bicep_GetRecipeMetadata_Success(t *testing.T) {
 	ts := registrytest.NewFakeRegistryServer(t)
 	t.Cleanup(ts.CloseServer)
@@ -107,7 +107,11 @@ func Install(ctx context.Context, installer *install.Installer, tfDir string) (*
 					metrics.OperationStateAttrKey.String(metrics.FailedOperationState),
 				},
 			)
-			time.Sleep(time.Duration(installVerificationRetryDelaySecs) * time.Second)
+			select {
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			case <-time.After(time.Duration(installVerificationRetryDelaySecs) * time.Second):
+			}
 			continue
 		}
 		return nil, fmt.Errorf("failed to verify Terraform installation completion after %d attempts. Error: %s", installVerificationRetryCount, err.Error())
@@ -53,7 +53,9 @@ const (
 	LocalIDDaprPubSubBrokerKafka        = "DaprPubSubBrokerKafka"
 	LocalIDDeployment                   = "Deployment"
 	LocalIDGateway                      = "Gateway"
+	LocalIDHeadlessService              = "HeadlessService"
 	LocalIDHttpProxy                    = "HttpProxy"
+	LocalIDPeerAuthentication           = "PeerAuthentication"
 	LocalIDKeyVault                     = "KeyVault"
 	LocalIDSecret                       = "Secret"
 	LocalIDConfigMap                    = "ConfigMap"
@@ -65,6 +67,7 @@ const (
 	LocalIDUserAssignedManagedIdentity  = "UserAssignedManagedIdentity"
 	LocalIDFederatedIdentity            = "FederatedIdentity"
 	LocalIDRoleAssignmentPrefix         = "RoleAssignment"
+	LocalIDPrivateEndpointPrefix        = "PrivateEndpoint"
 
 	// Obsolete when we remove AppModelV1
 	LocalIDRoleAssignmentKVKeys = "RoleAssignment-KVKeys"
@@ -91,6 +91,10 @@ func (w *AsyncWorker) Run(ctx context.Context) error {
 		if w.Options.Config.WorkerServer.MaxOperationRetryCount != nil {
 			workerOpts.MaxOperationRetryCount = *w.Options.Config.WorkerServer.MaxOperationRetryCount
 		}
+		workerOpts.OperationConcurrencyLimits = w.Options.Config.WorkerServer.OperationConcurrencyLimits
+		workerOpts.OperationStatusRetention = w.Options.Config.WorkerServer.OperationStatusRetention
+		workerOpts.OperationStatusSweepInterval = w.Options.Config.WorkerServer.OperationStatusSweepInterval
+		workerOpts.DrainTimeout = w.Options.Config.WorkerServer.DrainTimeout
 	}
 
 	return w.Start(ctx, workerOpts)
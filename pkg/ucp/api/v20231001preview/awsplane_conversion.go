@@ -38,7 +38,11 @@ func (src *AwsPlaneResource) ConvertTo() (v1.DataModelInterface, error) {
 				UpdatedAPIVersion: Version,
 			},
 		},
-		Properties: datamodel.AWSPlaneProperties{}, // Empty
+		Properties: datamodel.AWSPlaneProperties{},
+	}
+
+	if src.Properties != nil {
+		converted.Properties.Policy = toAWSPlanePolicyDataModel(src.Properties.Policy)
 	}
 
 	return converted, nil
@@ -60,7 +64,30 @@ func (dst *AwsPlaneResource) ConvertFrom(src v1.DataModelInterface) error {
 
 	dst.Properties = &AwsPlaneResourceProperties{
 		ProvisioningState: fromProvisioningStateDataModel(plane.InternalMetadata.AsyncProvisioningState),
+		Policy:            fromAWSPlanePolicyDataModel(plane.Properties.Policy),
 	}
 
 	return nil
 }
+
+func toAWSPlanePolicyDataModel(policy *AwsPlanePolicy) *datamodel.AWSPlanePolicy {
+	if policy == nil {
+		return nil
+	}
+
+	return &datamodel.AWSPlanePolicy{
+		AllowedRegions:  stringSlice(policy.AllowedRegions),
+		AllowedAccounts: stringSlice(policy.AllowedAccounts),
+	}
+}
+
+func fromAWSPlanePolicyDataModel(policy *datamodel.AWSPlanePolicy) *AwsPlanePolicy {
+	if policy == nil {
+		return nil
+	}
+
+	return &AwsPlanePolicy{
+		AllowedRegions:  to.SliceOfPtrs(policy.AllowedRegions...),
+		AllowedAccounts: to.SliceOfPtrs(policy.AllowedAccounts...),
+	}
+}
@@ -54,6 +54,31 @@ func Test_AWSPlane_ConvertVersionedToDataModel(t *testing.T) {
 				Properties: datamodel.AWSPlaneProperties{},
 			},
 		},
+		{
+			filename: "awsplane-resource-policy.json",
+			expected: &datamodel.AWSPlane{
+				BaseResource: v1.BaseResource{
+					TrackedResource: v1.TrackedResource{
+						ID:       "/planes/aws/aws",
+						Name:     "aws",
+						Type:     "System.AWS/planes",
+						Location: "global",
+						Tags: map[string]string{
+							"env": "dev",
+						},
+					},
+					InternalMetadata: v1.InternalMetadata{
+						UpdatedAPIVersion: Version,
+					},
+				},
+				Properties: datamodel.AWSPlaneProperties{
+					Policy: &datamodel.AWSPlanePolicy{
+						AllowedRegions:  []string{"us-west-2", "us-east-1"},
+						AllowedAccounts: []string{"123456789012"},
+					},
+				},
+			},
+		},
 	}
 
 	for _, tt := range conversionTests {
@@ -97,6 +122,25 @@ func Test_AWSPlane_ConvertDataModelToVersioned(t *testing.T) {
 				},
 			},
 		},
+		{
+			filename: "awsplane-datamodel-policy.json",
+			expected: &AwsPlaneResource{
+				ID:       to.Ptr("/planes/aws/aws"),
+				Name:     to.Ptr("aws"),
+				Type:     to.Ptr("System.AWS/planes"),
+				Location: to.Ptr("global"),
+				Tags: map[string]*string{
+					"env": to.Ptr("dev"),
+				},
+				Properties: &AwsPlaneResourceProperties{
+					ProvisioningState: fromProvisioningStateDataModel(v1.ProvisioningStateSucceeded),
+					Policy: &AwsPlanePolicy{
+						AllowedRegions:  to.SliceOfPtrs("us-west-2", "us-east-1"),
+						AllowedAccounts: to.SliceOfPtrs("123456789012"),
+					},
+				},
+			},
+		},
 	}
 
 	for _, tt := range conversionTests {
@@ -40,3 +40,14 @@ func fromSystemDataModel(s v1.SystemData) *SystemData {
 		LastModifiedAt:     v1.UnmarshalTimeString(s.LastModifiedAt),
 	}
 }
+
+func stringSlice(s []*string) []string {
+	if s == nil {
+		return nil
+	}
+	var r []string
+	for _, v := range s {
+		r = append(r, *v)
+	}
+	return r
+}
@@ -0,0 +1,122 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v20231001preview
+
+import (
+	"encoding/json"
+	"testing"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/radius-project/radius/test/testutil"
+	"github.com/radius-project/radius/test/testutil/resourcetypeutil"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Lock_ConvertVersionedToDataModel(t *testing.T) {
+	conversionTests := []struct {
+		filename string
+		expected *datamodel.Lock
+		err      error
+	}{
+		{
+			filename: "lock-resource.json",
+			expected: &datamodel.Lock{
+				BaseResource: v1.BaseResource{
+					TrackedResource: v1.TrackedResource{
+						ID:       "/planes/radius/local/resourceGroups/test-rg/providers/System.Resources/locks/test-lock",
+						Name:     "test-lock",
+						Type:     datamodel.LockResourceType,
+						Location: "global",
+						Tags:     map[string]string{},
+					},
+					InternalMetadata: v1.InternalMetadata{
+						UpdatedAPIVersion: Version,
+					},
+				},
+				Properties: datamodel.LockProperties{
+					Level: datamodel.LockLevelCanNotDelete,
+					Notes: "Do not delete the production environment.",
+				},
+			},
+		},
+		{
+			filename: "lock-resource-invalid-level.json",
+			err:      &v1.ErrModelConversion{PropertyName: "$.properties.level", ValidValue: "one of CanNotDelete, ReadOnly"},
+		},
+	}
+
+	for _, tt := range conversionTests {
+		t.Run(tt.filename, func(t *testing.T) {
+			rawPayload := testutil.ReadFixture(tt.filename)
+			r := &LockResource{}
+			err := json.Unmarshal(rawPayload, r)
+			require.NoError(t, err)
+
+			dm, err := r.ConvertTo()
+
+			if tt.err != nil {
+				require.Equal(t, tt.err, err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tt.expected, dm.(*datamodel.Lock))
+			}
+		})
+	}
+}
+
+func Test_Lock_ConvertDataModelToVersioned(t *testing.T) {
+	rawPayload := testutil.ReadFixture("lock-datamodel.json")
+	r := &datamodel.Lock{}
+	err := json.Unmarshal(rawPayload, r)
+	require.NoError(t, err)
+
+	versioned := &LockResource{}
+	err = versioned.ConvertFrom(r)
+	require.NoError(t, err)
+
+	expected := &LockResource{
+		ID:       to.Ptr("/planes/radius/local/resourceGroups/test-rg/providers/System.Resources/locks/test-lock"),
+		Name:     to.Ptr("test-lock"),
+		Type:     to.Ptr(datamodel.LockResourceType),
+		Location: to.Ptr("global"),
+		Tags:     map[string]*string{},
+		Properties: &LockProperties{
+			Level:             to.Ptr(LockLevelReadOnly),
+			ProvisioningState: to.Ptr(ProvisioningStateSucceeded),
+		},
+	}
+	require.Equal(t, expected, versioned)
+}
+
+func Test_Lock_ConvertFromValidation(t *testing.T) {
+	validationTests := []struct {
+		src v1.DataModelInterface
+		err error
+	}{
+		{&resourcetypeutil.FakeResource{}, v1.ErrInvalidModelConversion},
+		{nil, v1.ErrInvalidModelConversion},
+	}
+
+	for _, tc := range validationTests {
+		versioned := &LockResource{}
+		err := versioned.ConvertFrom(tc.src)
+		require.ErrorIs(t, err, tc.err)
+	}
+}
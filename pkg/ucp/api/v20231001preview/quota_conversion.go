@@ -0,0 +1,96 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v20231001preview
+
+import (
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+)
+
+const (
+	QuotaType = "System.Resources/quotas"
+)
+
+// ConvertTo converts from the versioned Quota resource to version-agnostic datamodel.
+func (src *QuotaResource) ConvertTo() (v1.DataModelInterface, error) {
+	// Note: SystemData conversion isn't required since this property comes ARM and datastore.
+
+	converted := &datamodel.Quota{
+		BaseResource: v1.BaseResource{
+			TrackedResource: v1.TrackedResource{
+				ID:       to.String(src.ID),
+				Name:     to.String(src.Name),
+				Type:     to.String(src.Type),
+				Location: to.String(src.Location),
+				Tags:     to.StringMap(src.Tags),
+			},
+			InternalMetadata: v1.InternalMetadata{
+				UpdatedAPIVersion: Version,
+			},
+		},
+	}
+
+	if src.Properties != nil {
+		if src.Properties.MaxResources != nil {
+			if *src.Properties.MaxResources < 0 {
+				return nil, &v1.ErrModelConversion{PropertyName: "$.properties.maxResources", ValidValue: "a non-negative integer"}
+			}
+			converted.Properties.MaxResources = int(*src.Properties.MaxResources)
+		}
+
+		if len(src.Properties.MaxResourcesPerType) > 0 {
+			converted.Properties.MaxResourcesPerType = map[string]int{}
+			for resourceType, limit := range src.Properties.MaxResourcesPerType {
+				if limit == nil || *limit < 0 {
+					return nil, &v1.ErrModelConversion{PropertyName: "$.properties.maxResourcesPerType['" + resourceType + "']", ValidValue: "a non-negative integer"}
+				}
+				converted.Properties.MaxResourcesPerType[resourceType] = int(*limit)
+			}
+		}
+	}
+
+	return converted, nil
+}
+
+// ConvertFrom converts from version-agnostic datamodel to the versioned Quota resource.
+func (dst *QuotaResource) ConvertFrom(src v1.DataModelInterface) error {
+	quota, ok := src.(*datamodel.Quota)
+	if !ok {
+		return v1.ErrInvalidModelConversion
+	}
+
+	dst.ID = to.Ptr(quota.ID)
+	dst.Name = to.Ptr(quota.Name)
+	dst.Type = to.Ptr(quota.Type)
+	dst.Location = to.Ptr(quota.Location)
+	dst.Tags = *to.StringMapPtr(quota.Tags)
+	dst.Properties = &QuotaProperties{
+		ProvisioningState: fromProvisioningStateDataModel(quota.InternalMetadata.AsyncProvisioningState),
+	}
+	if quota.Properties.MaxResources != 0 {
+		dst.Properties.MaxResources = to.Ptr(int32(quota.Properties.MaxResources))
+	}
+	if len(quota.Properties.MaxResourcesPerType) > 0 {
+		dst.Properties.MaxResourcesPerType = map[string]*int32{}
+		for resourceType, limit := range quota.Properties.MaxResourcesPerType {
+			dst.Properties.MaxResourcesPerType[resourceType] = to.Ptr(int32(limit))
+		}
+	}
+
+	return nil
+}
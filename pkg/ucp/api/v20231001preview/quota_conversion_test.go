@@ -0,0 +1,126 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v20231001preview
+
+import (
+	"encoding/json"
+	"testing"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/radius-project/radius/test/testutil"
+	"github.com/radius-project/radius/test/testutil/resourcetypeutil"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Quota_ConvertVersionedToDataModel(t *testing.T) {
+	conversionTests := []struct {
+		filename string
+		expected *datamodel.Quota
+		err      error
+	}{
+		{
+			filename: "quota-resource.json",
+			expected: &datamodel.Quota{
+				BaseResource: v1.BaseResource{
+					TrackedResource: v1.TrackedResource{
+						ID:       "/planes/radius/local/resourceGroups/test-rg/providers/System.Resources/quotas/test-quota",
+						Name:     "test-quota",
+						Type:     datamodel.QuotaResourceType,
+						Location: "global",
+						Tags:     map[string]string{},
+					},
+					InternalMetadata: v1.InternalMetadata{
+						UpdatedAPIVersion: Version,
+					},
+				},
+				Properties: datamodel.QuotaProperties{
+					MaxResources: 10,
+					MaxResourcesPerType: map[string]int{
+						"Applications.Core/containers": 5,
+					},
+				},
+			},
+		},
+		{
+			filename: "quota-resource-invalid-limit.json",
+			err:      &v1.ErrModelConversion{PropertyName: "$.properties.maxResources", ValidValue: "a non-negative integer"},
+		},
+	}
+
+	for _, tt := range conversionTests {
+		t.Run(tt.filename, func(t *testing.T) {
+			rawPayload := testutil.ReadFixture(tt.filename)
+			r := &QuotaResource{}
+			err := json.Unmarshal(rawPayload, r)
+			require.NoError(t, err)
+
+			dm, err := r.ConvertTo()
+
+			if tt.err != nil {
+				require.Equal(t, tt.err, err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tt.expected, dm.(*datamodel.Quota))
+			}
+		})
+	}
+}
+
+func Test_Quota_ConvertDataModelToVersioned(t *testing.T) {
+	rawPayload := testutil.ReadFixture("quota-datamodel.json")
+	r := &datamodel.Quota{}
+	err := json.Unmarshal(rawPayload, r)
+	require.NoError(t, err)
+
+	versioned := &QuotaResource{}
+	err = versioned.ConvertFrom(r)
+	require.NoError(t, err)
+
+	expected := &QuotaResource{
+		ID:       to.Ptr("/planes/radius/local/resourceGroups/test-rg/providers/System.Resources/quotas/test-quota"),
+		Name:     to.Ptr("test-quota"),
+		Type:     to.Ptr(datamodel.QuotaResourceType),
+		Location: to.Ptr("global"),
+		Tags:     map[string]*string{},
+		Properties: &QuotaProperties{
+			MaxResourcesPerType: map[string]*int32{
+				"Applications.Core/containers": to.Ptr(int32(3)),
+			},
+			ProvisioningState: to.Ptr(ProvisioningStateSucceeded),
+		},
+	}
+	require.Equal(t, expected, versioned)
+}
+
+func Test_Quota_ConvertFromValidation(t *testing.T) {
+	validationTests := []struct {
+		src v1.DataModelInterface
+		err error
+	}{
+		{&resourcetypeutil.FakeResource{}, v1.ErrInvalidModelConversion},
+		{nil, v1.ErrInvalidModelConversion},
+	}
+
+	for _, tc := range validationTests {
+		versioned := &QuotaResource{}
+		err := versioned.ConvertFrom(tc.src)
+		require.ErrorIs(t, err, tc.err)
+	}
+}
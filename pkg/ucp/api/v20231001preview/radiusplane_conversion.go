@@ -41,6 +41,7 @@ func (src *RadiusPlaneResource) ConvertTo() (v1.DataModelInterface, error) {
 
 		Properties: datamodel.RadiusPlaneProperties{
 			ResourceProviders: to.StringMap(src.Properties.ResourceProviders),
+			TLS:               toRadiusPlaneTLSDataModel(src.Properties.TLS),
 		},
 	}
 
@@ -64,7 +65,35 @@ func (dst *RadiusPlaneResource) ConvertFrom(src v1.DataModelInterface) error {
 	dst.Properties = &RadiusPlaneResourceProperties{
 		ProvisioningState: fromProvisioningStateDataModel(plane.InternalMetadata.AsyncProvisioningState),
 		ResourceProviders: *to.StringMapPtr(plane.Properties.ResourceProviders),
+		TLS:               fromRadiusPlaneTLSDataModel(plane.Properties.TLS),
 	}
 
 	return nil
 }
+
+func toRadiusPlaneTLSDataModel(properties *RadiusPlaneTLSProperties) *datamodel.RadiusPlaneTLS {
+	if properties == nil {
+		return nil
+	}
+
+	return &datamodel.RadiusPlaneTLS{
+		CABundle:                to.String(properties.CaBundle),
+		ClientCertificateSecret: to.String(properties.ClientCertificateSecret),
+	}
+}
+
+func fromRadiusPlaneTLSDataModel(properties *datamodel.RadiusPlaneTLS) *RadiusPlaneTLSProperties {
+	if properties == nil {
+		return nil
+	}
+
+	converted := &RadiusPlaneTLSProperties{}
+	if properties.CABundle != "" {
+		converted.CaBundle = to.Ptr(properties.CABundle)
+	}
+	if properties.ClientCertificateSecret != "" {
+		converted.ClientCertificateSecret = to.Ptr(properties.ClientCertificateSecret)
+	}
+
+	return converted
+}
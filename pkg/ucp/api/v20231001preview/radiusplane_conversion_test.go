@@ -58,6 +58,34 @@ func Test_RadiusPlane_ConvertVersionedToDataModel(t *testing.T) {
 				},
 			},
 		},
+		{
+			filename: "radiusplane-resource-tls.json",
+			expected: &datamodel.RadiusPlane{
+				BaseResource: v1.BaseResource{
+					TrackedResource: v1.TrackedResource{
+						ID:       "/planes/radius/local",
+						Name:     "local",
+						Type:     "System.Radius/planes",
+						Location: "global",
+						Tags: map[string]string{
+							"env": "dev",
+						},
+					},
+					InternalMetadata: v1.InternalMetadata{
+						UpdatedAPIVersion: Version,
+					},
+				},
+				Properties: datamodel.RadiusPlaneProperties{
+					ResourceProviders: map[string]string{
+						"Applications.Core": "https://applications-rp:9443",
+					},
+					TLS: &datamodel.RadiusPlaneTLS{
+						CABundle:                "-----BEGIN CERTIFICATE-----\nMIIB\n-----END CERTIFICATE-----\n",
+						ClientCertificateSecret: "applications-rp-client",
+					},
+				},
+			},
+		},
 	}
 
 	for _, tt := range conversionTests {
@@ -104,6 +132,28 @@ func Test_RadiusPlane_ConvertDataModelToVersioned(t *testing.T) {
 				},
 			},
 		},
+		{
+			filename: "radiusplane-datamodel-tls.json",
+			expected: &RadiusPlaneResource{
+				ID:       to.Ptr("/planes/radius/local"),
+				Name:     to.Ptr("local"),
+				Type:     to.Ptr("System.Radius/planes"),
+				Location: to.Ptr("global"),
+				Tags: map[string]*string{
+					"env": to.Ptr("dev"),
+				},
+				Properties: &RadiusPlaneResourceProperties{
+					ProvisioningState: fromProvisioningStateDataModel(v1.ProvisioningStateSucceeded),
+					ResourceProviders: map[string]*string{
+						"Applications.Core": to.Ptr("https://applications-rp:9443"),
+					},
+					TLS: &RadiusPlaneTLSProperties{
+						CaBundle:                to.Ptr("-----BEGIN CERTIFICATE-----\nMIIB\n-----END CERTIFICATE-----\n"),
+						ClientCertificateSecret: to.Ptr("applications-rp-client"),
+					},
+				},
+			},
+		},
 	}
 
 	for _, tt := range conversionTests {
@@ -83,6 +83,24 @@ func PossibleCredentialStorageKindValues() []CredentialStorageKind {
 	}
 }
 
+// LockLevel - The level of a management lock.
+type LockLevel string
+
+const (
+	// LockLevelCanNotDelete - Resources in the locked scope can be read and modified but not deleted.
+	LockLevelCanNotDelete LockLevel = "CanNotDelete"
+	// LockLevelReadOnly - Resources in the locked scope can be read but not modified or deleted.
+	LockLevelReadOnly LockLevel = "ReadOnly"
+)
+
+// PossibleLockLevelValues returns the possible values for the LockLevel const type.
+func PossibleLockLevelValues() []LockLevel {
+	return []LockLevel{	
+		LockLevelCanNotDelete,
+		LockLevelReadOnly,
+	}
+}
+
 // ProvisioningState - Provisioning state of the resource at the time the operation was called
 type ProvisioningState string
 
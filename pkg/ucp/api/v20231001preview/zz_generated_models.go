@@ -110,6 +110,15 @@ func (a *AwsIRSACredentialProperties) GetAwsCredentialProperties() *AwsCredentia
 	}
 }
 
+// AwsPlanePolicy - The AWS plane policy.
+type AwsPlanePolicy struct {
+	// The AWS account IDs that requests may target. All accounts are allowed when empty.
+	AllowedAccounts []*string
+
+	// The AWS regions that requests may target. All regions are allowed when empty.
+	AllowedRegions []*string
+}
+
 // AwsPlaneResource - The AWS plane resource
 type AwsPlaneResource struct {
 	// REQUIRED; The geo-location where the resource lives
@@ -145,6 +154,9 @@ type AwsPlaneResourceListResult struct {
 
 // AwsPlaneResourceProperties - The Plane properties.
 type AwsPlaneResourceProperties struct {
+	// The policy restricting the AWS accounts and regions that requests through the plane may target.
+	Policy *AwsPlanePolicy
+
 	// READ-ONLY; The status of the asynchronous operation.
 	ProvisioningState *ProvisioningState
 }
@@ -453,6 +465,51 @@ func (i *InternalCredentialStorageProperties) GetCredentialStorageProperties() *
 	}
 }
 
+// LockProperties - The management lock properties
+type LockProperties struct {
+	// REQUIRED; The level of the lock.
+	Level *LockLevel
+
+	// Notes describing the reason for the lock.
+	Notes *string
+
+	// READ-ONLY; The status of the asynchronous operation.
+	ProvisioningState *ProvisioningState
+}
+
+// LockResource - A management lock on a Radius plane or resource group.
+type LockResource struct {
+	// REQUIRED; The geo-location where the resource lives
+	Location *string
+
+	// The resource-specific properties for this resource.
+	Properties *LockProperties
+
+	// Resource tags.
+	Tags map[string]*string
+
+	// READ-ONLY; Fully qualified resource ID for the resource. Ex - /subscriptions/{subscriptionId}/resourceGroups/{resourceGroupName}/providers/{resourceProviderNamespace}/{resourceType}/{resourceName}
+	ID *string
+
+	// READ-ONLY; The name of the resource
+	Name *string
+
+	// READ-ONLY; Azure Resource Manager metadata containing createdBy and modifiedBy information.
+	SystemData *SystemData
+
+	// READ-ONLY; The type of the resource. E.g. "Microsoft.Compute/virtualMachines" or "Microsoft.Storage/storageAccounts"
+	Type *string
+}
+
+// LockResourceListResult - The response of a LockResource list operation.
+type LockResourceListResult struct {
+	// REQUIRED; The LockResource items on this page
+	Value []*LockResource
+
+	// The link to the next page of items
+	NextLink *string
+}
+
 // PlaneNameParameter - The Plane Name parameter.
 type PlaneNameParameter struct {
 	// REQUIRED; The name of the plane
@@ -475,6 +532,51 @@ type ProxyResource struct {
 	Type *string
 }
 
+// QuotaProperties - The resource group quota properties
+type QuotaProperties struct {
+	// The maximum number of resources in the resource group.
+	MaxResources *int32
+
+	// The maximum number of resources in the resource group, keyed by fully-qualified resource type.
+	MaxResourcesPerType map[string]*int32
+
+	// READ-ONLY; The status of the asynchronous operation.
+	ProvisioningState *ProvisioningState
+}
+
+// QuotaResource - A limit on the number of resources that can be created in a resource group.
+type QuotaResource struct {
+	// REQUIRED; The geo-location where the resource lives
+	Location *string
+
+	// The resource-specific properties for this resource.
+	Properties *QuotaProperties
+
+	// Resource tags.
+	Tags map[string]*string
+
+	// READ-ONLY; Fully qualified resource ID for the resource. Ex - /subscriptions/{subscriptionId}/resourceGroups/{resourceGroupName}/providers/{resourceProviderNamespace}/{resourceType}/{resourceName}
+	ID *string
+
+	// READ-ONLY; The name of the resource
+	Name *string
+
+	// READ-ONLY; Azure Resource Manager metadata containing createdBy and modifiedBy information.
+	SystemData *SystemData
+
+	// READ-ONLY; The type of the resource. E.g. "Microsoft.Compute/virtualMachines" or "Microsoft.Storage/storageAccounts"
+	Type *string
+}
+
+// QuotaResourceListResult - The response of a QuotaResource list operation.
+type QuotaResourceListResult struct {
+	// REQUIRED; The QuotaResource items on this page
+	Value []*QuotaResource
+
+	// The link to the next page of items
+	NextLink *string
+}
+
 // RadiusPlaneResource - The Radius plane resource.
 type RadiusPlaneResource struct {
 	// REQUIRED; The geo-location where the resource lives
@@ -513,6 +615,9 @@ type RadiusPlaneResourceProperties struct {
 	// REQUIRED; Resource Providers for UCP Native Plane
 	ResourceProviders map[string]*string
 
+	// The TLS configuration used when connecting to the resource providers of the plane.
+	TLS *RadiusPlaneTLSProperties
+
 	// READ-ONLY; The status of the asynchronous operation.
 	ProvisioningState *ProvisioningState
 }
@@ -523,6 +628,15 @@ type RadiusPlaneResourceTagsUpdate struct {
 	Tags map[string]*string
 }
 
+// RadiusPlaneTLSProperties - The TLS configuration used when connecting to downstream resource providers.
+type RadiusPlaneTLSProperties struct {
+	// PEM encoded bundle of CA certificates to trust in addition to the system trust store.
+	CaBundle *string
+
+	// The name of the UCP secret containing the client certificate and private key used for mutual TLS.
+	ClientCertificateSecret *string
+}
+
 // Resource - Common fields that are returned in the response for all Azure Resource Manager resources
 type Resource struct {
 	// READ-ONLY; Fully qualified resource ID for the resource. Ex - /subscriptions/{subscriptionId}/resourceGroups/{resourceGroupName}/providers/{resourceProviderNamespace}/{resourceType}/{resourceName}
@@ -151,6 +151,11 @@ type RadiusPlanesClientListOptions struct {
 	// placeholder for future optional parameters
 }
 
+// RadiusPlanesClientRestoreOptions contains the optional parameters for the RadiusPlanesClient.Restore method.
+type RadiusPlanesClientRestoreOptions struct {
+	// placeholder for future optional parameters
+}
+
 // ResourceGroupsClientCreateOrUpdateOptions contains the optional parameters for the ResourceGroupsClient.CreateOrUpdate
 // method.
 type ResourceGroupsClientCreateOrUpdateOptions struct {
@@ -172,6 +177,11 @@ type ResourceGroupsClientListOptions struct {
 	// placeholder for future optional parameters
 }
 
+// ResourceGroupsClientRestoreOptions contains the optional parameters for the ResourceGroupsClient.Restore method.
+type ResourceGroupsClientRestoreOptions struct {
+	// placeholder for future optional parameters
+}
+
 // ResourceGroupsClientUpdateOptions contains the optional parameters for the ResourceGroupsClient.Update method.
 type ResourceGroupsClientUpdateOptions struct {
 	// placeholder for future optional parameters
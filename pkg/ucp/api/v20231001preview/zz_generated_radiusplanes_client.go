@@ -271,6 +271,57 @@ func (client *RadiusPlanesClient) listHandleResponse(resp *http.Response) (Radiu
 	return result, nil
 }
 
+// Restore - Restore a deleted plane
+// If the operation fails it returns an *azcore.ResponseError type.
+//
+// Generated from API version 2023-10-01-preview
+//   - planeName - The plane name.
+//   - options - RadiusPlanesClientRestoreOptions contains the optional parameters for the RadiusPlanesClient.Restore method.
+func (client *RadiusPlanesClient) Restore(ctx context.Context, planeName string, options *RadiusPlanesClientRestoreOptions) (RadiusPlanesClientRestoreResponse, error) {
+	var err error
+	req, err := client.restoreCreateRequest(ctx, planeName, options)
+	if err != nil {
+		return RadiusPlanesClientRestoreResponse{}, err
+	}
+	httpResp, err := client.internal.Pipeline().Do(req)
+	if err != nil {
+		return RadiusPlanesClientRestoreResponse{}, err
+	}
+	if !runtime.HasStatusCode(httpResp, http.StatusOK) {
+		err = runtime.NewResponseError(httpResp)
+		return RadiusPlanesClientRestoreResponse{}, err
+	}
+	resp, err := client.restoreHandleResponse(httpResp)
+	return resp, err
+}
+
+// restoreCreateRequest creates the Restore request.
+func (client *RadiusPlanesClient) restoreCreateRequest(ctx context.Context, planeName string, options *RadiusPlanesClientRestoreOptions) (*policy.Request, error) {
+	urlPath := "/planes/radius/{planeName}/restore"
+	if planeName == "" {
+		return nil, errors.New("parameter planeName cannot be empty")
+	}
+	urlPath = strings.ReplaceAll(urlPath, "{planeName}", url.PathEscape(planeName))
+	req, err := runtime.NewRequest(ctx, http.MethodPost, runtime.JoinPaths(client.internal.Endpoint(), urlPath))
+	if err != nil {
+		return nil, err
+	}
+	reqQP := req.Raw().URL.Query()
+	reqQP.Set("api-version", "2023-10-01-preview")
+	req.Raw().URL.RawQuery = reqQP.Encode()
+	req.Raw().Header["Accept"] = []string{"application/json"}
+	return req, nil
+}
+
+// restoreHandleResponse handles the Restore response.
+func (client *RadiusPlanesClient) restoreHandleResponse(resp *http.Response) (RadiusPlanesClientRestoreResponse, error) {
+	result := RadiusPlanesClientRestoreResponse{}
+	if err := runtime.UnmarshalAsJSON(resp, &result.RadiusPlaneResource); err != nil {
+		return RadiusPlanesClientRestoreResponse{}, err
+	}
+	return result, nil
+}
+
 // BeginUpdate - Update a plane
 // If the operation fails it returns an *azcore.ResponseError type.
 //
@@ -262,6 +262,62 @@ func (client *ResourceGroupsClient) listHandleResponse(resp *http.Response) (Res
 	return result, nil
 }
 
+// Restore - Restore a deleted resource group
+// If the operation fails it returns an *azcore.ResponseError type.
+//
+// Generated from API version 2023-10-01-preview
+//   - planeName - The plane name.
+//   - resourceGroupName - The name of resource group
+//   - options - ResourceGroupsClientRestoreOptions contains the optional parameters for the ResourceGroupsClient.Restore method.
+func (client *ResourceGroupsClient) Restore(ctx context.Context, planeName string, resourceGroupName string, options *ResourceGroupsClientRestoreOptions) (ResourceGroupsClientRestoreResponse, error) {
+	var err error
+	req, err := client.restoreCreateRequest(ctx, planeName, resourceGroupName, options)
+	if err != nil {
+		return ResourceGroupsClientRestoreResponse{}, err
+	}
+	httpResp, err := client.internal.Pipeline().Do(req)
+	if err != nil {
+		return ResourceGroupsClientRestoreResponse{}, err
+	}
+	if !runtime.HasStatusCode(httpResp, http.StatusOK) {
+		err = runtime.NewResponseError(httpResp)
+		return ResourceGroupsClientRestoreResponse{}, err
+	}
+	resp, err := client.restoreHandleResponse(httpResp)
+	return resp, err
+}
+
+// restoreCreateRequest creates the Restore request.
+func (client *ResourceGroupsClient) restoreCreateRequest(ctx context.Context, planeName string, resourceGroupName string, options *ResourceGroupsClientRestoreOptions) (*policy.Request, error) {
+	urlPath := "/planes/radius/{planeName}/resourcegroups/{resourceGroupName}/restore"
+	if planeName == "" {
+		return nil, errors.New("parameter planeName cannot be empty")
+	}
+	urlPath = strings.ReplaceAll(urlPath, "{planeName}", url.PathEscape(planeName))
+	if resourceGroupName == "" {
+		return nil, errors.New("parameter resourceGroupName cannot be empty")
+	}
+	urlPath = strings.ReplaceAll(urlPath, "{resourceGroupName}", url.PathEscape(resourceGroupName))
+	req, err := runtime.NewRequest(ctx, http.MethodPost, runtime.JoinPaths(client.internal.Endpoint(), urlPath))
+	if err != nil {
+		return nil, err
+	}
+	reqQP := req.Raw().URL.Query()
+	reqQP.Set("api-version", "2023-10-01-preview")
+	req.Raw().URL.RawQuery = reqQP.Encode()
+	req.Raw().Header["Accept"] = []string{"application/json"}
+	return req, nil
+}
+
+// restoreHandleResponse handles the Restore response.
+func (client *ResourceGroupsClient) restoreHandleResponse(resp *http.Response) (ResourceGroupsClientRestoreResponse, error) {
+	result := ResourceGroupsClientRestoreResponse{}
+	if err := runtime.UnmarshalAsJSON(resp, &result.ResourceGroupResource); err != nil {
+		return ResourceGroupsClientRestoreResponse{}, err
+	}
+	return result, nil
+}
+
 // Update - Update a resource group
 // If the operation fails it returns an *azcore.ResponseError type.
 //
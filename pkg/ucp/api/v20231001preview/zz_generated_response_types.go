@@ -152,6 +152,12 @@ type RadiusPlanesClientListResponse struct {
 	RadiusPlaneResourceListResult
 }
 
+// RadiusPlanesClientRestoreResponse contains the response from method RadiusPlanesClient.Restore.
+type RadiusPlanesClientRestoreResponse struct {
+	// The Radius plane resource.
+	RadiusPlaneResource
+}
+
 // RadiusPlanesClientUpdateResponse contains the response from method RadiusPlanesClient.BeginUpdate.
 type RadiusPlanesClientUpdateResponse struct {
 	// The Radius plane resource.
@@ -181,6 +187,12 @@ type ResourceGroupsClientListResponse struct {
 	ResourceGroupResourceListResult
 }
 
+// ResourceGroupsClientRestoreResponse contains the response from method ResourceGroupsClient.Restore.
+type ResourceGroupsClientRestoreResponse struct {
+	// The resource group resource
+	ResourceGroupResource
+}
+
 // ResourceGroupsClientUpdateResponse contains the response from method ResourceGroupsClient.Update.
 type ResourceGroupsClientUpdateResponse struct {
 	// The resource group resource
@@ -19,6 +19,7 @@ package backend
 import (
 	"context"
 	"fmt"
+	"time"
 
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
 	ctrl "github.com/radius-project/radius/pkg/armrpc/asyncoperation/controller"
@@ -33,6 +34,16 @@ const (
 	UCPProviderName = "System.Resources"
 )
 
+// trackedResourceRetryPolicy is the retry policy of tracked resource processing. The operation is requeued while the
+// downstream resource is still being provisioned, so it backs off rather than polling the downstream resource
+// provider whenever the message lock expires.
+var trackedResourceRetryPolicy = worker.RetryPolicy{
+	InitialDelay: 5 * time.Second,
+	Multiplier:   2,
+	MaxDelay:     2 * time.Minute,
+	MaxAttempts:  10,
+}
+
 // Service is a service to run AsyncReqeustProcessWorker.
 type Service struct {
 	worker.Service
@@ -68,6 +79,10 @@ func (w *Service) Run(ctx context.Context) error {
 		if w.Options.Config.WorkerServer.MaxOperationRetryCount != nil {
 			workerOpts.MaxOperationRetryCount = *w.Options.Config.WorkerServer.MaxOperationRetryCount
 		}
+		workerOpts.OperationConcurrencyLimits = w.Options.Config.WorkerServer.OperationConcurrencyLimits
+		workerOpts.OperationStatusRetention = w.Options.Config.WorkerServer.OperationStatusRetention
+		workerOpts.OperationStatusSweepInterval = w.Options.Config.WorkerServer.OperationStatusSweepInterval
+		workerOpts.DrainTimeout = w.Options.Config.WorkerServer.DrainTimeout
 	}
 
 	opts := ctrl.Options{
@@ -84,7 +99,7 @@ func (w *Service) Run(ctx context.Context) error {
 
 // RegisterControllers registers the controllers for the UCP backend.
 func RegisterControllers(ctx context.Context, registry *worker.ControllerRegistry, opts ctrl.Options) error {
-	err := registry.Register(ctx, v20231001preview.ResourceType, v1.OperationMethod(datamodel.OperationProcess), resourcegroups.NewTrackedResourceProcessController, opts)
+	err := registry.Register(ctx, v20231001preview.ResourceType, v1.OperationMethod(datamodel.OperationProcess), resourcegroups.NewTrackedResourceProcessController, opts, worker.WithRetryPolicy(trackedResourceRetryPolicy))
 	if err != nil {
 		return err
 	}
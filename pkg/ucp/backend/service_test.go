@@ -0,0 +1,47 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backend
+
+import (
+	"context"
+	"testing"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	ctrl "github.com/radius-project/radius/pkg/armrpc/asyncoperation/controller"
+	"github.com/radius-project/radius/pkg/armrpc/asyncoperation/worker"
+	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/radius-project/radius/pkg/ucp/dataprovider"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_RegisterControllers(t *testing.T) {
+	mockSP := dataprovider.NewMockDataStorageProvider(gomock.NewController(t))
+	mockSP.EXPECT().GetStorageClient(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+	registry := worker.NewControllerRegistry(mockSP)
+
+	err := RegisterControllers(context.Background(), registry, ctrl.Options{DataProvider: mockSP})
+	require.NoError(t, err)
+
+	opType := v1.OperationType{Type: v20231001preview.ResourceType, Method: v1.OperationMethod(datamodel.OperationProcess)}
+	require.NotNil(t, registry.Get(opType))
+
+	policy, ok := registry.RetryPolicy(opType)
+	require.True(t, ok)
+	require.Equal(t, trackedResourceRetryPolicy, policy)
+}
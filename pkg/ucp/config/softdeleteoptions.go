@@ -0,0 +1,30 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package config
+
+import "time"
+
+// SoftDeleteOptions represents the configuration for soft-deleting planes and resource groups.
+type SoftDeleteOptions struct {
+	// RetentionPeriod is the duration that a deleted plane or resource group can be restored for. Soft-delete is
+	// disabled when the retention period is not set.
+	RetentionPeriod time.Duration `yaml:"retentionPeriod,omitempty"`
+
+	// PurgeInterval is the interval between purges of the deleted planes and resource groups whose retention period
+	// has elapsed. Defaults to one hour.
+	PurgeInterval time.Duration `yaml:"purgeInterval,omitempty"`
+}
@@ -0,0 +1,66 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package config
+
+import "time"
+
+// WebhookType is the type of a webhook registered with UCP.
+type WebhookType string
+
+const (
+	// WebhookTypeMutating webhooks are called before a PUT is proxied and may modify the request body.
+	WebhookTypeMutating WebhookType = "Mutating"
+
+	// WebhookTypeValidating webhooks are called before a PUT is proxied, after mutating webhooks, and may reject the request.
+	WebhookTypeValidating WebhookType = "Validating"
+
+	// WebhookTypeNotify webhooks are called after a DELETE has been accepted by the resource provider.
+	WebhookTypeNotify WebhookType = "Notify"
+)
+
+// WebhookFailurePolicy defines how UCP handles a webhook that cannot be reached or returns an invalid response.
+type WebhookFailurePolicy string
+
+const (
+	// WebhookFailurePolicyFail rejects the request when the webhook fails. This is the default.
+	WebhookFailurePolicyFail WebhookFailurePolicy = "Fail"
+
+	// WebhookFailurePolicyIgnore allows the request to continue when the webhook fails.
+	WebhookFailurePolicyIgnore WebhookFailurePolicy = "Ignore"
+)
+
+// WebhookOptions represents the configuration of an admission-style webhook for Radius resources.
+type WebhookOptions struct {
+	// Name is the name of the webhook, used in logs and error messages.
+	Name string `yaml:"name"`
+
+	// Type is the type of the webhook.
+	Type WebhookType `yaml:"type"`
+
+	// URL is the endpoint that will receive webhook requests.
+	URL string `yaml:"url"`
+
+	// ResourceTypes is the list of fully-qualified resource types the webhook applies to. The value "*" matches
+	// all resource types.
+	ResourceTypes []string `yaml:"resourceTypes"`
+
+	// FailurePolicy defines how failures calling the webhook are handled. Notify webhooks are always fail-open.
+	FailurePolicy WebhookFailurePolicy `yaml:"failurePolicy,omitempty"`
+
+	// Timeout is the timeout for calling the webhook. Defaults to 10 seconds.
+	Timeout time.Duration `yaml:"timeout,omitempty"`
+}
@@ -17,11 +17,49 @@ limitations under the License.
 package datamodel
 
 import (
+	"strings"
+
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
 )
 
 // AwsPlaneProperties is the properties of an AWS plane.
 type AWSPlaneProperties struct {
+	// Policy restricts the AWS accounts and regions that requests through the plane may target.
+	Policy *AWSPlanePolicy `json:"policy,omitempty"`
+}
+
+// AWSPlanePolicy is the policy of an AWS plane.
+type AWSPlanePolicy struct {
+	// AllowedRegions is the list of AWS regions that requests may target. An empty list allows all regions.
+	AllowedRegions []string `json:"allowedRegions,omitempty"`
+
+	// AllowedAccounts is the list of AWS account IDs that requests may target. An empty list allows all accounts.
+	AllowedAccounts []string `json:"allowedAccounts,omitempty"`
+}
+
+// IsRegionAllowed returns true if the policy permits requests targeting the given region.
+func (p *AWSPlanePolicy) IsRegionAllowed(region string) bool {
+	return p == nil || containsFold(p.AllowedRegions, region)
+}
+
+// IsAccountAllowed returns true if the policy permits requests targeting the given account.
+func (p *AWSPlanePolicy) IsAccountAllowed(account string) bool {
+	return p == nil || containsFold(p.AllowedAccounts, account)
+}
+
+// containsFold returns true if values is empty or contains value, ignoring case.
+func containsFold(values []string, value string) bool {
+	if len(values) == 0 {
+		return true
+	}
+
+	for _, v := range values {
+		if strings.EqualFold(v, value) {
+			return true
+		}
+	}
+
+	return false
 }
 
 // AWSPlane is the representation of an AWS plane.
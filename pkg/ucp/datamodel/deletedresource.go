@@ -0,0 +1,59 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datamodel
+
+import (
+	"time"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+)
+
+const (
+	// DeletedResourceType is the resource type used to store soft-deleted planes and resource groups.
+	DeletedResourceType = "System.Resources/deletedResources"
+)
+
+// DeletedResource represents a soft-deleted plane or resource group. The deleted resource can be restored
+// until its retention period expires.
+type DeletedResource struct {
+	v1.BaseResource
+
+	// Properties is the properties of the resource.
+	Properties DeletedResourceProperties `json:"properties"`
+}
+
+// DeletedResourceProperties is the properties of a soft-deleted plane or resource group.
+type DeletedResourceProperties struct {
+	// DeletedAt is the time that the resource was deleted.
+	DeletedAt time.Time `json:"deletedAt"`
+
+	// ExpiresAt is the time after which the resource can no longer be restored.
+	ExpiresAt time.Time `json:"expiresAt"`
+
+	// Data is the stored representation of the resource at the time it was deleted.
+	Data map[string]any `json:"data"`
+}
+
+// ResourceTypeName returns a string representing the resource type name of the DeletedResource object.
+func (d DeletedResource) ResourceTypeName() string {
+	return DeletedResourceType
+}
+
+// IsExpired returns true if the retention period of the deleted resource has elapsed.
+func (d DeletedResource) IsExpired(now time.Time) bool {
+	return !now.Before(d.Properties.ExpiresAt)
+}
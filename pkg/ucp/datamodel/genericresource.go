@@ -66,6 +66,12 @@ type GenericResourceProperties struct {
 	// APIVersion is the version of the API that can be used to query the resource.
 	APIVersion string `json:"apiVersion"`
 
+	// Tags are the tags of the resource as last reported by the downstream API.
+	Tags map[string]string `json:"tags,omitempty"`
+
+	// Application is the application the resource belongs to, if any.
+	Application string `json:"application,omitempty"`
+
 	// OperationID is the last operation that updated this entry. This is used when an operation
 	// is enqueued as a way to force a different Etag to be returned. This data doesn't need to be
 	// read or used, it's just acting as a "salt" for the Etag.
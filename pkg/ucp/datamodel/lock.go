@@ -0,0 +1,73 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datamodel
+
+import (
+	"net/http"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+)
+
+const (
+	// LockResourceType is the resource type of a management lock.
+	LockResourceType = "System.Resources/locks"
+)
+
+// LockLevel is the level of a management lock.
+type LockLevel string
+
+const (
+	// LockLevelCanNotDelete prevents resources in the locked scope from being deleted.
+	LockLevelCanNotDelete LockLevel = "CanNotDelete"
+
+	// LockLevelReadOnly prevents resources in the locked scope from being created, updated, or deleted.
+	LockLevelReadOnly LockLevel = "ReadOnly"
+)
+
+// Lock represents a management lock on a Radius plane or resource group.
+type Lock struct {
+	v1.BaseResource
+
+	// Properties is the properties of the lock.
+	Properties LockProperties `json:"properties"`
+}
+
+// LockProperties is the properties of a management lock.
+type LockProperties struct {
+	// Level is the level of the lock.
+	Level LockLevel `json:"level"`
+
+	// Notes describes the reason for the lock.
+	Notes string `json:"notes,omitempty"`
+}
+
+// ResourceTypeName returns a string representing the resource type name of the Lock object.
+func (l Lock) ResourceTypeName() string {
+	return LockResourceType
+}
+
+// Blocks returns true if the lock prevents a request with the given HTTP method.
+func (l Lock) Blocks(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return false
+	case http.MethodDelete:
+		return true
+	default:
+		return l.Properties.Level == LockLevelReadOnly
+	}
+}
@@ -0,0 +1,48 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datamodel
+
+import (
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+)
+
+const (
+	// QuotaResourceType is the resource type of a resource group quota.
+	QuotaResourceType = "System.Resources/quotas"
+)
+
+// Quota represents a limit on the number of resources that can be created in a resource group.
+type Quota struct {
+	v1.BaseResource
+
+	// Properties is the properties of the quota.
+	Properties QuotaProperties `json:"properties"`
+}
+
+// QuotaProperties is the properties of a resource group quota. A limit of zero means no limit.
+type QuotaProperties struct {
+	// MaxResources is the maximum number of resources in the resource group.
+	MaxResources int `json:"maxResources,omitempty"`
+
+	// MaxResourcesPerType is the maximum number of resources in the resource group, keyed by resource type.
+	MaxResourcesPerType map[string]int `json:"maxResourcesPerType,omitempty"`
+}
+
+// ResourceTypeName returns a string representing the resource type name of the Quota object.
+func (q Quota) ResourceTypeName() string {
+	return QuotaResourceType
+}
@@ -27,6 +27,30 @@ type RadiusPlaneProperties struct {
 
 	// ResourceProviders is a map of the support resource providers.
 	ResourceProviders map[string]string `json:"resourceProviders"`
+
+	// ResourceTypes is a map of fully-qualified resource type names (eg: Applications.Core/applications) to the
+	// resource types registered by the resource provider manifests. Resource providers without registered types
+	// accept any resource type.
+	ResourceTypes map[string]RadiusPlaneResourceType `json:"resourceTypes,omitempty"`
+
+	// TLS is the TLS configuration used when connecting to the resource providers of the plane.
+	TLS *RadiusPlaneTLS `json:"tls,omitempty"`
+}
+
+// RadiusPlaneResourceType is a resource type registered with a Radius plane.
+type RadiusPlaneResourceType struct {
+	// APIVersions is the list of API versions supported by the resource type.
+	APIVersions []string `json:"apiVersions,omitempty"`
+}
+
+// RadiusPlaneTLS is the TLS configuration used when connecting to downstream resource providers.
+type RadiusPlaneTLS struct {
+	// CABundle is a PEM encoded bundle of CA certificates to trust in addition to the system trust store.
+	CABundle string `json:"caBundle,omitempty"`
+
+	// ClientCertificateSecret is the name of the UCP secret containing the client certificate and private key
+	// presented to resource providers for mutual TLS.
+	ClientCertificateSecret string `json:"clientCertificateSecret,omitempty"`
 }
 
 // RadiusPlane is the representation of a Radius plane.
@@ -53,3 +77,49 @@ func (plane RadiusPlane) LookupResourceProvider(key string) string {
 	}
 	return value
 }
+
+// systemResourceTypes are the top-level resource types implemented by every resource provider.
+var systemResourceTypes = []string{"locations", "operations", "operationStatuses", "operationResults"}
+
+// IsResourceTypeSupported checks if the resource type and API version are registered with the plane. Only resource
+// providers with registered types are restricted. An empty API version matches any registered API version.
+func (plane RadiusPlane) IsResourceTypeSupported(resourceType string, apiVersion string) bool {
+	namespace, typeName, found := strings.Cut(resourceType, "/")
+	if !found {
+		return true
+	}
+
+	topLevelType, _, _ := strings.Cut(typeName, "/")
+	for _, systemType := range systemResourceTypes {
+		if strings.EqualFold(topLevelType, systemType) {
+			return true
+		}
+	}
+
+	restricted := false
+	for k, v := range plane.Properties.ResourceTypes {
+		registeredNamespace, registeredType, _ := strings.Cut(k, "/")
+		if !strings.EqualFold(registeredNamespace, namespace) {
+			continue
+		}
+
+		restricted = true
+		if !strings.EqualFold(registeredType, topLevelType) {
+			continue
+		}
+
+		if apiVersion == "" {
+			return true
+		}
+
+		for _, version := range v.APIVersions {
+			if strings.EqualFold(version, apiVersion) {
+				return true
+			}
+		}
+
+		return false
+	}
+
+	return !restricted
+}
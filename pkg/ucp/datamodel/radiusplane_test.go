@@ -0,0 +1,54 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datamodel
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRadiusPlane_IsResourceTypeSupported(t *testing.T) {
+	plane := RadiusPlane{
+		Properties: RadiusPlaneProperties{
+			ResourceTypes: map[string]RadiusPlaneResourceType{
+				"Applications.Core/applications": {APIVersions: []string{"2023-10-01-preview"}},
+			},
+		},
+	}
+
+	tests := []struct {
+		resourceType string
+		apiVersion   string
+		supported    bool
+	}{
+		{"Applications.Core/applications", "2023-10-01-preview", true},
+		{"applications.core/APPLICATIONS", "2023-10-01-preview", true},
+		{"Applications.Core/applications", "", true},
+		{"Applications.Core/applications", "2022-03-15-privatepreview", false},
+		{"Applications.Core/containers", "2023-10-01-preview", false},
+		{"Applications.Core/locations/operationStatuses", "2023-10-01-preview", true},
+		{"Applications.Dapr/stateStores", "2023-10-01-preview", true},
+		{"", "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.resourceType+"@"+tt.apiVersion, func(t *testing.T) {
+			require.Equal(t, tt.supported, plane.IsResourceTypeSupported(tt.resourceType, tt.apiVersion))
+		})
+	}
+}
@@ -20,13 +20,21 @@ import (
 	context "context"
 	"errors"
 	"fmt"
+	"net/url"
+	"sync"
 
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
 	"github.com/radius-project/radius/pkg/kubeutil"
 	store "github.com/radius-project/radius/pkg/ucp/store"
 	"github.com/radius-project/radius/pkg/ucp/store/apiserverstore"
 	ucpv1alpha1 "github.com/radius-project/radius/pkg/ucp/store/apiserverstore/api/ucp.dev/v1alpha1"
 	"github.com/radius-project/radius/pkg/ucp/store/cosmosdb"
+	"github.com/radius-project/radius/pkg/ucp/store/encryption"
 	"github.com/radius-project/radius/pkg/ucp/store/etcdstore"
+	"github.com/radius-project/radius/pkg/ucp/store/offload"
+	"github.com/radius-project/radius/pkg/ucp/store/partition"
+	etcdclient "go.etcd.io/etcd/client/v3"
 	"k8s.io/apimachinery/pkg/runtime"
 
 	runtimeclient "sigs.k8s.io/controller-runtime/pkg/client"
@@ -115,5 +123,92 @@ func InitETCDClient(ctx context.Context, opt StorageProviderOptions, _ string) (
 	}
 
 	etcdClient := etcdstore.NewETCDClient(client)
+	if opt.ETCD.Cache {
+		return sharedETCDCache(client, etcdClient), nil
+	}
+
 	return etcdClient, nil
 }
+
+var (
+	etcdCaches   = map[*etcdclient.Client]*etcdstore.CachedClient{}
+	etcdCachesMu sync.Mutex
+)
+
+// sharedETCDCache returns the cache of the etcd client. The storage clients of all resource types share the cache
+// since they share the keys of the etcd client. The cache is maintained until the etcd client is closed.
+func sharedETCDCache(client *etcdclient.Client, inner *etcdstore.ETCDClient) *etcdstore.CachedClient {
+	etcdCachesMu.Lock()
+	defer etcdCachesMu.Unlock()
+
+	cache, ok := etcdCaches[client]
+	if !ok {
+		cache = etcdstore.NewCachedClient(client.Ctx(), inner, etcdstore.CacheOptions{})
+		etcdCaches[client] = cache
+	}
+
+	return cache
+}
+
+// initOffloadClient wraps the client to offload large payloads to the configured object storage. The client is
+// returned as-is if offloading is not configured.
+func initOffloadClient(ctx context.Context, opt StorageProviderOptions, client store.StorageClient) (store.StorageClient, error) {
+	var blobs offload.BlobStore
+	switch opt.Offload.Provider {
+	case "":
+		return client, nil
+	case TypeAzureBlob:
+		containerURL, err := url.Parse(opt.Offload.AzureBlob.ContainerURL)
+		if err != nil {
+			return nil, fmt.Errorf("failed to initialize offload client: invalid container URL: %w", err)
+		}
+
+		// A SAS token in the container URL authorizes the requests.
+		var credential azcore.TokenCredential
+		if containerURL.RawQuery == "" {
+			credential, err = azidentity.NewDefaultAzureCredential(nil)
+			if err != nil {
+				return nil, fmt.Errorf("failed to initialize offload client: %w", err)
+			}
+		}
+
+		blobs, err = offload.NewAzureBlobStore(opt.Offload.AzureBlob.ContainerURL, credential, nil)
+		if err != nil {
+			return nil, fmt.Errorf("failed to initialize offload client: %w", err)
+		}
+	default:
+		return nil, fmt.Errorf("failed to initialize offload client: unsupported provider %q", opt.Offload.Provider)
+	}
+
+	return offload.NewStorageClient(client, blobs, offload.Options{Threshold: opt.Offload.Threshold}), nil
+}
+
+// initEncryptionClient wraps the client to encrypt secret fields with keys from the configured provider. The client
+// is returned as-is if encryption is not configured.
+func initEncryptionClient(ctx context.Context, opt StorageProviderOptions, client store.StorageClient) (store.StorageClient, error) {
+	var keys encryption.KeyProvider
+	switch opt.Encryption.Provider {
+	case "":
+		return client, nil
+	case TypeKeyring:
+		keyring, err := encryption.LoadKeyring(opt.Encryption.Keyring.Directory, opt.Encryption.Keyring.Primary)
+		if err != nil {
+			return nil, fmt.Errorf("failed to initialize encryption client: %w", err)
+		}
+		keys = keyring
+	default:
+		return nil, fmt.Errorf("failed to initialize encryption client: unsupported provider %q", opt.Encryption.Provider)
+	}
+
+	return encryption.NewStorageClient(client, keys, encryption.Options{Fields: opt.Encryption.Fields}), nil
+}
+
+// initPartitionClient wraps the client to isolate the data of each tenant in its own partition. The client is returned
+// as-is if partitioning is not enabled.
+func initPartitionClient(opt StorageProviderOptions, client store.StorageClient) store.StorageClient {
+	if !opt.Partition.Enabled {
+		return client
+	}
+
+	return partition.NewStorageClient(client)
+}
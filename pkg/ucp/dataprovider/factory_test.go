@@ -0,0 +1,68 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dataprovider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/radius-project/radius/pkg/ucp/store"
+	"github.com/radius-project/radius/pkg/ucp/store/offload"
+	"github.com/radius-project/radius/pkg/ucp/store/partition"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_InitOffloadClient(t *testing.T) {
+	inner := store.NewMockStorageClient(gomock.NewController(t))
+
+	t.Run("not configured", func(t *testing.T) {
+		client, err := initOffloadClient(context.Background(), StorageProviderOptions{}, inner)
+		require.NoError(t, err)
+		require.Same(t, inner, client)
+	})
+
+	t.Run("azure blob", func(t *testing.T) {
+		options := StorageProviderOptions{
+			Offload: OffloadOptions{
+				Provider:  TypeAzureBlob,
+				AzureBlob: AzureBlobOptions{ContainerURL: "https://account.blob.core.windows.net/payloads?sig=test"},
+			},
+		}
+
+		client, err := initOffloadClient(context.Background(), options, inner)
+		require.NoError(t, err)
+		require.IsType(t, &offload.StorageClient{}, client)
+	})
+
+	t.Run("unsupported provider", func(t *testing.T) {
+		options := StorageProviderOptions{Offload: OffloadOptions{Provider: "s3"}}
+
+		_, err := initOffloadClient(context.Background(), options, inner)
+		require.ErrorContains(t, err, "unsupported provider \"s3\"")
+	})
+}
+
+func Test_InitPartitionClient(t *testing.T) {
+	inner := store.NewMockStorageClient(gomock.NewController(t))
+
+	client := initPartitionClient(StorageProviderOptions{}, inner)
+	require.Same(t, inner, client)
+
+	client = initPartitionClient(StorageProviderOptions{Partition: PartitionOptions{Enabled: true}}, inner)
+	require.IsType(t, &partition.StorageClient{}, client)
+}
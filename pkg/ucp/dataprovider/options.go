@@ -34,6 +34,66 @@ type StorageProviderOptions struct {
 
 	// ETCD configures options for the etcd store. Will be ignored if another store is configured.
 	ETCD ETCDOptions `yaml:"etcd,omitempty"`
+
+	// Offload configures offloading of large payloads to object storage. Payloads are kept in the store if not configured.
+	Offload OffloadOptions `yaml:"offload,omitempty"`
+
+	// Encryption configures encryption of secret fields at rest. Secrets are stored as-is if not configured.
+	Encryption EncryptionOptions `yaml:"encryption,omitempty"`
+
+	// Partition configures partitioning of the data by tenant. All data is stored in a single partition if not configured.
+	Partition PartitionOptions `yaml:"partition,omitempty"`
+}
+
+// PartitionOptions represents options for partitioning the data of multiple tenants.
+type PartitionOptions struct {
+	// Enabled configures whether the data of each tenant is isolated in its own partition. The tenant of a request is
+	// read from the X-Ms-Home-Tenant-Id header once the request is authenticated as coming from ARM. Other requests use
+	// the default partition.
+	Enabled bool `yaml:"enabled,omitempty"`
+}
+
+// EncryptionOptions represents options for encrypting secret fields at rest.
+type EncryptionOptions struct {
+	// Provider configures the provider of the key encryption keys. Encryption is disabled if empty.
+	Provider EncryptionProviderType `yaml:"provider,omitempty"`
+
+	// Fields configures the dotted paths of the secret fields, where '*' matches any key of a map. The secret fields
+	// of the Radius data models are used if empty.
+	Fields []string `yaml:"fields,omitempty"`
+
+	// Keyring configures options for the local keyring. Will be ignored if another provider is configured.
+	Keyring KeyringOptions `yaml:"keyring,omitempty"`
+}
+
+// KeyringOptions represents options for loading the key encryption keys from a local directory.
+type KeyringOptions struct {
+	// Directory configures the directory holding the keys, such as a mounted Kubernetes secret. Each file holds one
+	// base64 encoded AES-256 key and is named by its key id.
+	Directory string `yaml:"directory"`
+
+	// Primary configures the id of the key used to encrypt new objects. The other keys are only used to decrypt
+	// objects that were encrypted before a key rotation.
+	Primary string `yaml:"primary"`
+}
+
+// OffloadOptions represents options for offloading large payloads to object storage.
+type OffloadOptions struct {
+	// Provider configures the object storage provider. Offloading is disabled if empty.
+	Provider OffloadProviderType `yaml:"provider,omitempty"`
+
+	// Threshold configures the payload size in bytes above which the payload is offloaded. A default is used if zero.
+	Threshold int `yaml:"threshold,omitempty"`
+
+	// AzureBlob configures options for Azure Blob Storage. Will be ignored if another provider is configured.
+	AzureBlob AzureBlobOptions `yaml:"azureblob,omitempty"`
+}
+
+// AzureBlobOptions represents options for storing offloaded payloads in Azure Blob Storage.
+type AzureBlobOptions struct {
+	// ContainerURL configures the URL of the blob container, eg: https://<account>.blob.core.windows.net/<container>.
+	// If the URL includes a SAS token it is used to authorize requests, otherwise the default Azure credential is used.
+	ContainerURL string `yaml:"containerUrl"`
 }
 
 // APIServerOptions represents options for the configuring the Kubernetes APIServer store.
@@ -64,4 +124,8 @@ type ETCDOptions struct {
 	// NOTE: when we run etcd in memory it will be registered as its own hosting.Service with its own startup/shutdown lifecyle.
 	// We need a way to share state between the etcd service and the things that want to consume it. This is that.
 	Client *hosting.AsyncValue[etcdclient.Client] `yaml:"-"`
+
+	// Cache configures the etcd store to serve reads from an in-memory copy of etcd, which is kept up to date with a
+	// watch. Reads are served by etcd if not configured.
+	Cache bool `yaml:"cache,omitempty"`
 }
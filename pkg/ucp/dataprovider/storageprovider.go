@@ -78,6 +78,16 @@ func (p *storageProvider) GetStorageClient(ctx context.Context, resourceType str
 		}
 
 		if c, err = fn(ctx, p.options, cn); err == nil {
+			c, err = initOffloadClient(ctx, p.options, c)
+		}
+		if err == nil {
+			// Secrets are encrypted before offloading so that offloaded payloads never hold plaintext secrets.
+			c, err = initEncryptionClient(ctx, p.options, c)
+		}
+		if err == nil {
+			c = initPartitionClient(p.options, c)
+		}
+		if err == nil {
 			p.clients[cn] = c
 		}
 	} else {
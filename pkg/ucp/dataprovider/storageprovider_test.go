@@ -0,0 +1,106 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dataprovider
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/radius-project/radius/pkg/ucp/data"
+	"github.com/radius-project/radius/pkg/ucp/hosting"
+	"github.com/radius-project/radius/pkg/ucp/store"
+	"github.com/radius-project/radius/pkg/ucp/store/etcdstore"
+	"github.com/radius-project/radius/test/testcontext"
+	"github.com/stretchr/testify/require"
+	etcdclient "go.etcd.io/etcd/client/v3"
+)
+
+func Test_StorageProvider_Encryption(t *testing.T) {
+	ctx, cancel := testcontext.NewWithCancel(t)
+	t.Cleanup(cancel)
+
+	config := hosting.NewAsyncValue[etcdclient.Client]()
+	service := data.NewEmbeddedETCDService(data.EmbeddedETCDServiceOptions{ClientConfigSink: config})
+	go func() {
+		// The test logger must not be used after the test finishes, so etcd runs with a background context.
+		_ = service.Run(context.Background())
+	}()
+
+	etcdc, err := config.Get(ctx)
+	require.NoError(t, err)
+
+	// The keyring is loaded from a directory, as when mounting a Kubernetes secret.
+	keyDir := t.TempDir()
+	key := make([]byte, 32)
+	_, err = rand.Read(key)
+	require.NoError(t, err)
+	err = os.WriteFile(filepath.Join(keyDir, "key-1"), []byte(base64.StdEncoding.EncodeToString(key)), 0600)
+	require.NoError(t, err)
+
+	provider := NewStorageProvider(StorageProviderOptions{
+		Provider: TypeETCD,
+		ETCD:     ETCDOptions{InMemory: true, Client: config},
+		Encryption: EncryptionOptions{
+			Provider: TypeKeyring,
+			Keyring:  KeyringOptions{Directory: keyDir, Primary: "key-1"},
+		},
+	})
+
+	client, err := provider.GetStorageClient(ctx, "Applications.Datastores/redisCaches")
+	require.NoError(t, err)
+
+	id := "/planes/radius/local/resourceGroups/test-rg/providers/Applications.Datastores/redisCaches/cache"
+	resource := map[string]any{
+		"name": "cache",
+		"properties": map[string]any{
+			"host":    "localhost",
+			"secrets": map[string]any{"password": "p@ssw0rd"},
+		},
+	}
+
+	err = client.Save(ctx, &store.Object{Metadata: store.Metadata{ID: id}, Data: resource})
+	require.NoError(t, err)
+
+	// The value stored in etcd holds the ciphertext of the secret.
+	raw, err := etcdstore.NewETCDClient(etcdc).Get(ctx, id)
+	require.NoError(t, err)
+	b, err := json.Marshal(raw.Data)
+	require.NoError(t, err)
+	require.NotContains(t, string(b), "p@ssw0rd")
+	require.Contains(t, string(b), "localhost")
+
+	// Reading through the provider returns the plaintext.
+	obj, err := client.Get(ctx, id)
+	require.NoError(t, err)
+	require.Equal(t, resource, obj.Data)
+
+	t.Run("unsupported provider", func(t *testing.T) {
+		_, err := initEncryptionClient(ctx, StorageProviderOptions{Encryption: EncryptionOptions{Provider: "kms"}}, client)
+		require.ErrorContains(t, err, "unsupported provider \"kms\"")
+	})
+
+	t.Run("missing keyring", func(t *testing.T) {
+		options := StorageProviderOptions{Encryption: EncryptionOptions{Provider: TypeKeyring, Keyring: KeyringOptions{Directory: filepath.Join(keyDir, "missing"), Primary: "key-1"}}}
+		_, err := initEncryptionClient(ctx, options, client)
+		require.Error(t, err)
+	})
+}
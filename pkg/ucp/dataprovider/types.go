@@ -36,6 +36,22 @@ const (
 	TypeETCD StorageProviderType = "etcd"
 )
 
+// OffloadProviderType represents types of object storage provider for offloaded payloads.
+type OffloadProviderType string
+
+const (
+	// TypeAzureBlob represents the Azure Blob Storage provider.
+	TypeAzureBlob OffloadProviderType = "azureblob"
+)
+
+// EncryptionProviderType represents types of provider for the key encryption keys.
+type EncryptionProviderType string
+
+const (
+	// TypeKeyring represents the local keyring provider.
+	TypeKeyring EncryptionProviderType = "keyring"
+)
+
 //go:generate mockgen -typed -destination=./mock_datastorage_provider.go -package=dataprovider -self_package github.com/radius-project/radius/pkg/ucp/dataprovider github.com/radius-project/radius/pkg/ucp/dataprovider DataStorageProvider
 
 // DataStorageProvider is an interfae to provide storage client.
@@ -30,6 +30,7 @@ import (
 	armrpc_controller "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
 	"github.com/radius-project/radius/pkg/armrpc/frontend/defaultoperation"
 	"github.com/radius-project/radius/pkg/armrpc/servicecontext"
+	"github.com/radius-project/radius/pkg/kubeutil"
 	"github.com/radius-project/radius/pkg/middleware"
 	"github.com/radius-project/radius/pkg/sdk"
 	"github.com/radius-project/radius/pkg/ucp/datamodel"
@@ -42,6 +43,7 @@ import (
 	"github.com/radius-project/radius/pkg/ucp/frontend/versions"
 	"github.com/radius-project/radius/pkg/ucp/hosting"
 	"github.com/radius-project/radius/pkg/ucp/hostoptions"
+	"github.com/radius-project/radius/pkg/ucp/manifest"
 	queueprovider "github.com/radius-project/radius/pkg/ucp/queue/provider"
 	"github.com/radius-project/radius/pkg/ucp/resources"
 	"github.com/radius-project/radius/pkg/ucp/rest"
@@ -53,6 +55,8 @@ import (
 	"github.com/go-chi/chi/v5"
 	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
 	"go.opentelemetry.io/otel"
+	"k8s.io/client-go/kubernetes/scheme"
+	controller_runtime "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 const (
@@ -162,7 +166,13 @@ func (s *Service) Initialize(ctx context.Context) (*http.Server, error) {
 		return nil, err
 	}
 
+	err = s.registerManifests(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	app := http.Handler(r)
+	app = middleware.UCPRequestMetrics(app)
 	app = servicecontext.ARMRequestCtx(s.options.PathBase, "global")(app)
 	app = middleware.WithLogger(app)
 
@@ -202,6 +212,64 @@ func (s *Service) configureDefaultPlanes(ctx context.Context) error {
 	return nil
 }
 
+// registerManifests reads the resource provider manifests from the configured directory and ConfigMap and registers
+// them with the Radius planes.
+func (s *Service) registerManifests(ctx context.Context) error {
+	if s.options.Config == nil || (s.options.Config.ManifestDirectory == "" && s.options.Config.ManifestConfigMap == "") {
+		return nil
+	}
+
+	providers := []manifest.ResourceProvider{}
+	if s.options.Config.ManifestDirectory != "" {
+		fromDirectory, err := manifest.ReadDirectory(s.options.Config.ManifestDirectory)
+		if err != nil {
+			return err
+		}
+		providers = append(providers, fromDirectory...)
+	}
+
+	if s.options.Config.ManifestConfigMap != "" {
+		fromConfigMap, err := s.readManifestConfigMap(ctx, s.options.Config.ManifestConfigMap)
+		if err != nil {
+			return err
+		}
+		providers = append(providers, fromConfigMap...)
+	}
+
+	db, err := s.storageProvider.GetStorageClient(ctx, "ucp")
+	if err != nil {
+		return err
+	}
+
+	return manifest.Register(ctx, db, providers, s.options.Location)
+}
+
+// readManifestConfigMap reads the resource provider manifests from the ConfigMap with the given name, in the
+// format <namespace>/<name>.
+func (s *Service) readManifestConfigMap(ctx context.Context, configMap string) ([]manifest.ResourceProvider, error) {
+	namespace, name, found := strings.Cut(configMap, "/")
+	if !found || namespace == "" || name == "" {
+		return nil, fmt.Errorf("invalid manifest ConfigMap %q: must be in the format <namespace>/<name>", configMap)
+	}
+
+	cfg, err := kubeutil.NewClientConfig(&kubeutil.ConfigOptions{
+		// TODO: Allow to use custom context via configuration. - https://github.com/radius-project/radius/issues/5433
+		ContextName: "",
+		QPS:         kubeutil.DefaultServerQPS,
+		Burst:       kubeutil.DefaultServerBurst,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get kubernetes config: %w", err)
+	}
+
+	client, err := controller_runtime.New(cfg, controller_runtime.Options{Scheme: scheme.Scheme})
+	if err != nil {
+		return nil, err
+	}
+
+	return manifest.ReadConfigMap(ctx, client, namespace, name)
+}
+
 func (s *Service) createPlane(ctx context.Context, plane rest.Plane) error {
 	body, err := json.Marshal(plane)
 	if err != nil {
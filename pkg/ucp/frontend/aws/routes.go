@@ -94,6 +94,14 @@ func (m *Module) Initialize(ctx context.Context) (http.Handler, error) {
 
 	// URLs for lifecycle of planes
 	planeResourceType := "System.AWS/planes"
+
+	// Proxied requests are validated against the account and region policy of the AWS plane.
+	planeStorageClient, err := m.options.DataProvider.GetStorageClient(ctx, planeResourceType)
+	if err != nil {
+		return nil, err
+	}
+	planePolicyValidator := awsproxy_ctrl.PlanePolicyValidator(planeStorageClient)
+
 	planeCollectionRouter := server.NewSubrouter(baseRouter, planeCollectionPath, apiValidator)
 	planeResourceRouter := server.NewSubrouter(baseRouter, planeResourcePath, apiValidator)
 
@@ -135,7 +143,7 @@ func (m *Module) Initialize(ctx context.Context) (http.Handler, error) {
 		},
 		{
 			// URLs for standard UCP resource async status result.
-			ParentRouter:  server.NewSubrouter(baseRouter, operationResultsPath),
+			ParentRouter:  server.NewSubrouter(baseRouter, operationResultsPath, planePolicyValidator),
 			Method:        v1.OperationGet,
 			OperationType: &v1.OperationType{Type: OperationResultsResourceType, Method: v1.OperationGet},
 			ControllerFactory: func(opt controller.Options) (controller.Controller, error) {
@@ -144,7 +152,7 @@ func (m *Module) Initialize(ctx context.Context) (http.Handler, error) {
 		},
 		{
 			// URLs for standard UCP resource async status.
-			ParentRouter:  server.NewSubrouter(baseRouter, operationStatusesPath),
+			ParentRouter:  server.NewSubrouter(baseRouter, operationStatusesPath, planePolicyValidator),
 			Method:        v1.OperationGet,
 			OperationType: &v1.OperationType{Type: OperationStatusResourceType, Method: v1.OperationGet},
 			ControllerFactory: func(opts controller.Options) (controller.Controller, error) {
@@ -153,7 +161,7 @@ func (m *Module) Initialize(ctx context.Context) (http.Handler, error) {
 		},
 	}
 
-	resourceCollectionRouter := server.NewSubrouter(baseRouter, resourceCollectionPath)
+	resourceCollectionRouter := server.NewSubrouter(baseRouter, resourceCollectionPath, planePolicyValidator)
 	handlerOptions = append(handlerOptions, []server.HandlerOptions{
 		{
 			// URLs for standard UCP resource lifecycle operations.
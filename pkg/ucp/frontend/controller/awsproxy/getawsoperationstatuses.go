@@ -92,6 +92,8 @@ func getAsyncOperationStatus(response *cloudcontrol.GetResourceRequestStatusOutp
 		os.AsyncOperationStatus.Status = armrpcv1.ProvisioningStateFailed
 	default:
 		os.AsyncOperationStatus.Status = armrpcv1.ProvisioningStateProvisioning
+		// Surface the AWS status of the running operation, such as PENDING or IN_PROGRESS, as its stage.
+		os.AsyncOperationStatus.Stage = string(response.ProgressEvent.OperationStatus)
 	}
 	os.AsyncOperationStatus.StartTime = *response.ProgressEvent.EventTime
 	if response.ProgressEvent.OperationStatus == types.OperationStatusFailed {
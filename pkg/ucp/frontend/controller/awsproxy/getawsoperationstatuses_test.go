@@ -70,6 +70,43 @@ func Test_GetAWSOperationStatuses(t *testing.T) {
 	require.Equal(t, expectedResponse, actualResponse)
 }
 
+func Test_GetAWSOperationStatuses_InProgress(t *testing.T) {
+	testResource := CreateKinesisStreamTestResource(uuid.NewString())
+
+	eventTime := time.Now()
+	testOptions := setupTest(t)
+	testOptions.AWSCloudControlClient.EXPECT().GetResourceRequestStatus(gomock.Any(), gomock.Any(), gomock.Any()).Return(
+		&cloudcontrol.GetResourceRequestStatusOutput{
+			ProgressEvent: &types.ProgressEvent{
+				EventTime:       aws.Time(eventTime),
+				OperationStatus: types.OperationStatusInProgress,
+				RequestToken:    aws.String(testAWSRequestToken),
+			},
+		}, nil)
+
+	awsClients := ucp_aws.Clients{
+		CloudControl:   testOptions.AWSCloudControlClient,
+		CloudFormation: testOptions.AWSCloudFormationClient,
+	}
+	awsController, err := NewGetAWSOperationStatuses(armrpc_controller.Options{StorageClient: testOptions.StorageClient}, awsClients)
+	require.NoError(t, err)
+
+	request, err := http.NewRequest(http.MethodGet, testResource.OperationStatusesPath, nil)
+	require.NoError(t, err)
+
+	ctx := rpctest.NewARMRequestContext(request)
+	actualResponse, err := awsController.Run(ctx, nil, request)
+	require.NoError(t, err)
+
+	expectedResponse := armrpc_rest.NewOKResponse(v1.AsyncOperationStatus{
+		Status:    v1.ProvisioningStateProvisioning,
+		StartTime: eventTime,
+		Stage:     string(types.OperationStatusInProgress),
+	})
+
+	require.Equal(t, expectedResponse, actualResponse)
+}
+
 func Test_GetAWSOperationStatuses_Failed(t *testing.T) {
 	testResource := CreateKinesisStreamTestResource(uuid.NewString())
 
@@ -0,0 +1,94 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package awsproxy
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	armrpc_rest "github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/radius-project/radius/pkg/ucp/frontend/controller/resourcegroups"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	resources_aws "github.com/radius-project/radius/pkg/ucp/resources/aws"
+	"github.com/radius-project/radius/pkg/ucp/store"
+)
+
+// ValidatePlanePolicy validates that the AWS account and region targeted by the given resource ID are permitted
+// by the policy of its AWS plane. Planes that do not exist or have no policy do not restrict requests.
+//
+// Returns resourcegroups.InvalidError when the account or region is not permitted.
+func ValidatePlanePolicy(ctx context.Context, client store.StorageClient, id resources.ID) error {
+	planeID := id.PlaneScope()
+	plane, err := store.GetResource[datamodel.AWSPlane](ctx, client, planeID)
+	if errors.Is(err, &store.ErrNotFound{}) {
+		return nil
+	} else if err != nil {
+		return fmt.Errorf("failed to find plane %q: %w", planeID, err)
+	}
+
+	policy := plane.Properties.Policy
+	if account := id.FindScope(resources_aws.ScopeAccounts); !policy.IsAccountAllowed(account) {
+		return &resourcegroups.InvalidError{Message: fmt.Sprintf("account %q is not allowed by the policy of plane %q", account, planeID)}
+	}
+
+	if region := id.FindScope(resources_aws.ScopeRegions); !policy.IsRegionAllowed(region) {
+		return &resourcegroups.InvalidError{Message: fmt.Sprintf("region %q is not allowed by the policy of plane %q", region, planeID)}
+	}
+
+	return nil
+}
+
+// PlanePolicyValidator is a middleware that rejects proxied AWS requests targeting an account or region
+// that is not permitted by the policy of the AWS plane.
+func PlanePolicyValidator(client store.StorageClient) func(h http.Handler) http.Handler {
+	return func(h http.Handler) http.Handler {
+		fn := func(w http.ResponseWriter, r *http.Request) {
+			ctx := r.Context()
+			id := v1.ARMRequestContextFromContext(ctx).ResourceID
+
+			err := ValidatePlanePolicy(ctx, client, id)
+			if errors.Is(err, &resourcegroups.InvalidError{}) {
+				resp := armrpc_rest.NewBadRequestARMResponse(v1.ErrorResponse{
+					Error: v1.ErrorDetails{
+						Code:    v1.CodeInvalid,
+						Message: err.Error(),
+						Target:  id.String(),
+					},
+				})
+				_ = resp.Apply(ctx, w, r)
+				return
+			} else if err != nil {
+				resp := armrpc_rest.NewInternalServerErrorARMResponse(v1.ErrorResponse{
+					Error: v1.ErrorDetails{
+						Code:    v1.CodeInternal,
+						Message: err.Error(),
+					},
+				})
+				_ = resp.Apply(ctx, w, r)
+				return
+			}
+
+			h.ServeHTTP(w, r)
+		}
+
+		return http.HandlerFunc(fn)
+	}
+}
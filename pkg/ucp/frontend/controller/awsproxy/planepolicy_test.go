@@ -0,0 +1,133 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package awsproxy
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/radius-project/radius/pkg/armrpc/rpctest"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/radius-project/radius/pkg/ucp/frontend/controller/resourcegroups"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/radius-project/radius/pkg/ucp/store"
+	"github.com/radius-project/radius/test/testcontext"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_ValidatePlanePolicy(t *testing.T) {
+	id := resources.MustParse("/planes/aws/aws/accounts/1234567/regions/us-west-2/providers/AWS.Kinesis/Stream/stream-1")
+
+	planeWithPolicy := func(policy *datamodel.AWSPlanePolicy) *store.Object {
+		return &store.Object{
+			Data: &datamodel.AWSPlane{
+				Properties: datamodel.AWSPlaneProperties{Policy: policy},
+			},
+		}
+	}
+
+	tests := []struct {
+		name   string
+		plane  *store.Object
+		getErr error
+		err    error
+	}{
+		{
+			name:   "plane not found",
+			getErr: &store.ErrNotFound{},
+		},
+		{
+			name:  "no policy",
+			plane: planeWithPolicy(nil),
+		},
+		{
+			name:  "empty policy",
+			plane: planeWithPolicy(&datamodel.AWSPlanePolicy{}),
+		},
+		{
+			name: "allowed",
+			plane: planeWithPolicy(&datamodel.AWSPlanePolicy{
+				AllowedRegions:  []string{"US-WEST-2"},
+				AllowedAccounts: []string{"1234567"},
+			}),
+		},
+		{
+			name:  "region not allowed",
+			plane: planeWithPolicy(&datamodel.AWSPlanePolicy{AllowedRegions: []string{"us-east-1"}}),
+			err:   &resourcegroups.InvalidError{Message: "region \"us-west-2\" is not allowed by the policy of plane \"/planes/aws/aws\""},
+		},
+		{
+			name:  "account not allowed",
+			plane: planeWithPolicy(&datamodel.AWSPlanePolicy{AllowedAccounts: []string{"7654321"}}),
+			err:   &resourcegroups.InvalidError{Message: "account \"1234567\" is not allowed by the policy of plane \"/planes/aws/aws\""},
+		},
+		{
+			name:   "storage failure",
+			getErr: errors.New("storage is down"),
+			err:    errors.New("failed to find plane \"/planes/aws/aws\": storage is down"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			storageClient := store.NewMockStorageClient(gomock.NewController(t))
+			storageClient.EXPECT().
+				Get(gomock.Any(), "/planes/aws/aws", gomock.Any()).
+				Return(tt.plane, tt.getErr)
+
+			err := ValidatePlanePolicy(testcontext.New(t), storageClient, id)
+			if tt.err == nil {
+				require.NoError(t, err)
+			} else {
+				require.EqualError(t, err, tt.err.Error())
+			}
+		})
+	}
+}
+
+func Test_PlanePolicyValidator(t *testing.T) {
+	storageClient := store.NewMockStorageClient(gomock.NewController(t))
+	storageClient.EXPECT().
+		Get(gomock.Any(), "/planes/aws/aws", gomock.Any()).
+		Return(&store.Object{
+			Data: &datamodel.AWSPlane{
+				Properties: datamodel.AWSPlaneProperties{
+					Policy: &datamodel.AWSPlanePolicy{AllowedRegions: []string{"us-east-1"}},
+				},
+			},
+		}, nil)
+
+	called := false
+	handler := PlanePolicyValidator(storageClient)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		called = true
+	}))
+
+	testResource := CreateKinesisStreamTestResource("stream-1")
+	request, err := http.NewRequest(http.MethodGet, testResource.SingleResourcePath, nil)
+	require.NoError(t, err)
+	request = request.WithContext(rpctest.NewARMRequestContext(request))
+
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, request)
+
+	require.False(t, called)
+	require.Equal(t, http.StatusBadRequest, w.Code)
+	require.Contains(t, w.Body.String(), "region \\\"us-west-2\\\" is not allowed by the policy of plane")
+}
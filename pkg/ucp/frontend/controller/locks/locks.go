@@ -0,0 +1,110 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package locks
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	armrpc_controller "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	armrpc_rest "github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/radius-project/radius/pkg/ucp/store"
+)
+
+const (
+	// CodeScopeLocked is the error code returned when a request is blocked by a management lock.
+	CodeScopeLocked = "ScopeLocked"
+)
+
+// Find returns the management locks that apply to the given ID. Locks apply to everything in the plane or
+// resource group where they are defined.
+func Find(ctx context.Context, client store.StorageClient, id resources.ID) ([]datamodel.Lock, error) {
+	scopes := []string{id.PlaneScope()}
+	if !strings.EqualFold(id.RootScope(), id.PlaneScope()) {
+		scopes = append(scopes, id.RootScope())
+	}
+
+	locks := []datamodel.Lock{}
+	for _, scope := range scopes {
+		result, err := client.Query(ctx, store.Query{RootScope: scope, ResourceType: datamodel.LockResourceType})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, item := range result.Items {
+			lock := datamodel.Lock{}
+			if err := item.As(&lock); err != nil {
+				return nil, err
+			}
+			locks = append(locks, lock)
+		}
+	}
+
+	return locks, nil
+}
+
+// Check returns a 409 Conflict response if a management lock prevents a request with the given HTTP method
+// from modifying the given ID. Returns nil if the request is allowed.
+func Check(ctx context.Context, client store.StorageClient, id resources.ID, method string) (armrpc_rest.Response, error) {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return nil, nil
+	}
+
+	locks, err := Find(ctx, client, id)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, lock := range locks {
+		if lock.Blocks(method) {
+			return &armrpc_rest.ConflictResponse{
+				Body: v1.ErrorResponse{
+					Error: v1.ErrorDetails{
+						Code:    CodeScopeLocked,
+						Message: fmt.Sprintf("The scope %q cannot perform %s operation because of the %s lock %q.", id.String(), method, lock.Properties.Level, lock.ID),
+						Target:  id.String(),
+					},
+				},
+			}, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// NewUpdateFilter returns an update filter that rejects creating or updating a plane or resource group when
+// a ReadOnly lock applies to it.
+func NewUpdateFilter[T any]() armrpc_controller.UpdateFilter[T] {
+	return func(ctx context.Context, newResource *T, oldResource *T, options *armrpc_controller.Options) (armrpc_rest.Response, error) {
+		id := v1.ARMRequestContextFromContext(ctx).ResourceID
+		return Check(ctx, options.StorageClient, id, http.MethodPut)
+	}
+}
+
+// NewDeleteFilter returns a delete filter that rejects deleting a plane or resource group when a lock applies to it.
+func NewDeleteFilter[T any]() armrpc_controller.DeleteFilter[T] {
+	return func(ctx context.Context, oldResource *T, options *armrpc_controller.Options) (armrpc_rest.Response, error) {
+		id := v1.ARMRequestContextFromContext(ctx).ResourceID
+		return Check(ctx, options.StorageClient, id, http.MethodDelete)
+	}
+}
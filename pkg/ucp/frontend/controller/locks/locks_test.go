@@ -0,0 +1,176 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package locks
+
+import (
+	"net/http"
+	"testing"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	armrpc_controller "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	armrpc_rest "github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/armrpc/rpctest"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/radius-project/radius/pkg/ucp/store"
+	"github.com/radius-project/radius/test/testcontext"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+const (
+	planeID         = "/planes/radius/local"
+	resourceGroupID = "/planes/radius/local/resourceGroups/test-rg"
+	resourceID      = "/planes/radius/local/resourceGroups/test-rg/providers/Applications.Core/containers/test-container"
+)
+
+func newLock(scope string, level datamodel.LockLevel) datamodel.Lock {
+	return datamodel.Lock{
+		BaseResource: v1.BaseResource{
+			TrackedResource: v1.TrackedResource{
+				ID:   scope + "/providers/System.Resources/locks/test-lock",
+				Name: "test-lock",
+				Type: datamodel.LockResourceType,
+			},
+		},
+		Properties: datamodel.LockProperties{Level: level},
+	}
+}
+
+func expectLocks(storageClient *store.MockStorageClient, scope string, locks ...datamodel.Lock) {
+	items := []store.Object{}
+	for _, lock := range locks {
+		items = append(items, store.Object{Data: lock})
+	}
+
+	storageClient.EXPECT().
+		Query(gomock.Any(), store.Query{RootScope: scope, ResourceType: datamodel.LockResourceType}).
+		Return(&store.ObjectQueryResult{Items: items}, nil)
+}
+
+func Test_Check(t *testing.T) {
+	tests := []struct {
+		name          string
+		id            string
+		method        string
+		planeLocks    []datamodel.Lock
+		groupLocks    []datamodel.Lock
+		skipQuery     bool
+		expectBlocked bool
+	}{
+		{
+			name:      "read is never blocked",
+			id:        resourceID,
+			method:    http.MethodGet,
+			skipQuery: true,
+		},
+		{
+			name:   "no locks",
+			id:     resourceID,
+			method: http.MethodDelete,
+		},
+		{
+			name:          "CanNotDelete blocks delete",
+			id:            resourceID,
+			method:        http.MethodDelete,
+			groupLocks:    []datamodel.Lock{newLock(resourceGroupID, datamodel.LockLevelCanNotDelete)},
+			expectBlocked: true,
+		},
+		{
+			name:       "CanNotDelete allows update",
+			id:         resourceID,
+			method:     http.MethodPut,
+			groupLocks: []datamodel.Lock{newLock(resourceGroupID, datamodel.LockLevelCanNotDelete)},
+		},
+		{
+			name:          "ReadOnly on plane blocks update",
+			id:            resourceID,
+			method:        http.MethodPatch,
+			planeLocks:    []datamodel.Lock{newLock(planeID, datamodel.LockLevelReadOnly)},
+			expectBlocked: true,
+		},
+		{
+			name:          "ReadOnly blocks actions",
+			id:            resourceID,
+			method:        http.MethodPost,
+			groupLocks:    []datamodel.Lock{newLock(resourceGroupID, datamodel.LockLevelReadOnly)},
+			expectBlocked: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			storageClient := store.NewMockStorageClient(gomock.NewController(t))
+			if !tt.skipQuery {
+				expectLocks(storageClient, planeID, tt.planeLocks...)
+				expectLocks(storageClient, resourceGroupID, tt.groupLocks...)
+			}
+
+			resp, err := Check(testcontext.New(t), storageClient, resources.MustParse(tt.id), tt.method)
+			require.NoError(t, err)
+			if !tt.expectBlocked {
+				require.Nil(t, resp)
+				return
+			}
+
+			require.IsType(t, &armrpc_rest.ConflictResponse{}, resp)
+			require.Equal(t, CodeScopeLocked, resp.(*armrpc_rest.ConflictResponse).Body.Error.Code)
+		})
+	}
+}
+
+func Test_Filters(t *testing.T) {
+	setup := func(t *testing.T, method string, id string) (*store.MockStorageClient, *armrpc_controller.Options, *http.Request) {
+		storageClient := store.NewMockStorageClient(gomock.NewController(t))
+		req, err := http.NewRequest(method, id+"?api-version=2023-10-01-preview", nil)
+		require.NoError(t, err)
+		req = req.WithContext(rpctest.NewARMRequestContext(req))
+		return storageClient, &armrpc_controller.Options{StorageClient: storageClient}, req
+	}
+
+	t.Run("plane delete blocked", func(t *testing.T) {
+		storageClient, options, req := setup(t, http.MethodDelete, planeID)
+		expectLocks(storageClient, planeID, newLock(planeID, datamodel.LockLevelCanNotDelete))
+
+		filter := NewDeleteFilter[datamodel.RadiusPlane]()
+		resp, err := filter(req.Context(), &datamodel.RadiusPlane{}, options)
+		require.NoError(t, err)
+		require.IsType(t, &armrpc_rest.ConflictResponse{}, resp)
+	})
+
+	t.Run("resource group update allowed", func(t *testing.T) {
+		storageClient, options, req := setup(t, http.MethodPut, resourceGroupID)
+		expectLocks(storageClient, planeID)
+		expectLocks(storageClient, resourceGroupID, newLock(resourceGroupID, datamodel.LockLevelCanNotDelete))
+
+		filter := NewUpdateFilter[datamodel.ResourceGroup]()
+		resp, err := filter(req.Context(), &datamodel.ResourceGroup{}, nil, options)
+		require.NoError(t, err)
+		require.Nil(t, resp)
+	})
+
+	t.Run("resource group update blocked", func(t *testing.T) {
+		storageClient, options, req := setup(t, http.MethodPut, resourceGroupID)
+		expectLocks(storageClient, planeID, newLock(planeID, datamodel.LockLevelReadOnly))
+		expectLocks(storageClient, resourceGroupID)
+
+		filter := NewUpdateFilter[datamodel.ResourceGroup]()
+		resp, err := filter(req.Context(), &datamodel.ResourceGroup{}, nil, options)
+		require.NoError(t, err)
+		require.IsType(t, &armrpc_rest.ConflictResponse{}, resp)
+	})
+}
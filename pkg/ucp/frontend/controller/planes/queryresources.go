@@ -0,0 +1,167 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package planes
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	http "net/http"
+	"strings"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	armrpc_controller "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	armrpc_rest "github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/radius-project/radius/pkg/ucp/datamodel/converter"
+	resources_radius "github.com/radius-project/radius/pkg/ucp/resources/radius"
+	"github.com/radius-project/radius/pkg/ucp/store"
+)
+
+var _ armrpc_controller.Controller = (*QueryResources)(nil)
+
+// OperationQueryResources is the operation method for searching the tracked resources of a plane or resource group.
+const OperationQueryResources v1.OperationMethod = "ACTIONQUERYRESOURCES"
+
+// QueryResourcesFilter is the request body of the resource query API. Every field is optional and
+// only resources matching all of the provided fields are returned.
+type QueryResourcesFilter struct {
+	// ResourceType is the fully-qualified resource type. Matching is case-insensitive.
+	ResourceType string `json:"resourceType,omitempty"`
+	// Name is the resource name. Matching is case-insensitive.
+	Name string `json:"name,omitempty"`
+	// Application is the resource ID of the application. Matching is case-insensitive.
+	Application string `json:"application,omitempty"`
+	// Tags are the tags that must be present on the resource with the same values.
+	Tags map[string]string `json:"tags,omitempty"`
+}
+
+// QueryResources is the controller implementation to search the tracked resources of a plane or resource group.
+type QueryResources struct {
+	armrpc_controller.Operation[*datamodel.GenericResource, datamodel.GenericResource]
+}
+
+// NewQueryResources creates a new controller for searching the tracked resources of a plane or resource group.
+func NewQueryResources(opts armrpc_controller.Options) (armrpc_controller.Controller, error) {
+	return &QueryResources{
+		Operation: armrpc_controller.NewOperation(opts,
+			armrpc_controller.ResourceOptions[datamodel.GenericResource]{
+				RequestConverter:  converter.GenericResourceDataModelFromVersioned,
+				ResponseConverter: converter.GenericResourceDataModelToVersioned,
+			},
+		),
+	}, nil
+}
+
+// Run implements controller.Controller.
+//
+// The results are paginated using $top and skipToken. The filter is applied to each page after it is read from the
+// store, so a page may contain fewer than $top resources and every page must be requested with the same filter.
+func (q *QueryResources) Run(ctx context.Context, w http.ResponseWriter, req *http.Request) (armrpc_rest.Response, error) {
+	serviceCtx := v1.ARMRequestContextFromContext(ctx)
+
+	// The ID is the plane or resource group that the action was invoked on.
+	scope := serviceCtx.ResourceID
+
+	filter := QueryResourcesFilter{}
+	defer req.Body.Close()
+	err := json.NewDecoder(req.Body).Decode(&filter)
+	if err != nil && !errors.Is(err, io.EOF) {
+		return armrpc_rest.NewBadRequestResponse("failed to read request body: " + err.Error()), nil
+	}
+
+	_, err = q.StorageClient().Get(ctx, scope.String())
+	if errors.Is(err, &store.ErrNotFound{}) {
+		return armrpc_rest.NewNotFoundResponse(scope), nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	// Tracked resources are stored in resource groups, so a plane is searched recursively.
+	// The application is selected by the store, so only the resources of the application are read.
+	query := store.Query{
+		RootScope:      scope.String(),
+		ScopeRecursive: scope.FindScope(resources_radius.ScopeResourceGroups) == "",
+		ResourceType:   v20231001preview.ResourceType,
+		Selector:       store.FieldSelector{Application: filter.Application},
+	}
+
+	result, err := q.StorageClient().Query(ctx, query, store.WithPaginationToken(serviceCtx.SkipToken), store.WithMaxQueryItemCount(serviceCtx.Top))
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := q.createResponse(ctx, result, &filter)
+	if err != nil {
+		return nil, err
+	}
+	response.NextLink = armrpc_controller.GetNextLinkURL(ctx, req, result.PaginationToken)
+
+	return armrpc_rest.NewOKResponse(response), nil
+}
+
+func (q *QueryResources) createResponse(ctx context.Context, result *store.ObjectQueryResult, filter *QueryResourcesFilter) (*v1.PaginatedList, error) {
+	serviceCtx := v1.ARMRequestContextFromContext(ctx)
+	items := v1.PaginatedList{}
+
+	for _, item := range result.Items {
+		data := datamodel.GenericResource{}
+		err := item.As(&data)
+		if err != nil {
+			return nil, err
+		}
+
+		if !filter.Matches(&data) {
+			continue
+		}
+
+		versioned, err := q.ResponseConverter()(&data, serviceCtx.APIVersion)
+		if err != nil {
+			return nil, err
+		}
+
+		items.Value = append(items.Value, versioned)
+	}
+
+	return &items, nil
+}
+
+// Matches returns true if the tracked resource matches all fields of the filter.
+func (f *QueryResourcesFilter) Matches(resource *datamodel.GenericResource) bool {
+	if f.ResourceType != "" && !strings.EqualFold(f.ResourceType, resource.Properties.Type) {
+		return false
+	}
+
+	if f.Name != "" && !strings.EqualFold(f.Name, resource.Properties.Name) {
+		return false
+	}
+
+	if f.Application != "" && !strings.EqualFold(f.Application, resource.Properties.Application) {
+		return false
+	}
+
+	for key, value := range f.Tags {
+		actual, ok := resource.Properties.Tags[key]
+		if !ok || actual != value {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,199 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package planes
+
+import (
+	"bytes"
+	"context"
+	http "net/http"
+	"testing"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	armrpc_controller "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	armrpc_rest "github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/armrpc/rpctest"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/radius-project/radius/pkg/ucp/store"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_QueryResources(t *testing.T) {
+	app := "/planes/radius/local/resourceGroups/group-a/providers/Applications.Core/applications/app"
+	containerID := resources.MustParse("/planes/radius/local/resourceGroups/group-a/providers/Applications.Core/containers/frontend")
+	databaseID := resources.MustParse("/planes/radius/other/resourceGroups/group-b/providers/Applications.Datastores/redisCaches/cache")
+
+	container := datamodel.GenericResourceFromID(containerID, resources.MustParse("/planes/radius/local/resourceGroups/group-a/providers/System.Resources/resources/frontend"))
+	container.Properties.Application = app
+	container.Properties.Tags = map[string]string{"env": "prod"}
+
+	database := datamodel.GenericResourceFromID(databaseID, resources.MustParse("/planes/radius/other/resourceGroups/group-b/providers/System.Resources/resources/cache"))
+	database.Properties.Tags = map[string]string{"env": "dev"}
+
+	tests := []struct {
+		name     string
+		body     string
+		selector store.FieldSelector
+		expected []resources.ID
+	}{
+		{
+			name:     "no filter",
+			body:     "",
+			expected: []resources.ID{containerID, databaseID},
+		},
+		{
+			name:     "by type",
+			body:     `{"resourceType":"applications.datastores/rediscaches"}`,
+			expected: []resources.ID{databaseID},
+		},
+		{
+			name:     "by name",
+			body:     `{"name":"FRONTEND"}`,
+			expected: []resources.ID{containerID},
+		},
+		{
+			name:     "by application",
+			body:     `{"application":"` + app + `"}`,
+			selector: store.FieldSelector{Application: app},
+			expected: []resources.ID{containerID},
+		},
+		{
+			name:     "by tag",
+			body:     `{"tags":{"env":"dev"}}`,
+			expected: []resources.ID{databaseID},
+		},
+		{
+			name:     "no match",
+			body:     `{"name":"frontend","tags":{"env":"dev"}}`,
+			expected: []resources.ID{},
+		},
+	}
+
+	planeID := "/planes/radius/local"
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			mockStorageClient := store.NewMockStorageClient(mockCtrl)
+
+			ctrl, err := NewQueryResources(armrpc_controller.Options{StorageClient: mockStorageClient})
+			require.NoError(t, err)
+
+			query := store.Query{
+				RootScope:      planeID,
+				ScopeRecursive: true,
+				ResourceType:   v20231001preview.ResourceType,
+				Selector:       tt.selector,
+			}
+			mockStorageClient.EXPECT().Get(gomock.Any(), planeID).Return(&store.Object{}, nil)
+			mockStorageClient.EXPECT().Query(gomock.Any(), query, gomock.Any(), gomock.Any()).Return(&store.ObjectQueryResult{
+				Items: []store.Object{
+					{Data: container},
+					{Data: database},
+				},
+			}, nil)
+
+			request, err := http.NewRequest(http.MethodPost, planeID+"/queryResources?api-version=2023-10-01-preview", bytes.NewBufferString(tt.body))
+			require.NoError(t, err)
+			ctx := rpctest.NewARMRequestContext(request)
+
+			actualResponse, err := ctrl.Run(ctx, nil, request)
+			require.NoError(t, err)
+
+			expected := &v1.PaginatedList{}
+			for _, id := range tt.expected {
+				expected.Value = append(expected.Value, &v20231001preview.GenericResource{
+					ID:   to.Ptr(id.String()),
+					Name: to.Ptr(id.Name()),
+					Type: to.Ptr(id.Type()),
+				})
+			}
+			require.Equal(t, armrpc_rest.NewOKResponse(expected), actualResponse)
+		})
+	}
+
+	t.Run("resource group scope with pagination", func(t *testing.T) {
+		mockCtrl := gomock.NewController(t)
+		mockStorageClient := store.NewMockStorageClient(mockCtrl)
+
+		ctrl, err := NewQueryResources(armrpc_controller.Options{StorageClient: mockStorageClient})
+		require.NoError(t, err)
+
+		resourceGroupID := "/planes/radius/local/resourceGroups/group-a"
+		query := store.Query{
+			RootScope:    resourceGroupID,
+			ResourceType: v20231001preview.ResourceType,
+		}
+		mockStorageClient.EXPECT().Get(gomock.Any(), resourceGroupID).Return(&store.Object{}, nil)
+		mockStorageClient.EXPECT().
+			Query(gomock.Any(), query, gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, query store.Query, options ...store.QueryOptions) (*store.ObjectQueryResult, error) {
+				cfg := store.NewQueryConfig(options...)
+				require.Equal(t, "page-1", cfg.PaginationToken)
+				require.Equal(t, 1, cfg.MaxQueryItemCount)
+				return &store.ObjectQueryResult{Items: []store.Object{{Data: container}}, PaginationToken: "page-2"}, nil
+			})
+
+		request, err := http.NewRequest(http.MethodPost, "http://localhost"+resourceGroupID+"/queryResources?api-version=2023-10-01-preview&top=1&skipToken=page-1", bytes.NewBufferString("{}"))
+		require.NoError(t, err)
+		ctx := rpctest.NewARMRequestContext(request)
+
+		actualResponse, err := ctrl.Run(ctx, nil, request)
+		require.NoError(t, err)
+
+		response := actualResponse.(*armrpc_rest.OKResponse).Body.(*v1.PaginatedList)
+		require.Len(t, response.Value, 1)
+		require.Contains(t, response.NextLink, "skipToken=page-2")
+	})
+
+	t.Run("scope not found", func(t *testing.T) {
+		mockCtrl := gomock.NewController(t)
+		mockStorageClient := store.NewMockStorageClient(mockCtrl)
+
+		ctrl, err := NewQueryResources(armrpc_controller.Options{StorageClient: mockStorageClient})
+		require.NoError(t, err)
+
+		mockStorageClient.EXPECT().Get(gomock.Any(), planeID).Return(nil, &store.ErrNotFound{ID: planeID})
+
+		request, err := http.NewRequest(http.MethodPost, planeID+"/queryResources?api-version=2023-10-01-preview", bytes.NewBufferString("{}"))
+		require.NoError(t, err)
+		ctx := rpctest.NewARMRequestContext(request)
+
+		actualResponse, err := ctrl.Run(ctx, nil, request)
+		require.NoError(t, err)
+		require.IsType(t, &armrpc_rest.NotFoundResponse{}, actualResponse)
+	})
+
+	t.Run("invalid body", func(t *testing.T) {
+		mockCtrl := gomock.NewController(t)
+		mockStorageClient := store.NewMockStorageClient(mockCtrl)
+
+		ctrl, err := NewQueryResources(armrpc_controller.Options{StorageClient: mockStorageClient})
+		require.NoError(t, err)
+
+		request, err := http.NewRequest(http.MethodPost, planeID+"/queryResources?api-version=2023-10-01-preview", bytes.NewBufferString("{"))
+		require.NoError(t, err)
+		ctx := rpctest.NewARMRequestContext(request)
+
+		actualResponse, err := ctrl.Run(ctx, nil, request)
+		require.NoError(t, err)
+		require.IsType(t, &armrpc_rest.BadRequestResponse{}, actualResponse)
+	})
+}
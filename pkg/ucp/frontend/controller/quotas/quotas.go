@@ -0,0 +1,148 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package quotas
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	armrpc_rest "github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	resources_radius "github.com/radius-project/radius/pkg/ucp/resources/radius"
+	"github.com/radius-project/radius/pkg/ucp/store"
+	"github.com/radius-project/radius/pkg/ucp/trackedresource"
+)
+
+const (
+	// CodeQuotaExceeded is the error code returned when a request would exceed a resource group quota.
+	CodeQuotaExceeded = "QuotaExceeded"
+)
+
+// Check returns a 409 Conflict response if creating the resource with the given ID would exceed a quota
+// defined on its resource group. Returns nil if the request is allowed.
+//
+// Only PUT requests that create a new top-level resource are counted against quotas. Updates to existing
+// resources are always allowed, even if the resource group is already over its quota.
+func Check(ctx context.Context, client store.StorageClient, id resources.ID, method string) (armrpc_rest.Response, error) {
+	if method != http.MethodPut || len(id.TypeSegments()) != 1 || !id.IsResource() || id.FindScope(resources_radius.ScopeResourceGroups) == "" {
+		return nil, nil
+	}
+
+	quotas, err := find(ctx, client, id.RootScope())
+	if err != nil {
+		return nil, err
+	} else if len(quotas) == 0 {
+		return nil, nil
+	}
+
+	// The resource already exists, so this is an update.
+	_, err = client.Get(ctx, trackedresource.IDFor(id).String())
+	if err == nil {
+		return nil, nil
+	} else if !errors.Is(err, &store.ErrNotFound{}) {
+		return nil, err
+	}
+
+	total, perType, err := count(ctx, client, id.RootScope())
+	if err != nil {
+		return nil, err
+	}
+
+	violations := []v1.ErrorDetails{}
+	for _, quota := range quotas {
+		if quota.Properties.MaxResources > 0 && total+1 > quota.Properties.MaxResources {
+			violations = append(violations, v1.ErrorDetails{
+				Code:    CodeQuotaExceeded,
+				Message: fmt.Sprintf("The quota %q allows at most %d resources in the resource group. The resource group contains %d resources.", quota.ID, quota.Properties.MaxResources, total),
+				Target:  quota.ID,
+			})
+		}
+
+		for resourceType, limit := range quota.Properties.MaxResourcesPerType {
+			if !strings.EqualFold(resourceType, id.Type()) || limit <= 0 {
+				continue
+			}
+
+			current := perType[strings.ToLower(id.Type())]
+			if current+1 > limit {
+				violations = append(violations, v1.ErrorDetails{
+					Code:    CodeQuotaExceeded,
+					Message: fmt.Sprintf("The quota %q allows at most %d resources of type %q in the resource group. The resource group contains %d resources of that type.", quota.ID, limit, resourceType, current),
+					Target:  quota.ID,
+				})
+			}
+		}
+	}
+
+	if len(violations) == 0 {
+		return nil, nil
+	}
+
+	return &armrpc_rest.ConflictResponse{
+		Body: v1.ErrorResponse{
+			Error: v1.ErrorDetails{
+				Code:    CodeQuotaExceeded,
+				Message: fmt.Sprintf("The resource %q cannot be created because it would exceed the quota of the resource group %q.", id.String(), id.RootScope()),
+				Target:  id.String(),
+				Details: violations,
+			},
+		},
+	}, nil
+}
+
+// find returns the quotas defined at the given scope.
+func find(ctx context.Context, client store.StorageClient, scope string) ([]datamodel.Quota, error) {
+	result, err := client.Query(ctx, store.Query{RootScope: scope, ResourceType: datamodel.QuotaResourceType})
+	if err != nil {
+		return nil, err
+	}
+
+	quotas := []datamodel.Quota{}
+	for _, item := range result.Items {
+		quota := datamodel.Quota{}
+		if err := item.As(&quota); err != nil {
+			return nil, err
+		}
+		quotas = append(quotas, quota)
+	}
+
+	return quotas, nil
+}
+
+// count returns the number of tracked resources at the given scope, in total and keyed by lowercased resource type.
+func count(ctx context.Context, client store.StorageClient, scope string) (int, map[string]int, error) {
+	result, err := client.Query(ctx, store.Query{RootScope: scope, ResourceType: datamodel.ResourceType})
+	if err != nil {
+		return 0, nil, err
+	}
+
+	perType := map[string]int{}
+	for _, item := range result.Items {
+		entry := datamodel.GenericResource{}
+		if err := item.As(&entry); err != nil {
+			return 0, nil, err
+		}
+		perType[strings.ToLower(entry.Properties.Type)]++
+	}
+
+	return len(result.Items), perType, nil
+}
@@ -0,0 +1,188 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package quotas
+
+import (
+	"net/http"
+	"testing"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	armrpc_rest "github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/radius-project/radius/pkg/ucp/store"
+	"github.com/radius-project/radius/pkg/ucp/trackedresource"
+	"github.com/radius-project/radius/test/testcontext"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+const (
+	resourceGroupID = "/planes/radius/local/resourceGroups/test-rg"
+	resourceID      = "/planes/radius/local/resourceGroups/test-rg/providers/Applications.Core/containers/test-container"
+	containerType   = "Applications.Core/containers"
+	gatewayType     = "Applications.Core/gateways"
+)
+
+func newQuota(properties datamodel.QuotaProperties) datamodel.Quota {
+	return datamodel.Quota{
+		BaseResource: v1.BaseResource{
+			TrackedResource: v1.TrackedResource{
+				ID:   resourceGroupID + "/providers/System.Resources/quotas/test-quota",
+				Name: "test-quota",
+				Type: datamodel.QuotaResourceType,
+			},
+		},
+		Properties: properties,
+	}
+}
+
+func expectQuotas(storageClient *store.MockStorageClient, quotas ...datamodel.Quota) {
+	items := []store.Object{}
+	for _, quota := range quotas {
+		items = append(items, store.Object{Data: quota})
+	}
+
+	storageClient.EXPECT().
+		Query(gomock.Any(), store.Query{RootScope: resourceGroupID, ResourceType: datamodel.QuotaResourceType}).
+		Return(&store.ObjectQueryResult{Items: items}, nil)
+}
+
+func expectTrackedResources(storageClient *store.MockStorageClient, resourceTypes ...string) {
+	items := []store.Object{}
+	for _, resourceType := range resourceTypes {
+		items = append(items, store.Object{Data: datamodel.GenericResource{Properties: datamodel.GenericResourceProperties{Type: resourceType}}})
+	}
+
+	storageClient.EXPECT().
+		Query(gomock.Any(), store.Query{RootScope: resourceGroupID, ResourceType: datamodel.ResourceType}).
+		Return(&store.ObjectQueryResult{Items: items}, nil)
+}
+
+func Test_Check(t *testing.T) {
+	trackingID := trackedresource.IDFor(resources.MustParse(resourceID)).String()
+
+	tests := []struct {
+		name           string
+		id             string
+		method         string
+		quotas         []datamodel.Quota
+		exists         bool
+		existing       []string
+		skipQuery      bool
+		expectedDetail int
+	}{
+		{
+			name:      "delete is never counted",
+			id:        resourceID,
+			method:    http.MethodDelete,
+			skipQuery: true,
+		},
+		{
+			name:      "nested resource is never counted",
+			id:        resourceID + "/routes/test-route",
+			method:    http.MethodPut,
+			skipQuery: true,
+		},
+		{
+			name:      "resource group is never counted",
+			id:        resourceGroupID,
+			method:    http.MethodPut,
+			skipQuery: true,
+		},
+		{
+			name:   "no quotas",
+			id:     resourceID,
+			method: http.MethodPut,
+		},
+		{
+			name:   "update of existing resource",
+			id:     resourceID,
+			method: http.MethodPut,
+			quotas: []datamodel.Quota{newQuota(datamodel.QuotaProperties{MaxResources: 1})},
+			exists: true,
+		},
+		{
+			name:     "within total limit",
+			id:       resourceID,
+			method:   http.MethodPut,
+			quotas:   []datamodel.Quota{newQuota(datamodel.QuotaProperties{MaxResources: 2})},
+			existing: []string{gatewayType},
+		},
+		{
+			name:           "exceeds total limit",
+			id:             resourceID,
+			method:         http.MethodPut,
+			quotas:         []datamodel.Quota{newQuota(datamodel.QuotaProperties{MaxResources: 1})},
+			existing:       []string{gatewayType},
+			expectedDetail: 1,
+		},
+		{
+			name:     "within per-type limit",
+			id:       resourceID,
+			method:   http.MethodPut,
+			quotas:   []datamodel.Quota{newQuota(datamodel.QuotaProperties{MaxResourcesPerType: map[string]int{containerType: 1}})},
+			existing: []string{gatewayType},
+		},
+		{
+			name:           "exceeds per-type limit",
+			id:             resourceID,
+			method:         http.MethodPut,
+			quotas:         []datamodel.Quota{newQuota(datamodel.QuotaProperties{MaxResourcesPerType: map[string]int{"applications.core/CONTAINERS": 1}})},
+			existing:       []string{containerType},
+			expectedDetail: 1,
+		},
+		{
+			name:           "exceeds both limits",
+			id:             resourceID,
+			method:         http.MethodPut,
+			quotas:         []datamodel.Quota{newQuota(datamodel.QuotaProperties{MaxResources: 2, MaxResourcesPerType: map[string]int{containerType: 1}})},
+			existing:       []string{containerType, gatewayType},
+			expectedDetail: 2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			storageClient := store.NewMockStorageClient(gomock.NewController(t))
+			if !tt.skipQuery {
+				expectQuotas(storageClient, tt.quotas...)
+			}
+			if len(tt.quotas) > 0 {
+				if tt.exists {
+					storageClient.EXPECT().Get(gomock.Any(), trackingID).Return(&store.Object{}, nil)
+				} else {
+					storageClient.EXPECT().Get(gomock.Any(), trackingID).Return(nil, &store.ErrNotFound{ID: trackingID})
+					expectTrackedResources(storageClient, tt.existing...)
+				}
+			}
+
+			resp, err := Check(testcontext.New(t), storageClient, resources.MustParse(tt.id), tt.method)
+			require.NoError(t, err)
+			if tt.expectedDetail == 0 {
+				require.Nil(t, resp)
+				return
+			}
+
+			require.IsType(t, &armrpc_rest.ConflictResponse{}, resp)
+			body := resp.(*armrpc_rest.ConflictResponse).Body
+			require.Equal(t, CodeQuotaExceeded, body.Error.Code)
+			require.Equal(t, resourceID, body.Error.Target)
+			require.Len(t, body.Error.Details, tt.expectedDetail)
+		})
+	}
+}
@@ -18,11 +18,16 @@ package radius
 
 import (
 	"context"
+	"crypto/sha256"
+	"crypto/tls"
+	"encoding/hex"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"net/http"
 	"net/url"
 	"strings"
+	"sync"
 	"time"
 
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
@@ -31,9 +36,13 @@ import (
 	armrpc_rest "github.com/radius-project/radius/pkg/armrpc/rest"
 	"github.com/radius-project/radius/pkg/middleware"
 	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/radius-project/radius/pkg/ucp/frontend/controller/locks"
+	"github.com/radius-project/radius/pkg/ucp/frontend/controller/quotas"
 	"github.com/radius-project/radius/pkg/ucp/frontend/controller/resourcegroups"
+	"github.com/radius-project/radius/pkg/ucp/frontend/controller/webhooks"
 	"github.com/radius-project/radius/pkg/ucp/proxy"
 	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/radius-project/radius/pkg/ucp/secret"
 	"github.com/radius-project/radius/pkg/ucp/store"
 	"github.com/radius-project/radius/pkg/ucp/trackedresource"
 	"github.com/radius-project/radius/pkg/ucp/ucplog"
@@ -52,6 +61,10 @@ const (
 
 	// EnqueueOperationRetryCount is the number of times to retry enqueueing an async operation before giving up.
 	EnqueueOperationRetryCount = 10
+
+	// ClientCertificateRefreshInterval is how long a client certificate read from the secret store is used before
+	// it is read again. This bounds how long it takes for a rotated certificate to be picked up.
+	ClientCertificateRefreshInterval = 5 * time.Minute
 )
 
 type updater interface {
@@ -69,19 +82,40 @@ type ProxyController struct {
 
 	// updater is used to process tracked resources. Can be overridden for testing.
 	updater updater
+
+	// downstreamTransport applies the TLS configuration of planes to requests sent to resource providers.
+	downstreamTransport *proxy.DownstreamTransport
+
+	// secretClient is used to read client certificates referenced by the TLS configuration of planes.
+	secretClient secret.Client
+
+	// clientCertificates caches the client certificates read using secretClient.
+	clientCertificates *clientCertificateCache
+
+	// downstreamCache caches the storage lookups used to resolve the downstream URL. May be nil.
+	downstreamCache *resourcegroups.DownstreamCache
+
+	// webhooks calls the webhooks registered for Radius resources. May be nil.
+	webhooks *webhooks.Dispatcher
 }
 
 // # Function Explanation
 //
 // NewProxyController creates a new ProxyPlane controller with the given options and returns it, or returns an error if the
 // controller cannot be created.
-func NewProxyController(opts armrpc_controller.Options) (armrpc_controller.Controller, error) {
-	transport := otelhttp.NewTransport(http.DefaultTransport)
+func NewProxyController(opts armrpc_controller.Options, secretClient secret.Client, downstreamCache *resourcegroups.DownstreamCache, webhookDispatcher *webhooks.Dispatcher) (armrpc_controller.Controller, error) {
+	downstreamTransport := proxy.NewDownstreamTransport()
+	transport := otelhttp.NewTransport(downstreamTransport)
 	updater := trackedresource.NewUpdater(opts.StorageClient, &http.Client{Transport: transport})
 	return &ProxyController{
-		Operation: armrpc_controller.NewOperation(opts, armrpc_controller.ResourceOptions[datamodel.RadiusPlane]{}),
-		transport: transport,
-		updater:   updater,
+		Operation:           armrpc_controller.NewOperation(opts, armrpc_controller.ResourceOptions[datamodel.RadiusPlane]{}),
+		transport:           transport,
+		updater:             updater,
+		downstreamTransport: downstreamTransport,
+		secretClient:        secretClient,
+		clientCertificates:  newClientCertificateCache(),
+		downstreamCache:     downstreamCache,
+		webhooks:            webhookDispatcher,
 	}, nil
 }
 
@@ -100,7 +134,7 @@ func (p *ProxyController) Run(ctx context.Context, w http.ResponseWriter, req *h
 	id := requestCtx.ResourceID
 	relativePath := middleware.GetRelativePath(p.Options().PathBase, requestCtx.OriginalURL.Path)
 
-	downstreamURL, err := resourcegroups.ValidateDownstream(ctx, p.StorageClient(), id)
+	downstreamURL, plane, err := p.downstreamCache.ResolveDownstream(ctx, p.StorageClient(), id)
 	if errors.Is(err, &resourcegroups.NotFoundError{}) {
 		return armrpc_rest.NewNotFoundResponse(id), nil
 	} else if errors.Is(err, &resourcegroups.InvalidError{}) {
@@ -110,6 +144,36 @@ func (p *ProxyController) Run(ctx context.Context, w http.ResponseWriter, req *h
 		return nil, fmt.Errorf("failed to validate downstream: %w", err)
 	}
 
+	// Resource providers registered through a manifest only serve the resource types and API versions they declare.
+	if !plane.IsResourceTypeSupported(id.Type(), requestCtx.APIVersion) {
+		message := fmt.Sprintf("resource type %s with api-version %q is not supported by resource provider %s", id.Type(), requestCtx.APIVersion, id.ProviderNamespace())
+		response := v1.ErrorResponse{Error: v1.ErrorDetails{Code: v1.CodeInvalidResourceType, Message: message, Target: id.String()}}
+		return armrpc_rest.NewBadRequestARMResponse(response), nil
+	}
+
+	// Management locks on the plane or resource group apply to every resource within them.
+	if response, err := locks.Check(ctx, p.StorageClient(), id, req.Method); response != nil || err != nil {
+		return response, err
+	}
+
+	// Quotas on the resource group limit the number of new resources that can be created within it.
+	if response, err := quotas.Check(ctx, p.StorageClient(), id, req.Method); response != nil || err != nil {
+		return response, err
+	}
+
+	// Mutating and validating webhooks run last so they only see requests that would otherwise be allowed.
+	if response, err := p.webhooks.Admit(ctx, id, requestCtx.APIVersion, req); response != nil || err != nil {
+		return response, err
+	}
+
+	err = p.ConfigureDownstreamTLS(ctx, plane.ID, downstreamURL, plane.Properties.TLS)
+	if err != nil {
+		return nil, fmt.Errorf("failed to configure TLS for downstream: %w", err)
+	}
+
+	// Requests sent to the downstream (including the tracked resource update) use the TLS configuration of the plane.
+	ctx = proxy.WithPlane(ctx, plane.ID)
+
 	proxyReq, err := p.PrepareProxyRequest(ctx, req, downstreamURL.String(), relativePath)
 	if err != nil {
 		return nil, err
@@ -131,6 +195,10 @@ func (p *ProxyController) Run(ctx context.Context, w http.ResponseWriter, req *h
 		logger.V(ucplog.LevelDebug).Info("outgoing response header", "key", key, "value", value)
 	}
 
+	if interceptor.Response.StatusCode >= 200 && interceptor.Response.StatusCode < 300 {
+		p.webhooks.Notify(ctx, id, requestCtx.APIVersion, req.Method)
+	}
+
 	if !p.ShouldTrackRequest(req.Method, id, interceptor.Response) {
 		logger.V(ucplog.LevelDebug).Info("request does not need to be tracked")
 		return nil, nil
@@ -165,6 +233,90 @@ func (p *ProxyController) Run(ctx context.Context, w http.ResponseWriter, req *h
 	return nil, nil
 }
 
+// ConfigureDownstreamTLS applies the TLS configuration of a plane to requests of the plane sent to the downstream URL.
+// The client certificate secret is cached and read again after ClientCertificateRefreshInterval so that a rotated
+// certificate is picked up, and the transport is only rebuilt when the CA bundle or the contents of the secret change.
+// When the plane has no TLS configuration, the configuration previously applied for the plane is removed.
+func (p *ProxyController) ConfigureDownstreamTLS(ctx context.Context, planeID string, downstreamURL *url.URL, config *datamodel.RadiusPlaneTLS) error {
+	if p.downstreamTransport == nil {
+		return nil
+	}
+
+	if config == nil {
+		p.downstreamTransport.Remove(planeID, downstreamURL.Host)
+		return nil
+	}
+
+	hash := sha256.New()
+	_, _ = hash.Write([]byte(config.CABundle))
+
+	var clientCertificate *proxy.ClientCertificate
+	if config.ClientCertificateSecret != "" {
+		if p.secretClient == nil {
+			return fmt.Errorf("client certificate secret %q cannot be read: no secret client configured", config.ClientCertificateSecret)
+		}
+
+		data, err := p.clientCertificates.Get(ctx, p.secretClient, config.ClientCertificateSecret)
+		if err != nil {
+			return fmt.Errorf("failed to read client certificate secret %q: %w", config.ClientCertificateSecret, err)
+		}
+
+		clientCertificate = &proxy.ClientCertificate{}
+		if err := json.Unmarshal(data, clientCertificate); err != nil {
+			return fmt.Errorf("failed to read client certificate secret %q: %w", config.ClientCertificateSecret, err)
+		}
+
+		_, _ = hash.Write([]byte{0})
+		_, _ = hash.Write(data)
+	}
+
+	key := hex.EncodeToString(hash.Sum(nil))
+	return p.downstreamTransport.Configure(planeID, downstreamURL.Host, key, func() (*tls.Config, error) {
+		return proxy.NewTLSConfig(config.CABundle, clientCertificate)
+	})
+}
+
+// clientCertificateCache caches the client certificate secrets referenced by the TLS configuration of planes, so that
+// the secret store is not read on every proxied request.
+type clientCertificateCache struct {
+	mutex   sync.Mutex
+	entries map[string]cachedClientCertificate
+
+	// now returns the current time. Can be overridden for testing.
+	now func() time.Time
+}
+
+type cachedClientCertificate struct {
+	data     []byte
+	loadedAt time.Time
+}
+
+func newClientCertificateCache() *clientCertificateCache {
+	return &clientCertificateCache{entries: map[string]cachedClientCertificate{}, now: time.Now}
+}
+
+// Get returns the data of the named secret, reading it from the secret store when it is not cached or was read more
+// than ClientCertificateRefreshInterval ago.
+func (c *clientCertificateCache) Get(ctx context.Context, client secret.Client, name string) ([]byte, error) {
+	c.mutex.Lock()
+	entry, ok := c.entries[name]
+	c.mutex.Unlock()
+	if ok && c.now().Sub(entry.loadedAt) < ClientCertificateRefreshInterval {
+		return entry.data, nil
+	}
+
+	data, err := client.Get(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.entries[name] = cachedClientCertificate{data: data, loadedAt: c.now()}
+
+	return data, nil
+}
+
 // PrepareProxyRequest constructs and initializes the proxy request.
 func (p *ProxyController) PrepareProxyRequest(ctx context.Context, originalReq *http.Request, downstream string, relativePath string) (*http.Request, error) {
 	proxyReq := originalReq.Clone(ctx)
@@ -18,18 +18,33 @@ package radius
 
 import (
 	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
 	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/json"
+	"encoding/pem"
+	"io"
+	"math/big"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
+	"strings"
 	"testing"
+	"time"
 
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
 	"github.com/radius-project/radius/pkg/armrpc/asyncoperation/statusmanager"
 	"github.com/radius-project/radius/pkg/armrpc/frontend/controller"
 	"github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/ucp/config"
 	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/radius-project/radius/pkg/ucp/frontend/controller/webhooks"
+	"github.com/radius-project/radius/pkg/ucp/proxy"
 	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/radius-project/radius/pkg/ucp/secret"
 	"github.com/radius-project/radius/pkg/ucp/store"
 	"github.com/radius-project/radius/pkg/ucp/trackedresource"
 	"github.com/radius-project/radius/test/testcontext"
@@ -44,7 +59,7 @@ func createController(t *testing.T) (*ProxyController, *store.MockStorageClient,
 	storageClient := store.NewMockStorageClient(ctrl)
 	statusManager := statusmanager.NewMockStatusManager(ctrl)
 
-	p, err := NewProxyController(controller.Options{StorageClient: storageClient, StatusManager: statusManager})
+	p, err := NewProxyController(controller.Options{StorageClient: storageClient, StatusManager: statusManager}, nil, nil, nil)
 	require.NoError(t, err)
 
 	updater := mockUpdater{}
@@ -122,6 +137,11 @@ func Test_Run(t *testing.T) {
 			Get(gomock.Any(), id.RootScope(), gomock.Any()).
 			Return(&store.Object{Data: resourceGroup}, nil).Times(1)
 
+		// No management locks on the plane or resource group.
+		storageClient.EXPECT().
+			Query(gomock.Any(), gomock.Any()).
+			Return(&store.ObjectQueryResult{}, nil).Times(2)
+
 		downstreamResponse := httptest.NewRecorder()
 		downstreamResponse.WriteHeader(http.StatusOK)
 		roundTripper.Response = downstreamResponse.Result()
@@ -156,6 +176,11 @@ func Test_Run(t *testing.T) {
 			Get(gomock.Any(), id.RootScope(), gomock.Any()).
 			Return(&store.Object{Data: resourceGroup}, nil).Times(1)
 
+		// No management locks on the plane or resource group.
+		storageClient.EXPECT().
+			Query(gomock.Any(), gomock.Any()).
+			Return(&store.ObjectQueryResult{}, nil).Times(2)
+
 		// Tracking entry created
 		storageClient.EXPECT().
 			Get(gomock.Any(), gomock.Any(), gomock.Any()).
@@ -202,6 +227,11 @@ func Test_Run(t *testing.T) {
 			Get(gomock.Any(), id.RootScope(), gomock.Any()).
 			Return(&store.Object{Data: resourceGroup}, nil).Times(1)
 
+		// No management locks on the plane or resource group.
+		storageClient.EXPECT().
+			Query(gomock.Any(), gomock.Any()).
+			Return(&store.ObjectQueryResult{}, nil).Times(2)
+
 		// Tracking entry created
 		existingEntry := &store.Object{
 			Data: &datamodel.GenericResource{
@@ -233,6 +263,112 @@ func Test_Run(t *testing.T) {
 		require.Nil(t, response)
 	})
 
+	t.Run("failure (scope locked)", func(t *testing.T) {
+		p, storageClient, _, _, _ := createController(t)
+
+		svcContext := &v1.ARMRequestContext{
+			ResourceID: id,
+		}
+		ctx := testcontext.New(t)
+		ctx = v1.WithARMRequestContext(ctx, svcContext)
+
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodDelete, id.String(), nil)
+
+		storageClient.EXPECT().
+			Get(gomock.Any(), "/planes/"+id.PlaneNamespace(), gomock.Any()).
+			Return(&store.Object{Data: plane}, nil).Times(1)
+
+		storageClient.EXPECT().
+			Get(gomock.Any(), id.RootScope(), gomock.Any()).
+			Return(&store.Object{Data: resourceGroup}, nil).Times(1)
+
+		lock := datamodel.Lock{Properties: datamodel.LockProperties{Level: datamodel.LockLevelCanNotDelete}}
+		storageClient.EXPECT().
+			Query(gomock.Any(), store.Query{RootScope: id.PlaneScope(), ResourceType: datamodel.LockResourceType}).
+			Return(&store.ObjectQueryResult{Items: []store.Object{{Data: lock}}}, nil).Times(1)
+		storageClient.EXPECT().
+			Query(gomock.Any(), store.Query{RootScope: id.RootScope(), ResourceType: datamodel.LockResourceType}).
+			Return(&store.ObjectQueryResult{}, nil).Times(1)
+
+		response, err := p.Run(ctx, w, req.WithContext(ctx))
+		require.NoError(t, err)
+		require.IsType(t, &rest.ConflictResponse{}, response)
+	})
+
+	t.Run("failure (denied by webhook)", func(t *testing.T) {
+		p, storageClient, _, _, _ := createController(t)
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			_ = json.NewEncoder(w).Encode(webhooks.Response{Allowed: false, Message: "denied"})
+		}))
+		defer server.Close()
+
+		dispatcher, err := webhooks.NewDispatcher([]config.WebhookOptions{
+			{Name: "test", Type: config.WebhookTypeValidating, URL: server.URL, ResourceTypes: []string{webhooks.AllResourceTypes}},
+		}, server.Client())
+		require.NoError(t, err)
+		p.webhooks = dispatcher
+
+		svcContext := &v1.ARMRequestContext{
+			ResourceID: id,
+		}
+		ctx := testcontext.New(t)
+		ctx = v1.WithARMRequestContext(ctx, svcContext)
+
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodPut, id.String(), strings.NewReader("{}"))
+
+		storageClient.EXPECT().
+			Get(gomock.Any(), "/planes/"+id.PlaneNamespace(), gomock.Any()).
+			Return(&store.Object{Data: plane}, nil).Times(1)
+
+		storageClient.EXPECT().
+			Get(gomock.Any(), id.RootScope(), gomock.Any()).
+			Return(&store.Object{Data: resourceGroup}, nil).Times(1)
+
+		storageClient.EXPECT().
+			Query(gomock.Any(), gomock.Any()).
+			Return(&store.ObjectQueryResult{}, nil).Times(3)
+
+		response, err := p.Run(ctx, w, req.WithContext(ctx))
+		require.NoError(t, err)
+		require.IsType(t, &rest.BadRequestResponse{}, response)
+		require.Equal(t, webhooks.CodeRequestDeniedByWebhook, response.(*rest.BadRequestResponse).Body.Error.Code)
+	})
+
+	t.Run("failure (resource type not registered)", func(t *testing.T) {
+		p, storageClient, _, _, _ := createController(t)
+
+		registered := plane
+		registered.Properties.ResourceTypes = map[string]datamodel.RadiusPlaneResourceType{
+			"Applications.Test/otherResources": {APIVersions: []string{"2023-10-01-preview"}},
+		}
+
+		svcContext := &v1.ARMRequestContext{
+			ResourceID: id,
+			APIVersion: "2023-10-01-preview",
+		}
+		ctx := testcontext.New(t)
+		ctx = v1.WithARMRequestContext(ctx, svcContext)
+
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodPut, id.String(), strings.NewReader("{}"))
+
+		storageClient.EXPECT().
+			Get(gomock.Any(), "/planes/"+id.PlaneNamespace(), gomock.Any()).
+			Return(&store.Object{Data: registered}, nil).Times(1)
+
+		storageClient.EXPECT().
+			Get(gomock.Any(), id.RootScope(), gomock.Any()).
+			Return(&store.Object{Data: resourceGroup}, nil).Times(1)
+
+		response, err := p.Run(ctx, w, req.WithContext(ctx))
+		require.NoError(t, err)
+		require.IsType(t, &rest.BadRequestResponse{}, response)
+		require.Equal(t, v1.CodeInvalidResourceType, response.(*rest.BadRequestResponse).Body.Error.Code)
+	})
+
 	t.Run("failure (validate downstream: not found)", func(t *testing.T) {
 		p, storageClient, _, _, _ := createController(t)
 
@@ -311,6 +447,109 @@ func Test_ProxyController_PrepareProxyRequest(t *testing.T) {
 	})
 }
 
+func Test_ProxyController_ConfigureDownstreamTLS(t *testing.T) {
+	// The server echoes the common name of the client certificate.
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if len(r.TLS.PeerCertificates) > 0 {
+			_, _ = w.Write([]byte(r.TLS.PeerCertificates[0].Subject.CommonName))
+		}
+	}))
+	server.TLS = &tls.Config{ClientAuth: tls.RequestClientCert}
+	server.StartTLS()
+	defer server.Close()
+
+	serverURL, err := url.Parse(server.URL)
+	require.NoError(t, err)
+
+	config := &datamodel.RadiusPlaneTLS{
+		CABundle:                string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})),
+		ClientCertificateSecret: "client-cert",
+	}
+
+	mctrl := gomock.NewController(t)
+	secretClient := secret.NewMockClient(mctrl)
+
+	p, err := NewProxyController(controller.Options{}, secretClient, nil, nil)
+	require.NoError(t, err)
+	pc := p.(*ProxyController)
+	client := &http.Client{Transport: pc.downstreamTransport}
+
+	const planeID = "/planes/radius/local"
+	const otherPlaneID = "/planes/radius/other"
+
+	getFor := func(planeID string) (string, error) {
+		request, err := http.NewRequestWithContext(proxy.WithPlane(testcontext.New(t), planeID), http.MethodGet, server.URL, nil)
+		require.NoError(t, err)
+
+		response, err := client.Do(request)
+		if err != nil {
+			return "", err
+		}
+		defer response.Body.Close()
+		body, err := io.ReadAll(response.Body)
+		return string(body), err
+	}
+	get := func() (string, error) {
+		return getFor(planeID)
+	}
+
+	// The secret is only read once within the refresh interval.
+	secretClient.EXPECT().Get(gomock.Any(), "client-cert").Return(newClientCertificateSecret(t, "first"), nil).Times(1)
+	require.NoError(t, pc.ConfigureDownstreamTLS(testcontext.New(t), planeID, serverURL, config))
+	require.NoError(t, pc.ConfigureDownstreamTLS(testcontext.New(t), planeID, serverURL, config))
+	cn, err := get()
+	require.NoError(t, err)
+	require.Equal(t, "first", cn)
+
+	// The certificate is rotated under the same secret name, and picked up once the refresh interval has passed.
+	secretClient.EXPECT().Get(gomock.Any(), "client-cert").Return(newClientCertificateSecret(t, "second"), nil)
+	pc.clientCertificates.now = func() time.Time { return time.Now().Add(ClientCertificateRefreshInterval) }
+	require.NoError(t, pc.ConfigureDownstreamTLS(testcontext.New(t), planeID, serverURL, config))
+	cn, err = get()
+	require.NoError(t, err)
+	require.Equal(t, "second", cn)
+
+	// Another plane on the same host without TLS configuration does not affect the plane.
+	require.NoError(t, pc.ConfigureDownstreamTLS(testcontext.New(t), otherPlaneID, serverURL, nil))
+	_, err = getFor(otherPlaneID)
+	require.Error(t, err)
+	cn, err = get()
+	require.NoError(t, err)
+	require.Equal(t, "second", cn)
+
+	// The TLS configuration is removed from the plane, so the server certificate is no longer trusted.
+	require.NoError(t, pc.ConfigureDownstreamTLS(testcontext.New(t), planeID, serverURL, nil))
+	_, err = get()
+	require.Error(t, err)
+}
+
+// newClientCertificateSecret creates the secret data of a self-signed client certificate with the given common name.
+func newClientCertificateSecret(t *testing.T, commonName string) []byte {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: commonName},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+
+	data, err := json.Marshal(proxy.ClientCertificate{
+		Certificate: string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})),
+		PrivateKey:  string(pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})),
+	})
+	require.NoError(t, err)
+
+	return data
+}
+
 type mockUpdater struct {
 	Result error
 }
@@ -20,6 +20,9 @@ import (
 	"errors"
 	"fmt"
 	"net/url"
+	"strings"
+	"sync"
+	"time"
 
 	"github.com/radius-project/radius/pkg/ucp/datamodel"
 	"github.com/radius-project/radius/pkg/ucp/resources"
@@ -63,16 +66,26 @@ func (e *InvalidError) Is(err error) bool {
 // Returns NotFoundError for the case where the plane or resource group does not exist.
 // Returns InvalidError for cases where the data is invalid, like when the resource provider is not configured.
 func ValidateDownstream(ctx context.Context, client store.StorageClient, id resources.ID) (*url.URL, error) {
+	downstreamURL, _, err := ResolveDownstream(ctx, client, id)
+	return downstreamURL, err
+}
+
+// ResolveDownstream is like ValidateDownstream, but also returns the plane that the downstream URL was resolved from.
+func ResolveDownstream(ctx context.Context, client store.StorageClient, id resources.ID) (*url.URL, *datamodel.RadiusPlane, error) {
+	return resolveDownstream(ctx, client, id, nil)
+}
+
+func resolveDownstream(ctx context.Context, client store.StorageClient, id resources.ID, cache *DownstreamCache) (*url.URL, *datamodel.RadiusPlane, error) {
 	planeID, err := resources.ParseScope(id.PlaneScope())
 	if err != nil {
 		// Not expected to happen.
-		return nil, err
+		return nil, nil, err
 	}
-	plane, err := store.GetResource[datamodel.RadiusPlane](ctx, client, planeID.String())
+	plane, err := cache.getPlane(ctx, client, planeID.String())
 	if errors.Is(err, &store.ErrNotFound{}) {
-		return nil, &NotFoundError{Message: fmt.Sprintf("plane %q not found", planeID.String())}
+		return nil, nil, &NotFoundError{Message: fmt.Sprintf("plane %q not found", planeID.String())}
 	} else if err != nil {
-		return nil, fmt.Errorf("failed to find plane %q: %w", planeID.String(), err)
+		return nil, nil, fmt.Errorf("failed to find plane %q: %w", planeID.String(), err)
 	}
 
 	// If the ID contains a resource group, validate it now.
@@ -80,26 +93,202 @@ func ValidateDownstream(ctx context.Context, client store.StorageClient, id reso
 		resourceGroupID, err := resources.ParseScope(id.RootScope())
 		if err != nil {
 			// Not expected to happen.
-			return nil, err
+			return nil, nil, err
 		}
 
-		_, err = store.GetResource[datamodel.ResourceGroup](ctx, client, resourceGroupID.String())
+		err = cache.checkResourceGroup(ctx, client, resourceGroupID.String())
 		if errors.Is(err, &store.ErrNotFound{}) {
-			return nil, &NotFoundError{Message: fmt.Sprintf("resource group %q not found", resourceGroupID.String())}
+			return nil, nil, &NotFoundError{Message: fmt.Sprintf("resource group %q not found", resourceGroupID.String())}
 		} else if err != nil {
-			return nil, fmt.Errorf("failed to find resource group %q: %w", resourceGroupID.String(), err)
+			return nil, nil, fmt.Errorf("failed to find resource group %q: %w", resourceGroupID.String(), err)
 		}
 	}
 
 	downstream := plane.LookupResourceProvider(id.ProviderNamespace())
 	if downstream == "" {
-		return nil, &InvalidError{Message: fmt.Sprintf("resource provider %s not configured", id.ProviderNamespace())}
+		return nil, nil, &InvalidError{Message: fmt.Sprintf("resource provider %s not configured", id.ProviderNamespace())}
 	}
 
 	downstreamURL, err := url.Parse(downstream)
 	if err != nil {
-		return nil, &InvalidError{Message: fmt.Sprintf("failed to parse downstream URL: %v", err.Error())}
+		return nil, nil, &InvalidError{Message: fmt.Sprintf("failed to parse downstream URL: %v", err.Error())}
+	}
+
+	return downstreamURL, plane, nil
+}
+
+// DefaultDownstreamCacheTTL is the default duration that lookups are cached by DownstreamCache.
+const DefaultDownstreamCacheTTL = 5 * time.Second
+
+// DownstreamCache caches the planes and resource groups read from storage when resolving the downstream URL
+// of a proxied request. Only successful lookups are cached.
+//
+// Entries are removed when Invalidate is called for their ID, and expire after the TTL so that changes made
+// through other replicas of UCP are observed. A nil *DownstreamCache is valid and disables caching.
+type DownstreamCache struct {
+	ttl   time.Duration
+	now   func() time.Time
+	mutex sync.Mutex
+
+	entries map[string]downstreamCacheEntry
+
+	// generation is incremented by Invalidate. A lookup that started reading storage before an invalidation is
+	// not added to the cache, because it may have read the state from before the change.
+	generation uint64
+
+	// nextSweep is the time when expired entries are next removed from entries.
+	nextSweep time.Time
+}
+
+type downstreamCacheEntry struct {
+	// plane is the cached plane, or nil for resource groups.
+	plane   *datamodel.RadiusPlane
+	expires time.Time
+}
+
+// NewDownstreamCache creates a new DownstreamCache that caches lookups for the given duration.
+func NewDownstreamCache(ttl time.Duration) *DownstreamCache {
+	return &DownstreamCache{
+		ttl:     ttl,
+		now:     time.Now,
+		entries: map[string]downstreamCacheEntry{},
+	}
+}
+
+// ResolveDownstream is like the ResolveDownstream function, but uses the cache for storage lookups.
+//
+// The returned plane may be shared with other callers and must not be modified.
+func (c *DownstreamCache) ResolveDownstream(ctx context.Context, client store.StorageClient, id resources.ID) (*url.URL, *datamodel.RadiusPlane, error) {
+	return resolveDownstream(ctx, client, id, c)
+}
+
+// Invalidate removes the cached entry for the plane or resource group with the given ID.
+func (c *DownstreamCache) Invalidate(id string) {
+	if c == nil {
+		return
+	}
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.generation++
+	delete(c.entries, strings.ToLower(id))
+}
+
+// InvalidatingClient returns a storage client that invalidates the cached entry of a plane or resource group after
+// it has been saved or deleted through the client. Controllers that modify planes or resource groups should use it,
+// so that the cache never holds the state from before a completed change.
+func (c *DownstreamCache) InvalidatingClient(client store.StorageClient) store.StorageClient {
+	if c == nil {
+		return client
+	}
+
+	return &invalidatingClient{StorageClient: client, cache: c}
+}
+
+type invalidatingClient struct {
+	store.StorageClient
+	cache *DownstreamCache
+}
+
+// Save saves the object and then invalidates its cached entry.
+func (c *invalidatingClient) Save(ctx context.Context, obj *store.Object, options ...store.SaveOptions) error {
+	err := c.StorageClient.Save(ctx, obj, options...)
+	if err != nil {
+		return err
+	}
+
+	c.cache.Invalidate(obj.ID)
+	return nil
+}
+
+// Delete deletes the object and then invalidates its cached entry.
+func (c *invalidatingClient) Delete(ctx context.Context, id string, options ...store.DeleteOptions) error {
+	err := c.StorageClient.Delete(ctx, id, options...)
+	if err != nil {
+		return err
+	}
+
+	c.cache.Invalidate(id)
+	return nil
+}
+
+// lookup returns the cached entry for the given ID, and the generation of the cache to pass to add if the entry
+// is not found.
+func (c *DownstreamCache) lookup(id string) (downstreamCacheEntry, uint64, bool) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	key := strings.ToLower(id)
+	entry, ok := c.entries[key]
+	if !ok {
+		return downstreamCacheEntry{}, c.generation, false
+	}
+
+	if !c.now().Before(entry.expires) {
+		delete(c.entries, key)
+		return downstreamCacheEntry{}, c.generation, false
+	}
+
+	return entry, c.generation, true
+}
+
+func (c *DownstreamCache) add(id string, plane *datamodel.RadiusPlane, generation uint64) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	now := c.now()
+	if !now.Before(c.nextSweep) {
+		// Remove the entries that have expired without being looked up again.
+		for key, entry := range c.entries {
+			if !now.Before(entry.expires) {
+				delete(c.entries, key)
+			}
+		}
+		c.nextSweep = now.Add(c.ttl)
+	}
+
+	if generation != c.generation {
+		return
+	}
+
+	c.entries[strings.ToLower(id)] = downstreamCacheEntry{plane: plane, expires: now.Add(c.ttl)}
+}
+
+func (c *DownstreamCache) getPlane(ctx context.Context, client store.StorageClient, id string) (*datamodel.RadiusPlane, error) {
+	if c == nil {
+		return store.GetResource[datamodel.RadiusPlane](ctx, client, id)
+	}
+
+	entry, generation, ok := c.lookup(id)
+	if ok && entry.plane != nil {
+		return entry.plane, nil
+	}
+
+	plane, err := store.GetResource[datamodel.RadiusPlane](ctx, client, id)
+	if err != nil {
+		return nil, err
+	}
+
+	c.add(id, plane, generation)
+	return plane, nil
+}
+
+func (c *DownstreamCache) checkResourceGroup(ctx context.Context, client store.StorageClient, id string) error {
+	if c == nil {
+		_, err := store.GetResource[datamodel.ResourceGroup](ctx, client, id)
+		return err
+	}
+
+	_, generation, ok := c.lookup(id)
+	if ok {
+		return nil
+	}
+
+	_, err := store.GetResource[datamodel.ResourceGroup](ctx, client, id)
+	if err != nil {
+		return err
 	}
 
-	return downstreamURL, nil
+	c.add(id, nil, generation)
+	return nil
 }
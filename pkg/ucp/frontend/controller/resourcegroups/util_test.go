@@ -16,10 +16,12 @@ limitations under the License.
 package resourcegroups
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"net/url"
 	"testing"
+	"time"
 
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
 	"github.com/radius-project/radius/pkg/ucp/datamodel"
@@ -197,3 +199,139 @@ func Test_ValidateDownstream(t *testing.T) {
 		require.Nil(t, downstreamURL)
 	})
 }
+
+func Test_DownstreamCache(t *testing.T) {
+	id := resources.MustParse("/planes/radius/local/resourceGroups/test-group/providers/System.TestRP/testResources/name")
+
+	plane := &datamodel.RadiusPlane{
+		Properties: datamodel.RadiusPlaneProperties{
+			ResourceProviders: map[string]string{
+				"System.TestRP": "http://localhost:7443",
+			},
+		},
+	}
+	resourceGroup := &datamodel.ResourceGroup{}
+
+	t.Run("lookups are cached", func(t *testing.T) {
+		mock := store.NewMockStorageClient(gomock.NewController(t))
+		mock.EXPECT().Get(gomock.Any(), id.PlaneScope()).Return(&store.Object{Data: plane}, nil).Times(1)
+		mock.EXPECT().Get(gomock.Any(), id.RootScope()).Return(&store.Object{Data: resourceGroup}, nil).Times(1)
+
+		cache := NewDownstreamCache(DefaultDownstreamCacheTTL)
+		for i := 0; i < 3; i++ {
+			downstreamURL, _, err := cache.ResolveDownstream(testcontext.New(t), mock, id)
+			require.NoError(t, err)
+			require.Equal(t, "http://localhost:7443", downstreamURL.String())
+		}
+	})
+
+	t.Run("not found is not cached", func(t *testing.T) {
+		mock := store.NewMockStorageClient(gomock.NewController(t))
+		mock.EXPECT().Get(gomock.Any(), id.PlaneScope()).Return(&store.Object{Data: plane}, nil).Times(1)
+		mock.EXPECT().Get(gomock.Any(), id.RootScope()).Return(nil, &store.ErrNotFound{}).Times(2)
+
+		cache := NewDownstreamCache(DefaultDownstreamCacheTTL)
+		for i := 0; i < 2; i++ {
+			_, _, err := cache.ResolveDownstream(testcontext.New(t), mock, id)
+			require.ErrorIs(t, err, &NotFoundError{})
+		}
+	})
+
+	t.Run("invalidate", func(t *testing.T) {
+		mock := store.NewMockStorageClient(gomock.NewController(t))
+		mock.EXPECT().Get(gomock.Any(), id.PlaneScope()).Return(&store.Object{Data: plane}, nil).Times(2)
+		mock.EXPECT().Get(gomock.Any(), id.RootScope()).Return(&store.Object{Data: resourceGroup}, nil).Times(1)
+
+		cache := NewDownstreamCache(DefaultDownstreamCacheTTL)
+		_, _, err := cache.ResolveDownstream(testcontext.New(t), mock, id)
+		require.NoError(t, err)
+
+		cache.Invalidate("/PLANES/radius/local")
+
+		_, _, err = cache.ResolveDownstream(testcontext.New(t), mock, id)
+		require.NoError(t, err)
+	})
+
+	t.Run("invalidating client", func(t *testing.T) {
+		mock := store.NewMockStorageClient(gomock.NewController(t))
+		mock.EXPECT().Get(gomock.Any(), id.PlaneScope()).Return(&store.Object{Data: plane}, nil).Times(2)
+		mock.EXPECT().Get(gomock.Any(), id.RootScope()).Return(&store.Object{Data: resourceGroup}, nil).Times(2)
+		mock.EXPECT().Save(gomock.Any(), gomock.Any(), gomock.Any()).Return(&store.ErrConcurrency{})
+		mock.EXPECT().Save(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+		mock.EXPECT().Delete(gomock.Any(), id.RootScope(), gomock.Any()).Return(nil)
+
+		cache := NewDownstreamCache(DefaultDownstreamCacheTTL)
+		client := cache.InvalidatingClient(mock)
+
+		_, _, err := cache.ResolveDownstream(testcontext.New(t), mock, id)
+		require.NoError(t, err)
+
+		// A failed save does not invalidate the cache.
+		err = client.Save(testcontext.New(t), &store.Object{Metadata: store.Metadata{ID: id.PlaneScope()}, Data: plane})
+		require.Error(t, err)
+		_, _, err = cache.ResolveDownstream(testcontext.New(t), mock, id)
+		require.NoError(t, err)
+
+		err = client.Save(testcontext.New(t), &store.Object{Metadata: store.Metadata{ID: id.PlaneScope()}, Data: plane})
+		require.NoError(t, err)
+		err = client.Delete(testcontext.New(t), id.RootScope())
+		require.NoError(t, err)
+
+		_, _, err = cache.ResolveDownstream(testcontext.New(t), mock, id)
+		require.NoError(t, err)
+	})
+
+	t.Run("lookup concurrent with invalidation is not cached", func(t *testing.T) {
+		cache := NewDownstreamCache(DefaultDownstreamCacheTTL)
+
+		mock := store.NewMockStorageClient(gomock.NewController(t))
+		mock.EXPECT().Get(gomock.Any(), id.PlaneScope()).
+			DoAndReturn(func(ctx context.Context, id string, options ...store.GetOptions) (*store.Object, error) {
+				// The plane is updated after it was read.
+				cache.Invalidate(id)
+				return &store.Object{Data: plane}, nil
+			}).Times(2)
+		mock.EXPECT().Get(gomock.Any(), id.RootScope()).Return(&store.Object{Data: resourceGroup}, nil).Times(1)
+
+		for i := 0; i < 2; i++ {
+			_, _, err := cache.ResolveDownstream(testcontext.New(t), mock, id)
+			require.NoError(t, err)
+		}
+	})
+
+	t.Run("expired entries are removed", func(t *testing.T) {
+		mock := store.NewMockStorageClient(gomock.NewController(t))
+		mock.EXPECT().Get(gomock.Any(), gomock.Any()).Return(&store.Object{Data: resourceGroup}, nil).AnyTimes()
+
+		now := time.Now()
+		cache := NewDownstreamCache(DefaultDownstreamCacheTTL)
+		cache.now = func() time.Time { return now }
+
+		require.NoError(t, cache.checkResourceGroup(testcontext.New(t), mock, "/planes/radius/local/resourceGroups/a"))
+		require.NoError(t, cache.checkResourceGroup(testcontext.New(t), mock, "/planes/radius/local/resourceGroups/b"))
+		require.Len(t, cache.entries, 2)
+
+		now = now.Add(DefaultDownstreamCacheTTL)
+
+		require.NoError(t, cache.checkResourceGroup(testcontext.New(t), mock, "/planes/radius/local/resourceGroups/c"))
+		require.Len(t, cache.entries, 1)
+	})
+
+	t.Run("entries expire", func(t *testing.T) {
+		mock := store.NewMockStorageClient(gomock.NewController(t))
+		mock.EXPECT().Get(gomock.Any(), id.PlaneScope()).Return(&store.Object{Data: plane}, nil).Times(2)
+		mock.EXPECT().Get(gomock.Any(), id.RootScope()).Return(&store.Object{Data: resourceGroup}, nil).Times(2)
+
+		now := time.Now()
+		cache := NewDownstreamCache(DefaultDownstreamCacheTTL)
+		cache.now = func() time.Time { return now }
+
+		_, _, err := cache.ResolveDownstream(testcontext.New(t), mock, id)
+		require.NoError(t, err)
+
+		now = now.Add(DefaultDownstreamCacheTTL)
+
+		_, _, err = cache.ResolveDownstream(testcontext.New(t), mock, id)
+		require.NoError(t, err)
+	})
+}
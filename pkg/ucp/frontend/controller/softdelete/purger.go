@@ -0,0 +1,75 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package softdelete
+
+import (
+	"context"
+	"time"
+
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/radius-project/radius/pkg/ucp/store"
+	"github.com/radius-project/radius/pkg/ucp/store/partition"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+)
+
+const (
+	// DefaultPurgeInterval is the default interval between purges of expired soft-deleted planes and resource groups.
+	DefaultPurgeInterval = time.Hour
+
+	// purgeRootScope is the root scope queried for expired soft-deleted planes and resource groups.
+	purgeRootScope = "/planes"
+)
+
+// RunPurger purges the expired soft-deleted planes and resource groups stored in the given clients every interval
+// until the context is canceled.
+func RunPurger(ctx context.Context, interval time.Duration, clients ...store.StorageClient) {
+	if interval <= 0 {
+		interval = DefaultPurgeInterval
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		purgeExpired(ctx, clients, time.Now().UTC())
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// purgeExpired purges the expired soft-deleted planes and resource groups of every storage partition in the given
+// clients. Purging is best-effort, failures are logged and retried on the next purge.
+func purgeExpired(ctx context.Context, clients []store.StorageClient, now time.Time) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+	for _, client := range clients {
+		partitions, err := partition.Partitions(ctx, client, datamodel.DeletedResourceType)
+		if err != nil {
+			logger.Error(err, "failed to list the partitions of deleted resources")
+			continue
+		}
+
+		for _, p := range partitions {
+			if err := Purge(partition.WithPartition(ctx, p), client, purgeRootScope, now); err != nil {
+				logger.Error(err, "failed to purge expired deleted resources", "partition", p)
+			}
+		}
+	}
+}
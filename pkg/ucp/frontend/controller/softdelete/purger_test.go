@@ -0,0 +1,56 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package softdelete
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/radius-project/radius/pkg/ucp/store"
+	"github.com/radius-project/radius/test/testcontext"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_PurgeExpired(t *testing.T) {
+	now := time.Now()
+	mctrl := gomock.NewController(t)
+
+	// A failure to purge one client does not stop the others from being purged.
+	failing := store.NewMockStorageClient(mctrl)
+	failing.EXPECT().
+		Query(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(nil, errors.New("query failed"))
+
+	storageClient := store.NewMockStorageClient(mctrl)
+	storageClient.EXPECT().
+		Query(gomock.Any(), store.Query{RootScope: "/planes", ScopeRecursive: true, ResourceType: datamodel.DeletedResourceType}, gomock.Any()).
+		Return(&store.ObjectQueryResult{
+			Items: []store.Object{
+				{
+					Metadata: store.Metadata{ID: "/planes/radius/other/providers/System.Resources/deletedResources/default"},
+					Data:     datamodel.DeletedResource{Properties: datamodel.DeletedResourceProperties{ExpiresAt: now.Add(-time.Minute)}},
+				},
+			},
+		}, nil)
+	storageClient.EXPECT().
+		Delete(gomock.Any(), "/planes/radius/other/providers/System.Resources/deletedResources/default").
+		Return(nil)
+
+	purgeExpired(testcontext.New(t), []store.StorageClient{failing, storageClient}, now)
+}
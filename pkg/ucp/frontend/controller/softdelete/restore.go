@@ -0,0 +1,97 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package softdelete
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	armrpc_controller "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	armrpc_rest "github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/radius-project/radius/pkg/ucp/store"
+)
+
+// RestoreResource is the controller implementation to restore a soft-deleted plane or resource group.
+type RestoreResource[P interface {
+	*T
+	v1.ResourceDataModel
+}, T any] struct {
+	armrpc_controller.Operation[P, T]
+}
+
+// NewRestoreResource creates a new RestoreResource controller.
+func NewRestoreResource[P interface {
+	*T
+	v1.ResourceDataModel
+}, T any](opts armrpc_controller.Options, resourceOpts armrpc_controller.ResourceOptions[T]) (armrpc_controller.Controller, error) {
+	return &RestoreResource[P, T]{armrpc_controller.NewOperation[P](opts, resourceOpts)}, nil
+}
+
+// Run restores the soft-deleted copy of the plane or resource group. It returns a Not Found response if there is no copy
+// or the retention period has elapsed, and a Conflict response if the plane or resource group already exists.
+func (e *RestoreResource[P, T]) Run(ctx context.Context, w http.ResponseWriter, req *http.Request) (armrpc_rest.Response, error) {
+	serviceCtx := v1.ARMRequestContextFromContext(ctx)
+	id := serviceCtx.ResourceID
+
+	existing, _, err := e.GetResource(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if existing != nil {
+		return armrpc_rest.NewConflictResponse(fmt.Sprintf("resource %q already exists and cannot be restored", id.String())), nil
+	}
+
+	deletedID := DeletedResourceID(id)
+	deleted, err := store.GetResource[datamodel.DeletedResource](ctx, e.StorageClient(), deletedID.String())
+	if errors.Is(err, &store.ErrNotFound{}) {
+		return armrpc_rest.NewNotFoundResponse(id), nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	if deleted.IsExpired(time.Now()) {
+		err = e.StorageClient().Delete(ctx, deletedID.String())
+		if err != nil && !errors.Is(err, &store.ErrNotFound{}) {
+			return nil, err
+		}
+
+		return armrpc_rest.NewNotFoundResponse(id), nil
+	}
+
+	resource := new(T)
+	if err := store.DecodeMap(deleted.Properties.Data, resource); err != nil {
+		return nil, fmt.Errorf("failed to read deleted resource %q: %w", deletedID.String(), err)
+	}
+
+	etag, err := e.SaveResource(ctx, id.String(), resource, "")
+	if err != nil {
+		return nil, err
+	}
+
+	err = e.StorageClient().Delete(ctx, deletedID.String())
+	if err != nil && !errors.Is(err, &store.ErrNotFound{}) {
+		return nil, err
+	}
+
+	return e.ConstructSyncResponse(ctx, req.Method, etag, resource)
+}
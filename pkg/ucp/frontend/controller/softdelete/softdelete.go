@@ -0,0 +1,134 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package softdelete
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	armrpc_controller "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	armrpc_rest "github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/radius-project/radius/pkg/ucp/store"
+)
+
+const (
+	// OperationRestore is the operation method for restoring a soft-deleted plane or resource group.
+	OperationRestore v1.OperationMethod = "ACTIONRESTORE"
+
+	// deletedResourceName is the name of the deleted resource stored in the scope of a soft-deleted plane or resource group.
+	deletedResourceName = "default"
+)
+
+// DeletedResourceID returns the ID used to store the soft-deleted copy of the plane or resource group with the given ID.
+//
+// Example:
+//
+//	/planes/radius/local/resourceGroups/rg1 -> /planes/radius/local/resourceGroups/rg1/providers/System.Resources/deletedResources/default
+func DeletedResourceID(id resources.ID) resources.ID {
+	return id.Append(resources.TypeSegment{Type: datamodel.DeletedResourceType, Name: deletedResourceName})
+}
+
+// NewDeleteFilter returns a delete filter that stores a copy of the plane or resource group being deleted, so that it can
+// be restored until the retention period elapses. Soft-delete is disabled when the retention period is not positive.
+//
+// Copies whose retention period has elapsed are purged in the background (see RunPurger).
+func NewDeleteFilter[T any](retentionPeriod time.Duration) armrpc_controller.DeleteFilter[T] {
+	return func(ctx context.Context, oldResource *T, options *armrpc_controller.Options) (armrpc_rest.Response, error) {
+		if retentionPeriod <= 0 {
+			return nil, nil
+		}
+
+		id := v1.ARMRequestContextFromContext(ctx).ResourceID
+		b, err := json.Marshal(oldResource)
+		if err != nil {
+			return nil, err
+		}
+
+		data := map[string]any{}
+		if err := json.Unmarshal(b, &data); err != nil {
+			return nil, err
+		}
+
+		now := time.Now().UTC()
+		deletedID := DeletedResourceID(id)
+		deleted := &datamodel.DeletedResource{
+			BaseResource: v1.BaseResource{
+				TrackedResource: v1.TrackedResource{
+					ID:   deletedID.String(),
+					Name: deletedResourceName,
+					Type: datamodel.DeletedResourceType,
+				},
+			},
+			Properties: datamodel.DeletedResourceProperties{
+				DeletedAt: now,
+				ExpiresAt: now.Add(retentionPeriod),
+				Data:      data,
+			},
+		}
+
+		err = options.StorageClient.Save(ctx, &store.Object{Metadata: store.Metadata{ID: deletedID.String()}, Data: deleted})
+		if err != nil {
+			return nil, fmt.Errorf("failed to save deleted resource %q: %w", deletedID.String(), err)
+		}
+
+		return nil, nil
+	}
+}
+
+// Purge removes the soft-deleted planes and resource groups in the given scope whose retention period has elapsed.
+func Purge(ctx context.Context, client store.StorageClient, rootScope string, now time.Time) error {
+	query := store.Query{
+		RootScope:      rootScope,
+		ScopeRecursive: true,
+		ResourceType:   datamodel.DeletedResourceType,
+	}
+
+	token := ""
+	for {
+		result, err := client.Query(ctx, query, store.WithPaginationToken(token))
+		if err != nil {
+			return err
+		}
+
+		for _, item := range result.Items {
+			deleted := datamodel.DeletedResource{}
+			if err := item.As(&deleted); err != nil {
+				return err
+			}
+
+			if !deleted.IsExpired(now) {
+				continue
+			}
+
+			err = client.Delete(ctx, item.ID)
+			if err != nil && !errors.Is(err, &store.ErrNotFound{}) {
+				return err
+			}
+		}
+
+		if result.PaginationToken == "" {
+			return nil
+		}
+		token = result.PaginationToken
+	}
+}
@@ -0,0 +1,221 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package softdelete
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	armrpc_controller "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/armrpc/rpctest"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/radius-project/radius/pkg/ucp/datamodel/converter"
+	"github.com/radius-project/radius/pkg/ucp/store"
+	"github.com/radius-project/radius/test/testcontext"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+const (
+	resourceGroupID = "/planes/radius/local/resourceGroups/test-rg"
+	deletedID       = "/planes/radius/local/resourceGroups/test-rg/providers/System.Resources/deletedResources/default"
+)
+
+func Test_NewDeleteFilter(t *testing.T) {
+	resourceGroup := &datamodel.ResourceGroup{
+		BaseResource: v1.BaseResource{
+			TrackedResource: v1.TrackedResource{ID: resourceGroupID, Name: "test-rg"},
+		},
+	}
+
+	setup := func(t *testing.T) (*store.MockStorageClient, *armrpc_controller.Options, *http.Request) {
+		storageClient := store.NewMockStorageClient(gomock.NewController(t))
+		req, err := http.NewRequest(http.MethodDelete, resourceGroupID+"?api-version=2023-10-01-preview", nil)
+		require.NoError(t, err)
+		req = req.WithContext(rpctest.NewARMRequestContext(req))
+		return storageClient, &armrpc_controller.Options{StorageClient: storageClient}, req
+	}
+
+	t.Run("disabled", func(t *testing.T) {
+		_, options, req := setup(t)
+
+		filter := NewDeleteFilter[datamodel.ResourceGroup](0)
+		resp, err := filter(req.Context(), resourceGroup, options)
+		require.NoError(t, err)
+		require.Nil(t, resp)
+	})
+
+	t.Run("soft-delete", func(t *testing.T) {
+		storageClient, options, req := setup(t)
+
+		var saved *datamodel.DeletedResource
+		storageClient.EXPECT().
+			Save(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, obj *store.Object, opts ...store.SaveOptions) error {
+				require.Equal(t, deletedID, obj.ID)
+				saved = obj.Data.(*datamodel.DeletedResource)
+				return nil
+			})
+
+		filter := NewDeleteFilter[datamodel.ResourceGroup](time.Hour)
+		resp, err := filter(req.Context(), resourceGroup, options)
+		require.NoError(t, err)
+		require.Nil(t, resp)
+
+		require.NotNil(t, saved)
+		require.Equal(t, time.Hour, saved.Properties.ExpiresAt.Sub(saved.Properties.DeletedAt))
+
+		require.Equal(t, resourceGroupID, saved.Properties.Data["id"])
+		require.Equal(t, "test-rg", saved.Properties.Data["name"])
+	})
+}
+
+func Test_Purge(t *testing.T) {
+	now := time.Now()
+	storageClient := store.NewMockStorageClient(gomock.NewController(t))
+	storageClient.EXPECT().
+		Query(gomock.Any(), store.Query{RootScope: "/planes/radius/local", ScopeRecursive: true, ResourceType: datamodel.DeletedResourceType}, gomock.Any()).
+		Return(&store.ObjectQueryResult{
+			Items: []store.Object{
+				{
+					Metadata: store.Metadata{ID: "/planes/radius/local/resourceGroups/rg1/providers/System.Resources/deletedResources/default"},
+					Data:     datamodel.DeletedResource{Properties: datamodel.DeletedResourceProperties{ExpiresAt: now}},
+				},
+			},
+			PaginationToken: "next",
+		}, nil)
+	storageClient.EXPECT().
+		Query(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(&store.ObjectQueryResult{
+			Items: []store.Object{
+				{
+					Metadata: store.Metadata{ID: "/planes/radius/local/resourceGroups/rg2/providers/System.Resources/deletedResources/default"},
+					Data:     datamodel.DeletedResource{Properties: datamodel.DeletedResourceProperties{ExpiresAt: now.Add(time.Minute)}},
+				},
+			},
+		}, nil)
+	storageClient.EXPECT().
+		Delete(gomock.Any(), "/planes/radius/local/resourceGroups/rg1/providers/System.Resources/deletedResources/default").
+		Return(&store.ErrNotFound{})
+
+	err := Purge(testcontext.New(t), storageClient, "/planes/radius/local", now)
+	require.NoError(t, err)
+}
+
+func Test_RestoreResource(t *testing.T) {
+	resourceGroup := &datamodel.ResourceGroup{
+		BaseResource: v1.BaseResource{
+			TrackedResource: v1.TrackedResource{
+				ID:   resourceGroupID,
+				Name: "test-rg",
+				Type: "System.Resources/resourceGroups",
+			},
+		},
+	}
+	data := map[string]any{
+		"id":   resourceGroupID,
+		"name": "test-rg",
+		"type": "System.Resources/resourceGroups",
+	}
+
+	setup := func(t *testing.T) (*store.MockStorageClient, armrpc_controller.Controller, *http.Request) {
+		storageClient := store.NewMockStorageClient(gomock.NewController(t))
+		ctrl, err := NewRestoreResource(armrpc_controller.Options{StorageClient: storageClient}, armrpc_controller.ResourceOptions[datamodel.ResourceGroup]{
+			RequestConverter:  converter.ResourceGroupDataModelFromVersioned,
+			ResponseConverter: converter.ResourceGroupDataModelToVersioned,
+		})
+		require.NoError(t, err)
+
+		req, err := http.NewRequest(http.MethodPost, resourceGroupID+"/restore?api-version=2023-10-01-preview", nil)
+		require.NoError(t, err)
+		req = req.WithContext(rpctest.NewARMRequestContext(req))
+		return storageClient, ctrl, req
+	}
+
+	t.Run("already exists", func(t *testing.T) {
+		storageClient, ctrl, req := setup(t)
+		storageClient.EXPECT().Get(gomock.Any(), resourceGroupID).Return(&store.Object{Data: resourceGroup}, nil)
+
+		resp, err := ctrl.Run(req.Context(), nil, req)
+		require.NoError(t, err)
+
+		w := httptest.NewRecorder()
+		require.NoError(t, resp.Apply(req.Context(), w, req))
+		require.Equal(t, http.StatusConflict, w.Code)
+	})
+
+	t.Run("not deleted", func(t *testing.T) {
+		storageClient, ctrl, req := setup(t)
+		storageClient.EXPECT().Get(gomock.Any(), resourceGroupID).Return(nil, &store.ErrNotFound{})
+		storageClient.EXPECT().Get(gomock.Any(), deletedID).Return(nil, &store.ErrNotFound{})
+
+		resp, err := ctrl.Run(req.Context(), nil, req)
+		require.NoError(t, err)
+
+		w := httptest.NewRecorder()
+		require.NoError(t, resp.Apply(req.Context(), w, req))
+		require.Equal(t, http.StatusNotFound, w.Code)
+	})
+
+	t.Run("expired", func(t *testing.T) {
+		storageClient, ctrl, req := setup(t)
+		storageClient.EXPECT().Get(gomock.Any(), resourceGroupID).Return(nil, &store.ErrNotFound{})
+		storageClient.EXPECT().Get(gomock.Any(), deletedID).Return(&store.Object{
+			Data: &datamodel.DeletedResource{
+				Properties: datamodel.DeletedResourceProperties{ExpiresAt: time.Now().Add(-time.Minute), Data: data},
+			},
+		}, nil)
+		storageClient.EXPECT().Delete(gomock.Any(), deletedID).Return(nil)
+
+		resp, err := ctrl.Run(req.Context(), nil, req)
+		require.NoError(t, err)
+
+		w := httptest.NewRecorder()
+		require.NoError(t, resp.Apply(req.Context(), w, req))
+		require.Equal(t, http.StatusNotFound, w.Code)
+	})
+
+	t.Run("restored", func(t *testing.T) {
+		storageClient, ctrl, req := setup(t)
+		storageClient.EXPECT().Get(gomock.Any(), resourceGroupID).Return(nil, &store.ErrNotFound{})
+		storageClient.EXPECT().Get(gomock.Any(), deletedID).Return(&store.Object{
+			Data: &datamodel.DeletedResource{
+				Properties: datamodel.DeletedResourceProperties{ExpiresAt: time.Now().Add(time.Hour), Data: data},
+			},
+		}, nil)
+		storageClient.EXPECT().
+			Save(gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, obj *store.Object, opts ...store.SaveOptions) error {
+				require.Equal(t, resourceGroupID, obj.ID)
+				obj.ETag = "new-etag"
+				return nil
+			})
+		storageClient.EXPECT().Delete(gomock.Any(), deletedID).Return(nil)
+
+		resp, err := ctrl.Run(req.Context(), nil, req)
+		require.NoError(t, err)
+
+		w := httptest.NewRecorder()
+		require.NoError(t, resp.Apply(req.Context(), w, req))
+		require.Equal(t, http.StatusOK, w.Code)
+		require.Equal(t, "new-etag", w.Header().Get("ETag"))
+	})
+}
@@ -0,0 +1,267 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhooks
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	armrpc_rest "github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/ucp/config"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+)
+
+const (
+	// CodeRequestDeniedByWebhook is the error code returned when a validating or mutating webhook rejects a request.
+	CodeRequestDeniedByWebhook = "RequestDeniedByWebhook"
+
+	// CodeWebhookFailed is the error code returned when a fail-closed webhook cannot be called successfully.
+	CodeWebhookFailed = "WebhookFailed"
+
+	// DefaultTimeout is the timeout for calling a webhook when none is configured.
+	DefaultTimeout = 10 * time.Second
+
+	// AllResourceTypes matches every resource type when used in the resource types of a webhook.
+	AllResourceTypes = "*"
+)
+
+// Request is the body sent to a webhook.
+type Request struct {
+	// Operation is the HTTP method of the operation, PUT or DELETE.
+	Operation string `json:"operation"`
+
+	// ResourceID is the ID of the resource being operated on.
+	ResourceID string `json:"resourceId"`
+
+	// ResourceType is the fully-qualified type of the resource being operated on.
+	ResourceType string `json:"resourceType"`
+
+	// APIVersion is the API version of the request.
+	APIVersion string `json:"apiVersion"`
+
+	// Resource is the request body of a PUT operation.
+	Resource json.RawMessage `json:"resource,omitempty"`
+}
+
+// Response is the body returned by a mutating or validating webhook. Notify webhooks do not need to return a body.
+type Response struct {
+	// Allowed is true if the request is allowed to continue.
+	Allowed bool `json:"allowed"`
+
+	// Message explains why the request was not allowed.
+	Message string `json:"message,omitempty"`
+
+	// Resource replaces the request body when returned by a mutating webhook.
+	Resource json.RawMessage `json:"resource,omitempty"`
+}
+
+// Dispatcher calls the webhooks registered with UCP for operations on Radius resources.
+type Dispatcher struct {
+	webhooks []config.WebhookOptions
+	client   *http.Client
+}
+
+// NewDispatcher creates a new Dispatcher for the given webhooks. Returns an error if a webhook is misconfigured.
+func NewDispatcher(webhooks []config.WebhookOptions, client *http.Client) (*Dispatcher, error) {
+	for _, webhook := range webhooks {
+		switch webhook.Type {
+		case config.WebhookTypeMutating, config.WebhookTypeValidating, config.WebhookTypeNotify:
+		default:
+			return nil, fmt.Errorf("webhook %q has unsupported type %q", webhook.Name, webhook.Type)
+		}
+
+		switch webhook.FailurePolicy {
+		case "", config.WebhookFailurePolicyFail, config.WebhookFailurePolicyIgnore:
+		default:
+			return nil, fmt.Errorf("webhook %q has unsupported failure policy %q", webhook.Name, webhook.FailurePolicy)
+		}
+
+		if u, err := url.Parse(webhook.URL); err != nil || !u.IsAbs() {
+			return nil, fmt.Errorf("webhook %q must have an absolute URL", webhook.Name)
+		}
+	}
+
+	return &Dispatcher{webhooks: webhooks, client: client}, nil
+}
+
+// Admit calls the mutating and then the validating webhooks for a PUT request. Mutating webhooks may replace
+// the body of the request. Returns a response if the request is rejected, or nil if it can continue.
+func (d *Dispatcher) Admit(ctx context.Context, id resources.ID, apiVersion string, req *http.Request) (armrpc_rest.Response, error) {
+	if d == nil || req.Method != http.MethodPut {
+		return nil, nil
+	}
+
+	mutating := d.match(id, config.WebhookTypeMutating)
+	validating := d.match(id, config.WebhookTypeValidating)
+	if len(mutating) == 0 && len(validating) == 0 {
+		return nil, nil
+	}
+
+	body := []byte{}
+	if req.Body != nil {
+		var err error
+		body, err = io.ReadAll(req.Body)
+		if err != nil {
+			return nil, err
+		}
+		_ = req.Body.Close()
+	}
+
+	logger := ucplog.FromContextOrDiscard(ctx)
+	for _, webhook := range append(mutating, validating...) {
+		response, err := d.call(ctx, webhook, &Request{
+			Operation:    req.Method,
+			ResourceID:   id.String(),
+			ResourceType: id.Type(),
+			APIVersion:   apiVersion,
+			Resource:     body,
+		})
+		if err == nil && webhook.Type == config.WebhookTypeMutating && response.Allowed && len(response.Resource) > 0 && !json.Valid(response.Resource) {
+			err = errors.New("webhook returned an invalid resource")
+		}
+
+		if err != nil && webhook.FailurePolicy == config.WebhookFailurePolicyIgnore {
+			logger.Error(err, "failed to call webhook, ignoring", "webhook", webhook.Name)
+			continue
+		} else if err != nil {
+			logger.Error(err, "failed to call webhook", "webhook", webhook.Name)
+			return armrpc_rest.NewInternalServerErrorARMResponse(v1.ErrorResponse{
+				Error: v1.ErrorDetails{
+					Code:    CodeWebhookFailed,
+					Message: fmt.Sprintf("Failed calling webhook %q: %s", webhook.Name, err.Error()),
+					Target:  id.String(),
+				},
+			}), nil
+		}
+
+		if !response.Allowed {
+			message := fmt.Sprintf("The request was denied by webhook %q.", webhook.Name)
+			if response.Message != "" {
+				message = fmt.Sprintf("The request was denied by webhook %q: %s", webhook.Name, response.Message)
+			}
+
+			return armrpc_rest.NewBadRequestARMResponse(v1.ErrorResponse{
+				Error: v1.ErrorDetails{
+					Code:    CodeRequestDeniedByWebhook,
+					Message: message,
+					Target:  id.String(),
+				},
+			}), nil
+		}
+
+		if webhook.Type == config.WebhookTypeMutating && len(response.Resource) > 0 {
+			body = response.Resource
+		}
+	}
+
+	req.Body = io.NopCloser(bytes.NewReader(body))
+	req.ContentLength = int64(len(body))
+	return nil, nil
+}
+
+// Notify calls the notify webhooks for a DELETE request that has been accepted by the resource provider.
+// Failures are logged and otherwise ignored, since the operation cannot be undone.
+func (d *Dispatcher) Notify(ctx context.Context, id resources.ID, apiVersion string, method string) {
+	if d == nil || method != http.MethodDelete {
+		return
+	}
+
+	logger := ucplog.FromContextOrDiscard(ctx)
+	for _, webhook := range d.match(id, config.WebhookTypeNotify) {
+		_, err := d.call(ctx, webhook, &Request{
+			Operation:    method,
+			ResourceID:   id.String(),
+			ResourceType: id.Type(),
+			APIVersion:   apiVersion,
+		})
+		if err != nil {
+			logger.Error(err, "failed to call webhook", "webhook", webhook.Name)
+		}
+	}
+}
+
+// match returns the webhooks of the given type that apply to the given ID.
+func (d *Dispatcher) match(id resources.ID, webhookType config.WebhookType) []config.WebhookOptions {
+	matches := []config.WebhookOptions{}
+	for _, webhook := range d.webhooks {
+		if webhook.Type != webhookType {
+			continue
+		}
+
+		for _, resourceType := range webhook.ResourceTypes {
+			if resourceType == AllResourceTypes || strings.EqualFold(resourceType, id.Type()) {
+				matches = append(matches, webhook)
+				break
+			}
+		}
+	}
+
+	return matches
+}
+
+// call sends the request to the webhook and decodes its response. Non-2xx status codes are treated as failures.
+func (d *Dispatcher) call(ctx context.Context, webhook config.WebhookOptions, request *Request) (*Response, error) {
+	timeout := webhook.Timeout
+	if timeout == 0 {
+		timeout = DefaultTimeout
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	b, err := json.Marshal(request)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, webhook.URL, bytes.NewReader(b))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := d.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return nil, fmt.Errorf("webhook returned status code %d", resp.StatusCode)
+	}
+
+	response := &Response{}
+	if webhook.Type == config.WebhookTypeNotify {
+		return response, nil
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(response); err != nil {
+		return nil, fmt.Errorf("failed to decode webhook response: %w", err)
+	}
+
+	return response, nil
+}
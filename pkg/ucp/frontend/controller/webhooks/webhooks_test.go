@@ -0,0 +1,198 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhooks
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	armrpc_rest "github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/ucp/config"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/radius-project/radius/test/testcontext"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	resourceID = "/planes/radius/local/resourceGroups/test-rg/providers/Applications.Core/containers/test-container"
+	apiVersion = "2023-10-01-preview"
+)
+
+func newServer(t *testing.T, handler func(request *Request) (int, *Response)) (*httptest.Server, *[]Request) {
+	requests := &[]Request{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		request := Request{}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&request))
+		*requests = append(*requests, request)
+
+		status, response := handler(&request)
+		w.WriteHeader(status)
+		if response != nil {
+			require.NoError(t, json.NewEncoder(w).Encode(response))
+		}
+	}))
+	t.Cleanup(server.Close)
+	return server, requests
+}
+
+func newDispatcher(t *testing.T, webhooks ...config.WebhookOptions) *Dispatcher {
+	dispatcher, err := NewDispatcher(webhooks, http.DefaultClient)
+	require.NoError(t, err)
+	return dispatcher
+}
+
+func newPutRequest(t *testing.T, body string) *http.Request {
+	req, err := http.NewRequest(http.MethodPut, "http://localhost"+resourceID, strings.NewReader(body))
+	require.NoError(t, err)
+	return req
+}
+
+func Test_NewDispatcher_Invalid(t *testing.T) {
+	tests := []struct {
+		name    string
+		webhook config.WebhookOptions
+	}{
+		{"invalid type", config.WebhookOptions{Name: "test", Type: "Other", URL: "http://localhost"}},
+		{"invalid failure policy", config.WebhookOptions{Name: "test", Type: config.WebhookTypeValidating, URL: "http://localhost", FailurePolicy: "Other"}},
+		{"relative url", config.WebhookOptions{Name: "test", Type: config.WebhookTypeValidating, URL: "/validate"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewDispatcher([]config.WebhookOptions{tt.webhook}, http.DefaultClient)
+			require.Error(t, err)
+		})
+	}
+}
+
+func Test_Admit(t *testing.T) {
+	id := resources.MustParse(resourceID)
+
+	t.Run("nil dispatcher", func(t *testing.T) {
+		var dispatcher *Dispatcher
+		resp, err := dispatcher.Admit(testcontext.New(t), id, apiVersion, newPutRequest(t, "{}"))
+		require.NoError(t, err)
+		require.Nil(t, resp)
+	})
+
+	t.Run("non-matching resource type", func(t *testing.T) {
+		server, requests := newServer(t, func(request *Request) (int, *Response) {
+			return http.StatusOK, &Response{Allowed: false}
+		})
+		dispatcher := newDispatcher(t, config.WebhookOptions{Name: "test", Type: config.WebhookTypeValidating, URL: server.URL, ResourceTypes: []string{"Applications.Core/gateways"}})
+
+		resp, err := dispatcher.Admit(testcontext.New(t), id, apiVersion, newPutRequest(t, "{}"))
+		require.NoError(t, err)
+		require.Nil(t, resp)
+		require.Empty(t, *requests)
+	})
+
+	t.Run("mutate then validate", func(t *testing.T) {
+		mutator, _ := newServer(t, func(request *Request) (int, *Response) {
+			return http.StatusOK, &Response{Allowed: true, Resource: json.RawMessage(`{"tags":{"owner":"radius"}}`)}
+		})
+		validator, requests := newServer(t, func(request *Request) (int, *Response) {
+			return http.StatusOK, &Response{Allowed: true}
+		})
+		dispatcher := newDispatcher(t,
+			config.WebhookOptions{Name: "validate", Type: config.WebhookTypeValidating, URL: validator.URL, ResourceTypes: []string{AllResourceTypes}},
+			config.WebhookOptions{Name: "mutate", Type: config.WebhookTypeMutating, URL: mutator.URL, ResourceTypes: []string{"applications.core/CONTAINERS"}},
+		)
+
+		req := newPutRequest(t, "{}")
+		resp, err := dispatcher.Admit(testcontext.New(t), id, apiVersion, req)
+		require.NoError(t, err)
+		require.Nil(t, resp)
+
+		require.Len(t, *requests, 1)
+		require.Equal(t, http.MethodPut, (*requests)[0].Operation)
+		require.Equal(t, resourceID, (*requests)[0].ResourceID)
+		require.Equal(t, "Applications.Core/containers", (*requests)[0].ResourceType)
+		require.JSONEq(t, `{"tags":{"owner":"radius"}}`, string((*requests)[0].Resource))
+
+		body, err := io.ReadAll(req.Body)
+		require.NoError(t, err)
+		require.JSONEq(t, `{"tags":{"owner":"radius"}}`, string(body))
+		require.Equal(t, int64(len(body)), req.ContentLength)
+	})
+
+	t.Run("denied", func(t *testing.T) {
+		server, _ := newServer(t, func(request *Request) (int, *Response) {
+			return http.StatusOK, &Response{Allowed: false, Message: "name must start with 'prod-'"}
+		})
+		dispatcher := newDispatcher(t, config.WebhookOptions{Name: "naming", Type: config.WebhookTypeValidating, URL: server.URL, ResourceTypes: []string{AllResourceTypes}})
+
+		resp, err := dispatcher.Admit(testcontext.New(t), id, apiVersion, newPutRequest(t, "{}"))
+		require.NoError(t, err)
+		require.IsType(t, &armrpc_rest.BadRequestResponse{}, resp)
+		body := resp.(*armrpc_rest.BadRequestResponse).Body
+		require.Equal(t, CodeRequestDeniedByWebhook, body.Error.Code)
+		require.Contains(t, body.Error.Message, "name must start with 'prod-'")
+	})
+
+	t.Run("failure (fail closed)", func(t *testing.T) {
+		server, _ := newServer(t, func(request *Request) (int, *Response) {
+			return http.StatusInternalServerError, nil
+		})
+		dispatcher := newDispatcher(t, config.WebhookOptions{Name: "test", Type: config.WebhookTypeValidating, URL: server.URL, ResourceTypes: []string{AllResourceTypes}})
+
+		resp, err := dispatcher.Admit(testcontext.New(t), id, apiVersion, newPutRequest(t, "{}"))
+		require.NoError(t, err)
+		require.IsType(t, &armrpc_rest.InternalServerErrorResponse{}, resp)
+		require.Equal(t, CodeWebhookFailed, resp.(*armrpc_rest.InternalServerErrorResponse).Body.Error.Code)
+	})
+
+	t.Run("failure (fail open)", func(t *testing.T) {
+		server, _ := newServer(t, func(request *Request) (int, *Response) {
+			return http.StatusInternalServerError, nil
+		})
+		dispatcher := newDispatcher(t, config.WebhookOptions{Name: "test", Type: config.WebhookTypeMutating, URL: server.URL, ResourceTypes: []string{AllResourceTypes}, FailurePolicy: config.WebhookFailurePolicyIgnore})
+
+		req := newPutRequest(t, `{"location":"global"}`)
+		resp, err := dispatcher.Admit(testcontext.New(t), id, apiVersion, req)
+		require.NoError(t, err)
+		require.Nil(t, resp)
+
+		body, err := io.ReadAll(req.Body)
+		require.NoError(t, err)
+		require.JSONEq(t, `{"location":"global"}`, string(body))
+	})
+}
+
+func Test_Notify(t *testing.T) {
+	id := resources.MustParse(resourceID)
+
+	server, requests := newServer(t, func(request *Request) (int, *Response) {
+		return http.StatusNoContent, nil
+	})
+	dispatcher := newDispatcher(t,
+		config.WebhookOptions{Name: "notify", Type: config.WebhookTypeNotify, URL: server.URL, ResourceTypes: []string{AllResourceTypes}},
+		config.WebhookOptions{Name: "validate", Type: config.WebhookTypeValidating, URL: server.URL, ResourceTypes: []string{AllResourceTypes}},
+	)
+
+	dispatcher.Notify(testcontext.New(t), id, apiVersion, http.MethodPut)
+	require.Empty(t, *requests)
+
+	dispatcher.Notify(testcontext.New(t), id, apiVersion, http.MethodDelete)
+	require.Len(t, *requests, 1)
+	require.Equal(t, http.MethodDelete, (*requests)[0].Operation)
+	require.Equal(t, resourceID, (*requests)[0].ResourceID)
+}
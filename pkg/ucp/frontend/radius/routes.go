@@ -19,18 +19,25 @@ package radius
 import (
 	"context"
 	"net/http"
+	"time"
 
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
 	"github.com/radius-project/radius/pkg/armrpc/frontend/controller"
 	"github.com/radius-project/radius/pkg/armrpc/frontend/defaultoperation"
 	"github.com/radius-project/radius/pkg/armrpc/frontend/server"
 	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/ucp/config"
 	"github.com/radius-project/radius/pkg/ucp/datamodel"
 	"github.com/radius-project/radius/pkg/ucp/datamodel/converter"
+	locks_ctrl "github.com/radius-project/radius/pkg/ucp/frontend/controller/locks"
 	planes_ctrl "github.com/radius-project/radius/pkg/ucp/frontend/controller/planes"
 	radius_ctrl "github.com/radius-project/radius/pkg/ucp/frontend/controller/radius"
 	resourcegroups_ctrl "github.com/radius-project/radius/pkg/ucp/frontend/controller/resourcegroups"
+	softdelete_ctrl "github.com/radius-project/radius/pkg/ucp/frontend/controller/softdelete"
+	webhooks_ctrl "github.com/radius-project/radius/pkg/ucp/frontend/controller/webhooks"
+	"github.com/radius-project/radius/pkg/ucp/store"
 	"github.com/radius-project/radius/pkg/validator"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
 )
 
 const (
@@ -38,12 +45,38 @@ const (
 	planeResourcePath           = "/planes/radius/{planeName}"
 	resourceGroupCollectionPath = planeResourcePath + "/resourcegroups"
 	resourceGroupResourcePath   = planeResourcePath + "/resourcegroups/{resourceGroupName}"
+	lockCollectionPath          = "/providers/System.Resources/locks"
+	lockResourcePath            = "/providers/System.Resources/locks/{lockName}"
+	quotaCollectionPath         = "/providers/System.Resources/quotas"
+	quotaResourcePath           = "/providers/System.Resources/quotas/{quotaName}"
 
 	// OperationTypeUCPRadiusProxy is the operation type for proxying Radius API calls.
 	OperationTypeUCPRadiusProxy = "UCPRADIUSPROXY"
 )
 
 func (m *Module) Initialize(ctx context.Context) (http.Handler, error) {
+	secretClient, err := m.options.SecretProvider.GetClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var retentionPeriod, purgeInterval time.Duration
+	var webhookOptions []config.WebhookOptions
+	if m.options.Config != nil {
+		retentionPeriod = m.options.Config.SoftDelete.RetentionPeriod
+		purgeInterval = m.options.Config.SoftDelete.PurgeInterval
+		webhookOptions = m.options.Config.Webhooks
+	}
+
+	webhookDispatcher, err := webhooks_ctrl.NewDispatcher(webhookOptions, &http.Client{Transport: otelhttp.NewTransport(http.DefaultTransport)})
+	if err != nil {
+		return nil, err
+	}
+
+	// downstreamCache is shared by the proxy controllers, and invalidated after planes or resource groups are saved
+	// or deleted.
+	downstreamCache := resourcegroups_ctrl.NewDownstreamCache(resourcegroups_ctrl.DefaultDownstreamCacheTTL)
+
 	baseRouter := server.NewSubrouter(m.router, m.options.PathBase)
 
 	apiValidator := validator.APIValidator(validator.Options{
@@ -54,6 +87,13 @@ func (m *Module) Initialize(ctx context.Context) (http.Handler, error) {
 	planeResourceOptions := controller.ResourceOptions[datamodel.RadiusPlane]{
 		RequestConverter:  converter.RadiusPlaneDataModelFromVersioned,
 		ResponseConverter: converter.RadiusPlaneDataModelToVersioned,
+		UpdateFilters: []controller.UpdateFilter[datamodel.RadiusPlane]{
+			locks_ctrl.NewUpdateFilter[datamodel.RadiusPlane](),
+		},
+		DeleteFilters: []controller.DeleteFilter[datamodel.RadiusPlane]{
+			locks_ctrl.NewDeleteFilter[datamodel.RadiusPlane](),
+			softdelete_ctrl.NewDeleteFilter[datamodel.RadiusPlane](retentionPeriod),
+		},
 	}
 
 	// URLs for lifecycle of planes
@@ -64,12 +104,30 @@ func (m *Module) Initialize(ctx context.Context) (http.Handler, error) {
 	resourceGroupResourceOptions := controller.ResourceOptions[datamodel.ResourceGroup]{
 		RequestConverter:  converter.ResourceGroupDataModelFromVersioned,
 		ResponseConverter: converter.ResourceGroupDataModelToVersioned,
+		UpdateFilters: []controller.UpdateFilter[datamodel.ResourceGroup]{
+			locks_ctrl.NewUpdateFilter[datamodel.ResourceGroup](),
+		},
+		DeleteFilters: []controller.DeleteFilter[datamodel.ResourceGroup]{
+			locks_ctrl.NewDeleteFilter[datamodel.ResourceGroup](),
+			softdelete_ctrl.NewDeleteFilter[datamodel.ResourceGroup](retentionPeriod),
+		},
 	}
 
 	// URLs for lifecycle of resource groups
 	resourceGroupCollectionRouter := server.NewSubrouter(baseRouter, resourceGroupCollectionPath, apiValidator)
 	resourceGroupResourceRouter := server.NewSubrouter(baseRouter, resourceGroupResourcePath, apiValidator)
 
+	// Locks are not subject to locks themselves, otherwise a lock could never be removed.
+	lockResourceOptions := controller.ResourceOptions[datamodel.Lock]{
+		RequestConverter:  converter.LockDataModelFromVersioned,
+		ResponseConverter: converter.LockDataModelToVersioned,
+	}
+
+	quotaResourceOptions := controller.ResourceOptions[datamodel.Quota]{
+		RequestConverter:  converter.QuotaDataModelFromVersioned,
+		ResponseConverter: converter.QuotaDataModelToVersioned,
+	}
+
 	handlerOptions := []server.HandlerOptions{
 		{
 			// This is a scope query so we can't use the default operation.
@@ -95,6 +153,7 @@ func (m *Module) Initialize(ctx context.Context) (http.Handler, error) {
 			Method:        v1.OperationPut,
 			OperationType: &v1.OperationType{Type: planeResourceType, Method: v1.OperationPut},
 			ControllerFactory: func(opts controller.Options) (controller.Controller, error) {
+				opts.StorageClient = downstreamCache.InvalidatingClient(opts.StorageClient)
 				return defaultoperation.NewDefaultSyncPut(opts, planeResourceOptions)
 			},
 		},
@@ -103,9 +162,27 @@ func (m *Module) Initialize(ctx context.Context) (http.Handler, error) {
 			Method:        v1.OperationDelete,
 			OperationType: &v1.OperationType{Type: planeResourceType, Method: v1.OperationDelete},
 			ControllerFactory: func(opts controller.Options) (controller.Controller, error) {
+				opts.StorageClient = downstreamCache.InvalidatingClient(opts.StorageClient)
 				return defaultoperation.NewDefaultSyncDelete(opts, planeResourceOptions)
 			},
 		},
+		{
+			ParentRouter:  planeResourceRouter,
+			Path:          "/restore",
+			Method:        softdelete_ctrl.OperationRestore,
+			OperationType: &v1.OperationType{Type: planeResourceType, Method: softdelete_ctrl.OperationRestore},
+			ControllerFactory: func(opts controller.Options) (controller.Controller, error) {
+				opts.StorageClient = downstreamCache.InvalidatingClient(opts.StorageClient)
+				return softdelete_ctrl.NewRestoreResource(opts, planeResourceOptions)
+			},
+		},
+		{
+			ParentRouter:      planeResourceRouter,
+			Path:              "/queryResources",
+			Method:            planes_ctrl.OperationQueryResources,
+			OperationType:     &v1.OperationType{Type: planeResourceType, Method: planes_ctrl.OperationQueryResources},
+			ControllerFactory: planes_ctrl.NewQueryResources,
+		},
 		{
 			ParentRouter:      resourceGroupCollectionRouter,
 			ResourceType:      v20231001preview.ResourceGroupType,
@@ -125,6 +202,7 @@ func (m *Module) Initialize(ctx context.Context) (http.Handler, error) {
 			ResourceType: v20231001preview.ResourceGroupType,
 			Method:       v1.OperationPut,
 			ControllerFactory: func(opts controller.Options) (controller.Controller, error) {
+				opts.StorageClient = downstreamCache.InvalidatingClient(opts.StorageClient)
 				return defaultoperation.NewDefaultSyncPut(opts, resourceGroupResourceOptions)
 			},
 		},
@@ -133,9 +211,27 @@ func (m *Module) Initialize(ctx context.Context) (http.Handler, error) {
 			ResourceType: v20231001preview.ResourceGroupType,
 			Method:       v1.OperationDelete,
 			ControllerFactory: func(opts controller.Options) (controller.Controller, error) {
+				opts.StorageClient = downstreamCache.InvalidatingClient(opts.StorageClient)
 				return defaultoperation.NewDefaultSyncDelete(opts, resourceGroupResourceOptions)
 			},
 		},
+		{
+			ParentRouter: resourceGroupResourceRouter,
+			ResourceType: v20231001preview.ResourceGroupType,
+			Path:         "/restore",
+			Method:       softdelete_ctrl.OperationRestore,
+			ControllerFactory: func(opts controller.Options) (controller.Controller, error) {
+				opts.StorageClient = downstreamCache.InvalidatingClient(opts.StorageClient)
+				return softdelete_ctrl.NewRestoreResource(opts, resourceGroupResourceOptions)
+			},
+		},
+		{
+			ParentRouter:      resourceGroupResourceRouter,
+			ResourceType:      v20231001preview.ResourceGroupType,
+			Path:              "/queryResources",
+			Method:            planes_ctrl.OperationQueryResources,
+			ControllerFactory: planes_ctrl.NewQueryResources,
+		},
 		{
 			ParentRouter: resourceGroupResourceRouter,
 			ResourceType: v20231001preview.ResourceType,
@@ -145,23 +241,135 @@ func (m *Module) Initialize(ctx context.Context) (http.Handler, error) {
 				return resourcegroups_ctrl.NewListResources(opt)
 			},
 		},
+		{
+			ParentRouter: planeResourceRouter,
+			ResourceType: v20231001preview.LockType,
+			Path:         lockCollectionPath,
+			Method:       v1.OperationList,
+			ControllerFactory: func(opts controller.Options) (controller.Controller, error) {
+				return defaultoperation.NewListResources[*datamodel.Lock, datamodel.Lock](opts, lockResourceOptions)
+			},
+		},
+		{
+			ParentRouter: planeResourceRouter,
+			ResourceType: v20231001preview.LockType,
+			Path:         lockResourcePath,
+			Method:       v1.OperationGet,
+			ControllerFactory: func(opts controller.Options) (controller.Controller, error) {
+				return defaultoperation.NewGetResource(opts, lockResourceOptions)
+			},
+		},
+		{
+			ParentRouter: planeResourceRouter,
+			ResourceType: v20231001preview.LockType,
+			Path:         lockResourcePath,
+			Method:       v1.OperationPut,
+			ControllerFactory: func(opts controller.Options) (controller.Controller, error) {
+				return defaultoperation.NewDefaultSyncPut(opts, lockResourceOptions)
+			},
+		},
+		{
+			ParentRouter: planeResourceRouter,
+			ResourceType: v20231001preview.LockType,
+			Path:         lockResourcePath,
+			Method:       v1.OperationDelete,
+			ControllerFactory: func(opts controller.Options) (controller.Controller, error) {
+				return defaultoperation.NewDefaultSyncDelete(opts, lockResourceOptions)
+			},
+		},
+		{
+			ParentRouter: resourceGroupResourceRouter,
+			ResourceType: v20231001preview.LockType,
+			Path:         lockCollectionPath,
+			Method:       v1.OperationList,
+			ControllerFactory: func(opts controller.Options) (controller.Controller, error) {
+				return defaultoperation.NewListResources[*datamodel.Lock, datamodel.Lock](opts, lockResourceOptions)
+			},
+		},
+		{
+			ParentRouter: resourceGroupResourceRouter,
+			ResourceType: v20231001preview.LockType,
+			Path:         lockResourcePath,
+			Method:       v1.OperationGet,
+			ControllerFactory: func(opts controller.Options) (controller.Controller, error) {
+				return defaultoperation.NewGetResource(opts, lockResourceOptions)
+			},
+		},
+		{
+			ParentRouter: resourceGroupResourceRouter,
+			ResourceType: v20231001preview.LockType,
+			Path:         lockResourcePath,
+			Method:       v1.OperationPut,
+			ControllerFactory: func(opts controller.Options) (controller.Controller, error) {
+				return defaultoperation.NewDefaultSyncPut(opts, lockResourceOptions)
+			},
+		},
+		{
+			ParentRouter: resourceGroupResourceRouter,
+			ResourceType: v20231001preview.LockType,
+			Path:         lockResourcePath,
+			Method:       v1.OperationDelete,
+			ControllerFactory: func(opts controller.Options) (controller.Controller, error) {
+				return defaultoperation.NewDefaultSyncDelete(opts, lockResourceOptions)
+			},
+		},
+		{
+			ParentRouter: resourceGroupResourceRouter,
+			ResourceType: v20231001preview.QuotaType,
+			Path:         quotaCollectionPath,
+			Method:       v1.OperationList,
+			ControllerFactory: func(opts controller.Options) (controller.Controller, error) {
+				return defaultoperation.NewListResources[*datamodel.Quota, datamodel.Quota](opts, quotaResourceOptions)
+			},
+		},
+		{
+			ParentRouter: resourceGroupResourceRouter,
+			ResourceType: v20231001preview.QuotaType,
+			Path:         quotaResourcePath,
+			Method:       v1.OperationGet,
+			ControllerFactory: func(opts controller.Options) (controller.Controller, error) {
+				return defaultoperation.NewGetResource(opts, quotaResourceOptions)
+			},
+		},
+		{
+			ParentRouter: resourceGroupResourceRouter,
+			ResourceType: v20231001preview.QuotaType,
+			Path:         quotaResourcePath,
+			Method:       v1.OperationPut,
+			ControllerFactory: func(opts controller.Options) (controller.Controller, error) {
+				return defaultoperation.NewDefaultSyncPut(opts, quotaResourceOptions)
+			},
+		},
+		{
+			ParentRouter: resourceGroupResourceRouter,
+			ResourceType: v20231001preview.QuotaType,
+			Path:         quotaResourcePath,
+			Method:       v1.OperationDelete,
+			ControllerFactory: func(opts controller.Options) (controller.Controller, error) {
+				return defaultoperation.NewDefaultSyncDelete(opts, quotaResourceOptions)
+			},
+		},
 		// Chi router uses radix tree so that it doesn't linear search the matched one. So, to catch all requests,
 		// we need to use CatchAllPath(/*) at the above matched routes path in chi router.
 		//
 		// Note that the API validation is not applied for CatchAllPath(/*).
 		{
 			// Proxy request should use CatchAllPath(/*) to process all requests under /planes/radius/{planeName}/resourcegroups/{resourceGroupName}.
-			ParentRouter:      resourceGroupResourceRouter,
-			Path:              server.CatchAllPath,
-			OperationType:     &v1.OperationType{Type: OperationTypeUCPRadiusProxy, Method: v1.OperationProxy},
-			ControllerFactory: radius_ctrl.NewProxyController,
+			ParentRouter:  resourceGroupResourceRouter,
+			Path:          server.CatchAllPath,
+			OperationType: &v1.OperationType{Type: OperationTypeUCPRadiusProxy, Method: v1.OperationProxy},
+			ControllerFactory: func(opts controller.Options) (controller.Controller, error) {
+				return radius_ctrl.NewProxyController(opts, secretClient, downstreamCache, webhookDispatcher)
+			},
 		},
 		{
 			// Proxy request should use CatchAllPath(/*) to process all requests under /planes/radius/{planeName}/.
-			ParentRouter:      planeResourceRouter,
-			Path:              server.CatchAllPath,
-			OperationType:     &v1.OperationType{Type: OperationTypeUCPRadiusProxy, Method: v1.OperationProxy},
-			ControllerFactory: radius_ctrl.NewProxyController,
+			ParentRouter:  planeResourceRouter,
+			Path:          server.CatchAllPath,
+			OperationType: &v1.OperationType{Type: OperationTypeUCPRadiusProxy, Method: v1.OperationProxy},
+			ControllerFactory: func(opts controller.Options) (controller.Controller, error) {
+				return radius_ctrl.NewProxyController(opts, secretClient, downstreamCache, webhookDispatcher)
+			},
 		},
 	}
 
@@ -178,5 +386,20 @@ func (m *Module) Initialize(ctx context.Context) (http.Handler, error) {
 		}
 	}
 
+	if retentionPeriod > 0 {
+		// The deleted copies are stored with the storage clients of the handlers which delete planes and resource
+		// groups. Plane handlers are registered by operation type, so they use the client of the empty resource type.
+		purgeClients := []store.StorageClient{}
+		for _, resourceType := range []string{"", v20231001preview.ResourceGroupType} {
+			client, err := m.options.DataProvider.GetStorageClient(ctx, resourceType)
+			if err != nil {
+				return nil, err
+			}
+			purgeClients = append(purgeClients, client)
+		}
+
+		go softdelete_ctrl.RunPurger(ctx, purgeInterval, purgeClients...)
+	}
+
 	return m.router, nil
 }
@@ -26,6 +26,8 @@ import (
 	"github.com/radius-project/radius/pkg/armrpc/rpctest"
 	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
 	"github.com/radius-project/radius/pkg/ucp/dataprovider"
+	planes_ctrl "github.com/radius-project/radius/pkg/ucp/frontend/controller/planes"
+	softdelete_ctrl "github.com/radius-project/radius/pkg/ucp/frontend/controller/softdelete"
 	"github.com/radius-project/radius/pkg/ucp/frontend/modules"
 	"github.com/radius-project/radius/pkg/ucp/hostoptions"
 	"github.com/radius-project/radius/pkg/ucp/secret"
@@ -57,6 +59,16 @@ func Test_Routes(t *testing.T) {
 			Method:        http.MethodDelete,
 			Path:          "/planes/radius/someName",
 		},
+		{
+			OperationType: v1.OperationType{Type: "System.Radius/planes", Method: softdelete_ctrl.OperationRestore},
+			Method:        http.MethodPost,
+			Path:          "/planes/radius/someName/restore",
+		},
+		{
+			OperationType: v1.OperationType{Type: "System.Radius/planes", Method: planes_ctrl.OperationQueryResources},
+			Method:        http.MethodPost,
+			Path:          "/planes/radius/someName/queryResources",
+		},
 		{
 			OperationType:               v1.OperationType{Type: OperationTypeUCPRadiusProxy, Method: v1.OperationProxy},
 			Method:                      http.MethodGet,
@@ -83,6 +95,62 @@ func Test_Routes(t *testing.T) {
 			OperationType: v1.OperationType{Type: v20231001preview.ResourceGroupType, Method: v1.OperationDelete},
 			Method:        http.MethodDelete,
 			Path:          "/planes/radius/local/resourcegroups/test-rg",
+		}, {
+			OperationType: v1.OperationType{Type: v20231001preview.ResourceGroupType, Method: softdelete_ctrl.OperationRestore},
+			Method:        http.MethodPost,
+			Path:          "/planes/radius/local/resourcegroups/test-rg/restore",
+		}, {
+			OperationType: v1.OperationType{Type: v20231001preview.ResourceGroupType, Method: planes_ctrl.OperationQueryResources},
+			Method:        http.MethodPost,
+			Path:          "/planes/radius/local/resourcegroups/test-rg/queryResources",
+		}, {
+			OperationType: v1.OperationType{Type: v20231001preview.LockType, Method: v1.OperationList},
+			Method:        http.MethodGet,
+			Path:          "/planes/radius/local/providers/System.Resources/locks",
+		}, {
+			OperationType: v1.OperationType{Type: v20231001preview.LockType, Method: v1.OperationGet},
+			Method:        http.MethodGet,
+			Path:          "/planes/radius/local/providers/System.Resources/locks/test-lock",
+		}, {
+			OperationType: v1.OperationType{Type: v20231001preview.LockType, Method: v1.OperationPut},
+			Method:        http.MethodPut,
+			Path:          "/planes/radius/local/providers/System.Resources/locks/test-lock",
+		}, {
+			OperationType: v1.OperationType{Type: v20231001preview.LockType, Method: v1.OperationDelete},
+			Method:        http.MethodDelete,
+			Path:          "/planes/radius/local/providers/System.Resources/locks/test-lock",
+		}, {
+			OperationType: v1.OperationType{Type: v20231001preview.LockType, Method: v1.OperationList},
+			Method:        http.MethodGet,
+			Path:          "/planes/radius/local/resourcegroups/test-rg/providers/System.Resources/locks",
+		}, {
+			OperationType: v1.OperationType{Type: v20231001preview.LockType, Method: v1.OperationGet},
+			Method:        http.MethodGet,
+			Path:          "/planes/radius/local/resourcegroups/test-rg/providers/System.Resources/locks/test-lock",
+		}, {
+			OperationType: v1.OperationType{Type: v20231001preview.LockType, Method: v1.OperationPut},
+			Method:        http.MethodPut,
+			Path:          "/planes/radius/local/resourcegroups/test-rg/providers/System.Resources/locks/test-lock",
+		}, {
+			OperationType: v1.OperationType{Type: v20231001preview.LockType, Method: v1.OperationDelete},
+			Method:        http.MethodDelete,
+			Path:          "/planes/radius/local/resourcegroups/test-rg/providers/System.Resources/locks/test-lock",
+		}, {
+			OperationType: v1.OperationType{Type: v20231001preview.QuotaType, Method: v1.OperationList},
+			Method:        http.MethodGet,
+			Path:          "/planes/radius/local/resourcegroups/test-rg/providers/System.Resources/quotas",
+		}, {
+			OperationType: v1.OperationType{Type: v20231001preview.QuotaType, Method: v1.OperationGet},
+			Method:        http.MethodGet,
+			Path:          "/planes/radius/local/resourcegroups/test-rg/providers/System.Resources/quotas/test-quota",
+		}, {
+			OperationType: v1.OperationType{Type: v20231001preview.QuotaType, Method: v1.OperationPut},
+			Method:        http.MethodPut,
+			Path:          "/planes/radius/local/resourcegroups/test-rg/providers/System.Resources/quotas/test-quota",
+		}, {
+			OperationType: v1.OperationType{Type: v20231001preview.QuotaType, Method: v1.OperationDelete},
+			Method:        http.MethodDelete,
+			Path:          "/planes/radius/local/resourcegroups/test-rg/providers/System.Resources/quotas/test-quota",
 		}, {
 			OperationType:               v1.OperationType{Type: OperationTypeUCPRadiusProxy, Method: v1.OperationProxy},
 			Method:                      http.MethodGet,
@@ -30,17 +30,21 @@ import (
 
 // UCPConfig includes the resource provider configuration.
 type UCPConfig struct {
-	StorageProvider  dataprovider.StorageProviderOptions      `yaml:"storageProvider"`
-	Planes           []rest.Plane                             `yaml:"planes"`
-	SecretProvider   provider.SecretProviderOptions           `yaml:"secretProvider"`
-	MetricsProvider  metricsprovider.MetricsProviderOptions   `yaml:"metricsProvider"`
-	ProfilerProvider profilerprovider.ProfilerProviderOptions `yaml:"profilerProvider"`
-	QueueProvider    qprovider.QueueProviderOptions           `yaml:"queueProvider"`
-	TracerProvider   trace.Options                            `yaml:"tracerProvider"`
-	Logging          ucplog.LoggingOptions                    `yaml:"logging"`
-	Identity         Identity                                 `yaml:"identity,omitempty"`
-	UCP              config.UCPOptions                        `yaml:"ucp"`
-	Location         string                                   `yaml:"location"`
+	StorageProvider   dataprovider.StorageProviderOptions      `yaml:"storageProvider"`
+	Planes            []rest.Plane                             `yaml:"planes"`
+	SecretProvider    provider.SecretProviderOptions           `yaml:"secretProvider"`
+	MetricsProvider   metricsprovider.MetricsProviderOptions   `yaml:"metricsProvider"`
+	ProfilerProvider  profilerprovider.ProfilerProviderOptions `yaml:"profilerProvider"`
+	QueueProvider     qprovider.QueueProviderOptions           `yaml:"queueProvider"`
+	TracerProvider    trace.Options                            `yaml:"tracerProvider"`
+	Logging           ucplog.LoggingOptions                    `yaml:"logging"`
+	Identity          Identity                                 `yaml:"identity,omitempty"`
+	UCP               config.UCPOptions                        `yaml:"ucp"`
+	SoftDelete        config.SoftDeleteOptions                 `yaml:"softDelete,omitempty"`
+	Webhooks          []config.WebhookOptions                  `yaml:"webhooks,omitempty"`
+	ManifestDirectory string                                   `yaml:"manifestDirectory,omitempty"`
+	ManifestConfigMap string                                   `yaml:"manifestConfigMap,omitempty"`
+	Location          string                                   `yaml:"location"`
 }
 
 const (
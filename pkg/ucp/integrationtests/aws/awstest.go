@@ -50,5 +50,11 @@ func initializeAWSTest(t *testing.T) (*testserver.TestServer, *store.MockStorage
 		return []modules.Initializer{module}
 	})
 
+	// Proxied requests look up the AWS plane to validate its policy. The default plane has no policy.
+	ucp.Mocks.Storage.EXPECT().
+		Get(gomock.Any(), "/planes/aws/aws", gomock.Any()).
+		Return(nil, &store.ErrNotFound{}).
+		AnyTimes()
+
 	return ucp, ucp.Mocks.Storage, ucp.Mocks.Secrets, cloudControlClient, cloudFormationClient
 }
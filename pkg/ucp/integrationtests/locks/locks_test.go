@@ -0,0 +1,88 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package locks
+
+import (
+	"testing"
+
+	"github.com/radius-project/radius/pkg/ucp/frontend/api"
+	"github.com/radius-project/radius/pkg/ucp/frontend/controller/locks"
+	"github.com/radius-project/radius/pkg/ucp/integrationtests/testserver"
+)
+
+const (
+	radiusPlaneResourceURL     = "/planes/radius/local?api-version=2023-10-01-preview"
+	radiusPlaneRequestFixture  = "../planes/testdata/radiusplane_v20231001preview_requestbody.json"
+	radiusPlaneResponseFixture = "../planes/testdata/radiusplane_v20231001preview_responsebody.json"
+
+	resourceGroupResourceURL     = "/planes/radius/local/resourcegroups/test-rg?api-version=2023-10-01-preview"
+	resourceGroupRequestFixture  = "../resourcegroups/testdata/resourcegroup_v20231001preview_requestbody.json"
+	resourceGroupResponseFixture = "../resourcegroups/testdata/resourcegroup_v20231001preview_responsebody.json"
+
+	lockResourceURL   = "/planes/radius/local/resourcegroups/test-rg/providers/System.Resources/locks/test-lock?api-version=2023-10-01-preview"
+	lockCollectionURL = "/planes/radius/local/resourcegroups/test-rg/providers/System.Resources/locks?api-version=2023-10-01-preview"
+)
+
+func Test_Lock_CanNotDelete(t *testing.T) {
+	server := testserver.StartWithETCD(t, api.DefaultModules)
+	defer server.Close()
+
+	response := server.MakeFixtureRequest("PUT", radiusPlaneResourceURL, radiusPlaneRequestFixture)
+	response.EqualsFixture(200, radiusPlaneResponseFixture)
+
+	response = server.MakeFixtureRequest("PUT", resourceGroupResourceURL, resourceGroupRequestFixture)
+	response.EqualsFixture(200, resourceGroupResponseFixture)
+
+	response = server.MakeFixtureRequest("PUT", lockResourceURL, "testdata/lock_cannotdelete_v20231001preview_requestbody.json")
+	response.EqualsFixture(200, "testdata/lock_cannotdelete_v20231001preview_responsebody.json")
+
+	response = server.MakeRequest("GET", lockCollectionURL, nil)
+	response.EqualsStatusCode(200)
+
+	// Updates are allowed, deletes are not.
+	response = server.MakeFixtureRequest("PUT", resourceGroupResourceURL, resourceGroupRequestFixture)
+	response.EqualsFixture(200, resourceGroupResponseFixture)
+
+	response = server.MakeRequest("DELETE", resourceGroupResourceURL, nil)
+	response.EqualsErrorCode(409, locks.CodeScopeLocked)
+
+	response = server.MakeRequest("DELETE", lockResourceURL, nil)
+	response.EqualsStatusCode(200)
+
+	response = server.MakeRequest("DELETE", resourceGroupResourceURL, nil)
+	response.EqualsStatusCode(200)
+}
+
+func Test_Lock_ReadOnly(t *testing.T) {
+	server := testserver.StartWithETCD(t, api.DefaultModules)
+	defer server.Close()
+
+	response := server.MakeFixtureRequest("PUT", radiusPlaneResourceURL, radiusPlaneRequestFixture)
+	response.EqualsFixture(200, radiusPlaneResponseFixture)
+
+	response = server.MakeFixtureRequest("PUT", resourceGroupResourceURL, resourceGroupRequestFixture)
+	response.EqualsFixture(200, resourceGroupResponseFixture)
+
+	response = server.MakeFixtureRequest("PUT", lockResourceURL, "testdata/lock_readonly_v20231001preview_requestbody.json")
+	response.EqualsStatusCode(200)
+
+	response = server.MakeFixtureRequest("PUT", resourceGroupResourceURL, resourceGroupRequestFixture)
+	response.EqualsErrorCode(409, locks.CodeScopeLocked)
+
+	response = server.MakeRequest("GET", resourceGroupResourceURL, nil)
+	response.EqualsFixture(200, resourceGroupResponseFixture)
+}
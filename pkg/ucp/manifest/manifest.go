@@ -0,0 +1,214 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package manifest
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+	corev1 "k8s.io/api/core/v1"
+	runtimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// ResourceProvider is the manifest of a resource provider that is registered with UCP at startup.
+//
+// Example:
+//
+//	namespace: Applications.Core
+//	locations:
+//	  global: http://applications-rp.radius-system:5443
+//	types:
+//	  applications:
+//	    apiVersions:
+//	      - 2023-10-01-preview
+type ResourceProvider struct {
+	// Namespace is the namespace of the resource provider, eg: Applications.Core.
+	Namespace string `yaml:"namespace"`
+
+	// Locations is a map of location names to the address of the resource provider in that location.
+	Locations map[string]string `yaml:"locations"`
+
+	// Types is a map of resource type names to the resource types provided by the resource provider.
+	Types map[string]ResourceType `yaml:"types,omitempty"`
+}
+
+// ResourceType is a resource type provided by a resource provider.
+type ResourceType struct {
+	// APIVersions is the list of API versions supported by the resource type.
+	APIVersions []string `yaml:"apiVersions,omitempty"`
+}
+
+// Address returns the address of the resource provider in the given location, or an empty string if the resource
+// provider is not available in the location.
+func (rp ResourceProvider) Address(location string) string {
+	for name, address := range rp.Locations {
+		if strings.EqualFold(name, location) {
+			return address
+		}
+	}
+
+	return ""
+}
+
+// Validate returns an error if the manifest is invalid.
+func (rp ResourceProvider) Validate() error {
+	if rp.Namespace == "" {
+		return errors.New("the property .namespace is required")
+	}
+
+	if len(rp.Locations) == 0 {
+		return fmt.Errorf("resource provider %q must specify at least one location", rp.Namespace)
+	}
+
+	for name, address := range rp.Locations {
+		u, err := url.Parse(address)
+		if err != nil || u.Scheme == "" || u.Host == "" {
+			return fmt.Errorf("resource provider %q has an invalid address %q for location %q", rp.Namespace, address, name)
+		}
+	}
+
+	for name, resourceType := range rp.Types {
+		if len(resourceType.APIVersions) == 0 {
+			return fmt.Errorf("resource type %q of resource provider %q must specify at least one API version", name, rp.Namespace)
+		}
+	}
+
+	return nil
+}
+
+// ReadFile reads and validates the resource provider manifest at the given path.
+func ReadFile(path string) (*ResourceProvider, error) {
+	buf, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	return parse(path, buf)
+}
+
+// ReadDirectory reads and validates the resource provider manifests (*.yaml, *.yml, *.json) in the given directory.
+// Manifests are returned in file name order. Duplicate namespaces are reported as an error.
+func ReadDirectory(dir string) ([]ResourceProvider, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	names := []string{}
+	for _, entry := range entries {
+		if !entry.IsDir() && isManifest(entry.Name()) {
+			names = append(names, entry.Name())
+		}
+	}
+	sort.Strings(names)
+
+	providers := []ResourceProvider{}
+	seen := map[string]string{}
+	for _, name := range names {
+		rp, err := ReadFile(filepath.Join(dir, name))
+		if err != nil {
+			return nil, err
+		}
+
+		providers, err = appendUnique(providers, seen, rp, name)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return providers, nil
+}
+
+// ReadConfigMap reads and validates the resource provider manifests stored in the data of the given Kubernetes
+// ConfigMap. Each key ending in .yaml, .yml or .json holds one manifest. Manifests are returned in key order.
+// Duplicate namespaces are reported as an error.
+func ReadConfigMap(ctx context.Context, client runtimeclient.Client, namespace string, name string) ([]ResourceProvider, error) {
+	configMap := &corev1.ConfigMap{}
+	err := client.Get(ctx, runtimeclient.ObjectKey{Namespace: namespace, Name: name}, configMap)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read manifest ConfigMap %s/%s: %w", namespace, name, err)
+	}
+
+	keys := []string{}
+	for key := range configMap.Data {
+		if isManifest(key) {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+
+	providers := []ResourceProvider{}
+	seen := map[string]string{}
+	for _, key := range keys {
+		rp, err := parse(fmt.Sprintf("%s/%s/%s", namespace, name, key), []byte(configMap.Data[key]))
+		if err != nil {
+			return nil, err
+		}
+
+		providers, err = appendUnique(providers, seen, rp, key)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return providers, nil
+}
+
+func isManifest(name string) bool {
+	switch strings.ToLower(filepath.Ext(name)) {
+	case ".yaml", ".yml", ".json":
+		return true
+	default:
+		return false
+	}
+}
+
+func parse(source string, buf []byte) (*ResourceProvider, error) {
+	rp := &ResourceProvider{}
+	decoder := yaml.NewDecoder(bytes.NewBuffer(buf))
+	decoder.KnownFields(true)
+
+	err := decoder.Decode(rp)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load manifest %q: %w", source, err)
+	}
+
+	err = rp.Validate()
+	if err != nil {
+		return nil, fmt.Errorf("invalid manifest %q: %w", source, err)
+	}
+
+	return rp, nil
+}
+
+func appendUnique(providers []ResourceProvider, seen map[string]string, rp *ResourceProvider, source string) ([]ResourceProvider, error) {
+	key := strings.ToLower(rp.Namespace)
+	if existing, ok := seen[key]; ok {
+		return nil, fmt.Errorf("resource provider %q is defined in both %q and %q", rp.Namespace, existing, source)
+	}
+	seen[key] = source
+
+	return append(providers, *rp), nil
+}
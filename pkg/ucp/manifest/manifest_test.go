@@ -0,0 +1,133 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package manifest
+
+import (
+	"testing"
+
+	"github.com/radius-project/radius/test/testcontext"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func Test_ReadDirectory(t *testing.T) {
+	t.Run("valid", func(t *testing.T) {
+		providers, err := ReadDirectory("testdata/valid")
+		require.NoError(t, err)
+		require.Len(t, providers, 2)
+
+		require.Equal(t, "Applications.Core", providers[0].Namespace)
+		require.Equal(t, []string{"2023-10-01-preview"}, providers[0].Types["applications"].APIVersions)
+		require.Equal(t, "Applications.Dapr", providers[1].Namespace)
+		require.Equal(t, "http://applications-rp.radius-system:5443", providers[1].Address("Global"))
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		_, err := ReadDirectory("testdata/invalid")
+		require.ErrorContains(t, err, "resource provider \"Applications.Core\" must specify at least one location")
+	})
+
+	t.Run("missing directory", func(t *testing.T) {
+		_, err := ReadDirectory("testdata/missing")
+		require.Error(t, err)
+	})
+}
+
+func Test_ReadConfigMap(t *testing.T) {
+	configMap := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "radius-system", Name: "manifests"},
+		Data: map[string]string{
+			"applications.dapr.json": `{"namespace": "Applications.Dapr", "locations": {"global": "http://dapr-rp:5443"}}`,
+			"applications.core.yaml": "namespace: Applications.Core\nlocations:\n  global: http://applications-rp:5443\ntypes:\n  applications:\n    apiVersions:\n      - 2023-10-01-preview\n",
+			"README.md":              "not a manifest",
+		},
+	}
+
+	t.Run("valid", func(t *testing.T) {
+		client := fake.NewClientBuilder().WithObjects(configMap).Build()
+
+		providers, err := ReadConfigMap(testcontext.New(t), client, "radius-system", "manifests")
+		require.NoError(t, err)
+		require.Len(t, providers, 2)
+
+		require.Equal(t, "Applications.Core", providers[0].Namespace)
+		require.Equal(t, []string{"2023-10-01-preview"}, providers[0].Types["applications"].APIVersions)
+		require.Equal(t, "Applications.Dapr", providers[1].Namespace)
+		require.Equal(t, "http://dapr-rp:5443", providers[1].Address("global"))
+	})
+
+	t.Run("duplicate namespace", func(t *testing.T) {
+		duplicate := configMap.DeepCopy()
+		duplicate.Data["dapr.yaml"] = "namespace: applications.dapr\nlocations:\n  global: http://dapr-rp:5443\n"
+		client := fake.NewClientBuilder().WithObjects(duplicate).Build()
+
+		_, err := ReadConfigMap(testcontext.New(t), client, "radius-system", "manifests")
+		require.ErrorContains(t, err, "is defined in both \"applications.dapr.json\" and \"dapr.yaml\"")
+	})
+
+	t.Run("missing ConfigMap", func(t *testing.T) {
+		client := fake.NewClientBuilder().Build()
+
+		_, err := ReadConfigMap(testcontext.New(t), client, "radius-system", "manifests")
+		require.ErrorContains(t, err, "failed to read manifest ConfigMap radius-system/manifests")
+	})
+}
+
+func Test_ResourceProvider_Validate(t *testing.T) {
+	tests := []struct {
+		name     string
+		provider ResourceProvider
+		err      string
+	}{
+		{
+			name:     "valid",
+			provider: ResourceProvider{Namespace: "Applications.Core", Locations: map[string]string{"global": "http://localhost:8080"}},
+		},
+		{
+			name:     "missing namespace",
+			provider: ResourceProvider{Locations: map[string]string{"global": "http://localhost:8080"}},
+			err:      "the property .namespace is required",
+		},
+		{
+			name:     "invalid address",
+			provider: ResourceProvider{Namespace: "Applications.Core", Locations: map[string]string{"global": "localhost"}},
+			err:      "resource provider \"Applications.Core\" has an invalid address \"localhost\" for location \"global\"",
+		},
+		{
+			name: "missing API versions",
+			provider: ResourceProvider{
+				Namespace: "Applications.Core",
+				Locations: map[string]string{"global": "http://localhost:8080"},
+				Types:     map[string]ResourceType{"applications": {}},
+			},
+			err: "resource type \"applications\" of resource provider \"Applications.Core\" must specify at least one API version",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.provider.Validate()
+			if tt.err == "" {
+				require.NoError(t, err)
+			} else {
+				require.EqualError(t, err, tt.err)
+			}
+		})
+	}
+}
@@ -0,0 +1,159 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package manifest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/radius-project/radius/pkg/ucp/store"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+)
+
+// maxRegisterAttempts is the number of times the registration of a plane is attempted when the plane is modified
+// concurrently, for example by another UCP replica starting at the same time.
+const maxRegisterAttempts = 5
+
+// Register reconciles the resource providers into the Radius planes in the store. The address of each resource
+// provider in the given location is added to (or updated in) the resource providers of every Radius plane, and the
+// resource types of the resource provider replace the types previously registered for its namespace. Resource
+// providers that are not available in the location are skipped.
+func Register(ctx context.Context, client store.StorageClient, providers []ResourceProvider, location string) error {
+	result, err := client.Query(ctx, store.Query{
+		RootScope:    resources.SegmentSeparator + resources.PlanesSegment,
+		IsScopeQuery: true,
+		ResourceType: "radius",
+	})
+	if err != nil {
+		return fmt.Errorf("failed to list Radius planes: %w", err)
+	}
+
+	for _, item := range result.Items {
+		err := registerPlane(ctx, client, item, providers, location)
+		if err != nil {
+			return fmt.Errorf("failed to register resource providers with plane %q: %w", item.ID, err)
+		}
+	}
+
+	return nil
+}
+
+func registerPlane(ctx context.Context, client store.StorageClient, item store.Object, providers []ResourceProvider, location string) error {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	for attempt := 1; ; attempt++ {
+		plane := datamodel.RadiusPlane{}
+		if err := item.As(&plane); err != nil {
+			return err
+		}
+
+		if !apply(ctx, &plane, providers, location) {
+			return nil
+		}
+
+		logger.Info(fmt.Sprintf("Registering resource providers with plane %s", item.ID))
+		err := client.Save(ctx, &store.Object{Metadata: store.Metadata{ID: item.ID}, Data: &plane}, store.WithETag(item.ETag))
+		if !errors.Is(err, &store.ErrConcurrency{}) || attempt == maxRegisterAttempts {
+			return err
+		}
+
+		// The plane was modified since it was read, reload it and try again.
+		logger.Info(fmt.Sprintf("Plane %s was modified concurrently, retrying registration", item.ID))
+		latest, err := client.Get(ctx, item.ID)
+		if err != nil {
+			return err
+		}
+		item = *latest
+	}
+}
+
+// apply applies the resource providers to the plane and returns true if the plane was changed.
+func apply(ctx context.Context, plane *datamodel.RadiusPlane, providers []ResourceProvider, location string) bool {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	if plane.Properties.ResourceProviders == nil {
+		plane.Properties.ResourceProviders = map[string]string{}
+	}
+
+	changed := false
+	for _, rp := range providers {
+		address := rp.Address(location)
+		if address == "" {
+			logger.Info(fmt.Sprintf("Skipping resource provider %s: not available in location %s", rp.Namespace, location))
+			continue
+		}
+
+		key := rp.Namespace
+		for k := range plane.Properties.ResourceProviders {
+			if strings.EqualFold(k, rp.Namespace) {
+				key = k
+				break
+			}
+		}
+
+		if plane.Properties.ResourceProviders[key] != address {
+			plane.Properties.ResourceProviders[key] = address
+			changed = true
+		}
+
+		if applyResourceTypes(plane, rp) {
+			changed = true
+		}
+	}
+
+	return changed
+}
+
+// applyResourceTypes replaces the resource types registered for the namespace of the resource provider and returns
+// true if they were changed.
+func applyResourceTypes(plane *datamodel.RadiusPlane, rp ResourceProvider) bool {
+	existing := map[string]datamodel.RadiusPlaneResourceType{}
+	for name, resourceType := range plane.Properties.ResourceTypes {
+		namespace, _, _ := strings.Cut(name, "/")
+		if strings.EqualFold(namespace, rp.Namespace) {
+			existing[name] = resourceType
+		}
+	}
+
+	desired := map[string]datamodel.RadiusPlaneResourceType{}
+	for name, resourceType := range rp.Types {
+		desired[rp.Namespace+"/"+name] = datamodel.RadiusPlaneResourceType{APIVersions: resourceType.APIVersions}
+	}
+
+	if reflect.DeepEqual(existing, desired) {
+		return false
+	}
+
+	for name := range existing {
+		delete(plane.Properties.ResourceTypes, name)
+	}
+
+	if len(desired) > 0 && plane.Properties.ResourceTypes == nil {
+		plane.Properties.ResourceTypes = map[string]datamodel.RadiusPlaneResourceType{}
+	}
+
+	for name, resourceType := range desired {
+		plane.Properties.ResourceTypes[name] = resourceType
+	}
+
+	return true
+}
@@ -0,0 +1,144 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package manifest
+
+import (
+	"context"
+	"testing"
+
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/radius-project/radius/pkg/ucp/store"
+	"github.com/radius-project/radius/test/testcontext"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_Register(t *testing.T) {
+	providers := []ResourceProvider{
+		{
+			Namespace: "Applications.Core",
+			Locations: map[string]string{"global": "http://applications-rp:5443"},
+			Types:     map[string]ResourceType{"applications": {APIVersions: []string{"2023-10-01-preview"}}},
+		},
+		{Namespace: "Applications.Dapr", Locations: map[string]string{"east": "http://dapr-rp:5443"}},
+	}
+
+	planes := &store.ObjectQueryResult{
+		Items: []store.Object{
+			{
+				Metadata: store.Metadata{ID: "/planes/radius/local", ETag: "etag-1"},
+				Data: &datamodel.RadiusPlane{
+					Properties: datamodel.RadiusPlaneProperties{
+						ResourceProviders: map[string]string{"applications.core": "http://old:5443"},
+					},
+				},
+			},
+			{
+				Metadata: store.Metadata{ID: "/planes/radius/other", ETag: "etag-2"},
+				Data: &datamodel.RadiusPlane{
+					Properties: datamodel.RadiusPlaneProperties{
+						ResourceProviders: map[string]string{"Applications.Core": "http://applications-rp:5443"},
+						ResourceTypes: map[string]datamodel.RadiusPlaneResourceType{
+							"Applications.Core/applications": {APIVersions: []string{"2023-10-01-preview"}},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	client := store.NewMockStorageClient(gomock.NewController(t))
+	client.EXPECT().
+		Query(gomock.Any(), store.Query{RootScope: "/planes", IsScopeQuery: true, ResourceType: "radius"}).
+		Return(planes, nil)
+
+	// Only the plane with an out of date address and resource types is saved.
+	client.EXPECT().
+		Save(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, obj *store.Object, options ...store.SaveOptions) error {
+			require.Equal(t, "/planes/radius/local", obj.ID)
+
+			plane := obj.Data.(*datamodel.RadiusPlane)
+			require.Equal(t, map[string]string{"applications.core": "http://applications-rp:5443"}, plane.Properties.ResourceProviders)
+			require.Equal(t, map[string]datamodel.RadiusPlaneResourceType{
+				"Applications.Core/applications": {APIVersions: []string{"2023-10-01-preview"}},
+			}, plane.Properties.ResourceTypes)
+			return nil
+		})
+
+	err := Register(testcontext.New(t), client, providers, "global")
+	require.NoError(t, err)
+}
+
+func Test_Register_Conflict(t *testing.T) {
+	providers := []ResourceProvider{
+		{
+			Namespace: "Applications.Core",
+			Locations: map[string]string{"global": "http://applications-rp:5443"},
+			Types:     map[string]ResourceType{"containers": {APIVersions: []string{"2023-10-01-preview"}}},
+		},
+	}
+
+	stale := store.Object{
+		Metadata: store.Metadata{ID: "/planes/radius/local", ETag: "etag-1"},
+		Data:     &datamodel.RadiusPlane{},
+	}
+
+	// Another replica registered a resource provider after the plane was read.
+	latest := &store.Object{
+		Metadata: store.Metadata{ID: "/planes/radius/local", ETag: "etag-2"},
+		Data: &datamodel.RadiusPlane{
+			Properties: datamodel.RadiusPlaneProperties{
+				ResourceProviders: map[string]string{"Applications.Dapr": "http://dapr-rp:5443"},
+				ResourceTypes: map[string]datamodel.RadiusPlaneResourceType{
+					"Applications.Core/applications": {APIVersions: []string{"2023-10-01-preview"}},
+				},
+			},
+		},
+	}
+
+	client := store.NewMockStorageClient(gomock.NewController(t))
+	client.EXPECT().
+		Query(gomock.Any(), gomock.Any()).
+		Return(&store.ObjectQueryResult{Items: []store.Object{stale}}, nil)
+	client.EXPECT().
+		Save(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(&store.ErrConcurrency{})
+	client.EXPECT().
+		Get(gomock.Any(), "/planes/radius/local").
+		Return(latest, nil)
+	client.EXPECT().
+		Save(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, obj *store.Object, options ...store.SaveOptions) error {
+			require.Equal(t, "etag-2", store.NewSaveConfig(options...).ETag)
+
+			plane := obj.Data.(*datamodel.RadiusPlane)
+			require.Equal(t, map[string]string{
+				"Applications.Core": "http://applications-rp:5443",
+				"Applications.Dapr": "http://dapr-rp:5443",
+			}, plane.Properties.ResourceProviders)
+
+			// The types of the namespace are replaced by the types in the manifest.
+			require.Equal(t, map[string]datamodel.RadiusPlaneResourceType{
+				"Applications.Core/containers": {APIVersions: []string{"2023-10-01-preview"}},
+			}, plane.Properties.ResourceTypes)
+			return nil
+		})
+
+	err := Register(testcontext.New(t), client, providers, "global")
+	require.NoError(t, err)
+}
@@ -0,0 +1,171 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package proxy
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"net/http"
+	"strings"
+	"sync"
+)
+
+// ClientCertificate is the client certificate and private key used for mutual TLS with a downstream server.
+// This is the format of the UCP secret referenced by a plane's TLS configuration.
+type ClientCertificate struct {
+	// Certificate is the PEM encoded client certificate chain.
+	Certificate string `json:"certificate"`
+
+	// PrivateKey is the PEM encoded private key of the client certificate.
+	PrivateKey string `json:"privateKey"`
+}
+
+// NewTLSConfig creates a TLS configuration that trusts the PEM encoded CA bundle in addition to the
+// system trust store, and presents the client certificate when it is provided.
+func NewTLSConfig(caBundle string, clientCertificate *ClientCertificate) (*tls.Config, error) {
+	config := &tls.Config{MinVersion: tls.VersionTLS12}
+
+	if caBundle != "" {
+		pool, err := x509.SystemCertPool()
+		if err != nil {
+			pool = x509.NewCertPool()
+		}
+
+		if !pool.AppendCertsFromPEM([]byte(caBundle)) {
+			return nil, errors.New("failed to parse CA bundle: no valid PEM certificates found")
+		}
+
+		config.RootCAs = pool
+	}
+
+	if clientCertificate != nil {
+		certificate, err := tls.X509KeyPair([]byte(clientCertificate.Certificate), []byte(clientCertificate.PrivateKey))
+		if err != nil {
+			return nil, err
+		}
+
+		config.Certificates = []tls.Certificate{certificate}
+	}
+
+	return config, nil
+}
+
+// DownstreamTransport is an http.RoundTripper that applies the TLS configuration of planes to requests sent to
+// downstream servers. Configuration is kept per plane and host, so that planes sharing a host do not overwrite each
+// other. The plane of a request is read from its context (see WithPlane). Requests without TLS configuration are
+// sent using the default transport.
+type DownstreamTransport struct {
+	mutex      sync.RWMutex
+	transports map[transportKey]*hostTransport
+}
+
+type transportKey struct {
+	plane string
+	host  string
+}
+
+type hostTransport struct {
+	key       string
+	transport *http.Transport
+}
+
+type planeContextKey struct{}
+
+var _ http.RoundTripper = (*DownstreamTransport)(nil)
+
+// WithPlane returns a copy of the context that makes a DownstreamTransport use the TLS configuration of the given
+// plane for requests sent with it.
+func WithPlane(ctx context.Context, planeID string) context.Context {
+	return context.WithValue(ctx, planeContextKey{}, strings.ToLower(planeID))
+}
+
+func planeFromContext(ctx context.Context) string {
+	planeID, _ := ctx.Value(planeContextKey{}).(string)
+	return planeID
+}
+
+// NewDownstreamTransport creates a new DownstreamTransport.
+func NewDownstreamTransport() *DownstreamTransport {
+	return &DownstreamTransport{transports: map[transportKey]*hostTransport{}}
+}
+
+// Configure sets the TLS configuration used for requests of the plane to the given host. The key identifies the
+// configuration so that the underlying transport is only rebuilt (and its connections dropped) when the configuration
+// changes.
+func (t *DownstreamTransport) Configure(planeID string, host string, key string, build func() (*tls.Config, error)) error {
+	k := transportKey{plane: strings.ToLower(planeID), host: strings.ToLower(host)}
+
+	t.mutex.RLock()
+	existing, ok := t.transports[k]
+	t.mutex.RUnlock()
+	if ok && existing.key == key {
+		return nil
+	}
+
+	config, err := build()
+	if err != nil {
+		return err
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.TLSClientConfig = config
+
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	if previous, ok := t.transports[k]; ok {
+		previous.transport.CloseIdleConnections()
+	}
+	t.transports[k] = &hostTransport{key: key, transport: transport}
+
+	return nil
+}
+
+// Remove removes the TLS configuration of the plane for the given host, so that requests of the plane to the host
+// are sent using the default transport. The configuration of other planes is not affected.
+func (t *DownstreamTransport) Remove(planeID string, host string) {
+	k := transportKey{plane: strings.ToLower(planeID), host: strings.ToLower(host)}
+
+	t.mutex.RLock()
+	_, ok := t.transports[k]
+	t.mutex.RUnlock()
+	if !ok {
+		return
+	}
+
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	if previous, ok := t.transports[k]; ok {
+		previous.transport.CloseIdleConnections()
+		delete(t.transports, k)
+	}
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *DownstreamTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	k := transportKey{plane: planeFromContext(req.Context()), host: strings.ToLower(req.URL.Host)}
+
+	t.mutex.RLock()
+	entry, ok := t.transports[k]
+	t.mutex.RUnlock()
+	if ok {
+		return entry.transport.RoundTrip(req)
+	}
+
+	return http.DefaultTransport.RoundTrip(req)
+}
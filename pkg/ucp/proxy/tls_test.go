@@ -0,0 +1,124 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package proxy
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_NewTLSConfig(t *testing.T) {
+	t.Run("empty", func(t *testing.T) {
+		config, err := NewTLSConfig("", nil)
+		require.NoError(t, err)
+		require.Nil(t, config.RootCAs)
+		require.Empty(t, config.Certificates)
+	})
+
+	t.Run("invalid CA bundle", func(t *testing.T) {
+		_, err := NewTLSConfig("not a certificate", nil)
+		require.EqualError(t, err, "failed to parse CA bundle: no valid PEM certificates found")
+	})
+
+	t.Run("invalid client certificate", func(t *testing.T) {
+		_, err := NewTLSConfig("", &ClientCertificate{Certificate: "invalid", PrivateKey: "invalid"})
+		require.Error(t, err)
+	})
+}
+
+func Test_DownstreamTransport(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	serverURL, err := url.Parse(server.URL)
+	require.NoError(t, err)
+
+	caBundle := string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw}))
+
+	const planeID = "/planes/radius/local"
+	const otherPlaneID = "/planes/radius/other"
+
+	transport := NewDownstreamTransport()
+	client := &http.Client{Transport: transport}
+
+	get := func(planeID string) error {
+		request, err := http.NewRequestWithContext(WithPlane(context.Background(), planeID), http.MethodGet, server.URL, nil)
+		require.NoError(t, err)
+
+		response, err := client.Do(request)
+		if err != nil {
+			return err
+		}
+
+		require.Equal(t, http.StatusNoContent, response.StatusCode)
+		return response.Body.Close()
+	}
+
+	// The server certificate is not trusted by the system trust store.
+	require.Error(t, get(planeID))
+
+	built := 0
+	build := func() (*tls.Config, error) {
+		built++
+		return NewTLSConfig(caBundle, nil)
+	}
+
+	err = transport.Configure(planeID, serverURL.Host, "key", build)
+	require.NoError(t, err)
+
+	require.NoError(t, get(planeID))
+
+	// The configuration only applies to requests of the configured plane.
+	require.Error(t, get(otherPlaneID))
+	_, err = client.Get(server.URL)
+	require.Error(t, err)
+
+	// Configuring the same key again does not rebuild the transport.
+	err = transport.Configure(planeID, serverURL.Host, "key", build)
+	require.NoError(t, err)
+	require.Equal(t, 1, built)
+
+	err = transport.Configure(planeID, serverURL.Host, "other-key", build)
+	require.NoError(t, err)
+	require.Equal(t, 2, built)
+
+	// Removing the configuration of another plane on the same host does not affect the plane.
+	err = transport.Configure(otherPlaneID, serverURL.Host, "key", build)
+	require.NoError(t, err)
+	require.NoError(t, get(otherPlaneID))
+
+	transport.Remove(otherPlaneID, serverURL.Host)
+	require.Error(t, get(otherPlaneID))
+	require.NoError(t, get(planeID))
+
+	// Removing the configuration falls back to the default transport.
+	transport.Remove(planeID, serverURL.Host)
+	require.Error(t, get(planeID))
+
+	err = transport.Configure(planeID, serverURL.Host, "other-key", build)
+	require.NoError(t, err)
+	require.Equal(t, 4, built)
+}
@@ -170,19 +170,21 @@ func (c *Client) Enqueue(ctx context.Context, msg *client.Message, options ...cl
 		return err
 	}
 
+	cfg := client.NewEnqueueConfig(options...)
+
 	resource := &v1alpha1.QueueMessage{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      id,
 			Namespace: c.opts.Namespace,
 			Labels: map[string]string{
-				LabelNextVisibleAt: int64toa(now.UnixNano()),
+				LabelNextVisibleAt: int64toa(now.Add(cfg.Delay).UnixNano()),
 				LabelQueueName:     c.opts.Name,
 			},
 		},
 		Spec: v1alpha1.QueueMessageSpec{
 			DequeueCount: 0,
 			EnqueueAt:    metav1.Time{Time: now.UTC()},
-			ExpireAt:     metav1.Time{Time: now.Add(c.opts.ExpiryDuration).UTC()},
+			ExpireAt:     metav1.Time{Time: now.Add(cfg.Delay).Add(c.opts.ExpiryDuration).UTC()},
 			ContentType:  client.JSONContentType, // RawExtension supports only JSON seralized data
 			Data:         &runtime.RawExtension{Raw: msg.Data},
 		},
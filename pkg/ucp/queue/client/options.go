@@ -21,6 +21,8 @@ import "time"
 type (
 	// EnqueueOptions applies an option to Enqueue().
 	EnqueueOptions interface {
+		// ApplyEnqueueOption applies EnqueueOptions to EnqueueConfig.
+		ApplyEnqueueOption(EnqueueConfig) EnqueueConfig
 		// A private method to prevent users implementing the
 		// interface and so future additions to it will not
 		// violate compatibility.
@@ -43,6 +45,42 @@ type QueueClientConfig struct {
 	DequeueIntervalDuration time.Duration
 }
 
+// EnqueueConfig is a configuration for Enqueue().
+type EnqueueConfig struct {
+	// Delay is the duration to wait after enqueueing before the message becomes visible to dequeuers.
+	Delay time.Duration
+}
+
+type enqueueOptions struct {
+	fn func(EnqueueConfig) EnqueueConfig
+}
+
+// ApplyEnqueueOption applies the configuration to the enqueued message.
+func (q *enqueueOptions) ApplyEnqueueOption(cfg EnqueueConfig) EnqueueConfig {
+	return q.fn(cfg)
+}
+
+// WithEnqueueAfter delays the visibility of the enqueued message by the given duration.
+func WithEnqueueAfter(t time.Duration) EnqueueOptions {
+	return &enqueueOptions{
+		fn: func(cfg EnqueueConfig) EnqueueConfig {
+			cfg.Delay = t
+			return cfg
+		},
+	}
+}
+
+func (q enqueueOptions) private() {}
+
+// NewEnqueueConfig returns new enqueue config for Enqueue().
+func NewEnqueueConfig(opts ...EnqueueOptions) EnqueueConfig {
+	cfg := EnqueueConfig{}
+	for _, opt := range opts {
+		cfg = opt.ApplyEnqueueOption(cfg)
+	}
+	return cfg
+}
+
 type dequeueOptions struct {
 	fn func(QueueClientConfig) QueueClientConfig
 }
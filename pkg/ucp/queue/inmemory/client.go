@@ -55,7 +55,8 @@ func (c *Client) Enqueue(ctx context.Context, msg *client.Message, options ...cl
 	if msg == nil || msg.Data == nil || len(msg.Data) == 0 {
 		return client.ErrEmptyMessage
 	}
-	c.queue.Enqueue(msg)
+	cfg := client.NewEnqueueConfig(options...)
+	c.queue.EnqueueAfter(msg, cfg.Delay)
 	return nil
 }
 
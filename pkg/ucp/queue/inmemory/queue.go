@@ -66,17 +66,29 @@ func (q *InmemQueue) DeleteAll() {
 }
 
 func (q *InmemQueue) Enqueue(msg *client.Message) {
+	q.EnqueueAfter(msg, 0)
+}
+
+// EnqueueAfter enqueues the message so that it becomes visible to dequeuers only after the given delay.
+func (q *InmemQueue) EnqueueAfter(msg *client.Message, delay time.Duration) {
 	q.updateQueue()
 
 	q.vMu.Lock()
 	defer q.vMu.Unlock()
 
+	now := time.Now().UTC()
 	msg.Metadata.ID = uuid.NewString()
 	msg.Metadata.DequeueCount = 0
-	msg.Metadata.EnqueueAt = time.Now().UTC()
-	msg.Metadata.ExpireAt = time.Now().UTC().Add(messageExpireDuration)
+	msg.Metadata.EnqueueAt = now
+	msg.Metadata.ExpireAt = now.Add(delay).Add(messageExpireDuration)
+
+	visible := true
+	if delay > 0 {
+		msg.Metadata.NextVisibleAt = now.Add(delay)
+		visible = false
+	}
 
-	q.v.PushBack(&element{val: msg, visible: true})
+	q.v.PushBack(&element{val: msg, visible: visible})
 }
 
 func (q *InmemQueue) Dequeue() *client.Message {
@@ -83,6 +83,25 @@ func TestExpiry(t *testing.T) {
 	require.Nil(t, msg2)
 }
 
+func TestEnqueueAfter(t *testing.T) {
+	q := NewInMemQueue(messageLockDuration)
+
+	q.EnqueueAfter(&client.Message{
+		Data: []byte("test"),
+	}, 5*time.Millisecond)
+
+	// The message is not visible until the delay has elapsed.
+	msg := q.Dequeue()
+	require.Nil(t, msg)
+
+	time.Sleep(10 * time.Millisecond)
+
+	msg = q.Dequeue()
+	require.NotNil(t, msg)
+	require.Equal(t, []byte("test"), msg.Data)
+	require.Equal(t, 1, msg.DequeueCount)
+}
+
 func TestComplete(t *testing.T) {
 	q := NewInMemQueue(messageLockDuration)
 
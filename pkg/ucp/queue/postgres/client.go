@@ -0,0 +1,223 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package postgres is a PostgreSQL based queue implementation. Messages of all queues are stored in a single table and
+// are distinguished by queue name, so that the queue can share the database used for other UCP data.
+//
+// We need four operations for the queue:
+//
+// 1. Enqueue: Inserts a row for the message.
+// 2. Dequeue: Selects the oldest visible message with FOR UPDATE SKIP LOCKED, so that concurrent clients never lease
+//             the same message, and atomically increases its DequeueCount and pushes next_visible_at out by the
+//             message lock duration.
+// 3. FinishMessage: Deletes the leased message row.
+// 4. ExtendMessage: Pushes next_visible_at out by the message lock duration if the lease is still held.
+//
+// Timestamps are always computed by the database, so this implementation is not affected by clock skew between the
+// nodes running the clients. DequeueCount is used as a revision number of the message: ExtendMessage only succeeds if
+// the DequeueCount of the message matches the row, which means that the message was not leased by another client in
+// the meantime.
+
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/radius-project/radius/pkg/ucp/queue/client"
+
+	// Registers the "postgres" database/sql driver.
+	_ "github.com/lib/pq"
+)
+
+const (
+	// DriverName is the database/sql driver name used to connect to PostgreSQL.
+	DriverName = "postgres"
+
+	defaultMessageLockDuration = time.Duration(5) * time.Minute
+	defaultExpiryDuration      = time.Duration(10) * time.Hour
+)
+
+// Schema is the SQL statement creating the table and index used by the queue.
+const Schema = `
+CREATE TABLE IF NOT EXISTS queue_messages (
+	id              TEXT PRIMARY KEY,
+	queue_name      TEXT NOT NULL,
+	dequeue_count   INTEGER NOT NULL DEFAULT 0,
+	enqueue_at      TIMESTAMPTZ NOT NULL,
+	expire_at       TIMESTAMPTZ NOT NULL,
+	next_visible_at TIMESTAMPTZ NOT NULL,
+	content_type    TEXT NOT NULL,
+	data            BYTEA NOT NULL
+);
+CREATE INDEX IF NOT EXISTS queue_messages_visible_idx ON queue_messages (queue_name, next_visible_at);
+`
+
+const (
+	enqueueQuery = `
+INSERT INTO queue_messages (id, queue_name, enqueue_at, expire_at, next_visible_at, content_type, data)
+VALUES ($1, $2, now(), now() + make_interval(secs => $3) + make_interval(secs => $4), now() + make_interval(secs => $3), $5, $6)`
+
+	deleteExpiredQuery = `DELETE FROM queue_messages WHERE queue_name = $1 AND expire_at <= now()`
+
+	dequeueQuery = `
+UPDATE queue_messages
+SET dequeue_count = dequeue_count + 1, next_visible_at = now() + make_interval(secs => $2)
+WHERE id = (
+	SELECT id FROM queue_messages
+	WHERE queue_name = $1 AND next_visible_at <= now() AND expire_at > now()
+	ORDER BY next_visible_at, enqueue_at
+	FOR UPDATE SKIP LOCKED
+	LIMIT 1
+)
+RETURNING id, dequeue_count, enqueue_at, expire_at, next_visible_at, content_type, data`
+
+	finishQuery = `DELETE FROM queue_messages WHERE id = $1 AND queue_name = $2`
+
+	extendQuery = `
+UPDATE queue_messages
+SET next_visible_at = now() + make_interval(secs => $4)
+WHERE id = $1 AND queue_name = $2 AND dequeue_count = $3 AND next_visible_at > now()
+RETURNING next_visible_at`
+)
+
+var _ client.Client = (*Client)(nil)
+
+// Client is the queue client backed by PostgreSQL.
+type Client struct {
+	db *sql.DB
+
+	opts Options
+}
+
+// Options is the options to create PostgreSQL queue client.
+type Options struct {
+	// Name represents the name of queue.
+	Name string
+
+	// MessageLockDuration represents the duration of message lock.
+	MessageLockDuration time.Duration
+	// ExpiryDuration represents the duration of the expiry.
+	ExpiryDuration time.Duration
+}
+
+// New creates the queue backed by PostgreSQL. name is unique name for each service which will consume the queue. The
+// table used by the queue must be created with Schema before using the client.
+func New(db *sql.DB, options Options) (*Client, error) {
+	if db == nil {
+		return nil, errors.New("db is required")
+	}
+
+	if options.Name == "" {
+		return nil, errors.New("Name is required")
+	}
+
+	if options.MessageLockDuration == time.Duration(0) {
+		options.MessageLockDuration = defaultMessageLockDuration
+	}
+
+	if options.ExpiryDuration == time.Duration(0) {
+		options.ExpiryDuration = defaultExpiryDuration
+	}
+
+	return &Client{db: db, opts: options}, nil
+}
+
+// EnsureSchema creates the table and index used by the queue if they do not exist.
+func EnsureSchema(ctx context.Context, db *sql.DB) error {
+	_, err := db.ExecContext(ctx, Schema)
+	return err
+}
+
+// Enqueue inserts the message into the queue. The message becomes visible to dequeuers after the delay configured by
+// the options.
+func (c *Client) Enqueue(ctx context.Context, msg *client.Message, options ...client.EnqueueOptions) error {
+	if msg == nil || msg.Data == nil || len(msg.Data) == 0 {
+		return client.ErrEmptyMessage
+	}
+
+	if msg.ContentType != client.JSONContentType {
+		return client.ErrUnsupportedContentType
+	}
+
+	cfg := client.NewEnqueueConfig(options...)
+	_, err := c.db.ExecContext(ctx, enqueueQuery,
+		uuid.NewString(), c.opts.Name, cfg.Delay.Seconds(), c.opts.ExpiryDuration.Seconds(), msg.ContentType, msg.Data)
+	return err
+}
+
+// Dequeue leases the oldest visible message in the queue. It returns ErrMessageNotFound if the queue is empty or all
+// messages are leased by other clients.
+func (c *Client) Dequeue(ctx context.Context, cfg client.QueueClientConfig) (*client.Message, error) {
+	if _, err := c.db.ExecContext(ctx, deleteExpiredQuery, c.opts.Name); err != nil {
+		return nil, err
+	}
+
+	msg := &client.Message{}
+	err := c.db.QueryRowContext(ctx, dequeueQuery, c.opts.Name, c.opts.MessageLockDuration.Seconds()).Scan(
+		&msg.ID, &msg.DequeueCount, &msg.EnqueueAt, &msg.ExpireAt, &msg.NextVisibleAt, &msg.ContentType, &msg.Data)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, client.ErrMessageNotFound
+	} else if err != nil {
+		return nil, err
+	}
+
+	return msg, nil
+}
+
+// FinishMessage deletes the leased message from the queue. It returns ErrInvalidMessage if the message was already
+// deleted.
+func (c *Client) FinishMessage(ctx context.Context, msg *client.Message) error {
+	if msg == nil {
+		return client.ErrEmptyMessage
+	}
+
+	result, err := c.db.ExecContext(ctx, finishQuery, msg.ID, c.opts.Name)
+	if err != nil {
+		return err
+	}
+
+	n, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return client.ErrInvalidMessage
+	}
+
+	return nil
+}
+
+// ExtendMessage extends the lock of the leased message. It returns ErrInvalidMessage if the lock has already expired
+// or the message was leased by another client.
+func (c *Client) ExtendMessage(ctx context.Context, msg *client.Message) error {
+	if msg == nil {
+		return client.ErrEmptyMessage
+	}
+
+	err := c.db.QueryRowContext(ctx, extendQuery, msg.ID, c.opts.Name, msg.DequeueCount, c.opts.MessageLockDuration.Seconds()).
+		Scan(&msg.NextVisibleAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return client.ErrInvalidMessage
+	} else if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,87 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"os"
+	"testing"
+
+	"github.com/radius-project/radius/pkg/ucp/queue/client"
+	sharedtest "github.com/radius-project/radius/test/ucp/queuetest"
+	"github.com/stretchr/testify/require"
+)
+
+var dbURL = os.Getenv("TEST_POSTGRESQL_URL")
+
+func TestNew(t *testing.T) {
+	db, err := sql.Open(DriverName, "postgres://localhost/ucp")
+	require.NoError(t, err)
+
+	t.Run("db is required", func(t *testing.T) {
+		_, err := New(nil, Options{Name: "applications.core"})
+		require.EqualError(t, err, "db is required")
+	})
+
+	t.Run("name is required", func(t *testing.T) {
+		_, err := New(db, Options{})
+		require.EqualError(t, err, "Name is required")
+	})
+
+	t.Run("default durations", func(t *testing.T) {
+		cli, err := New(db, Options{Name: "applications.core"})
+		require.NoError(t, err)
+		require.Equal(t, defaultMessageLockDuration, cli.opts.MessageLockDuration)
+		require.Equal(t, defaultExpiryDuration, cli.opts.ExpiryDuration)
+	})
+}
+
+func TestEnqueue_InvalidMessage(t *testing.T) {
+	db, err := sql.Open(DriverName, "postgres://localhost/ucp")
+	require.NoError(t, err)
+
+	cli, err := New(db, Options{Name: "applications.core"})
+	require.NoError(t, err)
+
+	err = cli.Enqueue(context.Background(), &client.Message{ContentType: "text/plain", Data: []byte("hello")})
+	require.ErrorIs(t, err, client.ErrUnsupportedContentType)
+}
+
+func TestClient(t *testing.T) {
+	if dbURL == "" {
+		t.Skip("TEST_POSTGRESQL_URL is not set.")
+	}
+
+	ctx := context.Background()
+	db, err := sql.Open(DriverName, dbURL)
+	require.NoError(t, err)
+	defer db.Close()
+
+	err = EnsureSchema(ctx, db)
+	require.NoError(t, err)
+
+	cli, err := New(db, Options{Name: "applications.core", MessageLockDuration: sharedtest.TestMessageLockTime})
+	require.NoError(t, err)
+
+	clear := func(t *testing.T) {
+		_, err := db.ExecContext(ctx, "DELETE FROM queue_messages WHERE queue_name = $1", cli.opts.Name)
+		require.NoError(t, err)
+	}
+
+	sharedtest.RunTest(t, cli, clear)
+}
@@ -18,6 +18,7 @@ package provider
 
 import (
 	context "context"
+	"database/sql"
 	"errors"
 	"fmt"
 
@@ -25,6 +26,7 @@ import (
 	"github.com/radius-project/radius/pkg/ucp/queue/apiserver"
 	queue "github.com/radius-project/radius/pkg/ucp/queue/client"
 	qinmem "github.com/radius-project/radius/pkg/ucp/queue/inmemory"
+	"github.com/radius-project/radius/pkg/ucp/queue/postgres"
 	ucpv1alpha1 "github.com/radius-project/radius/pkg/ucp/store/apiserverstore/api/ucp.dev/v1alpha1"
 	"k8s.io/apimachinery/pkg/runtime"
 	runtimeclient "sigs.k8s.io/controller-runtime/pkg/client"
@@ -33,8 +35,9 @@ import (
 type factoryFunc func(context.Context, QueueProviderOptions) (queue.Client, error)
 
 var clientFactory = map[QueueProviderType]factoryFunc{
-	TypeInmemory:  initInMemory,
-	TypeAPIServer: initAPIServer,
+	TypeInmemory:   initInMemory,
+	TypeAPIServer:  initAPIServer,
+	TypePostgreSQL: initPostgreSQL,
 }
 
 func initInMemory(ctx context.Context, opt QueueProviderOptions) (queue.Client, error) {
@@ -80,3 +83,21 @@ func initAPIServer(ctx context.Context, opt QueueProviderOptions) (queue.Client,
 		Namespace: opt.APIServer.Namespace,
 	})
 }
+
+func initPostgreSQL(ctx context.Context, opt QueueProviderOptions) (queue.Client, error) {
+	if opt.PostgreSQL.URL == "" {
+		return nil, errors.New("failed to initialize PostgreSQL client: url is required")
+	}
+
+	db, err := sql.Open(postgres.DriverName, opt.PostgreSQL.URL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize PostgreSQL client: %w", err)
+	}
+
+	if err := postgres.EnsureSchema(ctx, db); err != nil {
+		_ = db.Close()
+		return nil, fmt.Errorf("failed to initialize PostgreSQL queue schema: %w", err)
+	}
+
+	return postgres.New(db, postgres.Options{Name: opt.Name})
+}
@@ -47,3 +47,13 @@ func TestGetClient_InvalidQueue(t *testing.T) {
 	_, err := p.GetClient(context.TODO())
 	require.ErrorIs(t, ErrUnsupportedStorageProvider, err)
 }
+
+func TestGetClient_PostgreSQLWithoutURL(t *testing.T) {
+	p := New(QueueProviderOptions{
+		Name:     "Applications.Core",
+		Provider: TypePostgreSQL,
+	})
+
+	_, err := p.GetClient(context.TODO())
+	require.EqualError(t, err, "failed to initialize PostgreSQL client: url is required")
+}
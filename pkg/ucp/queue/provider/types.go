@@ -25,4 +25,7 @@ const (
 
 	// TypeAPIServer represents the Kubernetes APIServer provider.
 	TypeAPIServer QueueProviderType = "apiserver"
+
+	// TypePostgreSQL represents the PostgreSQL queue provider.
+	TypePostgreSQL QueueProviderType = "postgresql"
 )
@@ -23,4 +23,10 @@ const (
 	ResourceTypeManagedIdentityUserAssignedManagedIdentityFederatedIdentityCredential = "Microsoft.ManagedIdentity/userAssignedIdentities/federatedIdentityCredentials"
 	// ResourceTypeAuthorizationRoleAssignment is the resource type of a role assignment.
 	ResourceTypeAuthorizationRoleAssignment = "Microsoft.Authorization/roleAssignments"
+	// ResourceTypeNetworkPrivateEndpoint is the resource type of a private endpoint.
+	ResourceTypeNetworkPrivateEndpoint = "Microsoft.Network/privateEndpoints"
+	// ResourceTypeNetworkPrivateDNSZone is the resource type of a private DNS zone.
+	ResourceTypeNetworkPrivateDNSZone = "Microsoft.Network/privateDnsZones"
+	// ResourceTypeNetworkVirtualNetworkSubnet is the resource type of a subnet of a virtual network.
+	ResourceTypeNetworkVirtualNetworkSubnet = "Microsoft.Network/virtualNetworks/subnets"
 )
@@ -38,6 +38,11 @@ limitations under the License.
 //
 // We also use a labeling scheme to attach each root scope segment and the resource type as a label to the
 // Kubernetes objects. This allows us to filter the number of objects we transact with using the labels as hints.
+//
+// The same labeling scheme is used to maintain secondary indexes for the fields of store.FieldSelector. The
+// label value is the hash of the lowercased field value, since field values (eg: resource ids) are often not
+// valid label values. Objects written before indexing was introduced lack the indexed label, and are still
+// found by selector queries using client-side filtering until they are written again.
 package apiserverstore
 
 import (
@@ -73,6 +78,13 @@ const (
 	// LabelResourceType is used as the key of a label describing the resource type.
 	LabelResourceType = "ucp.dev/resource-type"
 
+	// LabelIndexFormat is used to format a label that describes an indexed field. The placeholder is replaced by the
+	// lowercased name of the field (eg: application).
+	LabelIndexFormat = "ucp.dev/index-%s"
+
+	// LabelIndexed is used to mark objects whose index labels are maintained.
+	LabelIndexed = "ucp.dev/indexed"
+
 	// LabelValueMultiple is used as the label value when a resource matches multiple scopes or types due to
 	// hash collision.
 	LabelValueMultiple = "m_u_l_t_i_p_l_e"
@@ -112,19 +124,25 @@ func (c *APIServerClient) Query(ctx context.Context, query store.Query, options
 		return nil, &store.ErrInvalid{Message: "invalid argument. 'query.RoutingScopePrefix' is not supported for scope queries"}
 	}
 
-	selector, err := createLabelSelector(query)
+	selectors, err := createLabelSelectors(query)
 	if err != nil {
 		return nil, err
 	}
 
-	rs := ucpv1alpha1.ResourceList{}
-	err = c.client.List(ctx, &rs, runtimeclient.InNamespace(c.namespace), runtimeclient.MatchingLabelsSelector{Selector: selector})
-	if err != nil {
-		return nil, err
+	items := []ucpv1alpha1.Resource{}
+	for _, selector := range selectors {
+		rs := ucpv1alpha1.ResourceList{}
+		err = c.client.List(ctx, &rs, runtimeclient.InNamespace(c.namespace), runtimeclient.MatchingLabelsSelector{Selector: selector})
+		if err != nil {
+			return nil, err
+		}
+
+		items = append(items, rs.Items...)
 	}
 
+	filters := query.AllFilters()
 	results := store.ObjectQueryResult{}
-	for _, resource := range rs.Items {
+	for _, resource := range items {
 		for _, entry := range resource.Entries {
 			id, err := resources.Parse(entry.ID)
 			if err != nil {
@@ -141,7 +159,7 @@ func (c *APIServerClient) Query(ctx context.Context, query store.Query, options
 					return nil, err
 				}
 
-				match, err := converted.MatchesFilters(query.Filters)
+				match, err := converted.MatchesFilters(filters)
 				if err != nil {
 					return nil, err
 				} else if !match {
@@ -462,11 +480,84 @@ func assignLabels(resource *ucpv1alpha1.Resource) labels.Set {
 		}
 
 		set[LabelResourceType] = value
+
+		if entry.Data == nil {
+			continue
+		}
+		converted, err := readEntry(&entry)
+		if err != nil {
+			continue
+		}
+		values, err := converted.IndexedValues()
+		if err != nil {
+			continue
+		}
+		for field, fieldValue := range values {
+			key := indexLabelKey(field)
+			value := indexLabelValue(fieldValue)
+
+			existing, ok := set[key]
+			if ok && existing != value {
+				value = LabelValueMultiple
+			}
+
+			set[key] = value
+		}
 	}
 
+	set[LabelIndexed] = "true"
+
 	return set
 }
 
+// indexLabelKey returns the label key used to index the given field.
+func indexLabelKey(field string) string {
+	parts := strings.Split(field, ".")
+	return fmt.Sprintf(LabelIndexFormat, strings.ToLower(parts[len(parts)-1]))
+}
+
+// indexLabelValue returns the label value used to index the given field value.
+func indexLabelValue(value string) string {
+	hasher := sha1.New()
+	_, _ = hasher.Write([]byte(strings.ToLower(value)))
+	return fmt.Sprintf("%x", hasher.Sum(nil))
+}
+
+// createLabelSelectors returns the label selectors that must be listed to execute the query. Queries that use a
+// field selector are split into a query on the index labels and a query for objects that have not been indexed.
+func createLabelSelectors(query store.Query) ([]labels.Selector, error) {
+	selector, err := createLabelSelector(query)
+	if err != nil {
+		return nil, err
+	}
+
+	if query.Selector.IsEmpty() {
+		return []labels.Selector{selector}, nil
+	}
+
+	unindexed, err := labels.NewRequirement(LabelIndexed, selection.DoesNotExist, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	indexed, err := labels.NewRequirement(LabelIndexed, selection.Exists, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	requirements := []labels.Requirement{*indexed}
+	for _, filter := range query.Selector.Filters() {
+		requirement, err := labels.NewRequirement(indexLabelKey(filter.Field), selection.In, []string{indexLabelValue(filter.Value), LabelValueMultiple})
+		if err != nil {
+			return nil, err
+		}
+
+		requirements = append(requirements, *requirement)
+	}
+
+	return []labels.Selector{selector.Add(requirements...), selector.Add(*unindexed)}, nil
+}
+
 func createLabelSelector(query store.Query) (labels.Selector, error) {
 	id, err := resources.Parse(query.RootScope)
 	if err != nil {
@@ -154,6 +154,7 @@ func Test_APIServer_Client(t *testing.T) {
 		require.NoError(t, err)
 
 		expected := map[string]string{
+			"ucp.dev/indexed":              "true",
 			"ucp.dev/kind":                 "resource",
 			"ucp.dev/resource-type":        "system.resources_resourcetype1",
 			"ucp.dev/scope-radius":         "local",
@@ -183,6 +184,7 @@ func Test_APIServer_Client(t *testing.T) {
 		require.NoError(t, err)
 
 		expected := map[string]string{
+			"ucp.dev/indexed":              "true",
 			"ucp.dev/kind":                 "resource",
 			"ucp.dev/resource-type":        "system.resources_resourcetype2",
 			"ucp.dev/scope-radius":         "local",
@@ -212,6 +214,7 @@ func Test_APIServer_Client(t *testing.T) {
 		require.NoError(t, err)
 
 		expected := map[string]string{
+			"ucp.dev/indexed":       "true",
 			"ucp.dev/kind":          "scope",
 			"ucp.dev/resource-type": "resourcegroups",
 			"ucp.dev/scope-radius":  "local",
@@ -259,6 +262,7 @@ func Test_APIServer_Client(t *testing.T) {
 		require.NoError(t, err)
 
 		expectedLabels := map[string]string{
+			"ucp.dev/indexed":              "true",
 			"ucp.dev/kind":                 "resource",
 			"ucp.dev/resource-type":        "m_u_l_t_i_p_l_e",
 			"ucp.dev/scope-radius":         "local",
@@ -369,6 +373,7 @@ func Test_APIServer_Client(t *testing.T) {
 		require.NoError(t, err)
 
 		expectedLabels := map[string]string{
+			"ucp.dev/indexed":              "true",
 			"ucp.dev/kind":                 "resource",
 			"ucp.dev/resource-type":        "m_u_l_t_i_p_l_e",
 			"ucp.dev/scope-radius":         "local",
@@ -463,6 +468,7 @@ func Test_APIServer_Client(t *testing.T) {
 		require.NoError(t, err)
 
 		expectedLabels := map[string]string{
+			"ucp.dev/indexed":              "true",
 			"ucp.dev/kind":                 "resource",
 			"ucp.dev/resource-type":        "m_u_l_t_i_p_l_e",
 			"ucp.dev/scope-radius":         "local",
@@ -555,6 +561,7 @@ func Test_APIServer_Client(t *testing.T) {
 		require.NoError(t, err)
 
 		expectedLabels := map[string]string{
+			"ucp.dev/indexed":              "true",
 			"ucp.dev/kind":                 "resource",
 			"ucp.dev/resource-type":        "system.resources_resourcetype2",
 			"ucp.dev/scope-radius":         "local",
@@ -651,6 +658,7 @@ func Test_AssignLabels_Resource_NoConflicts(t *testing.T) {
 	}
 
 	expected := labels.Set{
+		"ucp.dev/indexed":              "true",
 		"ucp.dev/kind":                 "resource",
 		"ucp.dev/resource-type":        "applications.core_applications",
 		"ucp.dev/scope-radius":         "local",
@@ -671,6 +679,7 @@ func Test_AssignLabels_Scope_NoConflicts(t *testing.T) {
 	}
 
 	expected := labels.Set{
+		"ucp.dev/indexed":       "true",
 		"ucp.dev/kind":          "scope",
 		"ucp.dev/resource-type": "resourcegroups",
 		"ucp.dev/scope-radius":  "local",
@@ -693,6 +702,7 @@ func Test_AssignLabels_PartialConflict(t *testing.T) {
 	}
 
 	expected := labels.Set{
+		"ucp.dev/indexed":              "true",
 		"ucp.dev/kind":                 "resource",
 		"ucp.dev/resource-type":        "m_u_l_t_i_p_l_e",
 		"ucp.dev/scope-radius":         "local",
@@ -716,6 +726,7 @@ func Test_AssignLabels_AllConflict(t *testing.T) {
 	}
 
 	expected := labels.Set{
+		"ucp.dev/indexed":              "true",
 		"ucp.dev/kind":                 "resource",
 		"ucp.dev/resource-type":        "m_u_l_t_i_p_l_e",
 		"ucp.dev/scope-azure":          "azurecloud",
@@ -728,6 +739,96 @@ func Test_AssignLabels_AllConflict(t *testing.T) {
 	require.Equal(t, expected, set)
 }
 
+func Test_AssignLabels_IndexedFields(t *testing.T) {
+	applicationID := "/planes/radius/local/resourceGroups/cool-group/providers/Applications.Core/applications/cool-app"
+	resource := ucpv1alpha1.Resource{
+		Entries: []ucpv1alpha1.ResourceEntry{
+			{
+				ID:   "/planes/radius/local/resourceGroups/cool-group/providers/Applications.Core/containers/backend",
+				Data: &runtime.RawExtension{Raw: []byte(`{"properties":{"application":"` + applicationID + `","provisioningState":"Succeeded"}}`)},
+			},
+			{
+				ID:   "/planes/radius/local/resourceGroups/cool-group/providers/Applications.Core/containers/frontend",
+				Data: &runtime.RawExtension{Raw: []byte(`{"properties":{"application":"` + applicationID + `","provisioningState":"Failed"}}`)},
+			},
+		},
+	}
+
+	expected := labels.Set{
+		"ucp.dev/indexed":                 "true",
+		"ucp.dev/index-application":       indexLabelValue(applicationID),
+		"ucp.dev/index-provisioningstate": "m_u_l_t_i_p_l_e",
+		"ucp.dev/kind":                    "resource",
+		"ucp.dev/resource-type":           "applications.core_containers",
+		"ucp.dev/scope-radius":            "local",
+		"ucp.dev/scope-resourcegroups":    "cool-group",
+	}
+
+	set := assignLabels(&resource)
+	require.Equal(t, expected, set)
+}
+
+func Test_CreateLabelSelectors_FieldSelector(t *testing.T) {
+	applicationID := "/planes/radius/local/resourceGroups/cool-group/providers/Applications.Core/applications/cool-app"
+	query := store.Query{
+		RootScope:    "/planes/radius/local/resourceGroups/cool-group",
+		ResourceType: "Applications.Core/containers",
+		Selector:     store.FieldSelector{Application: applicationID},
+	}
+
+	selectors, err := createLabelSelectors(query)
+	require.NoError(t, err)
+	require.Len(t, selectors, 2)
+
+	matches := func(set labels.Set) bool {
+		for _, selector := range selectors {
+			if selector.Matches(set) {
+				return true
+			}
+		}
+		return false
+	}
+
+	resource := ucpv1alpha1.Resource{
+		Entries: []ucpv1alpha1.ResourceEntry{
+			{
+				// Different application
+				ID:   "/planes/radius/local/resourceGroups/cool-group/providers/Applications.Core/containers/backend",
+				Data: &runtime.RawExtension{Raw: []byte(`{"properties":{"application":"/planes/radius/local/resourceGroups/cool-group/providers/Applications.Core/applications/another-app"}}`)},
+			},
+		},
+	}
+	require.False(t, matches(assignLabels(&resource)))
+
+	resource = ucpv1alpha1.Resource{
+		Entries: []ucpv1alpha1.ResourceEntry{
+			{
+				// No application
+				ID:   "/planes/radius/local/resourceGroups/cool-group/providers/Applications.Core/containers/backend",
+				Data: &runtime.RawExtension{Raw: []byte(`{"properties":{}}`)},
+			},
+		},
+	}
+	require.False(t, matches(assignLabels(&resource)))
+
+	resource = ucpv1alpha1.Resource{
+		Entries: []ucpv1alpha1.ResourceEntry{
+			{
+				// Match! Index values are case-insensitive.
+				ID:   "/planes/radius/local/resourceGroups/cool-group/providers/Applications.Core/containers/backend",
+				Data: &runtime.RawExtension{Raw: []byte(`{"properties":{"application":"` + strings.ToUpper(applicationID) + `"}}`)},
+			},
+		},
+	}
+	require.True(t, matches(assignLabels(&resource)))
+
+	// Objects written before indexing was introduced are matched so they can be filtered client-side.
+	set := assignLabels(&resource)
+	delete(set, LabelIndexed)
+	delete(set, "ucp.dev/index-application")
+	require.True(t, matches(set))
+}
+
 func Test_CreateLabelSelector_UCPID(t *testing.T) {
 	query := store.Query{
 		RootScope:    "/planes/radius/local/resourceGroups/cool-group",
@@ -75,6 +75,10 @@ type Query struct {
 
 	// Filters is an query filter to filter the specific property value.
 	Filters []QueryFilter
+
+	// Selector is an optional typed selector for the well-known fields of a resource. Storage providers can
+	// use secondary indexes to evaluate the selector instead of scanning every resource in the scope.
+	Selector FieldSelector
 }
 
 // QueryFilter is the filter which filters property in resource entity.
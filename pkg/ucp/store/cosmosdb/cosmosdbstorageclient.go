@@ -213,12 +213,13 @@ func constructCosmosDBQuery(query store.Query) (*cosmosapi.Query, error) {
 		})
 	}
 
-	for i, filter := range query.Filters {
+	for i, filter := range query.AllFilters() {
 		if whereParam != "" {
 			whereParam += " and "
 		}
 		filterParam := fmt.Sprintf("filter%d", i)
-		whereParam += fmt.Sprintf("STRINGEQUALS(c.entity.%s, @%s, true)", filter.Field, filterParam)
+		// Only resource IDs are compared ignoring case, consistent with the other storage providers.
+		whereParam += fmt.Sprintf("STRINGEQUALS(c.entity.%s, @%s, %t)", filter.Field, filterParam, store.IsIDField(filter.Field))
 		queryParams = append(queryParams, cosmosapi.QueryParam{
 			Name:  "@" + filterParam,
 			Value: filter.Value,
@@ -0,0 +1,306 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package encryption implements a store.StorageClient which encrypts secret fields of objects at rest using envelope
+// encryption. Each saved object gets a random data encryption key (DEK) which encrypts the values of its secret
+// fields. The DEK is wrapped by a KeyProvider (a KMS or a local keyring) and stored with the object, so the key
+// encryption key never leaves the KeyProvider and can be rotated without re-encrypting every object.
+//
+// Secret fields are designated by dotted field paths where '*' matches any key of a map, for example
+// "properties.secrets.*". Only string values are encrypted. Encrypted fields cannot be used in query filters.
+
+package encryption
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/radius-project/radius/pkg/ucp/store"
+)
+
+const (
+	// envelopeProperty is the property of the stored data which holds the encryption envelope.
+	envelopeProperty = "$encryption"
+)
+
+// DefaultSecretFields is the list of fields which hold secrets in the resource data models: the connection secrets
+// of portable resources and the secrets of their properties.
+var DefaultSecretFields = []string{
+	"secretValues.*.Value",
+	"properties.secrets.*",
+}
+
+// Options is the options to create the encrypting storage client.
+type Options struct {
+	// Fields is the list of dotted field paths to encrypt. '*' matches any key of a map. DefaultSecretFields is used
+	// if empty.
+	Fields []string
+}
+
+var _ store.StorageClient = (*StorageClient)(nil)
+
+// StorageClient is a store.StorageClient which encrypts secret fields before persisting objects and decrypts them
+// when reading.
+type StorageClient struct {
+	inner store.StorageClient
+	keys  KeyProvider
+
+	fields [][]string
+}
+
+// NewStorageClient creates a StorageClient which stores objects in inner and encrypts the configured fields with data
+// encryption keys wrapped by keys.
+func NewStorageClient(inner store.StorageClient, keys KeyProvider, options Options) *StorageClient {
+	if len(options.Fields) == 0 {
+		options.Fields = DefaultSecretFields
+	}
+
+	fields := [][]string{}
+	for _, field := range options.Fields {
+		fields = append(fields, strings.Split(field, "."))
+	}
+
+	return &StorageClient{inner: inner, keys: keys, fields: fields}
+}
+
+// envelope describes how the secret fields of an object are encrypted.
+type envelope struct {
+	// KeyID is the id of the key encryption key which wrapped the data encryption key.
+	KeyID string `json:"keyId"`
+	// Key is the wrapped data encryption key.
+	Key []byte `json:"key"`
+	// Fields is the list of paths of the encrypted fields.
+	Fields [][]string `json:"fields"`
+}
+
+// Query queries the inner store and decrypts the secret fields of the returned objects.
+func (c *StorageClient) Query(ctx context.Context, query store.Query, options ...store.QueryOptions) (*store.ObjectQueryResult, error) {
+	result, err := c.inner.Query(ctx, query, options...)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range result.Items {
+		if err := c.decrypt(ctx, &result.Items[i]); err != nil {
+			return nil, err
+		}
+	}
+
+	return result, nil
+}
+
+// Get gets the object from the inner store and decrypts its secret fields.
+func (c *StorageClient) Get(ctx context.Context, id string, options ...store.GetOptions) (*store.Object, error) {
+	obj, err := c.inner.Get(ctx, id, options...)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := c.decrypt(ctx, obj); err != nil {
+		return nil, err
+	}
+
+	return obj, nil
+}
+
+// Delete deletes the object from the inner store.
+func (c *StorageClient) Delete(ctx context.Context, id string, options ...store.DeleteOptions) error {
+	return c.inner.Delete(ctx, id, options...)
+}
+
+// Save encrypts the secret fields of the object and saves it to the inner store. obj is not modified except for its
+// ETag, which is updated as with the inner store.
+func (c *StorageClient) Save(ctx context.Context, obj *store.Object, options ...store.SaveOptions) error {
+	if obj == nil {
+		return &store.ErrInvalid{Message: "invalid argument. 'obj' is required"}
+	}
+
+	// Work on a copy of the data, the caller still owns the plaintext object.
+	b, err := json.Marshal(obj.Data)
+	if err != nil {
+		return err
+	}
+	var data any
+	if err := json.Unmarshal(b, &data); err != nil {
+		return err
+	}
+
+	paths := [][]string{}
+	for _, field := range c.fields {
+		paths = append(paths, matchPaths(data, field, nil)...)
+	}
+
+	if len(paths) == 0 {
+		return c.inner.Save(ctx, obj, options...)
+	}
+
+	dek := make([]byte, keySize)
+	if _, err := rand.Read(dek); err != nil {
+		return err
+	}
+	aead, err := newAEAD(dek)
+	if err != nil {
+		return err
+	}
+
+	for _, path := range paths {
+		value, _ := getPath(data, path)
+		ciphertext, err := seal(aead, []byte(value.(string)), additionalData(obj.ID, path))
+		if err != nil {
+			return fmt.Errorf("failed to encrypt secret field of %q: %w", obj.ID, err)
+		}
+		setPath(data, path, base64.StdEncoding.EncodeToString(ciphertext))
+	}
+
+	keyID, wrapped, err := c.keys.WrapKey(ctx, dek)
+	if err != nil {
+		return fmt.Errorf("failed to wrap data encryption key of %q: %w", obj.ID, err)
+	}
+	data.(map[string]any)[envelopeProperty] = envelope{KeyID: keyID, Key: wrapped, Fields: paths}
+
+	stored := &store.Object{Metadata: obj.Metadata, Data: data}
+	if err := c.inner.Save(ctx, stored, options...); err != nil {
+		return err
+	}
+	obj.Metadata = stored.Metadata
+
+	return nil
+}
+
+// decrypt replaces the encrypted fields of the object with their plaintext values if the object holds an envelope.
+func (c *StorageClient) decrypt(ctx context.Context, obj *store.Object) error {
+	data, ok := obj.Data.(map[string]any)
+	if !ok {
+		return nil
+	}
+
+	raw, ok := data[envelopeProperty]
+	if !ok {
+		return nil
+	}
+
+	b, err := json.Marshal(raw)
+	if err != nil {
+		return err
+	}
+	env := envelope{}
+	if err := json.Unmarshal(b, &env); err != nil {
+		return fmt.Errorf("failed to decode encryption envelope of %q: %w", obj.ID, err)
+	}
+
+	dek, err := c.keys.UnwrapKey(ctx, env.KeyID, env.Key)
+	if err != nil {
+		return fmt.Errorf("failed to unwrap data encryption key of %q: %w", obj.ID, err)
+	}
+	aead, err := newAEAD(dek)
+	if err != nil {
+		return err
+	}
+
+	for _, path := range env.Fields {
+		value, ok := getPath(data, path)
+		if !ok {
+			return fmt.Errorf("encrypted secret field %q of %q is missing", strings.Join(path, "."), obj.ID)
+		}
+		encoded, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("encrypted secret field %q of %q is not a string", strings.Join(path, "."), obj.ID)
+		}
+
+		ciphertext, err := base64.StdEncoding.DecodeString(encoded)
+		if err != nil {
+			return fmt.Errorf("failed to decode secret field %q of %q: %w", strings.Join(path, "."), obj.ID, err)
+		}
+		plaintext, err := open(aead, ciphertext, additionalData(obj.ID, path))
+		if err != nil {
+			return fmt.Errorf("failed to decrypt secret field %q of %q: %w", strings.Join(path, "."), obj.ID, err)
+		}
+
+		setPath(data, path, string(plaintext))
+	}
+
+	delete(data, envelopeProperty)
+	return nil
+}
+
+// additionalData binds a ciphertext to the object and the field it was encrypted for, so that it cannot be moved
+// to another field or object.
+func additionalData(id string, path []string) []byte {
+	b, _ := json.Marshal(append([]string{strings.ToLower(id)}, path...))
+	return b
+}
+
+// matchPaths returns the paths of the string values of data which match the field pattern.
+func matchPaths(data any, field []string, prefix []string) [][]string {
+	if len(field) == 0 {
+		if _, ok := data.(string); ok {
+			return [][]string{append([]string{}, prefix...)}
+		}
+		return nil
+	}
+
+	m, ok := data.(map[string]any)
+	if !ok {
+		return nil
+	}
+
+	if field[0] != "*" {
+		value, ok := m[field[0]]
+		if !ok {
+			return nil
+		}
+		return matchPaths(value, field[1:], append(prefix, field[0]))
+	}
+
+	paths := [][]string{}
+	for key, value := range m {
+		paths = append(paths, matchPaths(value, field[1:], append(prefix, key))...)
+	}
+	return paths
+}
+
+// getPath returns the value at the path of data.
+func getPath(data any, path []string) (any, bool) {
+	for _, segment := range path {
+		m, ok := data.(map[string]any)
+		if !ok {
+			return nil, false
+		}
+
+		data, ok = m[segment]
+		if !ok {
+			return nil, false
+		}
+	}
+
+	return data, true
+}
+
+// setPath sets the value at the path of data. The parent of the value must exist.
+func setPath(data any, path []string, value any) {
+	parent, ok := getPath(data, path[:len(path)-1])
+	if !ok {
+		return
+	}
+
+	if m, ok := parent.(map[string]any); ok {
+		m[path[len(path)-1]] = value
+	}
+}
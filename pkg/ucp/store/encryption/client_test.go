@@ -0,0 +1,244 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package encryption
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/radius-project/radius/pkg/ucp/store"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+const testID = "/planes/radius/local/resourceGroups/test-rg/providers/Applications.Datastores/redisCaches/test"
+
+func newTestKeyring(t *testing.T) *Keyring {
+	ring, err := NewKeyring("key1", map[string][]byte{
+		"key1": make([]byte, keySize),
+	})
+	require.NoError(t, err)
+	return ring
+}
+
+// setupInner configures the mock inner store to keep the JSON representation of objects in a map, as a real
+// store would persist them.
+func setupInner(t *testing.T) (*store.MockStorageClient, map[string][]byte) {
+	mctrl := gomock.NewController(t)
+	inner := store.NewMockStorageClient(mctrl)
+	objects := map[string][]byte{}
+
+	read := func(b []byte) *store.Object {
+		obj := &store.Object{}
+		require.NoError(t, json.Unmarshal(b, obj))
+		return obj
+	}
+
+	inner.EXPECT().
+		Get(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, id string, _ ...store.GetOptions) (*store.Object, error) {
+			b, ok := objects[id]
+			if !ok {
+				return nil, &store.ErrNotFound{ID: id}
+			}
+			return read(b), nil
+		}).AnyTimes()
+	inner.EXPECT().
+		Save(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, obj *store.Object, _ ...store.SaveOptions) error {
+			obj.ETag = "etag"
+			b, err := json.Marshal(obj)
+			require.NoError(t, err)
+			objects[obj.ID] = b
+			return nil
+		}).AnyTimes()
+	inner.EXPECT().
+		Query(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, query store.Query, _ ...store.QueryOptions) (*store.ObjectQueryResult, error) {
+			result := &store.ObjectQueryResult{}
+			for _, b := range objects {
+				result.Items = append(result.Items, *read(b))
+			}
+			return result, nil
+		}).AnyTimes()
+
+	return inner, objects
+}
+
+func testData() map[string]any {
+	return map[string]any{
+		"name": "test",
+		"properties": map[string]any{
+			"host": "localhost",
+			"secrets": map[string]any{
+				"password":         "p@ssw0rd",
+				"connectionString": "redis://localhost",
+			},
+		},
+		"secretValues": map[string]any{
+			"url": map[string]any{"Value": "redis://localhost:6379"},
+		},
+	}
+}
+
+func TestNewKeyring_Invalid(t *testing.T) {
+	_, err := NewKeyring("missing", map[string][]byte{"key1": make([]byte, keySize)})
+	require.Error(t, err)
+
+	_, err = NewKeyring("key1", map[string][]byte{"key1": make([]byte, 16)})
+	require.Error(t, err)
+}
+
+func TestLoadKeyring(t *testing.T) {
+	dir := t.TempDir()
+	key := bytes.Repeat([]byte{1}, keySize)
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "key-1"), []byte(base64.StdEncoding.EncodeToString(key)+"\n"), 0600))
+
+	// Hidden files, such as the symlinks of a mounted Kubernetes secret, are ignored.
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "..data"), []byte("not a key"), 0600))
+
+	ring, err := LoadKeyring(dir, "key-1")
+	require.NoError(t, err)
+
+	keyID, wrapped, err := ring.WrapKey(context.Background(), key)
+	require.NoError(t, err)
+	require.Equal(t, "key-1", keyID)
+
+	unwrapped, err := ring.UnwrapKey(context.Background(), keyID, wrapped)
+	require.NoError(t, err)
+	require.Equal(t, key, unwrapped)
+
+	t.Run("invalid key", func(t *testing.T) {
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "key-2"), []byte("not base64!"), 0600))
+		_, err := LoadKeyring(dir, "key-1")
+		require.ErrorContains(t, err, "key \"key-2\" is not base64 encoded")
+	})
+
+	t.Run("missing primary", func(t *testing.T) {
+		_, err := LoadKeyring(t.TempDir(), "key-1")
+		require.ErrorContains(t, err, "primary key \"key-1\" is not in the keyring")
+	})
+}
+
+func TestSave_EncryptsSecretFields(t *testing.T) {
+	inner, objects := setupInner(t)
+	client := NewStorageClient(inner, newTestKeyring(t), Options{})
+
+	obj := &store.Object{Metadata: store.Metadata{ID: testID}, Data: testData()}
+	err := client.Save(context.Background(), obj)
+	require.NoError(t, err)
+	require.Equal(t, "etag", obj.ETag)
+
+	// The caller's object is not modified.
+	require.Equal(t, testData(), obj.Data)
+
+	stored := string(objects[testID])
+	require.NotContains(t, stored, "p@ssw0rd")
+	require.NotContains(t, stored, "redis://localhost")
+	require.Contains(t, stored, "localhost")
+	require.Contains(t, stored, envelopeProperty)
+
+	got, err := client.Get(context.Background(), testID)
+	require.NoError(t, err)
+	require.Equal(t, testData(), got.Data)
+
+	result, err := client.Query(context.Background(), store.Query{})
+	require.NoError(t, err)
+	require.Len(t, result.Items, 1)
+	require.Equal(t, testData(), result.Items[0].Data)
+}
+
+func TestSave_NoSecretFields(t *testing.T) {
+	inner, objects := setupInner(t)
+	client := NewStorageClient(inner, newTestKeyring(t), Options{})
+
+	data := map[string]any{"name": "test", "properties": map[string]any{"secrets": map[string]any{"count": 3.0}}}
+	obj := &store.Object{Metadata: store.Metadata{ID: testID}, Data: data}
+	err := client.Save(context.Background(), obj)
+	require.NoError(t, err)
+	require.NotContains(t, string(objects[testID]), envelopeProperty)
+
+	got, err := client.Get(context.Background(), testID)
+	require.NoError(t, err)
+	require.Equal(t, data, got.Data)
+}
+
+func TestSave_CustomFields(t *testing.T) {
+	inner, objects := setupInner(t)
+	client := NewStorageClient(inner, newTestKeyring(t), Options{Fields: []string{"properties.host"}})
+
+	obj := &store.Object{Metadata: store.Metadata{ID: testID}, Data: testData()}
+	err := client.Save(context.Background(), obj)
+	require.NoError(t, err)
+
+	stored := string(objects[testID])
+	require.NotContains(t, stored, `"host":"localhost"`)
+	require.Contains(t, stored, "p@ssw0rd")
+
+	got, err := client.Get(context.Background(), testID)
+	require.NoError(t, err)
+	require.Equal(t, testData(), got.Data)
+}
+
+func TestGet_KeyRotation(t *testing.T) {
+	inner, _ := setupInner(t)
+	oldKey := make([]byte, keySize)
+	newKey := make([]byte, keySize)
+	newKey[0] = 1
+
+	ring, err := NewKeyring("key1", map[string][]byte{"key1": oldKey})
+	require.NoError(t, err)
+	err = NewStorageClient(inner, ring, Options{}).Save(context.Background(), &store.Object{Metadata: store.Metadata{ID: testID}, Data: testData()})
+	require.NoError(t, err)
+
+	// Objects wrapped with a previous key can still be read after rotation.
+	rotated, err := NewKeyring("key2", map[string][]byte{"key1": oldKey, "key2": newKey})
+	require.NoError(t, err)
+	got, err := NewStorageClient(inner, rotated, Options{}).Get(context.Background(), testID)
+	require.NoError(t, err)
+	require.Equal(t, testData(), got.Data)
+
+	// Objects cannot be read without the key.
+	removed, err := NewKeyring("key2", map[string][]byte{"key2": newKey})
+	require.NoError(t, err)
+	_, err = NewStorageClient(inner, removed, Options{}).Get(context.Background(), testID)
+	require.Error(t, err)
+}
+
+func TestGet_TamperedCiphertext(t *testing.T) {
+	inner, objects := setupInner(t)
+	client := NewStorageClient(inner, newTestKeyring(t), Options{Fields: []string{"properties.secrets.password"}})
+
+	err := client.Save(context.Background(), &store.Object{Metadata: store.Metadata{ID: testID}, Data: testData()})
+	require.NoError(t, err)
+
+	// Moving the stored object to another id must not allow it to be decrypted.
+	copied := store.Object{}
+	require.NoError(t, json.Unmarshal(objects[testID], &copied))
+	copied.ID = testID + "-copy"
+	b, err := json.Marshal(copied)
+	require.NoError(t, err)
+	objects[copied.ID] = b
+
+	_, err = client.Get(context.Background(), testID+"-copy")
+	require.Error(t, err)
+}
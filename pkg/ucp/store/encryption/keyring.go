@@ -0,0 +1,155 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package encryption
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// KeyProvider wraps and unwraps the data encryption keys of stored objects with a key encryption key. It is
+// implemented by a KMS or a local keyring.
+type KeyProvider interface {
+	// WrapKey encrypts the data encryption key with the current key encryption key. It returns the id of the key
+	// encryption key that was used and the wrapped key.
+	WrapKey(ctx context.Context, dek []byte) (string, []byte, error)
+	// UnwrapKey decrypts a data encryption key that was wrapped with the key encryption key with the given id.
+	UnwrapKey(ctx context.Context, keyID string, wrapped []byte) ([]byte, error)
+}
+
+var _ KeyProvider = (*Keyring)(nil)
+
+// Keyring is a KeyProvider which holds the key encryption keys in memory. Keys can be rotated by adding a new
+// primary key while keeping the previous keys, so that existing objects can still be decrypted.
+type Keyring struct {
+	primary string
+	keys    map[string]cipher.AEAD
+}
+
+// NewKeyring creates a Keyring from AES-256 keys keyed by key id. New data encryption keys are wrapped with the
+// key with the primary id.
+func NewKeyring(primary string, keys map[string][]byte) (*Keyring, error) {
+	if _, ok := keys[primary]; !ok {
+		return nil, fmt.Errorf("primary key %q is not in the keyring", primary)
+	}
+
+	ring := &Keyring{primary: primary, keys: map[string]cipher.AEAD{}}
+	for id, key := range keys {
+		if len(key) != keySize {
+			return nil, fmt.Errorf("key %q must be %d bytes", id, keySize)
+		}
+
+		aead, err := newAEAD(key)
+		if err != nil {
+			return nil, err
+		}
+		ring.keys[id] = aead
+	}
+
+	return ring, nil
+}
+
+// LoadKeyring creates a Keyring from the keys stored in the given directory. Each file holds one base64 encoded
+// AES-256 key and is named by its key id, which matches the layout of a mounted Kubernetes secret. Hidden files are
+// ignored. New data encryption keys are wrapped with the key with the primary id.
+func LoadKeyring(dir string, primary string) (*Keyring, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read keyring directory: %w", err)
+	}
+
+	keys := map[string][]byte{}
+	for _, entry := range entries {
+		if entry.IsDir() || strings.HasPrefix(entry.Name(), ".") {
+			continue
+		}
+
+		b, err := os.ReadFile(filepath.Join(dir, entry.Name()))
+		if err != nil {
+			return nil, fmt.Errorf("failed to read key %q: %w", entry.Name(), err)
+		}
+
+		key, err := base64.StdEncoding.DecodeString(strings.TrimSpace(string(b)))
+		if err != nil {
+			return nil, fmt.Errorf("key %q is not base64 encoded: %w", entry.Name(), err)
+		}
+		keys[entry.Name()] = key
+	}
+
+	return NewKeyring(primary, keys)
+}
+
+// WrapKey encrypts the data encryption key with the primary key.
+func (k *Keyring) WrapKey(ctx context.Context, dek []byte) (string, []byte, error) {
+	wrapped, err := seal(k.keys[k.primary], dek, []byte(k.primary))
+	if err != nil {
+		return "", nil, err
+	}
+
+	return k.primary, wrapped, nil
+}
+
+// UnwrapKey decrypts a data encryption key with the key with the given id.
+func (k *Keyring) UnwrapKey(ctx context.Context, keyID string, wrapped []byte) ([]byte, error) {
+	aead, ok := k.keys[keyID]
+	if !ok {
+		return nil, fmt.Errorf("key %q is not in the keyring", keyID)
+	}
+
+	return open(aead, wrapped, []byte(keyID))
+}
+
+// keySize is the size in bytes of AES-256 keys.
+const keySize = 32
+
+// newAEAD creates an AES-GCM cipher with the given key.
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return cipher.NewGCM(block)
+}
+
+// seal encrypts plaintext with a random nonce and returns the nonce followed by the ciphertext.
+func seal(aead cipher.AEAD, plaintext []byte, additionalData []byte) ([]byte, error) {
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+
+	return aead.Seal(nonce, nonce, plaintext, additionalData), nil
+}
+
+// open decrypts data produced by seal.
+func open(aead cipher.AEAD, data []byte, additionalData []byte) ([]byte, error) {
+	if len(data) < aead.NonceSize() {
+		return nil, errors.New("ciphertext is too short")
+	}
+
+	nonce, ciphertext := data[:aead.NonceSize()], data[aead.NonceSize():]
+	return aead.Open(nil, nonce, ciphertext, additionalData)
+}
@@ -0,0 +1,358 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package etcdstore
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/radius-project/radius/pkg/ucp/store"
+	"github.com/radius-project/radius/pkg/ucp/store/storeutil"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+	"github.com/radius-project/radius/pkg/ucp/util/etag"
+	etcdclient "go.etcd.io/etcd/client/v3"
+)
+
+const (
+	// defaultCacheSyncTimeout is the default time a read waits for the cache to catch up with a write.
+	defaultCacheSyncTimeout = time.Second
+
+	// cacheRetryInterval is the interval between attempts to reload a prefix after its watch failed.
+	cacheRetryInterval = time.Second
+)
+
+// CacheOptions represents the options of CachedClient.
+type CacheOptions struct {
+	// Prefixes configures the etcd key prefixes which are cached. Both scopes and resources are cached if empty.
+	Prefixes []string
+
+	// SyncTimeout configures the time a read waits for the cache to reflect the writes made through the client before
+	// it is served by etcd. A default is used if zero.
+	SyncTimeout time.Duration
+}
+
+var _ store.StorageClient = (*CachedClient)(nil)
+
+// CachedClient is a store.StorageClient which serves reads from an in-memory copy of etcd. The copy of each cached
+// prefix is loaded when the client is created and kept up to date with a watch on the prefix. Writes go to etcd.
+//
+// The revision of each write is used as a consistency token: a read waits until the cache of the prefix has caught
+// up with the writes made through the client, so that callers always read their own writes. Reads which can not be
+// served consistently, because the prefix is not cached, is being reloaded, or does not catch up in time, are served
+// by etcd.
+type CachedClient struct {
+	inner       *ETCDClient
+	syncTimeout time.Duration
+	cancel      context.CancelFunc
+
+	mu       sync.Mutex
+	prefixes []*cachedPrefix
+	// changed is closed and replaced whenever the cache of a prefix advances.
+	changed chan struct{}
+}
+
+// cachedPrefix is the copy of the keys of a prefix. It is guarded by the mutex of the CachedClient.
+type cachedPrefix struct {
+	prefix  string
+	entries map[string]cacheEntry
+	synced  bool
+
+	// revision is the etcd revision reflected by entries.
+	revision int64
+
+	// written is the revision of the latest write to the prefix made through the client.
+	written int64
+}
+
+type cacheEntry struct {
+	value       []byte
+	modRevision int64
+}
+
+// NewCachedClient creates a CachedClient which caches the configured prefixes of inner. The cache is maintained until
+// Close is called or ctx is canceled.
+func NewCachedClient(ctx context.Context, inner *ETCDClient, options CacheOptions) *CachedClient {
+	prefixes := options.Prefixes
+	if len(prefixes) == 0 {
+		prefixes = []string{storeutil.ScopePrefix + SectionSeparator, storeutil.ResourcePrefix + SectionSeparator}
+	}
+
+	syncTimeout := options.SyncTimeout
+	if syncTimeout == 0 {
+		syncTimeout = defaultCacheSyncTimeout
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	c := &CachedClient{
+		inner:       inner,
+		syncTimeout: syncTimeout,
+		cancel:      cancel,
+		changed:     make(chan struct{}),
+	}
+
+	for _, prefix := range prefixes {
+		p := &cachedPrefix{prefix: prefix}
+		c.prefixes = append(c.prefixes, p)
+		go c.maintain(ctx, p)
+	}
+
+	return c
+}
+
+// Close stops maintaining the cache. Reads are served by etcd afterwards.
+func (c *CachedClient) Close() {
+	c.cancel()
+}
+
+// Query retrieves objects from the cache that match the given query and filters.
+func (c *CachedClient) Query(ctx context.Context, query store.Query, options ...store.QueryOptions) (*store.ObjectQueryResult, error) {
+	if ctx == nil {
+		return nil, &store.ErrInvalid{Message: "invalid argument. 'ctx' is required"}
+	}
+	if err := validateQuery(query); err != nil {
+		return nil, err
+	}
+
+	key := keyFromQuery(query)
+	p := c.waitForPrefix(ctx, key)
+	if p == nil {
+		return c.inner.Query(ctx, query, options...)
+	}
+
+	keys := []string{}
+	entries := map[string]cacheEntry{}
+	for k, entry := range p.entries {
+		if strings.HasPrefix(k, key) {
+			keys = append(keys, k)
+			entries[k] = entry
+		}
+	}
+	c.mu.Unlock()
+
+	// etcd returns keys in order, and so do we.
+	sort.Strings(keys)
+
+	results := store.ObjectQueryResult{}
+	for _, k := range keys {
+		value, err := objectMatchingQuery([]byte(k), entries[k].value, entries[k].modRevision, query)
+		if err != nil {
+			return nil, err
+		} else if value != nil {
+			results.Items = append(results.Items, *value)
+		}
+	}
+
+	return &results, nil
+}
+
+// Get retrieves the object with the given id from the cache.
+func (c *CachedClient) Get(ctx context.Context, id string, options ...store.GetOptions) (*store.Object, error) {
+	if ctx == nil {
+		return nil, &store.ErrInvalid{Message: "invalid argument. 'ctx' is required"}
+	}
+	parsed, err := parseObjectID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	key := keyFromID(parsed)
+	p := c.waitForPrefix(ctx, key)
+	if p == nil {
+		return c.inner.Get(ctx, id, options...)
+	}
+
+	entry, ok := p.entries[key]
+	c.mu.Unlock()
+
+	if !ok {
+		return nil, &store.ErrNotFound{ID: id}
+	}
+
+	return decodeObject(entry.value, entry.modRevision)
+}
+
+// Delete deletes the object from etcd.
+func (c *CachedClient) Delete(ctx context.Context, id string, options ...store.DeleteOptions) error {
+	revision, err := c.inner.delete(ctx, id, options...)
+	if err != nil {
+		return err
+	}
+
+	// The id is valid since the deletion succeeded.
+	parsed, _ := parseObjectID(id)
+	c.recordWrite(keyFromID(parsed), revision)
+	return nil
+}
+
+// Save saves the object to etcd.
+func (c *CachedClient) Save(ctx context.Context, obj *store.Object, options ...store.SaveOptions) error {
+	if err := c.inner.Save(ctx, obj, options...); err != nil {
+		return err
+	}
+
+	// The id and ETag are valid since the save succeeded.
+	parsed, _ := resources.Parse(obj.ID)
+	revision, _ := etag.ParseRevision(obj.ETag)
+	c.recordWrite(keyFromID(parsed), revision)
+	return nil
+}
+
+// Client returns the etcdclient.Client instance of the inner ETCDClient.
+func (c *CachedClient) Client() *etcdclient.Client {
+	return c.inner.Client()
+}
+
+// recordWrite records the revision of a write to the key so that later reads wait for the cache to reflect it.
+func (c *CachedClient) recordWrite(key string, revision int64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if p := c.prefixOf(key); p != nil && revision > p.written {
+		p.written = revision
+	}
+}
+
+// prefixOf returns the cached prefix of the key, or nil if the key is not cached. The caller must hold the mutex.
+func (c *CachedClient) prefixOf(key string) *cachedPrefix {
+	for _, p := range c.prefixes {
+		if strings.HasPrefix(key, p.prefix) {
+			return p
+		}
+	}
+	return nil
+}
+
+// waitForPrefix waits until the cache of the prefix of the key reflects the writes made through the client. It
+// returns the prefix with the mutex held, or nil if the read must be served by etcd.
+func (c *CachedClient) waitForPrefix(ctx context.Context, key string) *cachedPrefix {
+	timer := time.NewTimer(c.syncTimeout)
+	defer timer.Stop()
+
+	for {
+		c.mu.Lock()
+		p := c.prefixOf(key)
+		if p == nil {
+			c.mu.Unlock()
+			return nil
+		}
+		if p.synced && p.revision >= p.written {
+			return p
+		}
+		changed := c.changed
+		c.mu.Unlock()
+
+		select {
+		case <-changed:
+		case <-timer.C:
+			return nil
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
+
+// notify wakes up the reads waiting for the cache. The caller must hold the mutex.
+func (c *CachedClient) notify() {
+	close(c.changed)
+	c.changed = make(chan struct{})
+}
+
+// maintain loads the prefix and applies the changes from its watch until ctx is canceled. The prefix is reloaded
+// whenever the watch fails, eg: when etcd compacted the revisions it had yet to deliver.
+func (c *CachedClient) maintain(ctx context.Context, p *cachedPrefix) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	for ctx.Err() == nil {
+		revision, err := c.load(ctx, p)
+		if err == nil {
+			err = c.watch(ctx, p, revision)
+		}
+
+		c.mu.Lock()
+		p.synced = false
+		p.entries = nil
+		c.mu.Unlock()
+
+		if ctx.Err() != nil {
+			return
+		}
+
+		logger.Info("Reloading the etcd cache.", "prefix", p.prefix, "error", err)
+		select {
+		case <-time.After(cacheRetryInterval):
+		case <-ctx.Done():
+		}
+	}
+}
+
+// load reads all keys of the prefix into the cache and returns the revision of the copy.
+func (c *CachedClient) load(ctx context.Context, p *cachedPrefix) (int64, error) {
+	response, err := c.inner.client.Get(ctx, p.prefix, etcdclient.WithPrefix())
+	if err != nil {
+		return 0, err
+	}
+
+	entries := map[string]cacheEntry{}
+	for _, kv := range response.Kvs {
+		entries[string(kv.Key)] = cacheEntry{value: kv.Value, modRevision: kv.ModRevision}
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	p.entries = entries
+	p.revision = response.Header.Revision
+	p.synced = true
+	c.notify()
+
+	return response.Header.Revision, nil
+}
+
+// watch applies the changes to the prefix after the revision until the watch fails.
+func (c *CachedClient) watch(ctx context.Context, p *cachedPrefix, revision int64) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	watch := c.inner.client.Watch(ctx, p.prefix, etcdclient.WithPrefix(), etcdclient.WithRev(revision+1))
+	for response := range watch {
+		if err := response.Err(); err != nil {
+			return err
+		}
+
+		c.mu.Lock()
+		for _, event := range response.Events {
+			switch event.Type {
+			case etcdclient.EventTypePut:
+				p.entries[string(event.Kv.Key)] = cacheEntry{value: event.Kv.Value, modRevision: event.Kv.ModRevision}
+			case etcdclient.EventTypeDelete:
+				delete(p.entries, string(event.Kv.Key))
+			}
+
+			if event.Kv.ModRevision > p.revision {
+				p.revision = event.Kv.ModRevision
+			}
+		}
+		c.notify()
+		c.mu.Unlock()
+	}
+
+	return ctx.Err()
+}
@@ -0,0 +1,88 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package etcdstore
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/radius-project/radius/pkg/ucp/data"
+	"github.com/radius-project/radius/pkg/ucp/hosting"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/radius-project/radius/pkg/ucp/store"
+	"github.com/stretchr/testify/require"
+	etcdclient "go.etcd.io/etcd/client/v3"
+
+	"github.com/radius-project/radius/test/testcontext"
+	shared "github.com/radius-project/radius/test/ucp/storetest"
+)
+
+func Test_CachedClient(t *testing.T) {
+	ctx, cancel := testcontext.NewWithCancel(t)
+	t.Cleanup(cancel)
+
+	config := hosting.NewAsyncValue[etcdclient.Client]()
+	service := data.NewEmbeddedETCDService(data.EmbeddedETCDServiceOptions{ClientConfigSink: config})
+
+	go func() {
+		// See Test_ETCDClient for why the test context is not used.
+		ctx := context.Background()
+		_ = service.Run(ctx)
+	}()
+
+	etcdc, err := config.Get(ctx)
+	require.NoError(t, err)
+
+	client := NewCachedClient(ctx, NewETCDClient(etcdc), CacheOptions{})
+	t.Cleanup(client.Close)
+
+	clear := func(t *testing.T) {
+		keys, err := etcdc.Get(ctx, "", etcdclient.WithKeysOnly(), etcdclient.WithPrefix())
+		require.NoError(t, err)
+
+		// Delete through the cache so that the following reads wait for the deletions.
+		for _, kv := range keys.Kvs {
+			id, err := idFromKey(kv.Key)
+			require.NoError(t, err)
+			err = client.Delete(ctx, id.String())
+			require.NoError(t, err)
+		}
+	}
+
+	// The actual test logic lives in a shared package, we're just doing the setup here.
+	shared.RunTest(t, client, clear)
+
+	t.Run("write outside of the client", func(t *testing.T) {
+		clear(t)
+
+		id := resources.MustParse("/planes/radius/local/resourceGroups/cool-group")
+		err := NewETCDClient(etcdc).Save(ctx, &store.Object{Metadata: store.Metadata{ID: id.String()}, Data: map[string]any{}})
+		require.NoError(t, err)
+
+		require.Eventually(t, func() bool {
+			client.mu.Lock()
+			defer client.mu.Unlock()
+			_, ok := client.prefixOf(keyFromID(id)).entries[keyFromID(id)]
+			return ok
+		}, 10*time.Second, 10*time.Millisecond)
+
+		obj, err := client.Get(ctx, id.String())
+		require.NoError(t, err)
+		require.Equal(t, id.String(), obj.ID)
+	})
+}
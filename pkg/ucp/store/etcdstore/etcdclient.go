@@ -78,11 +78,8 @@ func (c *ETCDClient) Query(ctx context.Context, query store.Query, options ...st
 	if ctx == nil {
 		return nil, &store.ErrInvalid{Message: "invalid argument. 'ctx' is required"}
 	}
-	if query.RootScope == "" {
-		return nil, &store.ErrInvalid{Message: "invalid argument. 'query.RootScope' is required"}
-	}
-	if query.IsScopeQuery && query.RoutingScopePrefix != "" {
-		return nil, &store.ErrInvalid{Message: "invalid argument. 'query.RoutingScopePrefix' is not supported for scope queries"}
+	if err := validateQuery(query); err != nil {
+		return nil, err
 	}
 
 	key := keyFromQuery(query)
@@ -98,43 +95,59 @@ func (c *ETCDClient) Query(ctx context.Context, query store.Query, options ...st
 
 	results := store.ObjectQueryResult{}
 	for _, kv := range response.Kvs {
-		if keyMatchesQuery(kv.Key, query) {
-			value := store.Object{}
-			err = json.Unmarshal(kv.Value, &value)
-			if err != nil {
-				return nil, err
-			}
-
-			match, err := value.MatchesFilters(query.Filters)
-			if err != nil {
-				return nil, err
-			} else if !match {
-				continue
-			}
-
-			value.ETag = etag.NewFromRevision(kv.ModRevision)
-			results.Items = append(results.Items, value)
+		value, err := objectMatchingQuery(kv.Key, kv.Value, kv.ModRevision, query)
+		if err != nil {
+			return nil, err
+		} else if value != nil {
+			results.Items = append(results.Items, *value)
 		}
 	}
 
 	return &results, nil
 }
 
+func validateQuery(query store.Query) error {
+	if query.RootScope == "" {
+		return &store.ErrInvalid{Message: "invalid argument. 'query.RootScope' is required"}
+	}
+	if query.IsScopeQuery && query.RoutingScopePrefix != "" {
+		return &store.ErrInvalid{Message: "invalid argument. 'query.RoutingScopePrefix' is not supported for scope queries"}
+	}
+	return nil
+}
+
+// objectMatchingQuery decodes the object stored at the key, or returns nil if the object does not match the query.
+func objectMatchingQuery(key []byte, data []byte, modRevision int64, query store.Query) (*store.Object, error) {
+	if !keyMatchesQuery(key, query) {
+		return nil, nil
+	}
+
+	value := store.Object{}
+	err := json.Unmarshal(data, &value)
+	if err != nil {
+		return nil, err
+	}
+
+	match, err := value.MatchesFilters(query.AllFilters())
+	if err != nil {
+		return nil, err
+	} else if !match {
+		return nil, nil
+	}
+
+	value.ETag = etag.NewFromRevision(modRevision)
+	return &value, nil
+}
+
 // Get checks if the provided context, id and options are valid, then retrieves the corresponding object from
 // the store and returns it, or an error if the object is not found or an error occurs.
 func (c *ETCDClient) Get(ctx context.Context, id string, options ...store.GetOptions) (*store.Object, error) {
 	if ctx == nil {
 		return nil, &store.ErrInvalid{Message: "invalid argument. 'ctx' is required"}
 	}
-	parsed, err := resources.Parse(id)
+	parsed, err := parseObjectID(id)
 	if err != nil {
-		return nil, &store.ErrInvalid{Message: "invalid argument. 'id' must be a valid resource id"}
-	}
-	if parsed.IsEmpty() {
-		return nil, &store.ErrInvalid{Message: "invalid argument. 'id' must not be empty"}
-	}
-	if parsed.IsResourceCollection() || parsed.IsScopeCollection() {
-		return nil, &store.ErrInvalid{Message: "invalid argument. 'id' must refer to a named resource, not a collection"}
+		return nil, err
 	}
 
 	key := keyFromID(parsed)
@@ -147,32 +160,50 @@ func (c *ETCDClient) Get(ctx context.Context, id string, options ...store.GetOpt
 		return nil, &store.ErrNotFound{ID: id}
 	}
 
+	return decodeObject(response.Kvs[0].Value, response.Kvs[0].ModRevision)
+}
+
+// parseObjectID parses the id of a named resource or scope.
+func parseObjectID(id string) (resources.ID, error) {
+	parsed, err := resources.Parse(id)
+	if err != nil {
+		return resources.ID{}, &store.ErrInvalid{Message: "invalid argument. 'id' must be a valid resource id"}
+	}
+	if parsed.IsEmpty() {
+		return resources.ID{}, &store.ErrInvalid{Message: "invalid argument. 'id' must not be empty"}
+	}
+	if parsed.IsResourceCollection() || parsed.IsScopeCollection() {
+		return resources.ID{}, &store.ErrInvalid{Message: "invalid argument. 'id' must refer to a named resource, not a collection"}
+	}
+	return parsed, nil
+}
+
+func decodeObject(data []byte, modRevision int64) (*store.Object, error) {
 	value := store.Object{}
-	err = json.Unmarshal(response.Kvs[0].Value, &value)
+	err := json.Unmarshal(data, &value)
 	if err != nil {
 		return nil, err
 	}
 
-	value.ETag = etag.NewFromRevision(response.Kvs[0].ModRevision)
-
+	value.ETag = etag.NewFromRevision(modRevision)
 	return &value, nil
 }
 
 // Delete checks if the given resource ID is valid, and if so, deletes it from the store, returning an error if the
 // resource does not exist or if an ETag is provided and does not match.
 func (c *ETCDClient) Delete(ctx context.Context, id string, options ...store.DeleteOptions) error {
+	_, err := c.delete(ctx, id, options...)
+	return err
+}
+
+// delete deletes the object and returns the revision of the deletion.
+func (c *ETCDClient) delete(ctx context.Context, id string, options ...store.DeleteOptions) (int64, error) {
 	if ctx == nil {
-		return &store.ErrInvalid{Message: "invalid argument. 'ctx' is required"}
+		return 0, &store.ErrInvalid{Message: "invalid argument. 'ctx' is required"}
 	}
-	parsed, err := resources.Parse(id)
+	parsed, err := parseObjectID(id)
 	if err != nil {
-		return &store.ErrInvalid{Message: "invalid argument. 'id' must be a valid resource id"}
-	}
-	if parsed.IsEmpty() {
-		return &store.ErrInvalid{Message: "invalid argument. 'id' must not be empty"}
-	}
-	if parsed.IsResourceCollection() || parsed.IsScopeCollection() {
-		return &store.ErrInvalid{Message: "invalid argument. 'id' must refer to a named resource, not a collection"}
+		return 0, err
 	}
 
 	key := keyFromID(parsed)
@@ -183,7 +214,7 @@ func (c *ETCDClient) Delete(ctx context.Context, id string, options ...store.Del
 		revision, err := etag.ParseRevision(config.ETag)
 		if err != nil {
 			// Treat an invalid ETag as a concurrency failure, since it will never match.
-			return &store.ErrConcurrency{}
+			return 0, &store.ErrConcurrency{}
 		}
 
 		txn, err := c.client.Txn(ctx).
@@ -191,32 +222,32 @@ func (c *ETCDClient) Delete(ctx context.Context, id string, options ...store.Del
 			Then(etcdclient.OpDelete(key)).
 			Commit()
 		if err != nil {
-			return err
+			return 0, err
 		}
 
 		if !txn.Succeeded {
-			return &store.ErrConcurrency{}
+			return 0, &store.ErrConcurrency{}
 		}
 
 		response := txn.Responses[0].GetResponseDeleteRange()
 		if response.Deleted == 0 {
-			return &store.ErrNotFound{ID: id}
+			return 0, &store.ErrNotFound{ID: id}
 		} else {
-			return nil
+			return txn.Header.Revision, nil
 		}
 	}
 
 	// If we don't have an ETag then things are straightforward :)
 	response, err := c.client.Delete(ctx, key)
 	if err != nil {
-		return err
+		return 0, err
 	}
 
 	if response.Deleted == 0 {
-		return &store.ErrNotFound{ID: id}
+		return 0, &store.ErrNotFound{ID: id}
 	}
 
-	return nil
+	return response.Header.Revision, nil
 }
 
 // Save checks the context and object parameters, parses the object ID, marshals the object into JSON, saves the object to
@@ -28,44 +28,19 @@ func (o Object) MatchesFilters(filters []QueryFilter) (bool, error) {
 		return true, nil
 	}
 
-	data := o.Data
-	if data == nil {
-		// Treat nil as "empty" data
-		data = map[string]any{}
-	} else if reflect.TypeOf(o.Data).Kind() != reflect.Map ||
-		reflect.TypeOf(o.Data).Key().Kind() != reflect.String {
-		// It's most likely for our use case that the data is a map[string]interface{}. However, if it's not
-		// then we need to convert This is basically just here for safety and completeness.
-		data = map[string]any{}
-		err := o.As(&data)
-		if err != nil {
-			return false, err
-		}
+	data, err := o.dataAsMap()
+	if err != nil {
+		return false, err
 	}
 
 	for _, filter := range filters {
-		value := reflect.ValueOf(data)
-		fields := strings.Split(filter.Field, ".")
-		for i, field := range fields {
-			value = value.MapIndex(reflect.ValueOf(field))
-			if i < len(fields)-1 {
-				// Need to go further into the nested fields
-				value = reflect.ValueOf(value.Interface())
-			}
-		}
-		comparator := reflect.ValueOf(filter.Value)
-
-		if value.Type().Kind() == reflect.Interface {
-			// Unwrap interface{}
-			value = reflect.ValueOf(value.Interface())
-		}
-
-		if value.Type().Kind() != reflect.String {
-			// not a string, can't compare!
+		value, ok := lookupField(data, filter.Field)
+		if !ok || value.Kind() != reflect.String {
+			// missing or not a string, can't compare!
 			return false, nil
 		}
 
-		if value.String() != comparator.String() {
+		if !filterValueEqual(filter.Field, value.String(), filter.Value) {
 			// not the same value!
 			return false, nil
 		}
@@ -73,3 +48,34 @@ func (o Object) MatchesFilters(filters []QueryFilter) (bool, error) {
 
 	return true, nil
 }
+
+// filterValueEqual compares the value of the field with the value of the filter. Resource IDs are case-insensitive,
+// so the values of ID fields are compared ignoring case.
+func filterValueEqual(field string, value string, expected string) bool {
+	if IsIDField(field) {
+		return strings.EqualFold(value, expected)
+	}
+
+	return value == expected
+}
+
+// dataAsMap returns the object's data as a map keyed by string, converting it if necessary.
+func (o Object) dataAsMap() (any, error) {
+	if o.Data == nil {
+		// Treat nil as "empty" data
+		return map[string]any{}, nil
+	} else if reflect.TypeOf(o.Data).Kind() != reflect.Map ||
+		reflect.TypeOf(o.Data).Key().Kind() != reflect.String {
+		// It's most likely for our use case that the data is a map[string]interface{}. However, if it's not
+		// then we need to convert This is basically just here for safety and completeness.
+		data := map[string]any{}
+		err := o.As(&data)
+		if err != nil {
+			return nil, err
+		}
+
+		return data, nil
+	}
+
+	return o.Data, nil
+}
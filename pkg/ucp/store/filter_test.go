@@ -57,6 +57,20 @@ func Test_MatchesFilters(t *testing.T) {
 			ExpectedMatch: false,
 		},
 
+		// Resource IDs are case-insensitive
+		{
+			Description:   "id_field_match_different_case",
+			Obj:           &Object{Data: map[string]any{"properties": map[string]any{"application": "/planes/radius/local/resourceGroups/rg/providers/Applications.Core/applications/app"}}},
+			Filters:       []QueryFilter{{Field: FieldApplication, Value: "/planes/radius/local/resourcegroups/RG/providers/applications.core/applications/APP"}},
+			ExpectedMatch: true,
+		},
+		{
+			Description:   "non_id_field_not_match_different_case",
+			Obj:           &Object{Data: map[string]any{"properties": map[string]any{"provisioningState": "Succeeded"}}},
+			Filters:       []QueryFilter{{Field: FieldProvisioningState, Value: "succeeded"}},
+			ExpectedMatch: false,
+		},
+
 		// We can work with maps of different types
 		{
 			Description:   "map_string_interface_match",
@@ -113,6 +127,18 @@ func Test_MatchesFilters(t *testing.T) {
 			Filters:       []QueryFilter{{Field: "properties.value", Value: "warm"}},
 			ExpectedMatch: false,
 		},
+		{
+			Description:   "missing_field",
+			Obj:           &Object{Data: map[string]any{"value": "cool"}},
+			Filters:       []QueryFilter{{Field: "properties.value", Value: "cool"}},
+			ExpectedMatch: false,
+		},
+		{
+			Description:   "nil_data",
+			Obj:           &Object{},
+			Filters:       []QueryFilter{{Field: "value", Value: "cool"}},
+			ExpectedMatch: false,
+		},
 	}
 
 	for _, testcase := range cases {
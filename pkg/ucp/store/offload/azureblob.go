@@ -0,0 +1,153 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package offload
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/runtime"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/streaming"
+)
+
+const (
+	azureBlobModuleName    = "github.com/radius-project/radius/pkg/ucp/store/offload"
+	azureBlobModuleVersion = "v0.0.1"
+
+	// azureBlobAPIVersion is the version of the Blob service REST API.
+	azureBlobAPIVersion = "2023-11-03"
+
+	// azureStorageScope is the OAuth scope of Azure Storage.
+	azureStorageScope = "https://storage.azure.com/.default"
+)
+
+var _ BlobStore = (*AzureBlobStore)(nil)
+
+// AzureBlobStore is a BlobStore which stores blobs as block blobs in an Azure Blob Storage container.
+type AzureBlobStore struct {
+	container *url.URL
+	pipeline  runtime.Pipeline
+}
+
+// NewAzureBlobStore creates an AzureBlobStore for the container with the given URL, eg:
+// https://<account>.blob.core.windows.net/<container>. Requests are authorized with the credential, or with the
+// SAS token in the query string of the container URL if the credential is nil.
+func NewAzureBlobStore(containerURL string, credential azcore.TokenCredential, options *policy.ClientOptions) (*AzureBlobStore, error) {
+	container, err := url.Parse(containerURL)
+	if err != nil || container.Scheme == "" || container.Host == "" {
+		return nil, fmt.Errorf("invalid Azure Blob Storage container URL %q", containerURL)
+	}
+
+	pipelineOptions := runtime.PipelineOptions{}
+	if credential != nil {
+		pipelineOptions.PerRetry = append(pipelineOptions.PerRetry, runtime.NewBearerTokenPolicy(credential, []string{azureStorageScope}, nil))
+	}
+
+	return &AzureBlobStore{
+		container: container,
+		pipeline:  runtime.NewPipeline(azureBlobModuleName, azureBlobModuleVersion, pipelineOptions, options),
+	}, nil
+}
+
+// blobURL returns the URL of the blob with the given key. The query string of the container URL is preserved.
+func (s *AzureBlobStore) blobURL(key string) string {
+	u := *s.container
+	u.Path = strings.TrimSuffix(u.Path, "/") + "/" + key
+	u.RawPath = ""
+	return u.String()
+}
+
+func (s *AzureBlobStore) newRequest(ctx context.Context, method string, key string) (*policy.Request, error) {
+	req, err := runtime.NewRequest(ctx, method, s.blobURL(key))
+	if err != nil {
+		return nil, err
+	}
+
+	req.Raw().Header.Set("x-ms-version", azureBlobAPIVersion)
+	return req, nil
+}
+
+// Put writes the blob with the given key as a block blob.
+func (s *AzureBlobStore) Put(ctx context.Context, key string, data []byte) error {
+	req, err := s.newRequest(ctx, http.MethodPut, key)
+	if err != nil {
+		return err
+	}
+
+	req.Raw().Header.Set("x-ms-blob-type", "BlockBlob")
+	if err := req.SetBody(streaming.NopCloser(bytes.NewReader(data)), "application/json"); err != nil {
+		return err
+	}
+
+	resp, err := s.pipeline.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if !runtime.HasStatusCode(resp, http.StatusCreated) {
+		return runtime.NewResponseError(resp)
+	}
+
+	return nil
+}
+
+// Get reads the blob with the given key.
+func (s *AzureBlobStore) Get(ctx context.Context, key string) ([]byte, error) {
+	req, err := s.newRequest(ctx, http.MethodGet, key)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.pipeline.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if !runtime.HasStatusCode(resp, http.StatusOK) {
+		return nil, runtime.NewResponseError(resp)
+	}
+
+	return runtime.Payload(resp)
+}
+
+// Delete deletes the blob with the given key. Deleting a blob which does not exist is not an error.
+func (s *AzureBlobStore) Delete(ctx context.Context, key string) error {
+	req, err := s.newRequest(ctx, http.MethodDelete, key)
+	if err != nil {
+		return err
+	}
+
+	resp, err := s.pipeline.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if !runtime.HasStatusCode(resp, http.StatusAccepted, http.StatusNotFound) {
+		return runtime.NewResponseError(resp)
+	}
+
+	return nil
+}
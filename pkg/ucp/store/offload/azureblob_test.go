@@ -0,0 +1,111 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package offload
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// newFakeBlobService returns a server which implements the subset of the Blob service REST API used by
+// AzureBlobStore. It only accepts requests carrying the SAS token "sig=test".
+func newFakeBlobService(t *testing.T) *httptest.Server {
+	mutex := sync.Mutex{}
+	blobs := map[string][]byte{}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mutex.Lock()
+		defer mutex.Unlock()
+
+		if r.URL.Query().Get("sig") != "test" {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+
+		switch r.Method {
+		case http.MethodPut:
+			if r.Header.Get("x-ms-blob-type") != "BlockBlob" {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			b, _ := io.ReadAll(r.Body)
+			blobs[r.URL.Path] = b
+			w.WriteHeader(http.StatusCreated)
+		case http.MethodGet:
+			b, ok := blobs[r.URL.Path]
+			if !ok {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			_, _ = w.Write(b)
+		case http.MethodDelete:
+			if _, ok := blobs[r.URL.Path]; !ok {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			delete(blobs, r.URL.Path)
+			w.WriteHeader(http.StatusAccepted)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+func TestAzureBlobStore(t *testing.T) {
+	server := newFakeBlobService(t)
+	ctx := context.Background()
+
+	blobs, err := NewAzureBlobStore(server.URL+"/payloads?sig=test", nil, nil)
+	require.NoError(t, err)
+
+	err = blobs.Put(ctx, "id/hash", []byte(`{"name":"test"}`))
+	require.NoError(t, err)
+
+	b, err := blobs.Get(ctx, "id/hash")
+	require.NoError(t, err)
+	require.Equal(t, `{"name":"test"}`, string(b))
+
+	err = blobs.Delete(ctx, "id/hash")
+	require.NoError(t, err)
+
+	_, err = blobs.Get(ctx, "id/hash")
+	require.Error(t, err)
+
+	// Deleting a blob which does not exist is not an error.
+	err = blobs.Delete(ctx, "id/hash")
+	require.NoError(t, err)
+
+	t.Run("unauthorized", func(t *testing.T) {
+		blobs, err := NewAzureBlobStore(server.URL+"/payloads", nil, nil)
+		require.NoError(t, err)
+
+		err = blobs.Put(ctx, "id/hash", []byte(`{}`))
+		require.Error(t, err)
+	})
+
+	t.Run("invalid container URL", func(t *testing.T) {
+		_, err := NewAzureBlobStore("payloads", nil, nil)
+		require.Error(t, err)
+	})
+}
@@ -0,0 +1,344 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package offload implements a store.StorageClient which keeps oversized payloads in object storage. Backing stores
+// such as etcd limit the size of a single value, while some resources (large base manifests, recipe outputs) can
+// exceed it. When the JSON payload of an object is larger than the configured threshold, the payload is written to
+// a BlobStore and the primary store only holds a small pointer to it. Reads transparently resolve the pointer.
+//
+// Blob keys include a hash of the payload, so a blob is never overwritten in place. This keeps the primary store
+// authoritative: if a conditional Save fails, the existing pointer still references the existing payload. Blobs that
+// are no longer referenced are deleted on a best-effort basis after the primary store is updated.
+//
+// Queries are evaluated by the primary store, which only sees the pointer of an offloaded payload. The values of
+// store.IndexedFields are therefore kept inline next to the pointer, so field selectors and filters on those fields
+// behave the same for offloaded objects. Filters on any other field are removed from the query sent to the primary
+// store and applied after the payloads are resolved; a page of results may then hold fewer items than requested.
+
+package offload
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/radius-project/radius/pkg/ucp/store"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+)
+
+const (
+	// DefaultThreshold is the default payload size in bytes above which the payload is offloaded. It is well below
+	// the 1.5MiB default value size limit of etcd to leave room for the object metadata.
+	DefaultThreshold = 512 * 1024
+
+	// pointerProperty is the property of the stored data which holds the pointer to an offloaded payload.
+	pointerProperty = "$offloadedPayload"
+)
+
+// BlobStore is the interface of the object storage (e.g. S3, Azure Blob Storage) that holds offloaded payloads.
+type BlobStore interface {
+	// Put writes the blob with the given key.
+	Put(ctx context.Context, key string, data []byte) error
+	// Get reads the blob with the given key.
+	Get(ctx context.Context, key string) ([]byte, error)
+	// Delete deletes the blob with the given key. Deleting a blob which does not exist is not an error.
+	Delete(ctx context.Context, key string) error
+}
+
+// Options is the options to create the offloading storage client.
+type Options struct {
+	// Threshold is the payload size in bytes above which the payload is offloaded. DefaultThreshold is used if zero.
+	Threshold int
+}
+
+var _ store.StorageClient = (*StorageClient)(nil)
+
+// StorageClient is a store.StorageClient which offloads oversized payloads to a BlobStore.
+type StorageClient struct {
+	inner store.StorageClient
+	blobs BlobStore
+
+	threshold int
+}
+
+// NewStorageClient creates a StorageClient which stores objects in inner and offloads payloads larger than the
+// threshold to blobs.
+func NewStorageClient(inner store.StorageClient, blobs BlobStore, options Options) *StorageClient {
+	if options.Threshold == 0 {
+		options.Threshold = DefaultThreshold
+	}
+
+	return &StorageClient{inner: inner, blobs: blobs, threshold: options.Threshold}
+}
+
+// pointer references an offloaded payload.
+type pointer struct {
+	// Key is the key of the blob holding the payload.
+	Key string `json:"key"`
+	// Size is the size of the payload in bytes.
+	Size int `json:"size"`
+}
+
+// blobKey returns the blob key of the payload of the object with the given id.
+func blobKey(id string, data []byte) string {
+	idHash := sha256.Sum256([]byte(strings.ToLower(id)))
+	dataHash := sha256.Sum256(data)
+	return hex.EncodeToString(idHash[:]) + "/" + hex.EncodeToString(dataHash[:])
+}
+
+// pointerFromData returns the pointer stored in the given object data, or nil if the payload is not offloaded.
+func pointerFromData(data any) *pointer {
+	m, ok := data.(map[string]any)
+	if !ok {
+		return nil
+	}
+
+	p, ok := m[pointerProperty].(map[string]any)
+	if !ok {
+		return nil
+	}
+
+	key, ok := p["key"].(string)
+	if !ok || key == "" {
+		return nil
+	}
+
+	result := &pointer{Key: key}
+	switch size := p["size"].(type) {
+	case int:
+		result.Size = size
+	case float64:
+		result.Size = int(size)
+	}
+
+	return result
+}
+
+// pointerData returns the data stored in the primary store for the offloaded payload of obj: the pointer, and the
+// values of the indexed fields so that the primary store can evaluate queries on them.
+func pointerData(obj *store.Object, p pointer) (map[string]any, error) {
+	data := map[string]any{
+		pointerProperty: map[string]any{
+			"key":  p.Key,
+			"size": p.Size,
+		},
+	}
+
+	values, err := obj.IndexedValues()
+	if err != nil {
+		return nil, err
+	}
+
+	for field, value := range values {
+		current := data
+		segments := strings.Split(field, ".")
+		for _, segment := range segments[:len(segments)-1] {
+			next, ok := current[segment].(map[string]any)
+			if !ok {
+				next = map[string]any{}
+				current[segment] = next
+			}
+			current = next
+		}
+		current[segments[len(segments)-1]] = value
+	}
+
+	return data, nil
+}
+
+// isIndexedField returns true if the values of the field are kept inline for offloaded payloads.
+func isIndexedField(field string) bool {
+	for _, indexed := range store.IndexedFields {
+		if strings.EqualFold(indexed, field) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// resolve replaces the data of the object with the offloaded payload if the object holds a pointer.
+func (c *StorageClient) resolve(ctx context.Context, obj *store.Object) error {
+	p := pointerFromData(obj.Data)
+	if p == nil {
+		return nil
+	}
+
+	b, err := c.blobs.Get(ctx, p.Key)
+	if err != nil {
+		return fmt.Errorf("failed to read offloaded payload of %q: %w", obj.ID, err)
+	}
+
+	var data any
+	if err := json.Unmarshal(b, &data); err != nil {
+		return fmt.Errorf("failed to decode offloaded payload of %q: %w", obj.ID, err)
+	}
+
+	obj.Data = data
+	return nil
+}
+
+// existingPointer returns the pointer of the object with the given id in the primary store, or nil if the object
+// does not exist or its payload is not offloaded.
+func (c *StorageClient) existingPointer(ctx context.Context, id string) (*pointer, error) {
+	obj, err := c.inner.Get(ctx, id)
+	if errors.Is(err, &store.ErrNotFound{}) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	return pointerFromData(obj.Data), nil
+}
+
+// deleteBlob deletes the blob on a best-effort basis. A blob which fails to be deleted is only orphaned.
+func (c *StorageClient) deleteBlob(ctx context.Context, key string) {
+	if err := c.blobs.Delete(ctx, key); err != nil {
+		logger := ucplog.FromContextOrDiscard(ctx)
+		logger.Error(err, "failed to delete offloaded payload", "key", key)
+	}
+}
+
+// Query queries the primary store and resolves the offloaded payloads of the returned objects.
+func (c *StorageClient) Query(ctx context.Context, query store.Query, options ...store.QueryOptions) (*store.ObjectQueryResult, error) {
+	// Only filters on the inlined fields can be evaluated by the primary store.
+	inner := query
+	inner.Filters = nil
+	resolved := []store.QueryFilter{}
+	for _, filter := range query.Filters {
+		if isIndexedField(filter.Field) {
+			inner.Filters = append(inner.Filters, filter)
+		} else {
+			resolved = append(resolved, filter)
+		}
+	}
+
+	result, err := c.inner.Query(ctx, inner, options...)
+	if err != nil {
+		return nil, err
+	}
+
+	items := []store.Object{}
+	for i := range result.Items {
+		if err := c.resolve(ctx, &result.Items[i]); err != nil {
+			return nil, err
+		}
+
+		match, err := result.Items[i].MatchesFilters(resolved)
+		if err != nil {
+			return nil, err
+		}
+
+		if match {
+			items = append(items, result.Items[i])
+		}
+	}
+	result.Items = items
+
+	return result, nil
+}
+
+// Get gets the object from the primary store and resolves its offloaded payload.
+func (c *StorageClient) Get(ctx context.Context, id string, options ...store.GetOptions) (*store.Object, error) {
+	obj, err := c.inner.Get(ctx, id, options...)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := c.resolve(ctx, obj); err != nil {
+		return nil, err
+	}
+
+	return obj, nil
+}
+
+// Delete deletes the object from the primary store and then deletes its offloaded payload.
+func (c *StorageClient) Delete(ctx context.Context, id string, options ...store.DeleteOptions) error {
+	existing, err := c.existingPointer(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if err := c.inner.Delete(ctx, id, options...); err != nil {
+		return err
+	}
+
+	if existing != nil {
+		c.deleteBlob(ctx, existing.Key)
+	}
+
+	return nil
+}
+
+// Save saves the object to the primary store. If the payload is larger than the threshold, the payload is written to
+// the BlobStore first and the primary store holds a pointer to it. The ETag of obj is updated as with the primary store.
+func (c *StorageClient) Save(ctx context.Context, obj *store.Object, options ...store.SaveOptions) error {
+	if obj == nil {
+		return &store.ErrInvalid{Message: "invalid argument. 'obj' is required"}
+	}
+
+	b, err := json.Marshal(obj.Data)
+	if err != nil {
+		return err
+	}
+
+	existing, err := c.existingPointer(ctx, obj.ID)
+	if err != nil {
+		return err
+	}
+
+	if len(b) <= c.threshold {
+		if err := c.inner.Save(ctx, obj, options...); err != nil {
+			return err
+		}
+
+		if existing != nil {
+			c.deleteBlob(ctx, existing.Key)
+		}
+		return nil
+	}
+
+	p := pointer{Key: blobKey(obj.ID, b), Size: len(b)}
+	if err := c.blobs.Put(ctx, p.Key, b); err != nil {
+		return fmt.Errorf("failed to write offloaded payload of %q: %w", obj.ID, err)
+	}
+
+	data, err := pointerData(obj, p)
+	if err != nil {
+		return err
+	}
+
+	stored := &store.Object{Metadata: obj.Metadata, Data: data}
+
+	if err := c.inner.Save(ctx, stored, options...); err != nil {
+		// The new blob is not referenced by the primary store unless it is identical to the existing one.
+		if existing == nil || existing.Key != p.Key {
+			c.deleteBlob(ctx, p.Key)
+		}
+		return err
+	}
+	obj.Metadata = stored.Metadata
+
+	if existing != nil && existing.Key != p.Key {
+		c.deleteBlob(ctx, existing.Key)
+	}
+
+	return nil
+}
@@ -0,0 +1,271 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package offload
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/radius-project/radius/pkg/ucp/store"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+const testID = "/planes/radius/local/resourceGroups/test-rg/providers/Applications.Core/containers/test"
+
+// fakeBlobStore is an in-memory BlobStore.
+type fakeBlobStore struct {
+	blobs  map[string][]byte
+	putErr error
+}
+
+func newFakeBlobStore() *fakeBlobStore {
+	return &fakeBlobStore{blobs: map[string][]byte{}}
+}
+
+func (f *fakeBlobStore) Put(ctx context.Context, key string, data []byte) error {
+	if f.putErr != nil {
+		return f.putErr
+	}
+	f.blobs[key] = data
+	return nil
+}
+
+func (f *fakeBlobStore) Get(ctx context.Context, key string) ([]byte, error) {
+	b, ok := f.blobs[key]
+	if !ok {
+		return nil, errors.New("blob not found")
+	}
+	return b, nil
+}
+
+func (f *fakeBlobStore) Delete(ctx context.Context, key string) error {
+	delete(f.blobs, key)
+	return nil
+}
+
+// setupInner configures the mock primary store to keep objects in a map.
+func setupInner(t *testing.T) (*store.MockStorageClient, map[string]*store.Object) {
+	mctrl := gomock.NewController(t)
+	inner := store.NewMockStorageClient(mctrl)
+	objects := map[string]*store.Object{}
+
+	inner.EXPECT().
+		Get(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, id string, _ ...store.GetOptions) (*store.Object, error) {
+			obj, ok := objects[id]
+			if !ok {
+				return nil, &store.ErrNotFound{ID: id}
+			}
+			copied := *obj
+			return &copied, nil
+		}).AnyTimes()
+	inner.EXPECT().
+		Save(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, obj *store.Object, _ ...store.SaveOptions) error {
+			obj.ETag = "etag"
+			copied := *obj
+			objects[obj.ID] = &copied
+			return nil
+		}).AnyTimes()
+	inner.EXPECT().
+		Delete(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, id string, _ ...store.DeleteOptions) error {
+			delete(objects, id)
+			return nil
+		}).AnyTimes()
+	inner.EXPECT().
+		Query(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, query store.Query, _ ...store.QueryOptions) (*store.ObjectQueryResult, error) {
+			result := &store.ObjectQueryResult{}
+			for _, obj := range objects {
+				match, err := obj.MatchesFilters(query.AllFilters())
+				if err != nil {
+					return nil, err
+				}
+				if match {
+					result.Items = append(result.Items, *obj)
+				}
+			}
+			return result, nil
+		}).AnyTimes()
+
+	return inner, objects
+}
+
+func largeData() map[string]any {
+	return map[string]any{
+		"name": "test",
+		"properties": map[string]any{
+			"manifest": strings.Repeat("a", 200),
+		},
+	}
+}
+
+func TestSave_SmallPayload(t *testing.T) {
+	inner, objects := setupInner(t)
+	blobs := newFakeBlobStore()
+	client := NewStorageClient(inner, blobs, Options{Threshold: 100})
+
+	obj := &store.Object{Metadata: store.Metadata{ID: testID}, Data: map[string]any{"name": "test"}}
+	err := client.Save(context.Background(), obj)
+	require.NoError(t, err)
+
+	require.Empty(t, blobs.blobs)
+	require.Equal(t, map[string]any{"name": "test"}, objects[testID].Data)
+	require.Equal(t, "etag", obj.ETag)
+}
+
+func TestSave_LargePayload(t *testing.T) {
+	inner, objects := setupInner(t)
+	blobs := newFakeBlobStore()
+	client := NewStorageClient(inner, blobs, Options{Threshold: 100})
+	ctx := context.Background()
+
+	obj := &store.Object{Metadata: store.Metadata{ID: testID}, Data: largeData()}
+	err := client.Save(ctx, obj)
+	require.NoError(t, err)
+	require.Equal(t, "etag", obj.ETag)
+
+	// The primary store only holds the pointer.
+	require.Len(t, blobs.blobs, 1)
+	p := pointerFromData(objects[testID].Data)
+	require.NotNil(t, p)
+	require.Contains(t, blobs.blobs, p.Key)
+
+	t.Run("get resolves the payload", func(t *testing.T) {
+		actual, err := client.Get(ctx, testID)
+		require.NoError(t, err)
+		require.Equal(t, largeData(), actual.Data)
+	})
+
+	t.Run("query resolves the payload", func(t *testing.T) {
+		result, err := client.Query(ctx, store.Query{RootScope: "/planes/radius/local/resourceGroups/test-rg"})
+		require.NoError(t, err)
+		require.Len(t, result.Items, 1)
+		require.Equal(t, largeData(), result.Items[0].Data)
+	})
+
+	t.Run("update deletes the previous payload", func(t *testing.T) {
+		updated := largeData()
+		updated["properties"].(map[string]any)["manifest"] = strings.Repeat("b", 200)
+		err := client.Save(ctx, &store.Object{Metadata: store.Metadata{ID: testID}, Data: updated})
+		require.NoError(t, err)
+
+		require.Len(t, blobs.blobs, 1)
+		require.NotContains(t, blobs.blobs, p.Key)
+
+		actual, err := client.Get(ctx, testID)
+		require.NoError(t, err)
+		require.Equal(t, updated, actual.Data)
+	})
+
+	t.Run("shrinking deletes the payload", func(t *testing.T) {
+		err := client.Save(ctx, &store.Object{Metadata: store.Metadata{ID: testID}, Data: map[string]any{"name": "test"}})
+		require.NoError(t, err)
+		require.Empty(t, blobs.blobs)
+	})
+
+	t.Run("delete deletes the payload", func(t *testing.T) {
+		err := client.Save(ctx, &store.Object{Metadata: store.Metadata{ID: testID}, Data: largeData()})
+		require.NoError(t, err)
+		require.Len(t, blobs.blobs, 1)
+
+		err = client.Delete(ctx, testID)
+		require.NoError(t, err)
+		require.Empty(t, blobs.blobs)
+		require.Empty(t, objects)
+	})
+}
+
+func TestQuery_Filters(t *testing.T) {
+	inner, objects := setupInner(t)
+	client := NewStorageClient(inner, newFakeBlobStore(), Options{Threshold: 100})
+	ctx := context.Background()
+
+	app := "/planes/radius/local/resourceGroups/test-rg/providers/Applications.Core/applications/app"
+	data := largeData()
+	data["properties"].(map[string]any)["application"] = app
+	data["properties"].(map[string]any)["compute"] = map[string]any{"namespace": "default"}
+
+	err := client.Save(ctx, &store.Object{Metadata: store.Metadata{ID: testID}, Data: data})
+	require.NoError(t, err)
+
+	// The indexed fields are kept inline next to the pointer, other fields are only in the payload.
+	stored := objects[testID].Data.(map[string]any)
+	require.NotNil(t, pointerFromData(stored))
+	require.Equal(t, map[string]any{"application": app}, stored["properties"])
+
+	tests := []struct {
+		name     string
+		query    store.Query
+		expected int
+	}{
+		{
+			name:     "indexed field",
+			query:    store.Query{Filters: []store.QueryFilter{{Field: store.FieldApplication, Value: app}}},
+			expected: 1,
+		},
+		{
+			name:     "selector",
+			query:    store.Query{Selector: store.FieldSelector{Application: app}},
+			expected: 1,
+		},
+		{
+			name:     "field in payload",
+			query:    store.Query{Filters: []store.QueryFilter{{Field: "properties.compute.namespace", Value: "default"}}},
+			expected: 1,
+		},
+		{
+			name: "no match",
+			query: store.Query{Filters: []store.QueryFilter{
+				{Field: store.FieldApplication, Value: app},
+				{Field: "properties.compute.namespace", Value: "other"},
+			}},
+			expected: 0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := client.Query(ctx, tt.query)
+			require.NoError(t, err)
+			require.Len(t, result.Items, tt.expected)
+			for _, item := range result.Items {
+				require.Equal(t, data, item.Data)
+			}
+		})
+	}
+}
+
+func TestSave_BlobStoreFailure(t *testing.T) {
+	inner, objects := setupInner(t)
+	blobs := newFakeBlobStore()
+	blobs.putErr = errors.New("unavailable")
+	client := NewStorageClient(inner, blobs, Options{Threshold: 100})
+
+	err := client.Save(context.Background(), &store.Object{Metadata: store.Metadata{ID: testID}, Data: largeData()})
+	require.ErrorContains(t, err, "unavailable")
+	require.Empty(t, objects)
+}
+
+func TestNewStorageClient_DefaultThreshold(t *testing.T) {
+	client := NewStorageClient(nil, nil, Options{})
+	require.Equal(t, DefaultThreshold, client.threshold)
+}
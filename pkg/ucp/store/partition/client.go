@@ -0,0 +1,260 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package partition implements a store.StorageClient which isolates the objects of multiple tenants sharing a
+// control plane. The partition of an operation is read from its context, where it is set from the tenant of an
+// authenticated request (see WithPartition).
+//
+// Objects are stored under their resource id with a leading scope segment holding the partition, eg:
+// /planes/radius/local/resourceGroups/rg is stored as /planes/partitions/<partition>/radius/local/resourceGroups/rg.
+// Every backing store therefore keeps the objects of a partition under a distinct key prefix, and queries are scoped
+// to the partition of the caller. Callers always see the original resource ids.
+//
+// Operations without a partition use the default partition, where objects are stored under their resource id
+// unchanged. This keeps the data of a control plane readable when partitioning is enabled. Resource ids can not
+// address another partition directly: ids which start with a partition scope are rejected, and queries in the
+// default partition omit the objects of other partitions.
+
+package partition
+
+import (
+	"context"
+	"strings"
+
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/radius-project/radius/pkg/ucp/store"
+)
+
+// ScopeType is the type of the scope segment which holds the partition in the keys of partitioned objects.
+const ScopeType = "partitions"
+
+type partitionKey struct{}
+
+// WithPartition returns a copy of the context in which storage operations use the given partition. An empty
+// partition selects the default partition.
+func WithPartition(ctx context.Context, partition string) context.Context {
+	return context.WithValue(ctx, partitionKey{}, strings.ToLower(partition))
+}
+
+// FromContext returns the partition of the context, or an empty string for the default partition.
+func FromContext(ctx context.Context) string {
+	partition, _ := ctx.Value(partitionKey{}).(string)
+	return partition
+}
+
+var _ store.StorageClient = (*StorageClient)(nil)
+
+// StorageClient is a store.StorageClient which stores objects in the partition of the caller.
+type StorageClient struct {
+	inner store.StorageClient
+}
+
+// NewStorageClient creates a StorageClient which stores the objects of each partition in inner.
+func NewStorageClient(inner store.StorageClient) *StorageClient {
+	return &StorageClient{inner: inner}
+}
+
+// Query queries the objects of the partition of the caller.
+func (c *StorageClient) Query(ctx context.Context, query store.Query, options ...store.QueryOptions) (*store.ObjectQueryResult, error) {
+	partition := FromContext(ctx)
+
+	if query.RootScope != "" {
+		rootScope, err := partitionedID(partition, query.RootScope)
+		if err != nil {
+			return nil, err
+		}
+		query.RootScope = rootScope
+	}
+
+	result, err := c.inner.Query(ctx, query, options...)
+	if err != nil {
+		return nil, err
+	}
+
+	items := []store.Object{}
+	for _, item := range result.Items {
+		id, ok := originalID(partition, item.ID)
+		if !ok {
+			// The object belongs to another partition. This happens for recursive queries in the default partition.
+			continue
+		}
+		item.ID = id
+		items = append(items, item)
+	}
+	result.Items = items
+
+	return result, nil
+}
+
+// Get gets the object with the given id from the partition of the caller.
+func (c *StorageClient) Get(ctx context.Context, id string, options ...store.GetOptions) (*store.Object, error) {
+	partition := FromContext(ctx)
+	key, err := partitionedID(partition, id)
+	if err != nil {
+		return nil, err
+	}
+
+	obj, err := c.inner.Get(ctx, key, options...)
+	if err != nil {
+		return nil, notFound(err, id)
+	}
+	obj.ID = id
+
+	return obj, nil
+}
+
+// Delete deletes the object with the given id from the partition of the caller.
+func (c *StorageClient) Delete(ctx context.Context, id string, options ...store.DeleteOptions) error {
+	key, err := partitionedID(FromContext(ctx), id)
+	if err != nil {
+		return err
+	}
+
+	return notFound(c.inner.Delete(ctx, key, options...), id)
+}
+
+// Save saves the object to the partition of the caller. obj is not modified except for its ETag, which is updated
+// as with the inner store.
+func (c *StorageClient) Save(ctx context.Context, obj *store.Object, options ...store.SaveOptions) error {
+	if obj == nil {
+		return &store.ErrInvalid{Message: "invalid argument. 'obj' is required"}
+	}
+
+	key, err := partitionedID(FromContext(ctx), obj.ID)
+	if err != nil {
+		return err
+	}
+
+	stored := &store.Object{Metadata: obj.Metadata, Data: obj.Data}
+	stored.ID = key
+	if err := c.inner.Save(ctx, stored, options...); err != nil {
+		return notFound(err, obj.ID)
+	}
+	obj.ETag = stored.ETag
+
+	return nil
+}
+
+// partitionRootScopes are the root scopes of the keys of partitioned objects with UCP and ARM resource ids.
+var partitionRootScopes = []string{"/planes/" + ScopeType, "/" + ScopeType}
+
+// Partitions returns the partitions of the client which hold objects of the given resource type, starting with the
+// default partition. Background tasks use it to process the objects of every partition. A client which is not a
+// StorageClient only has the default partition.
+func Partitions(ctx context.Context, client store.StorageClient, resourceType string) ([]string, error) {
+	partitions := []string{""}
+	c, ok := client.(*StorageClient)
+	if !ok {
+		return partitions, nil
+	}
+
+	seen := map[string]bool{}
+	for _, rootScope := range partitionRootScopes {
+		query := store.Query{RootScope: rootScope, ScopeRecursive: true, ResourceType: resourceType}
+
+		token := ""
+		for {
+			result, err := c.inner.Query(ctx, query, store.WithPaginationToken(token))
+			if err != nil {
+				return nil, err
+			}
+
+			for _, item := range result.Items {
+				parsed, err := resources.Parse(item.ID)
+				if err != nil {
+					continue
+				}
+
+				scopes := parsed.ScopeSegments()
+				if len(scopes) == 0 || !strings.EqualFold(scopes[0].Type, ScopeType) {
+					continue
+				}
+
+				name := strings.ToLower(scopes[0].Name)
+				if !seen[name] {
+					seen[name] = true
+					partitions = append(partitions, name)
+				}
+			}
+
+			if result.PaginationToken == "" {
+				break
+			}
+			token = result.PaginationToken
+		}
+	}
+
+	return partitions, nil
+}
+
+// partitionedID returns the key of the resource id in the partition. Resource ids which start with a partition
+// scope are rejected so that callers can not address another partition.
+func partitionedID(partition string, id string) (string, error) {
+	parsed, err := resources.Parse(id)
+	if err != nil {
+		return "", &store.ErrInvalid{Message: "invalid argument. 'id' must be a valid resource id"}
+	}
+
+	scopes := parsed.ScopeSegments()
+	if len(scopes) > 0 && strings.EqualFold(scopes[0].Type, ScopeType) {
+		return "", &store.ErrInvalid{Message: "invalid argument. 'id' must not include a partition"}
+	}
+
+	if partition == "" {
+		return id, nil
+	}
+
+	if strings.Contains(partition, resources.SegmentSeparator) {
+		return "", &store.ErrInvalid{Message: "invalid argument. the partition must not include '/'"}
+	}
+
+	scopes = append([]resources.ScopeSegment{{Type: ScopeType, Name: partition}}, scopes...)
+	if parsed.IsUCPQualified() {
+		return resources.MakeUCPID(scopes, parsed.TypeSegments(), parsed.ExtensionSegments()), nil
+	}
+	return resources.MakeRelativeID(scopes, parsed.TypeSegments(), parsed.ExtensionSegments()), nil
+}
+
+// originalID returns the resource id of the key of an object in the partition. It returns false if the key
+// belongs to another partition.
+func originalID(partition string, key string) (string, bool) {
+	parsed, err := resources.Parse(key)
+	if err != nil {
+		return key, partition == ""
+	}
+
+	scopes := parsed.ScopeSegments()
+	partitioned := len(scopes) > 0 && strings.EqualFold(scopes[0].Type, ScopeType)
+	if partition == "" {
+		return key, !partitioned
+	}
+	if !partitioned || !strings.EqualFold(scopes[0].Name, partition) {
+		return "", false
+	}
+
+	if parsed.IsUCPQualified() {
+		return resources.MakeUCPID(scopes[1:], parsed.TypeSegments(), parsed.ExtensionSegments()), true
+	}
+	return resources.MakeRelativeID(scopes[1:], parsed.TypeSegments(), parsed.ExtensionSegments()), true
+}
+
+// notFound replaces the partitioned key in a not found error with the resource id of the caller.
+func notFound(err error, id string) error {
+	if _, ok := err.(*store.ErrNotFound); ok {
+		return &store.ErrNotFound{ID: id}
+	}
+	return err
+}
@@ -0,0 +1,189 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package partition
+
+import (
+	"context"
+	"testing"
+
+	"github.com/radius-project/radius/pkg/ucp/store"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+const (
+	testID            = "/planes/radius/local/resourceGroups/test-rg/providers/Applications.Core/containers/test"
+	testPartitionedID = "/planes/partitions/contoso/radius/local/resourceGroups/test-rg/providers/Applications.Core/containers/test"
+)
+
+// setupInner configures the mock store to keep objects in a map.
+func setupInner(t *testing.T) (*store.MockStorageClient, map[string]*store.Object) {
+	inner := store.NewMockStorageClient(gomock.NewController(t))
+	objects := map[string]*store.Object{}
+
+	inner.EXPECT().
+		Get(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, id string, _ ...store.GetOptions) (*store.Object, error) {
+			obj, ok := objects[id]
+			if !ok {
+				return nil, &store.ErrNotFound{ID: id}
+			}
+			copied := *obj
+			return &copied, nil
+		}).AnyTimes()
+	inner.EXPECT().
+		Save(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, obj *store.Object, _ ...store.SaveOptions) error {
+			obj.ETag = "etag"
+			copied := *obj
+			objects[obj.ID] = &copied
+			return nil
+		}).AnyTimes()
+	inner.EXPECT().
+		Delete(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, id string, _ ...store.DeleteOptions) error {
+			if _, ok := objects[id]; !ok {
+				return &store.ErrNotFound{ID: id}
+			}
+			delete(objects, id)
+			return nil
+		}).AnyTimes()
+	inner.EXPECT().
+		Query(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, query store.Query, _ ...store.QueryOptions) (*store.ObjectQueryResult, error) {
+			result := &store.ObjectQueryResult{}
+			for id, obj := range objects {
+				if len(id) >= len(query.RootScope) && id[:len(query.RootScope)] == query.RootScope {
+					result.Items = append(result.Items, *obj)
+				}
+			}
+			return result, nil
+		}).AnyTimes()
+
+	return inner, objects
+}
+
+func Test_WithPartition(t *testing.T) {
+	require.Equal(t, "", FromContext(context.Background()))
+	require.Equal(t, "contoso", FromContext(WithPartition(context.Background(), "Contoso")))
+}
+
+func Test_SaveAndGet(t *testing.T) {
+	inner, objects := setupInner(t)
+	client := NewStorageClient(inner)
+	ctx := WithPartition(context.Background(), "contoso")
+
+	obj := &store.Object{Metadata: store.Metadata{ID: testID}, Data: map[string]any{"name": "test"}}
+	err := client.Save(ctx, obj)
+	require.NoError(t, err)
+	require.Equal(t, testID, obj.ID)
+	require.Equal(t, store.ETag("etag"), obj.ETag)
+
+	require.Contains(t, objects, testPartitionedID)
+	require.NotContains(t, objects, testID)
+
+	got, err := client.Get(ctx, testID)
+	require.NoError(t, err)
+	require.Equal(t, testID, got.ID)
+	require.Equal(t, obj.Data, got.Data)
+
+	t.Run("other partition", func(t *testing.T) {
+		_, err := client.Get(WithPartition(context.Background(), "fabrikam"), testID)
+		require.ErrorIs(t, err, &store.ErrNotFound{ID: testID})
+	})
+
+	t.Run("default partition", func(t *testing.T) {
+		_, err := client.Get(context.Background(), testID)
+		require.ErrorIs(t, err, &store.ErrNotFound{ID: testID})
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		err := client.Delete(ctx, testID)
+		require.NoError(t, err)
+		require.Empty(t, objects)
+
+		err = client.Delete(ctx, testID)
+		require.ErrorIs(t, err, &store.ErrNotFound{ID: testID})
+	})
+}
+
+func Test_DefaultPartition(t *testing.T) {
+	inner, objects := setupInner(t)
+	client := NewStorageClient(inner)
+
+	err := client.Save(context.Background(), &store.Object{Metadata: store.Metadata{ID: testID}})
+	require.NoError(t, err)
+	require.Contains(t, objects, testID)
+}
+
+func Test_Query(t *testing.T) {
+	inner, _ := setupInner(t)
+	client := NewStorageClient(inner)
+	ctx := WithPartition(context.Background(), "contoso")
+
+	require.NoError(t, client.Save(ctx, &store.Object{Metadata: store.Metadata{ID: testID}}))
+	require.NoError(t, client.Save(context.Background(), &store.Object{Metadata: store.Metadata{ID: testID + "-default"}}))
+
+	t.Run("partition", func(t *testing.T) {
+		result, err := client.Query(ctx, store.Query{RootScope: "/planes", ScopeRecursive: true})
+		require.NoError(t, err)
+		require.Len(t, result.Items, 1)
+		require.Equal(t, testID, result.Items[0].ID)
+	})
+
+	t.Run("default partition", func(t *testing.T) {
+		result, err := client.Query(context.Background(), store.Query{RootScope: "/planes", ScopeRecursive: true})
+		require.NoError(t, err)
+		require.Len(t, result.Items, 1)
+		require.Equal(t, testID+"-default", result.Items[0].ID)
+	})
+}
+
+func Test_Partitions(t *testing.T) {
+	inner, _ := setupInner(t)
+	client := NewStorageClient(inner)
+
+	for _, partition := range []string{"", "contoso", "Fabrikam"} {
+		ctx := WithPartition(context.Background(), partition)
+		require.NoError(t, client.Save(ctx, &store.Object{Metadata: store.Metadata{ID: testID}}))
+		require.NoError(t, client.Save(ctx, &store.Object{Metadata: store.Metadata{ID: testID + "-2"}}))
+	}
+
+	partitions, err := Partitions(context.Background(), client, "Applications.Core/containers")
+	require.NoError(t, err)
+	require.Equal(t, "", partitions[0])
+	require.ElementsMatch(t, []string{"", "contoso", "fabrikam"}, partitions)
+
+	// Clients which are not partitioned only have the default partition.
+	partitions, err = Partitions(context.Background(), inner, "Applications.Core/containers")
+	require.NoError(t, err)
+	require.Equal(t, []string{""}, partitions)
+}
+
+func Test_InvalidID(t *testing.T) {
+	inner, _ := setupInner(t)
+	client := NewStorageClient(inner)
+
+	_, err := client.Get(context.Background(), testPartitionedID)
+	require.ErrorIs(t, err, &store.ErrInvalid{})
+
+	_, err = client.Query(context.Background(), store.Query{RootScope: "/planes/partitions/contoso"})
+	require.ErrorIs(t, err, &store.ErrInvalid{})
+
+	_, err = client.Get(WithPartition(context.Background(), "a/b"), testID)
+	require.ErrorIs(t, err, &store.ErrInvalid{})
+}
@@ -0,0 +1,147 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package store
+
+import (
+	"reflect"
+	"strings"
+)
+
+const (
+	// FieldApplication is the field path of the application a resource belongs to.
+	FieldApplication = "properties.application"
+
+	// FieldEnvironment is the field path of the environment a resource belongs to.
+	FieldEnvironment = "properties.environment"
+
+	// FieldProvisioningState is the field path of the provisioning state of a resource.
+	FieldProvisioningState = "properties.provisioningState"
+)
+
+// IDFields is the list of indexed fields that hold resource IDs. Resource IDs are case-insensitive, so storage
+// providers must compare the values of these fields ignoring case.
+var IDFields = []string{FieldApplication, FieldEnvironment}
+
+// IsIDField returns true if the field holds a resource ID.
+func IsIDField(field string) bool {
+	for _, f := range IDFields {
+		if f == field {
+			return true
+		}
+	}
+
+	return false
+}
+
+// IndexedFields is the list of fields that storage providers may maintain secondary indexes for. Each field
+// corresponds to one of the typed selectors of FieldSelector.
+var IndexedFields = []string{FieldApplication, FieldEnvironment, FieldProvisioningState}
+
+// FieldSelector selects resources using the well-known fields that are shared across resource types. Unlike
+// free-form Filters, storage providers can use secondary indexes to evaluate a FieldSelector without reading
+// every resource in the queried scope.
+//
+// Empty values are ignored.
+type FieldSelector struct {
+	// Application selects resources that belong to the application with the given resource id.
+	Application string
+
+	// Environment selects resources that belong to the environment with the given resource id.
+	Environment string
+
+	// ProvisioningState selects resources with the given provisioning state.
+	ProvisioningState string
+}
+
+// IsEmpty returns true if the selector does not select on any field.
+func (s FieldSelector) IsEmpty() bool {
+	return s.Application == "" && s.Environment == "" && s.ProvisioningState == ""
+}
+
+// Filters converts the selector to the equivalent list of query filters.
+func (s FieldSelector) Filters() []QueryFilter {
+	filters := []QueryFilter{}
+	if s.Application != "" {
+		filters = append(filters, QueryFilter{Field: FieldApplication, Value: s.Application})
+	}
+	if s.Environment != "" {
+		filters = append(filters, QueryFilter{Field: FieldEnvironment, Value: s.Environment})
+	}
+	if s.ProvisioningState != "" {
+		filters = append(filters, QueryFilter{Field: FieldProvisioningState, Value: s.ProvisioningState})
+	}
+
+	return filters
+}
+
+// AllFilters returns the filters of the query combined with the filters of its field selector.
+func (q Query) AllFilters() []QueryFilter {
+	if q.Selector.IsEmpty() {
+		return q.Filters
+	}
+
+	return append(append([]QueryFilter{}, q.Filters...), q.Selector.Filters()...)
+}
+
+// IndexedValues returns the values of the indexed fields of the object's data, keyed by field path. Fields that
+// are missing or are not strings are omitted.
+func (o Object) IndexedValues() (map[string]string, error) {
+	data, err := o.dataAsMap()
+	if err != nil {
+		return nil, err
+	}
+
+	values := map[string]string{}
+	for _, field := range IndexedFields {
+		value, ok := lookupField(data, field)
+		if !ok || value.Kind() != reflect.String {
+			continue
+		}
+
+		values[field] = value.String()
+	}
+
+	return values, nil
+}
+
+// lookupField returns the value at the dotted field path of data. The boolean is false when any segment of
+// the path is missing.
+func lookupField(data any, field string) (reflect.Value, bool) {
+	value := reflect.ValueOf(data)
+	for _, segment := range strings.Split(field, ".") {
+		if value.Kind() == reflect.Interface {
+			// Unwrap interface{}
+			value = reflect.ValueOf(value.Interface())
+		}
+
+		if value.Kind() != reflect.Map || value.Type().Key().Kind() != reflect.String {
+			return reflect.Value{}, false
+		}
+
+		value = value.MapIndex(reflect.ValueOf(segment).Convert(value.Type().Key()))
+		if !value.IsValid() {
+			return reflect.Value{}, false
+		}
+	}
+
+	if value.Kind() == reflect.Interface {
+		// Unwrap interface{}
+		value = reflect.ValueOf(value.Interface())
+	}
+
+	return value, value.IsValid()
+}
@@ -0,0 +1,108 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package store
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_FieldSelector_Filters(t *testing.T) {
+	require.True(t, FieldSelector{}.IsEmpty())
+	require.Empty(t, FieldSelector{}.Filters())
+
+	selector := FieldSelector{Application: "app", Environment: "env", ProvisioningState: "Succeeded"}
+	require.False(t, selector.IsEmpty())
+	expected := []QueryFilter{
+		{Field: FieldApplication, Value: "app"},
+		{Field: FieldEnvironment, Value: "env"},
+		{Field: FieldProvisioningState, Value: "Succeeded"},
+	}
+	require.Equal(t, expected, selector.Filters())
+}
+
+func Test_Query_AllFilters(t *testing.T) {
+	query := Query{Filters: []QueryFilter{{Field: "value", Value: "cool"}}}
+	require.Equal(t, query.Filters, query.AllFilters())
+
+	query.Selector = FieldSelector{Application: "app"}
+	expected := []QueryFilter{
+		{Field: "value", Value: "cool"},
+		{Field: FieldApplication, Value: "app"},
+	}
+	require.Equal(t, expected, query.AllFilters())
+	require.Len(t, query.Filters, 1)
+}
+
+func Test_Object_IndexedValues(t *testing.T) {
+	type properties struct {
+		Application       string `json:"application"`
+		ProvisioningState string `json:"provisioningState,omitempty"`
+	}
+	type resource struct {
+		Properties properties `json:"properties"`
+	}
+
+	cases := []struct {
+		Description string
+		Obj         *Object
+		Expected    map[string]string
+	}{
+		{
+			Description: "nil",
+			Obj:         &Object{},
+			Expected:    map[string]string{},
+		},
+		{
+			Description: "map",
+			Obj: &Object{Data: map[string]any{"properties": map[string]any{
+				"application":       "app",
+				"environment":       "env",
+				"provisioningState": "Succeeded",
+			}}},
+			Expected: map[string]string{
+				FieldApplication:       "app",
+				FieldEnvironment:       "env",
+				FieldProvisioningState: "Succeeded",
+			},
+		},
+		{
+			Description: "struct",
+			Obj:         &Object{Data: &resource{Properties: properties{Application: "app"}}},
+			Expected:    map[string]string{FieldApplication: "app"},
+		},
+		{
+			Description: "not_string",
+			Obj:         &Object{Data: map[string]any{"properties": map[string]any{"application": 3, "environment": "env"}}},
+			Expected:    map[string]string{FieldEnvironment: "env"},
+		},
+		{
+			Description: "properties_not_map",
+			Obj:         &Object{Data: map[string]any{"properties": "cool"}},
+			Expected:    map[string]string{},
+		},
+	}
+
+	for _, testcase := range cases {
+		t.Run(testcase.Description, func(t *testing.T) {
+			values, err := testcase.Obj.IndexedValues()
+			require.NoError(t, err)
+			require.Equal(t, testcase.Expected, values)
+		})
+	}
+}
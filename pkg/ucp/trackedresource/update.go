@@ -91,11 +91,13 @@ type trackedResourceState struct {
 	ID         string                         `json:"id"`
 	Name       string                         `json:"name"`
 	Type       string                         `json:"type"`
+	Tags       map[string]string              `json:"tags,omitempty"`
 	Properties trackedResourceStateProperties `json:"properties,omitempty"`
 }
 
 type trackedResourceStateProperties struct {
 	ProvisioningState *v1.ProvisioningState `json:"provisioningState,omitempty"`
+	Application       string                `json:"application,omitempty"`
 }
 
 // Update updates a tracked resource.
@@ -201,6 +203,10 @@ func (u *Updater) run(ctx context.Context, id resources.ID, trackingID resources
 		entry.AsyncProvisioningState = *data.Properties.ProvisioningState
 	}
 
+	// Capture the fields used to query tracked resources.
+	entry.Properties.Tags = data.Tags
+	entry.Properties.Application = data.Properties.Application
+
 	obj = &store.Object{
 		Metadata: store.Metadata{
 			ID: trackingID.String(),
@@ -67,10 +67,13 @@ func Test_Update(t *testing.T) {
 
 		apiVersion := "1234"
 		resource := map[string]any{
-			"id":         testID.String(),
-			"name":       testID.Name(),
-			"type":       testID.Type(),
-			"properties": map[string]any{},
+			"id":   testID.String(),
+			"name": testID.Name(),
+			"type": testID.Type(),
+			"tags": map[string]any{"env": "test"},
+			"properties": map[string]any{
+				"application": "/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/applications/test-app",
+			},
 		}
 
 		storeClient.EXPECT().
@@ -90,6 +93,8 @@ func Test_Update(t *testing.T) {
 				require.Equal(t, IDFor(testID).String(), dm.ID)
 				require.Equal(t, testID.String(), dm.Properties.ID)
 				require.Equal(t, apiVersion, dm.Properties.APIVersion)
+				require.Equal(t, map[string]string{"env": "test"}, dm.Properties.Tags)
+				require.Equal(t, "/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/applications/test-app", dm.Properties.Application)
 				return nil
 			}).
 			Times(1)
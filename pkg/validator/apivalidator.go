@@ -36,6 +36,9 @@ const (
 	// Therefore, resourceType = ucp
 	UCPEndpointType = "ucp/openapi"
 	UCPApiVersion   = "2023-10-01-preview"
+
+	// WarningHeaderKey is the response header carrying the deprecation notice of a preview api-version.
+	WarningHeaderKey = "Warning"
 )
 
 // Options represents the options for APIValidator.
@@ -104,6 +107,10 @@ func APIValidator(options Options) func(h http.Handler) http.Handler {
 				}
 				return
 			}
+
+			if stable, ok := options.SpecLoader.SupersedingVersion(resourceType, apiVersion); ok {
+				w.Header().Add(WarningHeaderKey, deprecatedAPIVersionWarning(apiVersion, resourceType, stable))
+			}
 			h.ServeHTTP(w, r)
 		}
 
@@ -148,6 +155,12 @@ func unsupportedAPIVersionResponse(apiVersion, resourceType string, supportedAPI
 	})
 }
 
+// deprecatedAPIVersionWarning returns the value of the Warning header for a request using a preview api-version superseded
+// by a stable one. 299 is the "miscellaneous persistent warning" code of RFC 7234.
+func deprecatedAPIVersionWarning(apiVersion, resourceType, stableAPIVersion string) string {
+	return fmt.Sprintf("299 - \"API version '%s' for type '%s' is deprecated. Use the stable api-version '%s' instead.\"", apiVersion, resourceType, stableAPIVersion)
+}
+
 func validationFailedResponse(qualifiedName string, valErrs []ValidationError) rest.Response {
 	errDetails := []v1.ErrorDetails{}
 
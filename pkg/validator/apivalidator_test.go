@@ -121,6 +121,17 @@ func runTest(t *testing.T, resourceIDUrl, targetScope, planeRootScope string, pr
 			responseCode:  http.StatusAccepted,
 			validationErr: nil,
 		},
+		{
+			desc:            "valid environment resource with stable api-version",
+			method:          http.MethodPut,
+			rootScope:       planeRootScope + resourceGroupResource,
+			route:           environmentResourceRoute,
+			apiVersion:      "2025-08-01",
+			contentFilePath: "put-environments-valid.json",
+			url:             resourceIDUrl,
+			responseCode:    http.StatusAccepted,
+			validationErr:   nil,
+		},
 		{
 			desc:            "valid environment resource",
 			method:          http.MethodPut,
@@ -215,7 +226,7 @@ func runTest(t *testing.T, resourceIDUrl, targetScope, planeRootScope string, pr
 			validationErr: &v1.ErrorResponse{
 				Error: v1.ErrorDetails{
 					Code:    "InvalidApiVersionParameter",
-					Message: "API version '2022-06-20-privatepreview' for type 'applications.core/environments' is not supported. The supported api-versions are '2023-10-01-preview'.",
+					Message: "API version '2022-06-20-privatepreview' for type 'applications.core/environments' is not supported. The supported api-versions are '2023-10-01-preview, 2025-08-01'.",
 				},
 			},
 		},
@@ -522,3 +533,59 @@ func runTest(t *testing.T, resourceIDUrl, targetScope, planeRootScope string, pr
 		})
 	}
 }
+
+func Test_APIValidator_DeprecatedAPIVersion(t *testing.T) {
+	l, err := LoadSpec(context.Background(), "applications.core", swagger.SpecFiles, []string{"/planes/{planeType}/{planeName}/resourceGroups/{resourceGroupName}"}, "rootScope")
+	require.NoError(t, err)
+
+	tests := []struct {
+		desc       string
+		route      string
+		url        string
+		apiVersion string
+		warning    string
+	}{
+		{
+			desc:       "preview api-version superseded by a stable one",
+			route:      environmentResourceRoute,
+			url:        ucpResourceGroupScopedResourceURL,
+			apiVersion: "2023-10-01-preview",
+			warning:    "299 - \"API version '2023-10-01-preview' for type 'applications.core/environments' is deprecated. Use the stable api-version '2025-08-01' instead.\"",
+		},
+		{
+			desc:       "stable api-version",
+			route:      environmentResourceRoute,
+			url:        ucpResourceGroupScopedResourceURL,
+			apiVersion: "2025-08-01",
+		},
+		{
+			desc:       "preview api-version of a resource type without stable api-version",
+			route:      "/providers/applications.core/applications/{applicationName}",
+			url:        "http://localhost:8080/planes/radius/local/resourceGroups/radius-test-rg/providers/applications.core/applications/app0",
+			apiVersion: "2023-10-01-preview",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			r := chi.NewRouter()
+			r.Route("/planes/{planeType}/{planeName}"+resourceGroupResource+tc.route, func(r chi.Router) {
+				r.Use(APIValidator(Options{
+					SpecLoader:         l,
+					ResourceTypeGetter: RadiusResourceTypeGetter,
+				}))
+				r.Get("/", func(w http.ResponseWriter, r *http.Request) {
+					w.WriteHeader(http.StatusOK)
+				})
+			})
+
+			req, err := http.NewRequestWithContext(context.Background(), http.MethodGet, tc.url+"?api-version="+tc.apiVersion, nil)
+			require.NoError(t, err)
+			r.ServeHTTP(w, req)
+
+			require.Equal(t, http.StatusOK, w.Result().StatusCode, "%s", w.Body.String())
+			require.Equal(t, tc.warning, w.Result().Header.Get(WarningHeaderKey))
+		})
+	}
+}
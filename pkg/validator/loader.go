@@ -23,6 +23,7 @@ import (
 	"fmt"
 	"io/fs"
 	"regexp"
+	"sort"
 	"strings"
 	"sync"
 
@@ -40,6 +41,14 @@ type Loader struct {
 	validators        map[string]validator
 	supportedVersions map[string][]string
 	providerName      string
+
+	// coveredTypes is the set of resource types whose operations are defined in a provider-wide openapi.json spec.
+	coveredTypes map[string]struct{}
+	// previewVersions is the set of validator keys loaded from preview specs.
+	previewVersions map[string]struct{}
+	// stableVersions is the list of stable versions of each resource type.
+	stableVersions map[string][]string
+
 	rootScopePrefixes []string
 	rootScopeParam    string
 	specFiles         fs.FS
@@ -53,10 +62,15 @@ func (l *Loader) Name() string {
 // // SupportedVersions returns a list of supported versions for the given resource type, or an empty list if the resource
 // type is not supported.
 func (l *Loader) SupportedVersions(resourceType string) []string {
-	if versions, ok := l.supportedVersions[resourceType]; ok {
+	if versions, ok := l.supportedVersions[strings.ToLower(resourceType)]; ok {
 		return versions
 	}
 
+	// A resource type defined by some versions of openapi.json only supports these versions.
+	if _, ok := l.coveredTypes[strings.ToLower(resourceType)]; ok {
+		return []string{}
+	}
+
 	// using the openapi key here as all the portable resource app models are defines as part of openapi.json.
 	if versions, ok := l.supportedVersions[getOpenapiKey(resourceType)]; ok {
 		return versions
@@ -72,6 +86,11 @@ func (l *Loader) GetValidator(resourceType, version string) (Validator, bool) {
 		return &v, true
 	}
 
+	// A resource type removed from a version of openapi.json is not supported by this version.
+	if _, ok := l.coveredTypes[strings.ToLower(resourceType)]; ok {
+		return nil, false
+	}
+
 	// using the openapi key here as all the portable resource app models are defines as part of openapi.json.
 	v, ok = l.validators[getValidatorKey(getOpenapiKey(resourceType), version)]
 	if ok {
@@ -80,6 +99,20 @@ func (l *Loader) GetValidator(resourceType, version string) (Validator, bool) {
 	return nil, false
 }
 
+// SupersedingVersion returns the latest stable version of the given resource type if the given version is a preview version
+// and the resource type has a stable version. Preview versions superseded by a stable version are deprecated.
+func (l *Loader) SupersedingVersion(resourceType, version string) (string, bool) {
+	if _, ok := l.previewVersions[getValidatorKey(resourceType, version)]; !ok {
+		return "", false
+	}
+
+	stable := l.stableVersions[strings.ToLower(resourceType)]
+	if len(stable) == 0 {
+		return "", false
+	}
+	return stable[len(stable)-1], true
+}
+
 // LoadSpec loads OpenAPI spec documents from the given FS and returns a Loader instance. If no spec documents are
 // found, an error is returned.
 func LoadSpec(ctx context.Context, providerName string, specs fs.FS, rootScopePrefixes []string, rootScopeParam string) (*Loader, error) {
@@ -88,6 +121,9 @@ func LoadSpec(ctx context.Context, providerName string, specs fs.FS, rootScopePr
 		providerName:      providerName,
 		validators:        map[string]validator{},
 		supportedVersions: map[string][]string{},
+		coveredTypes:      map[string]struct{}{},
+		previewVersions:   map[string]struct{}{},
+		stableVersions:    map[string][]string{},
 		rootScopePrefixes: rootScopePrefixes,
 		rootScopeParam:    rootScopeParam,
 		specFiles:         specs,
@@ -144,8 +180,7 @@ func LoadSpec(ctx context.Context, providerName string, specs fs.FS, rootScopePr
 		}
 
 		qualifiedType := parsed["provider"] + "/" + parsed["resourcetype"]
-		key := getValidatorKey(qualifiedType, parsed["version"])
-		l.validators[key] = validator{
+		v := validator{
 			TypeName:          qualifiedType,
 			APIVersion:        parsed["version"],
 			specDoc:           wDoc,
@@ -154,7 +189,29 @@ func LoadSpec(ctx context.Context, providerName string, specs fs.FS, rootScopePr
 			paramCache:        make(map[string]map[string]spec.Parameter),
 			paramCacheMu:      &sync.RWMutex{},
 		}
-		l.supportedVersions[qualifiedType] = append(l.supportedVersions[qualifiedType], parsed["version"])
+
+		// A provider-wide openapi.json spec is also registered for each resource type it defines, so that the versions
+		// of a resource type can be told apart when versions of the spec define different resource types.
+		types := []string{qualifiedType}
+		if parsed["resourcetype"] == "openapi" {
+			for _, t := range getResourceTypes(parsed["provider"], wDoc) {
+				l.coveredTypes[t] = struct{}{}
+				types = append(types, t)
+			}
+		}
+
+		for _, t := range types {
+			key := getValidatorKey(t, parsed["version"])
+			l.validators[key] = v
+			l.supportedVersions[t] = append(l.supportedVersions[t], parsed["version"])
+
+			switch parsed["state"] {
+			case "preview":
+				l.previewVersions[key] = struct{}{}
+			case "stable":
+				l.stableVersions[t] = append(l.stableVersions[t], parsed["version"])
+			}
+		}
 
 		return nil
 	})
@@ -180,6 +237,46 @@ func getOpenapiKey(resourceType string) string {
 	return s[0] + "/openapi"
 }
 
+// getResourceTypes returns the lower-cased resource types of the given provider whose operations are defined in the spec document.
+func getResourceTypes(provider string, doc *loads.Document) []string {
+	if doc.Spec().Paths == nil {
+		return []string{}
+	}
+
+	types := []string{}
+	seen := map[string]struct{}{}
+	for path, item := range doc.Spec().Paths.Paths {
+		// Only the last segment of a path with a POST operation alone is an action rather than a resource type.
+		isAction := item.Post != nil && item.Get == nil && item.Put == nil && item.Patch == nil && item.Delete == nil
+
+		segments := strings.Split(strings.ToLower(path), "/")
+		for i := 0; i < len(segments)-1; i++ {
+			if segments[i] != "providers" || segments[i+1] != strings.ToLower(provider) {
+				continue
+			}
+
+			// Type segments alternate with name parameters after the provider namespace.
+			typeSegments := []string{provider}
+			for j := i + 2; j < len(segments); j += 2 {
+				if j == len(segments)-1 && isAction && len(typeSegments) > 1 {
+					break
+				}
+				typeSegments = append(typeSegments, segments[j])
+			}
+
+			t := strings.Join(typeSegments, "/")
+			if _, ok := seen[t]; !ok && len(typeSegments) > 1 {
+				seen[t] = struct{}{}
+				types = append(types, t)
+			}
+			break
+		}
+	}
+
+	sort.Strings(types)
+	return types
+}
+
 func parseSpecFilePath(path string) map[string]string {
 	// OpenAPI specs are stored under swagger/ directory structure based on this spec - https://github.com/Azure/azure-rest-api-specs/wiki#directory-structure
 	// This regex extracts the information from the filepath.
@@ -79,3 +79,49 @@ func Test_Loader(t *testing.T) {
 	require.True(t, ok)
 	require.NotNil(t, v)
 }
+
+func Test_Loader_StableVersion(t *testing.T) {
+	l, err := LoadSpec(context.Background(), "applications.core", swagger.SpecFiles, []string{"{rootScope:.*}"}, "rootScope")
+	require.NoError(t, err)
+
+	t.Run("resource type defined by both versions", func(t *testing.T) {
+		for _, version := range []string{"2023-10-01-preview", "2025-08-01"} {
+			v, ok := l.GetValidator("applications.core/containers", version)
+			require.True(t, ok)
+			require.NotNil(t, v)
+		}
+		require.Equal(t, []string{"2023-10-01-preview", "2025-08-01"}, l.SupportedVersions("Applications.Core/containers"))
+
+		stable, ok := l.SupersedingVersion("applications.core/containers", "2023-10-01-preview")
+		require.True(t, ok)
+		require.Equal(t, "2025-08-01", stable)
+
+		_, ok = l.SupersedingVersion("applications.core/containers", "2025-08-01")
+		require.False(t, ok)
+	})
+
+	t.Run("resource type removed from the stable version", func(t *testing.T) {
+		_, ok := l.GetValidator("applications.core/applications", "2025-08-01")
+		require.False(t, ok)
+		require.Equal(t, []string{"2023-10-01-preview"}, l.SupportedVersions("applications.core/applications"))
+
+		_, ok = l.SupersedingVersion("applications.core/applications", "2023-10-01-preview")
+		require.False(t, ok)
+	})
+}
+
+func Test_GetResourceTypes(t *testing.T) {
+	l, err := LoadSpec(context.Background(), "applications.core", swagger.SpecFiles, []string{"{rootScope:.*}"}, "rootScope")
+	require.NoError(t, err)
+
+	v, ok := l.GetValidator("applications.core/openapi", "2025-08-01")
+	require.True(t, ok)
+
+	types := getResourceTypes("applications.core", v.(*validator).specDoc)
+	require.Equal(t, []string{
+		"applications.core/containers",
+		"applications.core/environments",
+		"applications.core/gateways",
+		"applications.core/operations",
+	}, types)
+}
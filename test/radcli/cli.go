@@ -218,6 +218,19 @@ func (cli *CLI) ResourceExpose(ctx context.Context, applicationName string, reso
 	return cli.RunCommand(ctx, args)
 }
 
+// ResourceExec runs a command in a resource of an application and returns the output of the command.
+func (cli *CLI) ResourceExec(ctx context.Context, applicationName string, resourceName string, command ...string) (string, error) {
+	args := []string{
+		"resource",
+		"exec",
+		"-a", applicationName,
+		"containers",
+		resourceName,
+		"--",
+	}
+	return cli.RunCommand(ctx, append(args, command...))
+}
+
 // RecipeList runs the "recipe list" command with the given environment name and returns the output as a string, returning
 // an error if the command fails.
 func (cli *CLI) RecipeList(ctx context.Context, envName string) (string, error) {
@@ -19,6 +19,7 @@ package storetest
 
 import (
 	"encoding/json"
+	"strings"
 	"testing"
 
 	"github.com/radius-project/radius/pkg/ucp/resources"
@@ -359,6 +360,51 @@ func RunTest(t *testing.T, client store.StorageClient, clear func(t *testing.T))
 		require.Empty(t, objs)
 	})
 
+	t.Run("query_with_field_selector", func(t *testing.T) {
+		clear(t)
+
+		app1 := ResourceGroup1Scope + "/providers/Applications.Core/applications/app1"
+		app2 := ResourceGroup1Scope + "/providers/Applications.Core/applications/app2"
+
+		obj1 := createObject(Resource1ID, map[string]any{"properties": map[string]any{"application": app1, "provisioningState": "Succeeded"}})
+		err := client.Save(ctx, &obj1)
+		require.NoError(t, err)
+
+		nested1 := createObject(NestedResource1ID, map[string]any{"properties": map[string]any{"application": app2, "provisioningState": "Succeeded"}})
+		err = client.Save(ctx, &nested1)
+		require.NoError(t, err)
+
+		obj2 := createObject(Resource2ID, map[string]any{"properties": map[string]any{"application": app1, "provisioningState": "Failed"}})
+		err = client.Save(ctx, &obj2)
+		require.NoError(t, err)
+
+		objs, err := client.Query(ctx, store.Query{RootScope: RadiusScope, ScopeRecursive: true, Selector: store.FieldSelector{Application: app1}})
+		require.NoError(t, err)
+		CompareObjectLists(t, []store.Object{obj1, obj2}, objs.Items)
+
+		objs, err = client.Query(ctx, store.Query{RootScope: RadiusScope, ScopeRecursive: true, Selector: store.FieldSelector{Application: app1, ProvisioningState: "Failed"}})
+		require.NoError(t, err)
+		CompareObjectLists(t, []store.Object{obj2}, objs.Items)
+
+		objs, err = client.Query(ctx, store.Query{RootScope: ResourceGroup1Scope, Selector: store.FieldSelector{ProvisioningState: "Succeeded"}})
+		require.NoError(t, err)
+		CompareObjectLists(t, []store.Object{obj1, nested1}, objs.Items)
+
+		// Resource IDs are case-insensitive, so the selector matches IDs stored in a different casing.
+		objs, err = client.Query(ctx, store.Query{RootScope: RadiusScope, ScopeRecursive: true, Selector: store.FieldSelector{Application: strings.ToUpper(app1)}})
+		require.NoError(t, err)
+		CompareObjectLists(t, []store.Object{obj1, obj2}, objs.Items)
+
+		// Updates must be reflected in the results.
+		obj2.Data = map[string]any{"properties": map[string]any{"application": app2, "provisioningState": "Failed"}}
+		err = client.Save(ctx, &obj2)
+		require.NoError(t, err)
+
+		objs, err = client.Query(ctx, store.Query{RootScope: RadiusScope, ScopeRecursive: true, Selector: store.FieldSelector{Application: app1}})
+		require.NoError(t, err)
+		CompareObjectLists(t, []store.Object{obj1}, objs.Items)
+	})
+
 	t.Run("query_planes", func(t *testing.T) {
 		clear(t)
 